@@ -0,0 +1,149 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conn
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// breakerThreshold is the number of consecutive failed requests to a peer after which its
+	// circuit is opened, and requests are no longer routed to it.
+	breakerThreshold = 5
+	// breakerCooldown is how long the circuit of a peer stays open. After that, requests are
+	// routed to the peer again, and the circuit is closed by the first one which succeeds, or
+	// opened again by the first one which fails.
+	breakerCooldown = 10 * time.Second
+	// latencyTTL is how long the latency of a peer is trusted without new requests to it. After
+	// that, the latency of the peer is unknown, so that requests are routed to it again and a
+	// peer which was slow for a while isn't avoided forever.
+	latencyTTL = 30 * time.Second
+	// latencyWeight is the weight of a new sample in the moving average of the latency.
+	latencyWeight = 0.2
+)
+
+// peerStats tracks the latency of the requests sent to a peer, and the state of its circuit.
+type peerStats struct {
+	sync.Mutex
+	// latency is the exponentially weighted moving average of the latency of the requests.
+	latency    time.Duration
+	lastSample time.Time
+	// failures is the number of consecutive failed requests.
+	failures  int
+	openUntil time.Time
+}
+
+// record adds a request to the stats. It returns whether the circuit was opened or closed by it.
+func (s *peerStats) record(latency time.Duration, failed bool, now time.Time) (opened, closed bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.lastSample.IsZero() || now.Sub(s.lastSample) > latencyTTL {
+		s.latency = latency
+	} else {
+		s.latency = time.Duration(latencyWeight*float64(latency) +
+			(1-latencyWeight)*float64(s.latency))
+	}
+	s.lastSample = now
+
+	if !failed {
+		closed = s.failures >= breakerThreshold
+		s.failures = 0
+		s.openUntil = time.Time{}
+		return false, closed
+	}
+	s.failures++
+	if s.failures >= breakerThreshold && !now.Before(s.openUntil) {
+		s.openUntil = now.Add(breakerCooldown)
+		return true, false
+	}
+	return false, false
+}
+
+// open returns whether the circuit is open.
+func (s *peerStats) open(now time.Time) bool {
+	s.Lock()
+	defer s.Unlock()
+	return now.Before(s.openUntil)
+}
+
+// avgLatency returns the average latency of the requests, or zero if it is unknown.
+func (s *peerStats) avgLatency(now time.Time) time.Duration {
+	s.Lock()
+	defer s.Unlock()
+	if s.lastSample.IsZero() || now.Sub(s.lastSample) > latencyTTL {
+		return 0
+	}
+	return s.latency
+}
+
+// isPeerFailure returns whether err means that the peer couldn't serve the request, as opposed to
+// an error returned by the peer while serving it.
+func isPeerFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// RecordRequest records the outcome of a request sent to the peer at start, which is used to
+// route the requests to the fastest peers and to open the circuit of a failing peer. If ctx is
+// done, the time spent so far is recorded as a latency, but the request isn't counted as failed.
+func (p *Pool) RecordRequest(ctx context.Context, start time.Time, err error) {
+	now := time.Now()
+	failed := err != nil && ctx.Err() == nil && isPeerFailure(err)
+	opened, closed := p.stats.record(now.Sub(start), failed, now)
+	switch {
+	case opened:
+		glog.Warningf("Circuit opened to %s after %d failed requests. Last error: %v",
+			p.Addr, breakerThreshold, err)
+	case closed:
+		glog.Infof("Circuit closed to %s", p.Addr)
+	}
+}
+
+// CircuitOpen returns whether requests should not be routed to the peer, because the recent
+// requests sent to it have failed.
+func (p *Pool) CircuitOpen() bool {
+	return p.stats.open(time.Now())
+}
+
+// Latency returns the average latency of the recent requests sent to the peer, or zero if no
+// request was sent to it recently.
+func (p *Pool) Latency() time.Duration {
+	return p.stats.avgLatency(time.Now())
+}
+
+// SortByLatency sorts the pools by increasing latency. The pools whose latency is unknown come
+// first, so that their latency gets measured.
+func SortByLatency(pools []*Pool) {
+	latencies := make(map[*Pool]time.Duration, len(pools))
+	for _, pl := range pools {
+		latencies[pl] = pl.Latency()
+	}
+	sort.SliceStable(pools, func(i, j int) bool {
+		return latencies[pools[i]] < latencies[pools[j]]
+	})
+}
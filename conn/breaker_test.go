@@ -0,0 +1,82 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerStatsBreaker(t *testing.T) {
+	var s peerStats
+	now := time.Now()
+	for i := 1; i < breakerThreshold; i++ {
+		opened, _ := s.record(time.Millisecond, true, now)
+		require.False(t, opened)
+	}
+	require.False(t, s.open(now))
+
+	opened, _ := s.record(time.Millisecond, true, now)
+	require.True(t, opened)
+	require.True(t, s.open(now))
+	require.True(t, s.open(now.Add(breakerCooldown-time.Millisecond)))
+
+	// Once the cooldown is over, the first failed request opens the circuit again.
+	now = now.Add(breakerCooldown)
+	require.False(t, s.open(now))
+	opened, _ = s.record(time.Millisecond, true, now)
+	require.True(t, opened)
+	require.True(t, s.open(now))
+
+	// And the first successful request closes it.
+	now = now.Add(breakerCooldown)
+	_, closed := s.record(time.Millisecond, false, now)
+	require.True(t, closed)
+	require.False(t, s.open(now))
+	opened, _ = s.record(time.Millisecond, true, now)
+	require.False(t, opened)
+}
+
+func TestPeerStatsLatency(t *testing.T) {
+	var s peerStats
+	now := time.Now()
+	require.Zero(t, s.avgLatency(now))
+
+	s.record(10*time.Millisecond, false, now)
+	require.Equal(t, 10*time.Millisecond, s.avgLatency(now))
+	s.record(110*time.Millisecond, false, now)
+	require.Equal(t, 30*time.Millisecond, s.avgLatency(now))
+
+	// The latency is forgotten after a while without requests.
+	now = now.Add(latencyTTL + time.Second)
+	require.Zero(t, s.avgLatency(now))
+	s.record(50*time.Millisecond, false, now)
+	require.Equal(t, 50*time.Millisecond, s.avgLatency(now))
+}
+
+func TestSortByLatency(t *testing.T) {
+	now := time.Now()
+	slow, fast, unknown := &Pool{Addr: "slow"}, &Pool{Addr: "fast"}, &Pool{Addr: "unknown"}
+	slow.stats.record(time.Second, false, now)
+	fast.stats.record(time.Millisecond, false, now)
+
+	pools := []*Pool{slow, fast, unknown}
+	SortByLatency(pools)
+	require.Equal(t, []*Pool{unknown, fast, slow}, pools)
+}
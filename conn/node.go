@@ -83,6 +83,9 @@ type Node struct {
 
 	heartbeatsOut int64
 	heartbeatsIn  int64
+
+	// leaderContact is the time in unix nanoseconds of the last message received from the leader.
+	leaderContact int64
 }
 
 // NewNode returns a new Node instance.
@@ -225,6 +228,11 @@ func (n *Node) ConfState() *raftpb.ConfState {
 	return n._confState
 }
 
+// LastLeaderContact returns the last time this node received a message from the leader.
+func (n *Node) LastLeaderContact() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&n.leaderContact))
+}
+
 // Peer returns the address of the peer with the given id.
 func (n *Node) Peer(pid uint64) (string, bool) {
 	n.RLock()
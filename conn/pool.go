@@ -54,6 +54,8 @@ type Pool struct {
 	Addr       string
 	closer     *z.Closer
 	healthInfo pb.HealthInfo
+	// stats tracks the requests sent to the peer, to route the requests to the fastest peers.
+	stats peerStats
 }
 
 // Pools manages a concurrency-safe set of Pool.
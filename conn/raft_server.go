@@ -240,6 +240,11 @@ func (w *RaftServer) RaftMessage(server pb.Raft_RaftMessageServer) error {
 					rc.GetId(), err)
 				return errors.Wrapf(err, "error while raft.Step from %#x", rc.GetId())
 			}
+			switch msg.Type {
+			case raftpb.MsgHeartbeat, raftpb.MsgApp, raftpb.MsgSnap:
+				// Only the leader sends these.
+				atomic.StoreInt64(&node.leaderContact, time.Now().UnixNano())
+			}
 			idx += sz
 		}
 		return nil
@@ -288,6 +293,7 @@ func (w *RaftServer) Heartbeat(_ *api.Payload, stream pb.Raft_HeartbeatServer) e
 		Group:    strconv.Itoa(int(node.RaftContext.GetGroup())),
 		Version:  x.Version(),
 		Uptime:   int64(time.Since(node.StartTime) / time.Second),
+		Learner:  node.RaftContext.GetIsLearner(),
 	}
 	if info.Group == "0" {
 		info.Instance = "zero"
@@ -44,13 +44,23 @@ import (
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func allowed(method string) bool {
 	return method == http.MethodPost || method == http.MethodPut
 }
 
+// setErrorStatus sets the HTTP status code of the response for the errors which have one, like the
+// errors returned when the rate limit of the user is exceeded.
+func setErrorStatus(w http.ResponseWriter, err error) {
+	if status.Code(err) == codes.ResourceExhausted {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+}
+
 // Common functionality for these request handlers. Returns true if the request is completely
 // handled here and nothing further needs to be done.
 func commonHandler(w http.ResponseWriter, r *http.Request) bool {
@@ -207,7 +217,12 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 
 	ctx := context.WithValue(r.Context(), query.DebugKey, isDebugMode)
 	ctx = x.AttachAccessJwt(ctx, r)
+	ctx = x.AttachNamespaceHeader(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
+	// The isolation level of the transaction: snapshot (default), serializable or read-committed.
+	if isolation := r.URL.Query().Get("isolation"); isolation != "" {
+		ctx = x.AttachIsolation(ctx, isolation)
+	}
 
 	if queryTimeout != 0 {
 		var cancel context.CancelFunc
@@ -242,11 +257,34 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		if isReadOnly {
 			req.ReadOnly = true
 		}
+
+		// If linearizable is set, a read-only query gets its timestamp from Zero, instead of
+		// reading at the latest timestamp known to this Alpha.
+		isLinearizable, err := parseBool(r, "linearizable")
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+			return
+		}
+		if isLinearizable {
+			ctx = x.AttachLinearizable(ctx)
+		}
+
+		// If maxStaleness is set, this Alpha can serve the query if it is not further behind
+		// the leader of its group.
+		maxStaleness, err := parseDuration(r, "maxStaleness")
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+			return
+		}
+		if maxStaleness > 0 {
+			ctx = x.AttachMaxStaleness(ctx, maxStaleness)
+		}
 	}
 
 	// Core processing happens here.
 	resp, err := (&edgraph.Server{}).Query(ctx, &req)
 	if err != nil {
+		setErrorStatus(w, err)
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
@@ -409,8 +447,14 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	req.CommitNow = commitNow
 
 	ctx := x.AttachAccessJwt(context.Background(), r)
+	ctx = x.AttachRemoteIP(ctx, r)
+	ctx = x.AttachNamespaceHeader(ctx, r)
+	if isolation := r.URL.Query().Get("isolation"); isolation != "" {
+		ctx = x.AttachIsolation(ctx, isolation)
+	}
 	resp, err := (&edgraph.Server{}).Query(ctx, req)
 	if err != nil {
+		setErrorStatus(w, err)
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
@@ -592,8 +636,10 @@ func alterHandler(w http.ResponseWriter, r *http.Request) {
 	// Pass in PoorMan's auth, ACL and IP information if present.
 	ctx := x.AttachAuthToken(context.Background(), r)
 	ctx = x.AttachAccessJwt(ctx, r)
+	ctx = x.AttachNamespaceHeader(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
 	if _, err := (&edgraph.Server{}).Alter(ctx, op); err != nil {
+		setErrorStatus(w, err)
 		x.SetStatus(w, x.Error, err.Error())
 		return
 	}
@@ -643,6 +689,11 @@ func resolveWithAdminServer(gqlReq *schema.Request, r *http.Request,
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
 	ctx = x.AttachAuthToken(ctx, r)
+	ctx = x.AttachNamespaceHeader(ctx, r)
+	ctx, _, err := edgraph.ResolveNamespace(ctx)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
 
 	return adminServer.Resolve(ctx, gqlReq)
 }
@@ -37,6 +37,7 @@ func loginHandler(w http.ResponseWriter, r *http.Request) {
 	// Pass in PoorMan's auth, IP information if present.
 	ctx := x.AttachRemoteIP(context.Background(), r)
 	ctx = x.AttachAuthToken(ctx, r)
+	ctx = x.AttachNamespaceHeader(ctx, r)
 
 	body := readRequest(w, r)
 	loginReq := api.LoginRequest{}
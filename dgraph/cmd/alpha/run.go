@@ -38,7 +38,9 @@ import (
 	badgerpb "github.com/dgraph-io/badger/v3/pb"
 	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/ee/audit"
 	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/ee/idp"
 	"github.com/dgraph-io/dgraph/graphql/admin"
 	"github.com/dgraph-io/dgraph/graphql/web"
 	"github.com/dgraph-io/dgraph/posting"
@@ -124,6 +126,11 @@ they form a Raft group and provide synchronous replication.
 	flag.String("export", "export", "Folder in which to store exports.")
 	flag.Int("pending_proposals", 256,
 		"Number of pending mutation proposals. Useful for rate limiting.")
+	flag.Float64("ip_rate_limit", 0, "The number of requests per second allowed from each "+
+		"client IP, for the requests which aren't sent by an ACL user. Zero means that they "+
+		"aren't limited. Enterprise feature.")
+	flag.Int64("ip_rate_burst", 0, "The max number of requests allowed at once from a client "+
+		"IP, limited by ip_rate_limit. Defaults to ip_rate_limit. Enterprise feature.")
 	flag.StringP("zero", "z", fmt.Sprintf("localhost:%d", x.PortZeroGrpc),
 		"Comma separated list of Dgraph zero addresses of the form IP_ADDRESS:PORT.")
 
@@ -135,6 +142,8 @@ they form a Raft group and provide synchronous replication.
 		not participate in Raft elections. This can be used to achieve a read-only replica.
 	snapshot-after=N would create a new Raft snapshot after N number of Raft entries.
 		The lower this number, the more frequent snapshot creation would be.
+	zone=Z provides the availability zone or rack of this Alpha. Zero spreads the replicas of
+		each group across zones, so that losing a zone doesn't lose the quorum of a group.
 	`)
 	flag.Int("max_retries", -1,
 		"Commits to disk will give up after these number of retries to prevent locking the worker"+
@@ -147,10 +156,51 @@ they form a Raft group and provide synchronous replication.
 	flag.String("acl_secret_file", "", "The file that stores the HMAC secret, "+
 		"which is used for signing the JWT and should have at least 32 ASCII characters. "+
 		"Enterprise feature.")
+	enc.RegisterACLFlags(flag)
 	flag.Duration("acl_access_ttl", 6*time.Hour, "The TTL for the access jwt. "+
 		"Enterprise feature.")
 	flag.Duration("acl_refresh_ttl", 30*24*time.Hour, "The TTL for the refresh jwt. "+
 		"Enterprise feature.")
+	flag.Int("acl_password_min_length", 6, "The min length of the passwords of the ACL users. "+
+		"Enterprise feature.")
+	flag.Int("acl_password_min_classes", 0, "The min number of character classes, out of lower "+
+		"case letters, upper case letters, digits and other characters, of the passwords of the "+
+		"ACL users. Enterprise feature.")
+	flag.Duration("acl_password_max_age", 0, "The age after which the passwords of the ACL "+
+		"users, except groot, expire and must be changed by a guardian. Zero means that they "+
+		"never expire. Enterprise feature.")
+	flag.Int("acl_lockout_threshold", 0, "The number of failed logins after which an ACL user "+
+		"is locked out of an Alpha. Zero means that the users are never locked out. "+
+		"Enterprise feature.")
+	flag.Duration("acl_lockout_duration", time.Minute, "How long the first lockout of an ACL "+
+		"user lasts. Every further failed login doubles it. Enterprise feature.")
+	flag.String("acl_cert_identity", "", "The field of the verified client certificates which "+
+		"identifies the ACL user of the requests without an access JWT: cn for the common name, "+
+		"or san for the first subject alternative name. The client certificates don't identify "+
+		"users if it is empty. Enterprise feature.")
+	flag.String("acl_cert_user_map", "", "Comma separated list of identity=user pairs mapping "+
+		"the identities of the client certificates to ACL users, where the user may end with "+
+		"@ and its namespace. The identities which aren't mapped are the user ids of the galaxy "+
+		"namespace.")
+	flag.String("acl_ldap_url", "", "The ldap:// or ldaps:// URL of the LDAP directory against "+
+		"which the users who aren't in Dgraph log in. Enterprise feature.")
+	flag.String("acl_ldap_user_dn", "", "The DN of the LDAP users, where %s stands for the "+
+		"user id, like uid=%s,ou=people,dc=example,dc=org.")
+	flag.String("acl_ldap_group_attr", "memberOf",
+		"The attribute of the LDAP users which holds their groups.")
+	flag.String("acl_oidc_jwks", "", "The file or the URL of the JSON Web Key Set of the OpenID "+
+		"Connect provider, whose ID tokens log users in when given as the password of a login "+
+		"without user id. Enterprise feature.")
+	flag.String("acl_oidc_issuer", "", "The issuer of the OpenID Connect ID tokens.")
+	flag.String("acl_oidc_audience", "", "The audience of the OpenID Connect ID tokens.")
+	flag.String("acl_oidc_user_claim", "sub", "The claim of the ID tokens holding the user id.")
+	flag.String("acl_oidc_groups_claim", "groups",
+		"The claim of the ID tokens holding the groups of the user.")
+	flag.String("acl_group_map", "", "Comma separated list of external=acl pairs mapping the "+
+		"groups of the LDAP or OpenID Connect users to ACL groups. The groups are used as they "+
+		"are if it is empty, except guardians, which must be mapped explicitly.")
+	flag.Uint64("acl_idp_namespace", 0, "The namespace which the LDAP or OpenID Connect users "+
+		"log into. Their logins into the other namespaces are rejected.")
 	flag.String("mutations", "allow",
 		"Set mutation mode to allow, disallow, or strict.")
 
@@ -190,6 +240,25 @@ they form a Raft group and provide synchronous replication.
 	flag.String("cache_percentage", "0,65,35,0",
 		`Cache percentages summing up to 100 for various caches (FORMAT:
 		PostingListCache,PstoreBlockCache,PstoreIndexCache,WAL).`)
+	flag.Int64("query_cache_mb", 0,
+		"Size of the cache of the results of read-only DQL queries, in MB. The cache is disabled"+
+			" if it is zero. A cached result is served until a predicate read by the query is"+
+			" written. It must be enabled on all the Alphas or on none of them.")
+	flag.String("audit_dir", "",
+		"Directory of the audit log of the queries, mutations, alter operations and admin"+
+			" requests. Audit logging is disabled if it is empty. Enterprise feature.")
+	flag.Int64("audit_max_size_mb", 100,
+		"Size of an audit log file, in MB, after which it is rotated.")
+	flag.Bool("audit_encrypt", false,
+		"Encrypt the audit log files with the key of the encryption_key_file or Vault options."+
+			" Enterprise feature.")
+	flag.String("audit_secret_file", "",
+		"The file that stores the HMAC secret which chains the lines of the audit log, of at"+
+			" least 32 ascii chars. Required by audit_dir. Enterprise feature.")
+	flag.String("audit_anchor_file", "",
+		"The file that stores the hash of the last line of the audit log, which detects its"+
+			" truncation. It should be kept apart from the audit log. Defaults to"+
+			" dgraph_audit.anchor in audit_dir. Enterprise feature.")
 
 	// TLS configurations
 	x.RegisterServerTLSFlags(flag)
@@ -293,6 +362,7 @@ func healthCheck(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 
 		ctx := x.AttachAccessJwt(context.Background(), r)
+		ctx = x.AttachRemoteIP(ctx, r)
 		var resp *api.Response
 		if resp, err = (&edgraph.Server{}).Health(ctx, true); err != nil {
 			x.SetStatus(w, x.Error, err.Error())
@@ -339,6 +409,7 @@ func stateHandler(w http.ResponseWriter, r *http.Request) {
 
 	ctx := context.Background()
 	ctx = x.AttachAccessJwt(ctx, r)
+	ctx = x.AttachRemoteIP(ctx, r)
 
 	var aResp *api.Response
 	if aResp, err = (&edgraph.Server{}).State(ctx); err != nil {
@@ -597,21 +668,24 @@ func run() {
 
 		MutationsMode: worker.AllowMutations,
 		AuthToken:     Alpha.Conf.GetString("auth_token"),
+		IPRateLimit:   Alpha.Conf.GetFloat64("ip_rate_limit"),
+		IPRateBurst:   Alpha.Conf.GetInt64("ip_rate_burst"),
 	}
 
-	secretFile := Alpha.Conf.GetString("acl_secret_file")
-	if secretFile != "" {
-		hmacSecret, err := ioutil.ReadFile(secretFile)
-		if err != nil {
-			glog.Fatalf("Unable to read HMAC secret from file: %v", secretFile)
-		}
-		if len(hmacSecret) < 32 {
-			glog.Fatalf("The HMAC secret file should contain at least 256 bits (32 ascii chars)")
-		}
-
+	hmacSecret, err := enc.ReadACLSecret(Alpha.Conf)
+	if err != nil {
+		glog.Fatalf("Unable to read the HMAC secret: %v", err)
+	}
+	aclEnabled := len(hmacSecret) > 0
+	if aclEnabled {
 		opts.HmacSecret = hmacSecret
 		opts.AccessJwtTtl = Alpha.Conf.GetDuration("acl_access_ttl")
 		opts.RefreshJwtTtl = Alpha.Conf.GetDuration("acl_refresh_ttl")
+		opts.PasswordMinLength = Alpha.Conf.GetInt("acl_password_min_length")
+		opts.PasswordMinClasses = Alpha.Conf.GetInt("acl_password_min_classes")
+		opts.PasswordMaxAge = Alpha.Conf.GetDuration("acl_password_max_age")
+		opts.LoginLockoutThreshold = Alpha.Conf.GetInt("acl_lockout_threshold")
+		opts.LoginLockoutDuration = Alpha.Conf.GetDuration("acl_lockout_duration")
 
 		glog.Info("HMAC secret loaded successfully.")
 	}
@@ -629,6 +703,7 @@ func run() {
 	}
 
 	worker.SetConfiguration(&opts)
+	x.Check(edgraph.InitQueryCache(Alpha.Conf.GetInt64("query_cache_mb") << 20))
 
 	ips, err := getIPsFromString(Alpha.Conf.GetString("whitelist"))
 	x.Check(err)
@@ -651,7 +726,7 @@ func run() {
 		WhiteListedIPRanges:  ips,
 		MaxRetries:           Alpha.Conf.GetInt("max_retries"),
 		StrictMutations:      opts.MutationsMode == worker.StrictMutations,
-		AclEnabled:           secretFile != "",
+		AclEnabled:           aclEnabled,
 		AbortOlderThan:       abortDur,
 		StartTime:            startTime,
 		LudicrousMode:        Alpha.Conf.GetBool("ludicrous_mode"),
@@ -669,6 +744,66 @@ func run() {
 		return
 	}
 
+	auditConf := audit.Config{
+		Dir:        Alpha.Conf.GetString("audit_dir"),
+		MaxSize:    Alpha.Conf.GetInt64("audit_max_size_mb") << 20,
+		AnchorFile: Alpha.Conf.GetString("audit_anchor_file"),
+	}
+	if auditConf.Dir != "" {
+		secretFile := Alpha.Conf.GetString("audit_secret_file")
+		if secretFile == "" {
+			glog.Errorf("audit_dir requires audit_secret_file")
+			return
+		}
+		if auditConf.Secret, err = ioutil.ReadFile(secretFile); err != nil {
+			glog.Errorf("unable to read the audit secret: %v", err)
+			return
+		}
+	}
+	if Alpha.Conf.GetBool("audit_encrypt") {
+		if x.WorkerConfig.EncryptionKey == nil {
+			glog.Errorf("audit_encrypt requires an encryption key")
+			return
+		}
+		auditConf.EncryptionKey = x.WorkerConfig.EncryptionKey
+	}
+	if err := audit.Init(auditConf); err != nil {
+		glog.Errorf("unable to init the audit log: %v", err)
+		return
+	}
+	defer audit.Close()
+
+	idpConf := idp.Config{
+		LDAP: idp.LDAPConfig{
+			URL:       Alpha.Conf.GetString("acl_ldap_url"),
+			UserDN:    Alpha.Conf.GetString("acl_ldap_user_dn"),
+			GroupAttr: Alpha.Conf.GetString("acl_ldap_group_attr"),
+		},
+		OIDC: idp.OIDCConfig{
+			Issuer:      Alpha.Conf.GetString("acl_oidc_issuer"),
+			Audience:    Alpha.Conf.GetString("acl_oidc_audience"),
+			JWKS:        Alpha.Conf.GetString("acl_oidc_jwks"),
+			UserClaim:   Alpha.Conf.GetString("acl_oidc_user_claim"),
+			GroupsClaim: Alpha.Conf.GetString("acl_oidc_groups_claim"),
+		},
+		GroupMap:  Alpha.Conf.GetString("acl_group_map"),
+		Namespace: Alpha.Conf.GetUint64("acl_idp_namespace"),
+	}
+	if !aclEnabled && (idpConf.LDAP.URL != "" || idpConf.OIDC.JWKS != "") {
+		glog.Fatalf("The external identity providers require ACLs, enabled by an ACL secret")
+	}
+	if err := idp.Init(idpConf); err != nil {
+		glog.Fatalf("unable to init the external identity providers: %v", err)
+	}
+	certIdentity := Alpha.Conf.GetString("acl_cert_identity")
+	if !aclEnabled && certIdentity != "" {
+		glog.Fatalf("The client certificate identities require ACLs, enabled by an ACL secret")
+	}
+	if err := edgraph.InitCertIdentity(certIdentity,
+		Alpha.Conf.GetString("acl_cert_user_map")); err != nil {
+		glog.Fatalf("unable to init the client certificate identities: %v", err)
+	}
+
 	setupCustomTokenizers()
 	x.Init()
 	x.Config.PortOffset = Alpha.Conf.GetInt("port_offset")
@@ -750,7 +885,7 @@ func run() {
 		}
 	}()
 
-	updaters := z.NewCloser(4)
+	updaters := z.NewCloser(5)
 	go func() {
 		worker.StartRaftNodes(worker.State.WALstore, bindall)
 		atomic.AddUint32(&initDone, 1)
@@ -774,6 +909,9 @@ func run() {
 	}()
 	// Listen for any new cors origin update.
 	go listenForCorsUpdate(updaters)
+	// Pick up rotated keys.
+	go enc.WatchKeys(Alpha.Conf, updaters, x.WorkerConfig.EncryptionKey, opts.HmacSecret,
+		worker.RotateEncryptionKey, worker.RotateHmacSecret)
 
 	// Graphql subscribes to alpha to get schema updates. We need to close that before we
 	// close alpha. This closer is for closing and waiting that subscription.
@@ -811,9 +949,9 @@ func listenForCorsUpdate(closer *z.Closer) {
 
 		kv := x.KvWithMaxVersion(kvs, [][]byte{prefix}, "CORS Subscription")
 		glog.Infof("Updating cors from subscription.")
-		// Unmarshal the incoming posting list.
+		// Unmarshal the incoming posting list, which may be compressed as per its @storage hint.
 		pl := &pb.PostingList{}
-		err := pl.Unmarshal(kv.GetValue())
+		err := posting.UnmarshalPostingList(kv.GetValue(), pl)
 		if err != nil {
 			glog.Errorf("Unable to unmarshal the posting list for cors update %s", err)
 			return
@@ -203,6 +203,8 @@ func run() {
 		} else {
 			log.Printf("Encryption feature enabled.")
 		}
+		// The data keys of the @encrypted predicates are wrapped with the same key.
+		x.WorkerConfig.EncryptionKey = opt.EncryptionKey
 	}
 	fmt.Printf("Encrypted input: %v; Encrypted output: %v\n", opt.Encrypted, opt.EncryptedOut)
 
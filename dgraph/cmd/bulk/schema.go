@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/ee/enc"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
@@ -69,6 +70,11 @@ func newSchemaStore(initial *schema.ParsedSchema, opt *options, state *state) *s
 			fmt.Printf("Predicate %q already exists in schema\n", p)
 			continue
 		}
+		if sch.Encrypted {
+			dataKey, err := enc.NewDataKey(opt.EncryptionKey)
+			x.Checkf(err, "while creating the data key of predicate %s", p)
+			sch.DataKeys = [][]byte{dataKey}
+		}
 		s.schemaMap[p] = sch
 	}
 
@@ -199,7 +199,16 @@ EOF
   dgraph debug -p out/0/p 2>|/dev/null | grep '{s}' | cut -d' ' -f3  > all_dbs.out
   dgraph debug -p out/1/p 2>|/dev/null | grep '{s}' | cut -d' ' -f3 >> all_dbs.out
   diff <(LC_ALL=C sort all_dbs.out | uniq -c) - <<EOF
+      1 dgraph.acl.burst
+      1 dgraph.acl.policy
+      1 dgraph.acl.rate_limit
       1 dgraph.acl.rule
+      1 dgraph.apikey.expiry
+      1 dgraph.apikey.group
+      1 dgraph.apikey.hash
+      1 dgraph.apikey.ips
+      1 dgraph.apikey.name
+      1 dgraph.apikey.readonly
       1 dgraph.cors
       1 dgraph.drop.op
       1 dgraph.graphql.p_query
@@ -209,10 +218,13 @@ EOF
       1 dgraph.graphql.schema_history
       1 dgraph.graphql.xid
       1 dgraph.password
+      1 dgraph.policy.filter
+      1 dgraph.policy.type
       1 dgraph.rule.permission
       1 dgraph.rule.predicate
       1 dgraph.type
       1 dgraph.user.group
+      1 dgraph.user.password_changed
       1 dgraph.xid
       1 genre
       1 language
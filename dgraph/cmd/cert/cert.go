@@ -26,7 +26,9 @@ import (
 	"math"
 	"math/big"
 	"net"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -46,6 +48,7 @@ type certConfig struct {
 	force   bool
 	hosts   []string
 	client  string
+	sans    []string
 	curve   string
 }
 
@@ -101,6 +104,21 @@ func (c *certConfig) generatePair(keyFile, certFile string) error {
 		template.Subject.CommonName = c.client
 		template.KeyUsage = x509.KeyUsageDigitalSignature
 		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+		for _, san := range c.sans {
+			switch {
+			case strings.Contains(san, "://"):
+				u, err := url.Parse(san)
+				if err != nil {
+					return errors.Wrapf(err, "--client_san: invalid URI %q", san)
+				}
+				template.URIs = append(template.URIs, u)
+			case strings.Contains(san, "@"):
+				template.EmailAddresses = append(template.EmailAddresses, san)
+			default:
+				template.DNSNames = append(template.DNSNames, san)
+			}
+		}
 	}
 
 	if c.signer == nil {
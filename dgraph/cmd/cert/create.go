@@ -220,6 +220,7 @@ func createClientPair(opt *options) error {
 		keySize: opt.keySize,
 		force:   opt.force,
 		client:  opt.client,
+		sans:    opt.clientSANs,
 		curve:   opt.curve,
 	}
 
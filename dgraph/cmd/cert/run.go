@@ -31,7 +31,7 @@ type options struct {
 	dir, caKey, caCert, client, curve string
 	force, verify                     bool
 	keySize, days                     int
-	nodes                             []string
+	nodes, clientSANs                 []string
 }
 
 var opt options
@@ -55,7 +55,11 @@ func init() {
 		`ECDSA curve for private key. Values are: "P224", "P256", "P384", "P521".`)
 	flag.Int("duration", defaultDays, "duration of cert validity in days")
 	flag.StringSliceP("nodes", "n", nil, "creates cert/key pair for nodes")
-	flag.StringP("client", "c", "", "create cert/key pair for a client name")
+	flag.StringP("client", "c", "", "create cert/key pair for a client name, which is the "+
+		"common name of the cert")
+	flag.StringSlice("client_san", nil, "subject alternative names of the client cert: email "+
+		"addresses, DNS names or URIs. Alphas with --acl_cert_identity=san take the first one, "+
+		"or the client name with --acl_cert_identity=cn, as the ACL user of the client")
 	flag.Bool("force", false, "overwrite any existing key and cert")
 	flag.Bool("verify", true, "verify certs against root CA when creating")
 
@@ -73,15 +77,16 @@ func init() {
 
 func run() error {
 	opt = options{
-		dir:     Cert.Conf.GetString("dir"),
-		caKey:   Cert.Conf.GetString("ca-key"),
-		client:  Cert.Conf.GetString("client"),
-		keySize: Cert.Conf.GetInt("keysize"),
-		days:    Cert.Conf.GetInt("duration"),
-		nodes:   Cert.Conf.GetStringSlice("nodes"),
-		force:   Cert.Conf.GetBool("force"),
-		verify:  Cert.Conf.GetBool("verify"),
-		curve:   Cert.Conf.GetString("elliptic-curve"),
+		dir:        Cert.Conf.GetString("dir"),
+		caKey:      Cert.Conf.GetString("ca-key"),
+		client:     Cert.Conf.GetString("client"),
+		clientSANs: Cert.Conf.GetStringSlice("client_san"),
+		keySize:    Cert.Conf.GetInt("keysize"),
+		days:       Cert.Conf.GetInt("duration"),
+		nodes:      Cert.Conf.GetStringSlice("nodes"),
+		force:      Cert.Conf.GetBool("force"),
+		verify:     Cert.Conf.GetBool("verify"),
+		curve:      Cert.Conf.GetString("elliptic-curve"),
 	}
 
 	return createCerts(&opt)
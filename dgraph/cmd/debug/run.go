@@ -290,7 +290,7 @@ func showAllPostingsAt(db *badger.DB, readTs uint64) {
 		val, err := item.ValueCopy(nil)
 		x.Check(err)
 		var plist pb.PostingList
-		x.Check(plist.Unmarshal(val))
+		x.Check(posting.UnmarshalPostingList(val, &plist))
 
 		x.AssertTrue(len(plist.Postings) <= 1)
 		var num int
@@ -404,7 +404,7 @@ func history(lookup []byte, itr *badger.Iterator) {
 		}
 		if meta&posting.BitCompletePosting > 0 {
 			var plist pb.PostingList
-			x.Check(plist.Unmarshal(val))
+			x.Check(posting.UnmarshalPostingList(val, &plist))
 
 			for _, p := range plist.Postings {
 				appendPosting(&buf, p)
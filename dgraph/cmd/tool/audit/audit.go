@@ -0,0 +1,78 @@
+/*
+ * Copyright 2017-2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/dgraph-io/dgraph/ee/audit"
+	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/spf13/cobra"
+)
+
+var Audit x.SubCommand
+
+func init() {
+	Audit.Cmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Run Audit tool",
+		Long: "A tool to verify the audit log written by an Alpha: that no line of its files " +
+			"was changed or removed, and that it wasn't truncated.",
+		Run: func(cmd *cobra.Command, args []string) {
+			run()
+		},
+	}
+	Audit.EnvPrefix = "DGRAPH_TOOL_AUDIT"
+	flag := Audit.Cmd.Flags()
+	flag.StringP("dir", "d", "", "Directory of the audit log, given to the Alpha by audit_dir.")
+	flag.String("secret_file", "",
+		"The file that stores the HMAC secret of the audit log, given to the Alpha by "+
+			"audit_secret_file.")
+	flag.String("anchor_file", "",
+		"The file that stores the hash of the last line of the audit log, given to the Alpha by "+
+			"audit_anchor_file. Defaults to dgraph_audit.anchor in the audit log directory.")
+	// The key of an encrypted audit log is given by the encryption_key_file or Vault flags.
+	enc.RegisterFlags(flag)
+}
+
+func run() {
+	conf := audit.Config{
+		Dir:        Audit.Conf.GetString("dir"),
+		AnchorFile: Audit.Conf.GetString("anchor_file"),
+	}
+	if conf.Dir == "" {
+		log.Fatal("The directory of the audit log must be given with --dir")
+	}
+	secretFile := Audit.Conf.GetString("secret_file")
+	if secretFile == "" {
+		log.Fatal("The HMAC secret of the audit log must be given with --secret_file")
+	}
+	secret, err := ioutil.ReadFile(secretFile)
+	x.Checkf(err, "could not read the secret file")
+	conf.Secret = secret
+	conf.EncryptionKey, err = enc.ReadKey(Audit.Conf)
+	x.Checkf(err, "could not read encryption key file")
+
+	n, err := audit.VerifyDir(conf)
+	if err != nil {
+		log.Fatalf("The audit log in %s is invalid: %v\n", conf.Dir, err)
+	}
+	fmt.Printf("Verified %d files of the audit log in %s.\n", n, conf.Dir)
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rotate
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/ee/audit"
+	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/raftwal"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var Rotate x.SubCommand
+
+func init() {
+	Rotate.Cmd = &cobra.Command{
+		Use:   "rotate",
+		Short: "Run Rotate tool",
+		Long: "A tool to change the encryption key of an Alpha. It re-encrypts the data keys " +
+			"of the postings and WAL directories and of the @encrypted predicates with the new " +
+			"key, without rewriting the data, and the audit logs in the audit directory. The " +
+			"Alpha must be stopped. Rotate one Alpha at a time to keep the cluster available.",
+		Run: func(cmd *cobra.Command, args []string) {
+			run()
+		},
+	}
+	Rotate.EnvPrefix = "DGRAPH_TOOL_ROTATE"
+	flag := Rotate.Cmd.Flags()
+	flag.StringP("postings", "p", "p",
+		"Directory of the postings of the Alpha. Set it to \"\" to skip the postings.")
+	flag.StringP("wal", "w", "w",
+		"Directory of the WAL of the Alpha. Set it to \"\" to skip the WAL.")
+	flag.String("audit_dir", "",
+		"Directory of the encrypted audit logs of the Alpha, if any, to re-encrypt them.")
+	flag.String("new_encryption_key_file", "",
+		"The file that stores the new key, of length 16, 24, or 32 bytes.")
+	enc.RegisterFlags(flag)
+}
+
+func run() {
+	oldKey, err := enc.ReadKey(Rotate.Conf)
+	x.Checkf(err, "could not read the current encryption key")
+	if len(oldKey) == 0 {
+		log.Fatal("Error while reading the current encryption key: Key is empty")
+	}
+
+	newKeyConf := viper.New()
+	newKeyConf.Set("encryption_key_file", Rotate.Conf.GetString("new_encryption_key_file"))
+	newKey, err := enc.ReadKey(newKeyConf)
+	x.Checkf(err, "could not read the new encryption key")
+	if len(newKey) == 0 {
+		log.Fatal("Error while reading the new encryption key: Key is empty")
+	}
+
+	pdir := Rotate.Conf.GetString("postings")
+	wdir := Rotate.Conf.GetString("wal")
+	adir := Rotate.Conf.GetString("audit_dir")
+	if pdir != "" {
+		// An online rotation might still have to be finished before p can be opened.
+		x.Checkf(enc.FinishKeyRotation(pdir, oldKey),
+			"could not finish the previous rotation of the encryption key of %s", pdir)
+	}
+
+	// The directories are rotated one by one, so a failure tells which are left to rotate.
+	var done []string
+	rotate := func(dir, skipFlag string, fn func() error) {
+		if dir == "" {
+			return
+		}
+		fmt.Printf("Rotating the encryption key of %s\n", dir)
+		if err := fn(); err != nil {
+			if len(done) == 0 {
+				log.Fatalf("Error while rotating the encryption key of %s: %v\n", dir, err)
+			}
+			log.Fatalf("Error while rotating the encryption key of %s: %v. The key of %s is "+
+				"already rotated, run the tool again with %s.\n", dir, err,
+				strings.Join(done, ", "), skipFlag)
+		}
+		done = append(done, dir)
+	}
+	rotate(wdir, "", func() error {
+		return rotateWALKey(wdir, oldKey, newKey)
+	})
+	rotate(pdir, `--wal ""`, func() error {
+		if err := rewrapDataKeys(pdir, oldKey, newKey); err != nil {
+			return errors.Wrapf(err, "while wrapping the data keys of the @encrypted predicates")
+		}
+		return enc.RotateKeyRegistry(pdir, oldKey, newKey)
+	})
+	rotate(adir, `--wal "" --postings ""`, func() error {
+		return audit.ReencryptDir(adir, oldKey, newKey)
+	})
+	fmt.Printf("Done. The current key ID is %s.\n", newKey.KeyId())
+}
+
+// rewrapDataKeys wraps the data keys of the @encrypted predicates in the postings in dir with
+// newKey. They stay wrapped with oldKey too, so that the WAL entries replayed when the Alpha starts
+// again can't replace them with data keys wrapped with oldKey only.
+func rewrapDataKeys(dir string, oldKey, newKey x.SensitiveByteSlice) error {
+	db, err := badger.OpenManaged(badger.DefaultOptions(dir).
+		WithEncryptionKey(oldKey).
+		WithIndexCacheSize(100 << 20).
+		WithLogger(nil))
+	if err != nil {
+		return errors.Wrapf(err, "while opening the postings")
+	}
+	defer db.Close()
+
+	// The schema is always written at version 1.
+	txn := db.NewTransactionAt(1, true)
+	defer txn.Discard()
+	prefix := x.SchemaPrefix()
+	itr := txn.NewIterator(badger.DefaultIteratorOptions)
+	var updates []*pb.SchemaUpdate
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); itr.Next() {
+		su := &pb.SchemaUpdate{}
+		if err := itr.Item().Value(su.Unmarshal); err != nil {
+			itr.Close()
+			return err
+		}
+		if len(su.DataKeys) == 0 {
+			continue
+		}
+		dataKeys, err := enc.RewrapDataKey(oldKey, newKey, su.DataKeys)
+		if err != nil {
+			itr.Close()
+			return errors.Wrapf(err, "predicate %s", x.ParseAttr(su.Predicate))
+		}
+		if dataKeys != nil {
+			su.DataKeys = dataKeys
+			updates = append(updates, su)
+		}
+	}
+	itr.Close()
+
+	for _, su := range updates {
+		data, err := su.Marshal()
+		if err != nil {
+			return err
+		}
+		err = txn.SetEntry(&badger.Entry{
+			Key:      x.SchemaKey(su.Predicate),
+			Value:    data,
+			UserMeta: posting.BitSchemaPosting,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return txn.CommitAt(1, nil)
+}
+
+func rotateWALKey(dir string, oldKey, newKey x.SensitiveByteSlice) error {
+	// A wrong directory isn't mistaken for one without data keys.
+	if _, err := os.Stat(filepath.Join(dir, badger.KeyRegistryFileName)); err != nil {
+		return errors.Wrapf(err, "while looking for the key registry")
+	}
+	ds, err := raftwal.InitEncrypted(dir, oldKey)
+	if err != nil {
+		return errors.Wrapf(err, "while opening the WAL")
+	}
+	if err := ds.RotateEncryptionKey(newKey); err != nil {
+		return err
+	}
+	return ds.Close()
+}
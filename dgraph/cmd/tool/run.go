@@ -18,7 +18,9 @@ package tool
 import (
 	"strings"
 
+	"github.com/dgraph-io/dgraph/dgraph/cmd/tool/audit"
 	tool "github.com/dgraph-io/dgraph/dgraph/cmd/tool/decrypt"
+	"github.com/dgraph-io/dgraph/dgraph/cmd/tool/rotate"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -27,7 +29,7 @@ import (
 var Tool x.SubCommand
 
 var subcommands = []*x.SubCommand{
-	&tool.Decrypt,
+	&tool.Decrypt, &rotate.Rotate, &audit.Audit,
 }
 
 func init() {
@@ -18,6 +18,7 @@ package zero
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -185,6 +186,13 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if pinned := st.zero.pinnedGroup(tablet); pinned != 0 && pinned != dstGroup {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf(
+			"Tablet: [%s] is pinned to group: [%d]. Unpin it first.", tablet, pinned))
+		return
+	}
+
 	srcGroup := tab.GroupId
 	if srcGroup == dstGroup {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -207,6 +215,156 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// splitTablet can be used to split a tablet by subject uid ranges. It takes in tablet, the uid to
+// split at and the group which should serve the uids from there up to the next split.
+func (st *state) splitTablet(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	tablet := r.URL.Query().Get("tablet")
+	if len(tablet) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "tablet is a mandatory query parameter")
+		return
+	}
+	at, ok := intFromQueryParam(w, r, "at")
+	if !ok {
+		return
+	}
+	groupId, ok := intFromQueryParam(w, r, "group")
+	if !ok {
+		return
+	}
+	dstGroup := uint32(groupId)
+	var isKnown bool
+	for _, grp := range st.zero.KnownGroups() {
+		if grp == dstGroup {
+			isKnown = true
+			break
+		}
+	}
+	if !isKnown {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Group: [%d] is not a known group.",
+			dstGroup))
+		return
+	}
+
+	if err := st.zero.splitTablet(tablet, at, dstGroup); err != nil {
+		glog.Errorf("While splitting predicate %s at %#x to group %d. Error: %v",
+			tablet, at, dstGroup, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	_, err := fmt.Fprintf(w, "Predicate: [%s] split at [%#x], served by group [%d]",
+		tablet, at, dstGroup)
+	if err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// pinTablet can be used to pin a tablet to a group, so that it is never moved by the rebalancer.
+// It takes in tablet and group as argument. The tablet is moved to the group if needed. A group of
+// zero unpins the tablet.
+func (st *state) pinTablet(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	tablet := r.URL.Query().Get("tablet")
+	if len(tablet) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "tablet is a mandatory query parameter")
+		return
+	}
+	groupId, ok := intFromQueryParam(w, r, "group")
+	if !ok {
+		return
+	}
+	dstGroup := uint32(groupId)
+	if dstGroup != 0 && !st.zero.knownGroup(dstGroup) {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Group: [%d] is not a known group.",
+			dstGroup))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), predicateMoveTimeout)
+	defer cancel()
+	if err := st.zero.pinTablet(ctx, tablet, dstGroup); err != nil {
+		glog.Errorf("While pinning predicate %s to group %d. Error: %v", tablet, dstGroup, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	var err error
+	if dstGroup == 0 {
+		_, err = fmt.Fprintf(w, "Predicate: [%s] unpinned", tablet)
+	} else {
+		_, err = fmt.Fprintf(w, "Predicate: [%s] pinned to group [%d]", tablet, dstGroup)
+	}
+	if err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
+// rebalancePlan returns the tablet moves the rebalancer would make, without moving anything.
+func (st *state) rebalancePlan(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	moves := st.zero.rebalancePlan(maxPlanMoves)
+	if moves == nil {
+		moves = []*tabletMove{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{"moves": moves}); err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
 func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
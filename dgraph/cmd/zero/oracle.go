@@ -48,7 +48,12 @@ type Oracle struct {
 	maxAssigned uint64  // max transaction assigned by us.
 
 	// All transactions with startTs < startTxnTs return true for hasConflict.
-	startTxnTs  uint64
+	startTxnTs uint64
+	// The conflict keys of the whole lists written are only recorded while serializable
+	// transactions commit, since the timestamp listKeysSince. It is zero when they aren't.
+	listKeysSince    uint64
+	lastSerializable time.Time
+
 	subscribers map[int]chan pb.OracleDelta
 	updates     chan *pb.OracleDelta
 	doneUntil   y.WaterMark
@@ -75,6 +80,38 @@ func (o *Oracle) updateStartTxnTs(ts uint64) {
 	defer o.Unlock()
 	o.startTxnTs = ts
 	o.keyCommit.Reset()
+	o.listKeysSince = 0
+}
+
+// listKeysIdle is how long the conflict keys of the whole lists written are still recorded after
+// the last commit of a serializable transaction.
+const listKeysIdle = 5 * time.Minute
+
+// isSerializable tells if the transaction read lists as a serializable transaction.
+func isSerializable(src *api.TxnContext) bool {
+	for _, k := range src.Keys {
+		if strings.HasPrefix(k, x.ReadConflictKeyPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// trackListKeys starts recording the conflict keys of the whole lists written, if src is a
+// serializable transaction. The writes committed before that were not recorded, so the
+// transactions which started before the last timestamp handed out can't commit as serializable.
+func (o *Oracle) trackListKeys(src *api.TxnContext) {
+	if !isSerializable(src) {
+		return
+	}
+	o.Lock()
+	defer o.Unlock()
+	o.lastSerializable = time.Now()
+	if o.listKeysSince == 0 {
+		o.listKeysSince = x.Max(o.doneUntil.LastIndex(), 1)
+		glog.Infof("Recording the conflict keys of the lists written since ts: %d",
+			o.listKeysSince)
+	}
 }
 
 // TODO: This should be done during proposal application for Txn status.
@@ -83,12 +120,20 @@ func (o *Oracle) hasConflict(src *api.TxnContext) bool {
 	if src.StartTs < o.startTxnTs {
 		return true
 	}
+	if isSerializable(src) && (o.listKeysSince == 0 || src.StartTs < o.listKeysSince) {
+		// The writes to the lists read might not have been recorded.
+		return true
+	}
 	for _, k := range src.Keys {
-		ki, err := strconv.ParseUint(k, 36, 64)
+		ki, prefix, err := parseConflictKey(k)
 		if err != nil {
 			glog.Errorf("Got error while parsing conflict key %q: %v\n", k, err)
 			continue
 		}
+		if prefix == x.ListConflictKeyPrefix {
+			// Only the reads of serializable transactions conflict with these.
+			continue
+		}
 		if last := o.keyCommit.Get(ki); last > src.StartTs {
 			return true
 		}
@@ -96,6 +141,21 @@ func (o *Oracle) hasConflict(src *api.TxnContext) bool {
 	return false
 }
 
+// parseConflictKey parses a conflict key sent by the Alphas, and returns its prefix if it is the
+// conflict key of a list read or written. See FillContext in posting/mvcc.go.
+func parseConflictKey(k string) (uint64, string, error) {
+	var prefix string
+	for _, p := range []string{x.ReadConflictKeyPrefix, x.ListConflictKeyPrefix} {
+		if strings.HasPrefix(k, p) {
+			prefix = p
+			k = k[len(p):]
+			break
+		}
+	}
+	ki, err := strconv.ParseUint(k, 36, 64)
+	return ki, prefix, err
+}
+
 func (o *Oracle) purgeBelow(minTs uint64) {
 	var timer x.Timer
 	timer.Start()
@@ -136,15 +196,27 @@ func (o *Oracle) commit(src *api.TxnContext) error {
 	if o.hasConflict(src) {
 		return ErrConflict
 	}
+	if o.listKeysSince > 0 && time.Since(o.lastSerializable) > listKeysIdle {
+		glog.Infof("No serializable transaction committed for %v. Not recording the conflict"+
+			" keys of the lists written anymore.", listKeysIdle)
+		o.listKeysSince = 0
+	}
 	// We store src.Keys as string to ensure compatibility with all the various language clients we
 	// have. But, really they are just uint64s encoded as strings. We use base 36 during creation of
 	// these keys in FillContext in posting/mvcc.go.
 	for _, k := range src.Keys {
-		ki, err := strconv.ParseUint(k, 36, 64)
+		ki, prefix, err := parseConflictKey(k)
 		if err != nil {
 			glog.Errorf("Got error while parsing conflict key %q: %v\n", k, err)
 			continue
 		}
+		if prefix == x.ReadConflictKeyPrefix {
+			// The lists read by serializable transactions can't conflict with other transactions.
+			continue
+		}
+		if prefix == x.ListConflictKeyPrefix && o.listKeysSince == 0 {
+			continue
+		}
 		o.keyCommit.Set(ki, src.CommitTs) // CommitTs is handed out before calling this func.
 	}
 	return nil
@@ -273,15 +345,37 @@ func (o *Oracle) updateCommitStatusHelper(index uint64, src *api.TxnContext) boo
 func (o *Oracle) updateCommitStatus(index uint64, src *api.TxnContext) {
 	// TODO: We should check if the tablet is in read-only status here.
 	if o.updateCommitStatusHelper(index, src) {
-		delta := new(pb.OracleDelta)
-		delta.Txns = append(delta.Txns, &pb.TxnStatus{
+		status := &pb.TxnStatus{
 			StartTs:  src.StartTs,
 			CommitTs: o.commitTs(src.StartTs),
-		})
+		}
+		if status.CommitTs > 0 {
+			status.Preds = txnPreds(src)
+		}
+		delta := new(pb.OracleDelta)
+		delta.Txns = append(delta.Txns, status)
 		o.updates <- delta
 	}
 }
 
+// txnPreds returns the predicates written by a transaction, without their group ids, so that
+// Alphas can invalidate the cached results of the queries reading them.
+func txnPreds(src *api.TxnContext) []string {
+	seen := make(map[string]struct{})
+	var preds []string
+	for _, pkey := range src.Preds {
+		splits := strings.SplitN(pkey, "-", 2)
+		if len(splits) < 2 {
+			continue
+		}
+		if _, ok := seen[splits[1]]; !ok {
+			seen[splits[1]] = struct{}{}
+			preds = append(preds, splits[1])
+		}
+	}
+	return preds
+}
+
 func (o *Oracle) commitTs(startTs uint64) uint64 {
 	o.RLock()
 	defer o.RUnlock()
@@ -357,6 +451,7 @@ func (s *Server) commit(ctx context.Context, src *api.TxnContext) error {
 		return s.proposeTxn(ctx, src)
 	}
 
+	s.orc.trackListKeys(src)
 	// Use the start timestamp to check if we have a conflict, before we need to assign a commit ts.
 	s.orc.RLock()
 	conflict := s.orc.hasConflict(src)
@@ -389,7 +484,10 @@ func (s *Server) commit(ctx context.Context, src *api.TxnContext) error {
 			if tablet == nil {
 				return errors.Errorf("Tablet for %s is nil", pred)
 			}
-			if tablet.GroupId != uint32(gid) {
+			s.RLock()
+			servesPart := s.servesPartOf(uint32(gid), pred)
+			s.RUnlock()
+			if !servesPart {
 				return errors.Errorf("Mutation done in group: %d. Predicate %s assigned to %d",
 					gid, pred, tablet.GroupId)
 			}
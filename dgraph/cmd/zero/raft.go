@@ -314,6 +314,29 @@ func (n *node) handleTabletProposal(tablet *pb.Tablet) error {
 	return nil
 }
 
+func (n *node) handlePinProposal(pin *pb.TabletPin) error {
+	n.server.AssertLock()
+	state := n.server.state
+
+	if pin.Predicate == "" {
+		return errors.Errorf("Pinned tablet predicate is empty in %+v", pin)
+	}
+	if pin.GroupId == 0 {
+		glog.Infof("Unpinning tablet for attr: [%v]\n", pin.Predicate)
+		delete(state.PinnedTablets, pin.Predicate)
+		return nil
+	}
+	if _, ok := state.Groups[pin.GroupId]; !ok {
+		return errors.Errorf("Unknown group %d for pinned tablet %s", pin.GroupId, pin.Predicate)
+	}
+	if state.PinnedTablets == nil {
+		state.PinnedTablets = make(map[string]uint32)
+	}
+	glog.Infof("Pinning tablet for attr: [%v] to gid: [%v]\n", pin.Predicate, pin.GroupId)
+	state.PinnedTablets[pin.Predicate] = pin.GroupId
+	return nil
+}
+
 func (n *node) applySnapshot(snap *pb.ZeroSnapshot) error {
 	existing, err := n.Store.Snapshot()
 	if err != nil {
@@ -389,6 +412,13 @@ func (n *node) applyProposal(e raftpb.Entry) (uint64, error) {
 			return key, err
 		}
 	}
+	if p.Pin != nil {
+		if err := n.handlePinProposal(p.Pin); err != nil {
+			span.Annotatef(nil, "While applying pin proposal: %v", err)
+			glog.Errorf("While applying pin proposal: %v", err)
+			return key, err
+		}
+	}
 	if p.License != nil {
 		// Check that the number of nodes in the cluster should be less than MaxNodes, otherwise
 		// reject the proposal.
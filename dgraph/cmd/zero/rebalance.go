@@ -0,0 +1,315 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+)
+
+/*
+Tablets are rebalanced based on a score per group, which adds up three fractions:
+• The on-disk size of the group, over the size of the cluster.
+• The reads and writes per second served by the group, over those of the cluster.
+• The pressure on the group, which is the average of the CPU usage and the memory in use relative
+  to the member using the most memory, of the busiest member of the group.
+
+The rebalancer moves a tablet from the group with the highest score to the one with the lowest,
+if the scores differ by at least minScoreDiff. It picks the tablet contributing the most to the
+score, such that moving it would not make the destination score higher than the source. So, a hot
+small predicate can be moved off an overloaded group, even if the sizes are balanced.
+
+Loads are reported by every Alpha on each membership update. They are only kept in memory by the
+Zero leader, and are never proposed. Tablets pinned to a group are never moved by the rebalancer.
+*/
+
+const (
+	// minScoreDiff is the minimum difference in score between two groups to move a tablet.
+	minScoreDiff = 0.1
+	// loadExpiry is how long a reported load is considered. Alphas report every 10 seconds.
+	loadExpiry = time.Minute
+	// maxPlanMoves is the maximum number of moves returned in a rebalance plan.
+	maxPlanMoves = 10
+)
+
+// memberLoad is the latest load reported by an Alpha.
+type memberLoad struct {
+	gid      uint32
+	cpu      float64
+	memory   uint64
+	tablets  map[string]*pb.TabletLoad
+	received time.Time
+}
+
+// tabletStat is the size and load of a tablet, as considered by the rebalancer.
+type tabletStat struct {
+	predicate string
+	attr      string
+	size      int64
+	ops       float64
+}
+
+// groupStat is the size and load of a group, as considered by the rebalancer.
+type groupStat struct {
+	gid       uint32
+	size      int64
+	ops       float64
+	pressure  float64
+	hasLeader bool
+	tablets   []*tabletStat
+}
+
+// tabletMove is a tablet move proposed by the rebalancer.
+type tabletMove struct {
+	Predicate string  `json:"predicate"`
+	SrcGroup  uint32  `json:"srcGroup"`
+	DstGroup  uint32  `json:"dstGroup"`
+	Size      int64   `json:"size"`
+	Ops       float64 `json:"opsPerSec"`
+}
+
+// recordLoad keeps the load reported by the members of group, and strips it from them so that it
+// doesn't get proposed as part of the membership.
+func (s *Server) recordLoad(group *pb.Group) {
+	s.Lock()
+	defer s.Unlock()
+
+	now := time.Now()
+	for id, m := range group.GetMembers() {
+		s.memberLoads[id] = &memberLoad{
+			gid:      m.GroupId,
+			cpu:      m.CpuUsage,
+			memory:   m.MemoryInUse,
+			tablets:  m.TabletLoads,
+			received: now,
+		}
+		m.CpuUsage, m.MemoryInUse, m.TabletLoads = 0, 0, nil
+	}
+}
+
+// groupStats returns the size and the load of every group.
+func (s *Server) groupStats() []*groupStat {
+	s.AssertRLock()
+
+	var maxMemory uint64
+	var loads []*memberLoad
+	for _, load := range s.memberLoads {
+		if time.Since(load.received) > loadExpiry {
+			continue
+		}
+		loads = append(loads, load)
+		maxMemory = x.Max(maxMemory, load.memory)
+	}
+
+	var groups []*groupStat
+	for gid, group := range s.state.Groups {
+		g := &groupStat{gid: gid, hasLeader: s.hasLeader(gid)}
+		for _, load := range loads {
+			if load.gid != gid {
+				continue
+			}
+			pressure := load.cpu
+			if maxMemory > 0 {
+				pressure = (load.cpu + float64(load.memory)/float64(maxMemory)) / 2
+			}
+			if pressure > g.pressure {
+				g.pressure = pressure
+			}
+		}
+		for key, tab := range group.Tablets {
+			attr, _, ok := x.ParseSplitTablet(key)
+			if !ok {
+				attr = key
+			}
+			t := &tabletStat{predicate: key, attr: attr, size: tab.OnDiskBytes}
+			// Reads are served by every member, so add up their loads.
+			for _, load := range loads {
+				if tl, ok := load.tablets[key]; ok && load.gid == gid {
+					t.ops += tl.ReadsPerSec + tl.WritesPerSec
+				}
+			}
+			g.size += t.size
+			g.ops += t.ops
+			g.tablets = append(g.tablets, t)
+		}
+		sort.Slice(g.tablets, func(i, j int) bool {
+			return g.tablets[i].predicate < g.tablets[j].predicate
+		})
+		groups = append(groups, g)
+	}
+	return groups
+}
+
+// rebalancePlan returns up to n tablet moves which would balance the load across the groups.
+func (s *Server) rebalancePlan(n int) []*tabletMove {
+	s.RLock()
+	defer s.RUnlock()
+	if s.state == nil || len(s.state.Groups) <= 1 {
+		return nil
+	}
+	return planMoves(s.groupStats(), s.state.PinnedTablets, n)
+}
+
+// planMoves returns up to n tablet moves which would balance the scores of groups. groups is
+// updated to reflect the moves.
+func planMoves(groups []*groupStat, pinned map[string]uint32, n int) []*tabletMove {
+	var moves []*tabletMove
+	for len(moves) < n {
+		move := chooseMove(groups, pinned)
+		if move == nil {
+			break
+		}
+		moves = append(moves, move)
+	}
+	return moves
+}
+
+// chooseMove picks a tablet to move from the group with the highest score to the one with the
+// lowest, and applies the move to groups. It returns nil if the groups are balanced.
+func chooseMove(groups []*groupStat, pinned map[string]uint32) *tabletMove {
+	if len(groups) <= 1 {
+		return nil
+	}
+	var totalSize int64
+	var totalOps float64
+	for _, g := range groups {
+		totalSize += g.size
+		totalOps += g.ops
+	}
+	weight := func(size int64, ops float64) float64 {
+		var w float64
+		if totalSize > 0 {
+			w += float64(size) / float64(totalSize)
+		}
+		if totalOps > 0 {
+			w += ops / totalOps
+		}
+		return w
+	}
+	score := func(g *groupStat) float64 {
+		return weight(g.size, g.ops) + g.pressure
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if si, sj := score(groups[i]), score(groups[j]); si != sj {
+			return si < sj
+		}
+		return groups[i].gid < groups[j].gid
+	})
+
+	dst := groups[0]
+	// Don't move a tablet unless the destination has reported its tablets via its leader.
+	if !dst.hasLeader {
+		return nil
+	}
+	servesPartOf := func(g *groupStat, attr string) bool {
+		for _, t := range g.tablets {
+			if t.attr == attr {
+				return true
+			}
+		}
+		return false
+	}
+	for i := len(groups) - 1; i > 0; i-- {
+		src := groups[i]
+		diff := score(src) - score(dst)
+		if diff < minScoreDiff {
+			continue
+		}
+		var best *tabletStat
+		var bestWeight float64
+		for _, t := range src.tablets {
+			// Reserved predicates should always be in group 1 so do not re-balance them.
+			if x.IsReservedPredicate(t.attr) {
+				continue
+			}
+			if _, ok := pinned[t.predicate]; ok {
+				continue
+			}
+			// A group can't receive a predicate while it serves a part of it.
+			if servesPartOf(dst, t.attr) {
+				continue
+			}
+			// Find a tablet as heavy as possible such that on moving it, the score of dst is
+			// less than or equal to that of src.
+			if w := weight(t.size, t.ops); w <= diff/2 && w > bestWeight {
+				best, bestWeight = t, w
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		for idx, t := range src.tablets {
+			if t == best {
+				src.tablets = append(src.tablets[:idx], src.tablets[idx+1:]...)
+				break
+			}
+		}
+		src.size -= best.size
+		src.ops -= best.ops
+		dst.tablets = append(dst.tablets, best)
+		dst.size += best.size
+		dst.ops += best.ops
+		return &tabletMove{
+			Predicate: best.predicate,
+			SrcGroup:  src.gid,
+			DstGroup:  dst.gid,
+			Size:      best.size,
+			Ops:       best.ops,
+		}
+	}
+	return nil
+}
+
+// pinnedGroup returns the group the tablet is pinned to, or zero if it isn't pinned.
+func (s *Server) pinnedGroup(tablet string) uint32 {
+	s.RLock()
+	defer s.RUnlock()
+	return s.state.GetPinnedTablets()[tablet]
+}
+
+// pinTablet pins the tablet to group gid, moving it there if needed. Pinned tablets are never
+// moved by the rebalancer. A gid of zero unpins the tablet.
+func (s *Server) pinTablet(ctx context.Context, tablet string, gid uint32) error {
+	var tab *pb.Tablet
+	if gid != 0 {
+		if tab = s.ServingTablet(tablet); tab == nil {
+			return errors.Errorf("Tablet to be pinned: [%v] is not being served", tablet)
+		}
+	} else if s.pinnedGroup(tablet) == 0 {
+		return errors.Errorf("Tablet: [%v] is not pinned", tablet)
+	}
+	// Pin the tablet before moving it, so that the rebalancer doesn't move it meanwhile.
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{
+		Pin: &pb.TabletPin{Predicate: tablet, GroupId: gid},
+	}); err != nil {
+		return err
+	}
+	if tab == nil || tab.GroupId == gid {
+		return nil
+	}
+	if err := s.movePredicate(tablet, tab.GroupId, gid); err != nil {
+		return errors.Wrapf(err, "Tablet: [%v] is pinned to group: [%d], but couldn't be moved"+
+			" there. Pin it again to retry", tablet, gid)
+	}
+	return nil
+}
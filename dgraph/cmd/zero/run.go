@@ -259,6 +259,9 @@ func run() {
 	http.HandleFunc("/state", st.getState)
 	http.HandleFunc("/removeNode", st.removeNode)
 	http.HandleFunc("/moveTablet", st.moveTablet)
+	http.HandleFunc("/splitTablet", st.splitTablet)
+	http.HandleFunc("/pinTablet", st.pinTablet)
+	http.HandleFunc("/rebalancePlan", st.rebalancePlan)
 	http.HandleFunc("/assign", st.assign)
 	http.HandleFunc("/enterpriseLicense", st.applyEnterpriseLicense)
 	http.HandleFunc("/jemalloc", x.JemallocHandler)
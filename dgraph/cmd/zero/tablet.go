@@ -19,7 +19,6 @@ package zero
 import (
 	"context"
 	"fmt"
-	"sort"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -87,8 +86,14 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	ctx, span := otrace.StartSpan(ctx, "Zero.MovePredicate")
 	defer span.End()
 
+	// A split tablet holds the data of its predicate for a range of subject uids.
+	attr, _, isSplit := x.ParseSplitTablet(predicate)
+	if !isSplit {
+		attr = predicate
+	}
+
 	// Ensure that reserved predicates cannot be moved.
-	if x.IsReservedPredicate(predicate) {
+	if x.IsReservedPredicate(attr) {
 		return errors.Errorf("Unable to move reserved predicate %s", predicate)
 	}
 
@@ -100,9 +105,20 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 		return errors.Errorf("I am not the Zero leader")
 	}
 	tab := s.ServingTablet(predicate)
+	if tab == nil && isSplit && s.ServingTablet(attr) != nil {
+		// This is a new split of a predicate being served.
+		tab = &pb.Tablet{Predicate: predicate}
+	}
 	if tab == nil {
 		return errors.Errorf("Tablet to be moved: [%v] is not being served", predicate)
 	}
+	// The destination group would drop whatever it has for the predicate before receiving it.
+	s.RLock()
+	servesPart := s.servesPartOf(dstGroup, attr)
+	s.RUnlock()
+	if servesPart {
+		return errors.Errorf("Group %d already serves a part of predicate %s", dstGroup, attr)
+	}
 	msg := fmt.Sprintf("Going to move predicate: [%v], size: [ondisk: %v, uncompressed: %v]"+
 		" from group %d to %d\n", predicate, humanize.IBytes(uint64(tab.OnDiskBytes)),
 		humanize.IBytes(uint64(tab.UncompressedBytes)), srcGroup, dstGroup)
@@ -110,7 +126,7 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	span.Annotate([]otrace.Attribute{otrace.StringAttribute("tablet", predicate)}, msg)
 
 	// Block all commits on this predicate. Keep them blocked until we return from this function.
-	unblock := s.blockTablet(predicate)
+	unblock := s.blockTablet(attr)
 	defer unblock()
 
 	// Get a new timestamp, beyond which we are sure that no new txns would be committed for this
@@ -179,70 +195,76 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	return nil
 }
 
-func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uint32) {
-	s.RLock()
-	defer s.RUnlock()
-	if s.state == nil {
-		return
+// splitTablet splits the range of subject uids of predicate which contains at. The uids from at up
+// to the start of the next split of predicate are moved to dstGroup, which would serve them as a
+// separate tablet.
+func (s *Server) splitTablet(predicate string, at uint64, dstGroup uint32) error {
+	if at == 0 {
+		return errors.Errorf("Unable to split predicate %s at uid 0", predicate)
 	}
-	numGroups := len(s.state.Groups)
-	if !s.Node.AmLeader() || numGroups <= 1 {
-		return
+	name := x.SplitTabletName(predicate, at)
+	if tab := s.ServingTablet(name); tab != nil {
+		return errors.Errorf("Predicate %s is already split at %#x, served by group %d",
+			predicate, at, tab.GroupId)
 	}
-
-	// Sort all groups by their sizes.
-	type kv struct {
-		gid  uint32
-		size int64 // in bytes
+	srcGroup := s.groupServingUid(predicate, at)
+	if srcGroup == 0 {
+		return errors.Errorf("Tablet to be split: [%v] is not being served", predicate)
 	}
-	var groups []kv
-	for k, v := range s.state.Groups {
-		space := int64(0)
-		for _, tab := range v.Tablets {
-			space += tab.OnDiskBytes
-		}
-		groups = append(groups, kv{k, space})
-	}
-	sort.Slice(groups, func(i, j int) bool {
-		return groups[i].size < groups[j].size
-	})
-
-	glog.Infof("\n\nGroups sorted by size: %+v\n\n", groups)
-	for lastGroup := numGroups - 1; lastGroup > 0; lastGroup-- {
-		srcGroup = groups[lastGroup].gid
-		dstGroup = groups[0].gid
-		sizeDiff := groups[lastGroup].size - groups[0].size
-		glog.Infof("size_diff %v\n", sizeDiff)
-		// Don't move a node unless you receive atleast one update regarding tablet size.
-		// Tablet size would have come up with leader update.
-		if !s.hasLeader(dstGroup) {
-			return
-		}
-		// We move the predicate only if the difference between size of both machines is
-		// atleast 10% of dst group.
-		if float64(sizeDiff) < 0.1*float64(groups[0].size) {
-			continue
-		}
+	if srcGroup == dstGroup {
+		return errors.Errorf("Uid %#x of predicate %s is already served by group %d",
+			at, predicate, dstGroup)
+	}
+	return s.movePredicate(name, srcGroup, dstGroup)
+}
 
-		// Try to find a predicate which we can move.
-		size := int64(0)
-		group := s.state.Groups[srcGroup]
-		for _, tab := range group.Tablets {
-			// Reserved predicates should always be in group 1 so do not re-balance them.
-			if x.IsReservedPredicate(tab.Predicate) {
-				continue
-			}
+// groupServingUid returns the group serving the data of predicate for the subject uid.
+func (s *Server) groupServingUid(predicate string, uid uint64) uint32 {
+	s.RLock()
+	defer s.RUnlock()
 
-			// Finds a tablet as big a possible such that on moving it dstGroup's size is
-			// less than or equal to srcGroup.
-			if tab.OnDiskBytes <= sizeDiff/2 && tab.OnDiskBytes > size {
-				predicate = tab.Predicate
-				size = tab.OnDiskBytes
+	var gid uint32
+	var start uint64
+	for _, group := range s.state.Groups {
+		for key, tab := range group.Tablets {
+			switch attr, from, ok := x.ParseSplitTablet(key); {
+			case key == predicate && start == 0:
+				gid = tab.GroupId
+			case ok && attr == predicate && from <= uid && from > start:
+				gid, start = tab.GroupId, from
 			}
 		}
-		if len(predicate) > 0 {
-			return
+	}
+	return gid
+}
+
+// servesPartOf returns true if gid serves the predicate or a uid range of it.
+func (s *Server) servesPartOf(gid uint32, predicate string) bool {
+	s.AssertRLock()
+
+	group, ok := s.state.Groups[gid]
+	if !ok {
+		return false
+	}
+	for key := range group.Tablets {
+		if attr, _, ok := x.ParseSplitTablet(key); key == predicate || (ok && attr == predicate) {
+			return true
 		}
 	}
-	return
+	return false
+}
+
+// chooseTablet returns the tablet the rebalancer should move next, if any.
+func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uint32) {
+	if !s.Node.AmLeader() {
+		return
+	}
+	moves := s.rebalancePlan(1)
+	if len(moves) == 0 {
+		return
+	}
+	move := moves[0]
+	glog.Infof("Rebalancing tablet %s of size %s serving %.2f ops/sec from group %d to %d",
+		move.Predicate, humanize.IBytes(uint64(move.Size)), move.Ops, move.SrcGroup, move.DstGroup)
+	return move.Predicate, move.SrcGroup, move.DstGroup
 }
@@ -77,6 +77,7 @@ type Server struct {
 	blockCommitsOn *sync.Map
 
 	checkpointPerGroup map[uint32]uint64
+	memberLoads        map[uint64]*memberLoad
 }
 
 // Init initializes the zero server.
@@ -99,6 +100,7 @@ func (s *Server) Init() {
 	s.blockCommitsOn = new(sync.Map)
 	s.moveOngoing = make(chan struct{}, 1)
 	s.checkpointPerGroup = make(map[uint32]uint64)
+	s.memberLoads = make(map[uint64]*memberLoad)
 
 	go s.rebalanceTablets()
 }
@@ -205,6 +207,13 @@ func (s *Server) KnownGroups() []uint32 {
 	return groups
 }
 
+func (s *Server) knownGroup(gid uint32) bool {
+	s.RLock()
+	defer s.RUnlock()
+	_, ok := s.state.Groups[gid]
+	return ok
+}
+
 func (s *Server) hasLeader(gid uint32) bool {
 	s.AssertRLock()
 	if s.state == nil {
@@ -423,6 +432,56 @@ func (s *Server) removeNode(ctx context.Context, nodeId uint64, groupId uint32)
 	return s.Node.proposeAndWait(ctx, zp)
 }
 
+// maxMembersPerZone returns the number of members of a group with numReplicas replicas which can
+// be in the same zone, without losing the quorum of the group if the zone goes down.
+func maxMembersPerZone(numReplicas int) int {
+	if max := (numReplicas - 1) / 2; max > 0 {
+		return max
+	}
+	// Groups with less than three replicas can't survive the loss of any of their members.
+	return 1
+}
+
+func membersInZone(group *pb.Group, zone string) int {
+	var n int
+	for _, member := range group.GetMembers() {
+		// Learners don't vote, so they don't count towards the quorum.
+		if member.Zone == zone && !member.Learner {
+			n++
+		}
+	}
+	return n
+}
+
+// groupForMember returns the group that the new member m should join, or zero if it should start
+// a new group. It picks a group which needs more replicas, preferring the ones with the fewest
+// members in the zone of m. A member doesn't join a group where its zone would hold a quorum, so
+// that losing one zone doesn't lose the quorum of any group. If every group which needs more
+// replicas already has too many members in the zone of m, m still joins the one with the fewest,
+// rather than starting a new group which would only live in that zone. s must be locked.
+func (s *Server) groupForMember(m *pb.Member) uint32 {
+	var best uint32
+	var bestInZone int
+	for gid, group := range s.state.Groups {
+		if len(group.Members) >= s.NumReplicas {
+			continue
+		}
+		var inZone int
+		if m.Zone != "" {
+			inZone = membersInZone(group, m.Zone)
+		}
+		if best == 0 || inZone < bestInZone || (inZone == bestInZone && gid < best) {
+			best, bestInZone = gid, inZone
+		}
+	}
+	if best > 0 && m.Zone != "" && bestInZone >= maxMembersPerZone(s.NumReplicas) {
+		glog.Warningf("Every group which needs more replicas has %d or more members in zone %q."+
+			" Member %d joins group %d, but losing this zone would lose the quorum of the group."+
+			" Add Alphas in other zones.", bestInZone, m.Zone, m.Id, best)
+	}
+	return best
+}
+
 // Connect is used by Alpha nodes to connect the very first time with group zero.
 func (s *Server) Connect(ctx context.Context,
 	m *pb.Member) (resp *pb.ConnectionState, err error) {
@@ -540,6 +599,11 @@ func (s *Server) Connect(ctx context.Context,
 			// We don't have this server in the list.
 			if len(group.Members) < s.NumReplicas {
 				// We need more servers here, so let's add it.
+				if m.Zone != "" && membersInZone(group, m.Zone) >= maxMembersPerZone(s.NumReplicas) {
+					glog.Warningf("Adding member %#x to group %d which already has %d members in"+
+						" zone %q. Losing this zone would lose the quorum of the group.",
+						m.Id, m.GroupId, membersInZone(group, m.Zone), m.Zone)
+				}
 				proposal.Member = m
 				return proposal
 			} else if m.ForceGroupId {
@@ -552,12 +616,10 @@ func (s *Server) Connect(ctx context.Context,
 			// Already have plenty of servers serving this group.
 		}
 		// Let's assign this server to a new group.
-		for gid, group := range s.state.Groups {
-			if len(group.Members) < s.NumReplicas {
-				m.GroupId = gid
-				proposal.Member = m
-				return proposal
-			}
+		if gid := s.groupForMember(m); gid > 0 {
+			m.GroupId = gid
+			proposal.Member = m
+			return proposal
 		}
 		// We either don't have any groups, or don't have any groups which need another member.
 		m.GroupId = s.nextGroup
@@ -630,6 +692,9 @@ func (s *Server) ShouldServe(
 		// This will also make it easier to restore the reserved predicates after
 		// a DropAll operation.
 		tablet.GroupId = 1
+	} else if gid := s.pinnedGroup(tablet.Predicate); gid != 0 && s.knownGroup(gid) {
+		// A pinned tablet which was dropped, gets served again by the group it's pinned to.
+		tablet.GroupId = gid
 	}
 	proposal.Tablet = tablet
 	if err := s.Node.proposeAndWait(ctx, &proposal); err != nil && err != errTabletAlreadyServed {
@@ -652,6 +717,7 @@ func (s *Server) UpdateMembership(ctx context.Context, group *pb.Group) (*api.Pa
 			s.Unlock()
 		}
 	}
+	s.recordLoad(group)
 	proposals, err := s.createProposals(group)
 	if err != nil {
 		// Sleep here so the caller doesn't keep on retrying indefinitely, creating a busy
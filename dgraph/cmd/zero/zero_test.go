@@ -18,9 +18,13 @@ package zero
 
 import (
 	"context"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,3 +39,197 @@ func TestRemoveNode(t *testing.T) {
 	err = server.removeNode(context.TODO(), 1, 2)
 	require.Error(t, err)
 }
+
+func TestSplitTablet(t *testing.T) {
+	server := &Server{
+		state: &pb.MembershipState{
+			Groups: map[uint32]*pb.Group{
+				1: {Tablets: map[string]*pb.Tablet{
+					"follows": {GroupId: 1, Predicate: "follows"},
+				}},
+				2: {Tablets: map[string]*pb.Tablet{
+					"follows|0x100": {GroupId: 2, Predicate: "follows|0x100"},
+				}},
+				3: {Tablets: map[string]*pb.Tablet{}},
+			},
+		},
+	}
+	require.Equal(t, uint32(1), server.groupServingUid("follows", 0xff))
+	require.Equal(t, uint32(2), server.groupServingUid("follows", 0x100))
+	require.Equal(t, uint32(2), server.groupServingUid("follows", 0x1000))
+	require.Equal(t, uint32(0), server.groupServingUid("name", 0x1000))
+
+	server.RLock()
+	require.True(t, server.servesPartOf(1, "follows"))
+	require.True(t, server.servesPartOf(2, "follows"))
+	require.False(t, server.servesPartOf(3, "follows"))
+	server.RUnlock()
+
+	require.Error(t, server.splitTablet("follows", 0, 3))
+	require.Error(t, server.splitTablet("follows", 0x100, 3))
+	require.Error(t, server.splitTablet("follows", 0x200, 2))
+	require.Error(t, server.splitTablet("name", 0x200, 3))
+}
+
+func TestChooseMove(t *testing.T) {
+	// Both groups have the same size, but small predicates in group 1 are hot.
+	hotGroups := func() []*groupStat {
+		return []*groupStat{
+			{gid: 1, size: 100, ops: 900, hasLeader: true, tablets: []*tabletStat{
+				{predicate: "name", attr: "name", size: 70},
+				{predicate: "hot1", attr: "hot1", size: 10, ops: 300},
+				{predicate: "hot2", attr: "hot2", size: 10, ops: 300},
+				{predicate: "hot3", attr: "hot3", size: 10, ops: 300},
+			}},
+			{gid: 2, size: 100, hasLeader: true, tablets: []*tabletStat{
+				{predicate: "age", attr: "age", size: 100},
+			}},
+		}
+	}
+	moves := planMoves(hotGroups(), nil, maxPlanMoves)
+	require.Len(t, moves, 1)
+	require.Equal(t, tabletMove{Predicate: "hot1", SrcGroup: 1, DstGroup: 2, Size: 10, Ops: 300},
+		*moves[0])
+
+	// Pinned tablets are never moved.
+	pinned := map[string]uint32{"hot1": 1, "hot2": 1, "hot3": 1}
+	moves = planMoves(hotGroups(), pinned, maxPlanMoves)
+	require.Len(t, moves, 1)
+	require.Equal(t, "name", moves[0].Predicate)
+
+	// The destination must have a leader.
+	groups := hotGroups()
+	groups[1].hasLeader = false
+	require.Empty(t, planMoves(groups, nil, maxPlanMoves))
+
+	// A group under pressure sheds its tablets.
+	groups = []*groupStat{
+		{gid: 1, size: 100, pressure: 0.9, hasLeader: true, tablets: []*tabletStat{
+			{predicate: "name", attr: "name", size: 50},
+			{predicate: "friend", attr: "friend", size: 50},
+		}},
+		{gid: 2, size: 100, pressure: 0.1, hasLeader: true, tablets: []*tabletStat{
+			{predicate: "age", attr: "age", size: 100},
+		}},
+	}
+	moves = planMoves(groups, nil, maxPlanMoves)
+	require.Len(t, moves, 1)
+	require.Equal(t, uint32(1), moves[0].SrcGroup)
+
+	// Balanced groups are left alone.
+	groups = []*groupStat{
+		{gid: 1, size: 100, hasLeader: true, tablets: []*tabletStat{
+			{predicate: "name", attr: "name", size: 100},
+		}},
+		{gid: 2, size: 105, hasLeader: true, tablets: []*tabletStat{
+			{predicate: "age", attr: "age", size: 105},
+		}},
+	}
+	require.Empty(t, planMoves(groups, nil, maxPlanMoves))
+}
+
+func TestRecordLoad(t *testing.T) {
+	server := &Server{memberLoads: make(map[uint64]*memberLoad)}
+	group := &pb.Group{Members: map[uint64]*pb.Member{
+		1: {Id: 1, GroupId: 2, CpuUsage: 0.5, MemoryInUse: 1 << 20,
+			TabletLoads: map[string]*pb.TabletLoad{"name": {ReadsPerSec: 10}}},
+	}}
+	server.recordLoad(group)
+	require.Zero(t, group.Members[1].CpuUsage)
+	require.Nil(t, group.Members[1].TabletLoads)
+
+	load := server.memberLoads[1]
+	require.Equal(t, uint32(2), load.gid)
+	require.Equal(t, 0.5, load.cpu)
+	require.Equal(t, uint64(1<<20), load.memory)
+	require.Equal(t, 10.0, load.tablets["name"].ReadsPerSec)
+}
+
+func TestSerializableConflict(t *testing.T) {
+	var o Oracle
+	o.Init()
+	key := func(prefix string, k uint64) string {
+		return prefix + strconv.FormatUint(k, 36)
+	}
+	commit := func(startTs, commitTs uint64, keys ...string) error {
+		src := &api.TxnContext{StartTs: startTs, CommitTs: commitTs, Keys: keys}
+		o.trackListKeys(src)
+		return o.commit(src)
+	}
+
+	// Write skew: both transactions read what the other one writes. Under snapshot isolation,
+	// both commit.
+	require.NoError(t, commit(1, 3, key("", 10)))
+	require.NoError(t, commit(2, 4, key("", 11)))
+
+	// As serializable transactions, the second one to commit is aborted.
+	require.NoError(t, commit(5, 7, key("", 10), key(x.ReadConflictKeyPrefix, 11)))
+	require.Equal(t, ErrConflict, commit(6, 8, key("", 11), key(x.ReadConflictKeyPrefix, 10)))
+
+	// Writes to a list conflict with the reads of the whole list, but not with other writes.
+	require.NoError(t, commit(9, 11, key("", 20^1), key(x.ListConflictKeyPrefix, 20)))
+	require.NoError(t, commit(10, 12, key("", 20^2), key(x.ListConflictKeyPrefix, 20)))
+	require.Equal(t, ErrConflict, commit(10, 13, key(x.ReadConflictKeyPrefix, 20)))
+
+	// Reads are not recorded, so they don't conflict with later writes.
+	require.NoError(t, commit(14, 15, key(x.ReadConflictKeyPrefix, 30)))
+	require.NoError(t, commit(14, 16, key("", 30)))
+
+	// Once no serializable transaction committed for a while, the writes to whole lists are not
+	// recorded anymore. A serializable transaction started meanwhile is aborted.
+	o.lastSerializable = time.Now().Add(-2 * listKeysIdle)
+	require.NoError(t, commit(17, 18, key("", 40^1), key(x.ListConflictKeyPrefix, 40)))
+	require.Zero(t, o.listKeysSince)
+	o.doneUntil.Begin(20)
+	require.Equal(t, ErrConflict, commit(19, 21, key(x.ReadConflictKeyPrefix, 40)))
+	require.Equal(t, uint64(20), o.listKeysSince)
+	require.NoError(t, commit(22, 23, key(x.ReadConflictKeyPrefix, 40)))
+}
+
+func TestGroupForMember(t *testing.T) {
+	members := func(zones ...string) map[uint64]*pb.Member {
+		m := make(map[uint64]*pb.Member)
+		for i, zone := range zones {
+			m[uint64(i+1)] = &pb.Member{Id: uint64(i + 1), Zone: zone}
+		}
+		return m
+	}
+	server := &Server{
+		NumReplicas: 3,
+		state: &pb.MembershipState{
+			Groups: map[uint32]*pb.Group{
+				1: {Members: members("a", "b")},
+				2: {Members: members("a")},
+				3: {Members: members("b", "c", "a")},
+			},
+		},
+	}
+	// Zone a would hold a quorum of groups 1 and 2, and group 3 is full. The member still joins
+	// an under-replicated group, instead of starting a new group living only in zone a.
+	require.Equal(t, uint32(1), server.groupForMember(&pb.Member{Zone: "a"}))
+	// Group 2 has no member in zone b.
+	require.Equal(t, uint32(2), server.groupForMember(&pb.Member{Zone: "b"}))
+	require.Equal(t, uint32(1), server.groupForMember(&pb.Member{Zone: "c"}))
+	// Members without a zone join any group which needs more replicas.
+	require.Equal(t, uint32(1), server.groupForMember(&pb.Member{}))
+
+	// Learners don't count towards the quorum.
+	server.state.Groups[2].Members[1].Learner = true
+	require.Equal(t, uint32(1), server.groupForMember(&pb.Member{Zone: "c"}))
+	require.Equal(t, uint32(2), server.groupForMember(&pb.Member{Zone: "a"}))
+
+	// A new group is only started once all the groups have enough replicas.
+	server.state.Groups[1].Members = members("a", "b", "c")
+	server.state.Groups[2].Members = members("a", "b", "c")
+	require.Equal(t, uint32(0), server.groupForMember(&pb.Member{Zone: "a"}))
+
+	require.Equal(t, 1, maxMembersPerZone(1))
+	require.Equal(t, 1, maxMembersPerZone(3))
+	require.Equal(t, 2, maxMembersPerZone(5))
+}
+
+func TestTxnPreds(t *testing.T) {
+	preds := txnPreds(&api.TxnContext{Preds: []string{"1-name", "2-dgraph.type", "1-name",
+		"3-my-pred", "invalid"}})
+	require.Equal(t, []string{"name", "dgraph.type", "my-pred"}, preds)
+}
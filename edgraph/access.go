@@ -20,8 +20,10 @@ package edgraph
 
 import (
 	"context"
+	"time"
 
 	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/ee/audit"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/x"
@@ -66,6 +68,21 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result, graphql bool) er
 	return nil
 }
 
+func validateAclNodes(nquads []*api.NQuad) error {
+	return nil
+}
+
+// applyPasswordPolicy does nothing since ACL is only supported in the enterprise version.
+func applyPasswordPolicy(qc *queryContext, check bool) error {
+	return nil
+}
+
+// decrypter returns a function which allows the values of the @encrypted predicates to be
+// decrypted, since ACLs are only supported in the enterprise version.
+func decrypter(ctx context.Context) func(pred string) bool {
+	return func(string) bool { return true }
+}
+
 func authorizeSchemaQuery(ctx context.Context, er *query.ExecutionResult) error {
 	// always allow schema access
 	return nil
@@ -75,3 +92,73 @@ func AuthorizeGuardians(ctx context.Context) error {
 	// always allow access
 	return nil
 }
+
+func AuthorizeNamespaceGuardians(ctx context.Context) error {
+	// always allow access
+	return nil
+}
+
+// AuthorizeWrites always allows the request since ACL is only supported in the enterprise version.
+func AuthorizeWrites(ctx context.Context) error {
+	return nil
+}
+
+// AddApiKey is not supported since ACL is only supported in the enterprise version.
+func AddApiKey(ctx context.Context, name, group string, expiry time.Time, ips []string,
+	readOnly bool) (string, error) {
+	return "", x.ErrNotSupported
+}
+
+// InitCertIdentity returns an error if the client certificates should identify the ACL users,
+// since ACL is only supported in the enterprise version.
+func InitCertIdentity(field, userMap string) error {
+	if field == "" {
+		return nil
+	}
+	return x.ErrNotSupported
+}
+
+// CheckRateLimit always allows the request since ACL is only supported in the enterprise version.
+func CheckRateLimit(ctx context.Context) error {
+	return nil
+}
+
+// extractUserAndGroups returns an error since ACL is only supported in the enterprise version.
+func extractUserAndGroups(ctx context.Context) ([]string, error) {
+	return nil, x.ErrNotSupported
+}
+
+func namespaceOfJwt(ctx context.Context) (uint64, bool) {
+	// there are no users without ACL
+	return 0, false
+}
+
+func createNamespaceGuardians(ctx context.Context, ns uint64, password string) error {
+	return nil
+}
+
+func readNamespaceGuardians(ctx context.Context, ns uint64) (*namespaceGuardians, error) {
+	return nil, nil
+}
+
+func restoreNamespaceGuardians(ctx context.Context, g *namespaceGuardians) error {
+	return nil
+}
+
+// NewAuditEvent returns an empty event since audit logging is only supported in the enterprise
+// version.
+func NewAuditEvent(ctx context.Context, endpoint, operation string) *audit.Event {
+	return audit.NewEvent(endpoint, operation)
+}
+
+func auditRequest(ctx context.Context, req *api.Request,
+	graphql bool) (context.Context, func(*api.Response, error)) {
+	return ctx, func(*api.Response, error) {}
+}
+
+func auditPredicates(ctx context.Context, qc *queryContext) {
+}
+
+func auditAlter(ctx context.Context, op *api.Operation) (context.Context, func(error)) {
+	return ctx, func(error) {}
+}
@@ -16,6 +16,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -31,6 +32,8 @@ import (
 	bpb "github.com/dgraph-io/badger/v3/pb"
 	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/ee/audit"
+	"github.com/dgraph-io/dgraph/ee/idp"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/worker"
@@ -75,21 +78,27 @@ func (s *Server) Login(ctx context.Context,
 	}
 
 	user, err := s.authenticateLogin(ctx, request)
+	auditLogin(ctx, request, user, err)
 	if err != nil {
 		glog.Errorf("Authentication from address %s failed: %v", addr, err)
+		// The lockouts are reported, and so are the expired passwords, since their users gave
+		// the right password.
+		if err == errPasswordExpired || status.Code(err) == codes.ResourceExhausted {
+			return nil, err
+		}
 		return nil, x.ErrorInvalidLogin
 	}
 	glog.Infof("%s logged in successfully", user.UserID)
 
 	resp := &api.Response{}
-	accessJwt, err := getAccessJwt(user.UserID, user.Groups)
+	accessJwt, err := getAccessJwt(user.UserID, user.Groups, user.Namespace)
 	if err != nil {
 		errMsg := fmt.Sprintf("unable to get access jwt (userid=%s,addr=%s):%v",
 			user.UserID, addr, err)
 		glog.Errorf(errMsg)
 		return nil, errors.Errorf(errMsg)
 	}
-	refreshJwt, err := getRefreshJwt(user.UserID)
+	refreshJwt, err := getRefreshJwt(user)
 	if err != nil {
 		errMsg := fmt.Sprintf("unable to get refresh jwt (userid=%s,addr=%s):%v",
 			user.UserID, addr, err)
@@ -115,7 +124,10 @@ func (s *Server) Login(ctx context.Context,
 
 // authenticateLogin authenticates the login request using either the refresh token if present, or
 // the <userId, password> pair. If authentication passes, it queries the user's uid and associated
-// groups from DB and returns the user object
+// groups from DB and returns the user object. The users who aren't in the DB are authenticated by
+// the external identity providers, if any. The user belongs to the namespace of the refresh
+// token, or else to the namespace of the request. The users are locked out after too many failed
+// password logins, and can't log in once their password has expired.
 func (s *Server) authenticateLogin(ctx context.Context, request *api.LoginRequest) (*acl.User,
 	error) {
 	if err := validateLoginRequest(request); err != nil {
@@ -124,14 +136,21 @@ func (s *Server) authenticateLogin(ctx context.Context, request *api.LoginReques
 
 	var user *acl.User
 	if len(request.RefreshToken) > 0 {
-		userData, err := validateToken(request.RefreshToken)
+		userData, ns, err := validateToken(request.RefreshToken)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to authenticate the refresh token %v",
 				request.RefreshToken)
 		}
 
 		userId := userData[0]
-		user, err = authorizeUser(ctx, userId, "")
+		if isExternalJwt(request.RefreshToken) {
+			if err := checkExternalNamespace(ns); err != nil {
+				return nil, err
+			}
+			glog.Infof("Authenticated external user %s through refresh token", userId)
+			return externalUser(userId, userData[1:], ns), nil
+		}
+		user, err = authorizeUser(x.AttachNamespace(ctx, ns), userId, "")
 		if err != nil {
 			return nil, errors.Wrapf(err, "while querying user with id %v", userId)
 		}
@@ -141,59 +160,169 @@ func (s *Server) authenticateLogin(ctx context.Context, request *api.LoginReques
 				"invalid username or password")
 		}
 
+		if passwordExpired(user, time.Now()) {
+			return nil, errPasswordExpired
+		}
+
 		glog.Infof("Authenticated user %s through refresh token", userId)
+		user.Namespace = ns
 		return user, nil
 	}
 
-	// authorize the user using password
-	var err error
-	user, err = authorizeUser(ctx, request.Userid, request.Password)
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !namespaceExists(ns) {
+		return nil, errors.Errorf("Namespace %d doesn't exist", ns)
+	}
+	// The logins without a user id carry an ID token of an identity provider.
+	if len(request.Userid) == 0 {
+		return authenticateExternal(ctx, request, ns)
+	}
+	if err := checkLockout(request.Userid, ns); err != nil {
+		return nil, err
+	}
+	user, err = authenticatePassword(ctx, request, ns)
+	recordLogin(ctx, request.Userid, ns, err)
+	if err != nil {
+		return nil, err
+	}
+	// The users with an expired password gave the right password, so they aren't locked out.
+	if !user.External && passwordExpired(user, time.Now()) {
+		return nil, errPasswordExpired
+	}
+	return user, nil
+}
+
+// authenticatePassword authenticates the login request using the <userId, password> pair.
+func authenticatePassword(ctx context.Context, request *api.LoginRequest, ns uint64) (
+	*acl.User, error) {
+	user, err := authorizeUser(ctx, request.Userid, request.Password)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while querying user with id %v",
 			request.Userid)
 	}
 
 	if user == nil {
+		if idp.Enabled() && ns == idp.Namespace() {
+			return authenticateExternal(ctx, request, ns)
+		}
 		return nil, errors.Errorf("unable to authenticate: " +
 			"invalid username or password")
 	}
 	if !user.PasswordMatch {
 		return nil, x.ErrorInvalidLogin
 	}
+	user.Namespace = ns
 	return user, nil
 }
 
-// validateToken verifies the signature and expiration of the jwt, and if validation passes,
-// returns a slice of strings, where the first element is the extracted userId
-// and the rest are groupIds encoded in the jwt.
-func validateToken(jwtStr string) ([]string, error) {
-	token, err := jwt.Parse(jwtStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.Errorf("unexpected signing method: %v", token.Header["alg"])
+// authenticateExternal authenticates the login request against the external identity providers.
+// The users of the identity providers can't take the user ids of the users in the DB, whose
+// permissions they would otherwise get, and only log into the namespace of the identity providers.
+func authenticateExternal(ctx context.Context, request *api.LoginRequest, ns uint64) (
+	*acl.User, error) {
+	if err := checkExternalNamespace(ns); err != nil {
+		return nil, err
+	}
+	id, err := idp.Authenticate(ctx, request.Userid, request.Password)
+	if err != nil {
+		return nil, err
+	}
+	if id == nil {
+		return nil, errors.Errorf("unable to authenticate: " +
+			"invalid username or password")
+	}
+	if id.UserID != request.Userid {
+		user, err := authorizeUser(ctx, id.UserID, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "while querying user with id %v", id.UserID)
 		}
-		return []byte(worker.Config.HmacSecret), nil
-	})
+		if user != nil {
+			return nil, errors.Errorf("the external user %s is a user of the DB", id.UserID)
+		}
+	}
+	glog.Infof("Authenticated external user %s with groups %v", id.UserID, id.Groups)
+	return externalUser(id.UserID, id.Groups, ns), nil
+}
+
+// checkExternalNamespace returns an error if the users of the external identity providers can't
+// log into the namespace ns. Their groups are the groups of a single namespace, so they would get
+// the permissions of the groups with the same names in any other namespace.
+func checkExternalNamespace(ns uint64) error {
+	if ns != idp.Namespace() {
+		return errors.Errorf("unable to authenticate: the external users can't log into "+
+			"namespace %d", ns)
+	}
+	return nil
+}
+
+// externalUser returns the user of an external identity provider, of the given ACL groups.
+func externalUser(userId string, groupIds []string, ns uint64) *acl.User {
+	user := &acl.User{UserID: userId, Namespace: ns, External: true}
+	for _, groupId := range groupIds {
+		user.Groups = append(user.Groups, acl.Group{GroupID: groupId})
+	}
+	return user
+}
+
+// isExternalJwt returns whether a valid jwt belongs to a user of an external identity provider.
+func isExternalJwt(jwtStr string) bool {
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(jwtStr, claims); err != nil {
+		return false
+	}
+	external, _ := claims["external"].(bool)
+	return external
+}
+
+// parseWithHmacSecrets parses the jwt and verifies it with the current HMAC secret, or else with
+// the secret that the last rotation replaced.
+func parseWithHmacSecrets(jwtStr string) (*jwt.Token, error) {
+	var firstErr error
+	for _, secret := range worker.HmacSecrets() {
+		token, err := jwt.Parse(jwtStr, func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, errors.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return []byte(secret), nil
+		})
+		if err == nil {
+			return token, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
 
+// validateToken verifies the signature and expiration of the jwt, and if validation passes,
+// returns a slice of strings, where the first element is the extracted userId
+// and the rest are groupIds encoded in the jwt, and the namespace of the user.
+func validateToken(jwtStr string) ([]string, uint64, error) {
+	token, err := parseWithHmacSecrets(jwtStr)
 	if err != nil {
-		return nil, errors.Errorf("unable to parse jwt token:%v", err)
+		return nil, 0, errors.Errorf("unable to parse jwt token:%v", err)
 	}
 
 	// TODO(arijit): Upgrade the jwt library to v4.0
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok || !token.Valid {
-		return nil, errors.Errorf("claims in jwt token is not map claims")
+		return nil, 0, errors.Errorf("claims in jwt token is not map claims")
 	}
 
 	// by default, the MapClaims.Valid will return true if the exp field is not set
 	// here we enforce the checking to make sure that the refresh token has not expired
 	now := time.Now().Unix()
 	if !claims.VerifyExpiresAt(now, true) {
-		return nil, errors.Errorf("Token is expired") // the same error msg that's used inside jwt-go
+		return nil, 0, errors.Errorf("Token is expired") // the same error msg that's used inside jwt-go
 	}
 
 	userId, ok := claims["userid"].(string)
 	if !ok {
-		return nil, errors.Errorf("userid in claims is not a string:%v", userId)
+		return nil, 0, errors.Errorf("userid in claims is not a string:%v", userId)
 	}
 
 	groups, ok := claims["groups"].([]interface{})
@@ -204,17 +333,51 @@ func validateToken(jwtStr string) ([]string, error) {
 			groupId, ok := group.(string)
 			if !ok {
 				// This shouldn't happen. So, no need to make the client try to refresh the tokens.
-				return nil, errors.Errorf("unable to convert group to string:%v", group)
+				return nil, 0, errors.Errorf("unable to convert group to string:%v", group)
 			}
 
 			groupIds = append(groupIds, groupId)
 		}
 	}
-	return append([]string{userId}, groupIds...), nil
+	ns, err := namespaceOfClaims(claims)
+	if err != nil {
+		return nil, 0, err
+	}
+	return append([]string{userId}, groupIds...), ns, nil
+}
+
+// maxExactFloat is the largest integer up to which every integer is exactly a float64.
+const maxExactFloat = 1 << 53
+
+// namespaceOfClaims returns the namespace of the claims of a JWT. It's issued as a decimal string,
+// since the namespaces are uint64s, which JSON numbers can't hold exactly. The tokens issued before
+// namespaces existed belong to the galaxy namespace.
+func namespaceOfClaims(claims jwt.MapClaims) (uint64, error) {
+	val, ok := claims["namespace"]
+	if !ok {
+		return x.GalaxyNamespace, nil
+	}
+	switch val := val.(type) {
+	case string:
+		ns, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			return 0, errors.Errorf("namespace in claims is not a uint64:%v", val)
+		}
+		return ns, nil
+	case float64:
+		// The tokens issued as numbers are only accepted if the number is exact.
+		if val < 0 || val > maxExactFloat || val != math.Trunc(val) {
+			return 0, errors.Errorf("namespace in claims is not a valid number:%v", val)
+		}
+		return uint64(val), nil
+	default:
+		return 0, errors.Errorf("namespace in claims is not a string:%v", val)
+	}
 }
 
 // validateLoginRequest validates that the login request has either the refresh token or the
-// <user id, password> pair
+// <user id, password> pair. The user id may be left out if there are external identity providers,
+// in which case the password is an ID token.
 func validateLoginRequest(request *api.LoginRequest) error {
 	if request == nil {
 		return errors.Errorf("the request should not be nil")
@@ -225,7 +388,7 @@ func validateLoginRequest(request *api.LoginRequest) error {
 	}
 
 	// otherwise make sure both userid and password are set
-	if len(request.Userid) == 0 {
+	if len(request.Userid) == 0 && !idp.Enabled() {
 		return errors.Errorf("the userid should not be empty")
 	}
 	if len(request.Password) == 0 {
@@ -234,32 +397,40 @@ func validateLoginRequest(request *api.LoginRequest) error {
 	return nil
 }
 
-// getAccessJwt constructs an access jwt with the given user id, groupIds,
+// getAccessJwt constructs an access jwt with the given user id, groupIds, namespace
 // and expiration TTL specified by worker.Config.AccessJwtTtl
-func getAccessJwt(userId string, groups []acl.Group) (string, error) {
+func getAccessJwt(userId string, groups []acl.Group, ns uint64) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"userid": userId,
-		"groups": acl.GetGroupIDs(groups),
+		"userid":    userId,
+		"groups":    acl.GetGroupIDs(groups),
+		"namespace": strconv.FormatUint(ns, 10),
 		// set the jwt exp according to the ttl
 		"exp": time.Now().Add(worker.Config.AccessJwtTtl).Unix(),
 	})
 
-	jwtString, err := token.SignedString([]byte(worker.Config.HmacSecret))
+	jwtString, err := token.SignedString([]byte(worker.HmacSecrets()[0]))
 	if err != nil {
 		return "", errors.Errorf("unable to encode jwt to string: %v", err)
 	}
 	return jwtString, nil
 }
 
-// getRefreshJwt constructs a refresh jwt with the given user id, and expiration ttl specified by
-// worker.Config.RefreshJwtTtl
-func getRefreshJwt(userId string) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"userid": userId,
-		"exp":    time.Now().Add(worker.Config.RefreshJwtTtl).Unix(),
-	})
+// getRefreshJwt constructs a refresh jwt with the user id, namespace, and expiration ttl
+// specified by worker.Config.RefreshJwtTtl. The refresh jwt of an external user also holds its
+// groups, since they can't be queried from DB.
+func getRefreshJwt(user *acl.User) (string, error) {
+	claims := jwt.MapClaims{
+		"userid":    user.UserID,
+		"namespace": strconv.FormatUint(user.Namespace, 10),
+		"exp":       time.Now().Add(worker.Config.RefreshJwtTtl).Unix(),
+	}
+	if user.External {
+		claims["groups"] = acl.GetGroupIDs(user.Groups)
+		claims["external"] = true
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	jwtString, err := token.SignedString([]byte(worker.Config.HmacSecret))
+	jwtString, err := token.SignedString([]byte(worker.HmacSecrets()[0]))
 	if err != nil {
 		return "", errors.Errorf("unable to encode jwt to string: %v", err)
 	}
@@ -272,6 +443,7 @@ const queryUser = `
 	    uid
         dgraph.xid
         password_match: checkpwd(dgraph.password, $password)
+        dgraph.user.password_changed
         dgraph.user.group {
           uid
           dgraph.xid
@@ -364,13 +536,26 @@ const queryAcls = `
 {
   allAcls(func: type(dgraph.type.Group)) {
     dgraph.xid
+	dgraph.acl.rate_limit
+	dgraph.acl.burst
 	dgraph.acl.rule {
 		dgraph.rule.predicate
 		dgraph.rule.permission
 	}
+	dgraph.acl.policy {
+		dgraph.policy.type
+		dgraph.policy.filter
+	}
 	~dgraph.user.group{
 		dgraph.xid
 	}
+	~dgraph.apikey.group {
+		dgraph.apikey.name
+		dgraph.apikey.hash
+		dgraph.apikey.expiry
+		dgraph.apikey.ips
+		dgraph.apikey.readonly
+	}
   }
 }
 `
@@ -379,6 +564,17 @@ var aclPrefixes = [][]byte{
 	x.PredicatePrefix("dgraph.acl.permission"),
 	x.PredicatePrefix("dgraph.acl.predicate"),
 	x.PredicatePrefix("dgraph.acl.rule"),
+	x.PredicatePrefix("dgraph.acl.rate_limit"),
+	x.PredicatePrefix("dgraph.acl.burst"),
+	x.PredicatePrefix("dgraph.acl.policy"),
+	x.PredicatePrefix("dgraph.policy.type"),
+	x.PredicatePrefix("dgraph.policy.filter"),
+	x.PredicatePrefix("dgraph.apikey.name"),
+	x.PredicatePrefix("dgraph.apikey.hash"),
+	x.PredicatePrefix("dgraph.apikey.group"),
+	x.PredicatePrefix("dgraph.apikey.expiry"),
+	x.PredicatePrefix("dgraph.apikey.ips"),
+	x.PredicatePrefix("dgraph.apikey.readonly"),
 	x.PredicatePrefix("dgraph.user.group"),
 	x.PredicatePrefix("dgraph.type.Group"),
 	x.PredicatePrefix("dgraph.xid"),
@@ -550,21 +746,177 @@ func ResetAcl(closer *z.Closer) {
 	}
 }
 
-// extract the userId, groupIds from the accessJwt in the context
+// createNamespaceGuardians creates the guardians group and the groot user of the new namespace ns,
+// who administer the namespace. The password of groot is set to password.
+func createNamespaceGuardians(ctx context.Context, ns uint64, password string) error {
+	if len(worker.Config.HmacSecret) == 0 {
+		// The acl feature is not turned on.
+		return nil
+	}
+	if len(password) == 0 {
+		return errors.Errorf("The password of groot must be set to add a namespace with ACLs")
+	}
+	if err := checkPassword(password); err != nil {
+		return err
+	}
+	return addNamespaceGuardians(ctx, ns, password)
+}
+
+// readNamespaceGuardians returns the groot user and the guardians group of namespace ns, which
+// are kept when its data is dropped, or nil if ACLs are disabled. It's read before anything is
+// dropped.
+func readNamespaceGuardians(ctx context.Context, ns uint64) (*namespaceGuardians, error) {
+	if len(worker.Config.HmacSecret) == 0 {
+		return nil, nil
+	}
+	resp, err := (&Server{}).doQuery(x.AttachNamespace(ctx, ns), &api.Request{
+		Query: fmt.Sprintf(`{
+			groot(func: eq(dgraph.xid, "%s")) @filter(type(dgraph.type.User)) {
+				uid
+			}
+			guardians(func: eq(dgraph.xid, "%s")) @filter(type(dgraph.type.Group)) {
+				uid
+			}
+		}`, x.GrootId, x.GuardiansId),
+		ReadOnly: true,
+	}, NoAuthorize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the guardians of namespace %d", ns)
+	}
+	var result struct {
+		Groot     []struct{ Uid string } `json:"groot"`
+		Guardians []struct{ Uid string } `json:"guardians"`
+	}
+	if err := json.Unmarshal(resp.GetJson(), &result); err != nil {
+		return nil, err
+	}
+	if len(result.Groot) != 1 || len(result.Guardians) != 1 {
+		return nil, errors.Errorf("the groot user or the guardians group of namespace %d is "+
+			"missing", ns)
+	}
+	g := &namespaceGuardians{ns: ns}
+	if g.groot, err = strconv.ParseUint(result.Groot[0].Uid, 0, 64); err != nil {
+		return nil, err
+	}
+	if g.group, err = strconv.ParseUint(result.Guardians[0].Uid, 0, 64); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// restoreNamespaceGuardians recreates the groot user and the guardians group of a namespace on
+// their nodes, once the data of the namespace is dropped. The password of groot is kept, since it
+// can't be read back: dropNamespace keeps the passwords, and the ones of the other users are
+// deleted here.
+func restoreNamespaceGuardians(ctx context.Context, g *namespaceGuardians) error {
+	if g == nil {
+		return nil
+	}
+	groot, group := fmt.Sprintf("%#x", g.groot), fmt.Sprintf("%#x", g.group)
+	nquads := acl.CreateGroupNQuads(x.GuardiansId)
+	for _, nq := range nquads {
+		nq.Subject = group
+	}
+	for _, nq := range acl.CreateUserNQuads(x.GrootId, "") {
+		if nq.Predicate != "dgraph.password" {
+			nq.Subject = groot
+			nquads = append(nquads, nq)
+		}
+	}
+	nquads = append(nquads, &api.NQuad{
+		Subject:   groot,
+		Predicate: "dgraph.user.group",
+		ObjectId:  group,
+	})
+	req := &api.Request{
+		Query: fmt.Sprintf(`{
+			users as var(func: has(dgraph.password)) @filter(NOT uid(%#x))
+		}`, g.groot),
+		CommitNow: true,
+		Mutations: []*api.Mutation{{
+			Set: nquads,
+			Del: []*api.NQuad{{
+				Subject:     "uid(users)",
+				Predicate:   "dgraph.password",
+				ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}},
+			}},
+		}},
+	}
+	if _, err := (&Server{}).doQuery(x.AttachNamespace(ctx, g.ns), req, NoAuthorize); err != nil {
+		return errors.Wrapf(err, "while restoring the guardians of namespace %d", g.ns)
+	}
+	return nil
+}
+
+func addNamespaceGuardians(ctx context.Context, ns uint64, password string) error {
+	nquads := acl.CreateGroupNQuads(x.GuardiansId)
+	nquads = append(nquads, acl.CreateUserNQuads(x.GrootId, password)...)
+	nquads = append(nquads, &api.NQuad{
+		Subject:   "_:newuser",
+		Predicate: "dgraph.user.group",
+		ObjectId:  "_:newgroup",
+	})
+	req := &api.Request{
+		CommitNow: true,
+		Mutations: []*api.Mutation{{Set: nquads}},
+	}
+	if _, err := (&Server{}).doQuery(x.AttachNamespace(ctx, ns), req, NoAuthorize); err != nil {
+		return errors.Wrapf(err, "while creating the guardians of namespace %d", ns)
+	}
+	return nil
+}
+
+// extract the userId, groupIds from the accessJwt in the context, or else from the client
+// certificate of the request
 func extractUserAndGroups(ctx context.Context) ([]string, error) {
 	accessJwt, err := x.ExtractJwt(ctx)
+	if err == x.ErrNoJwt {
+		userData, _, err := authenticateCert(ctx)
+		return userData, err
+	}
 	if err != nil {
 		return nil, err
 	}
-	return validateToken(accessJwt[0])
+	if isApiKey(accessJwt[0]) {
+		key, _, err := authenticateApiKey(ctx, accessJwt[0])
+		if err != nil {
+			return nil, err
+		}
+		return []string{key.userId(), key.group}, nil
+	}
+	userData, _, err := validateToken(accessJwt[0])
+	return userData, err
+}
+
+// namespaceOfJwt returns the namespace of the user of the valid accessJwt in the context, or else
+// of the user of the client certificate of the request, and false if there is none or ACLs are
+// disabled.
+func namespaceOfJwt(ctx context.Context) (uint64, bool) {
+	if len(worker.Config.HmacSecret) == 0 {
+		return 0, false
+	}
+	accessJwt, err := x.ExtractJwt(ctx)
+	if err == x.ErrNoJwt {
+		_, ns, err := authenticateCert(ctx)
+		return ns, err == nil
+	}
+	if err != nil {
+		return 0, false
+	}
+	if isApiKey(accessJwt[0]) {
+		_, ns, err := authenticateApiKey(ctx, accessJwt[0])
+		return ns, err == nil
+	}
+	_, ns, err := validateToken(accessJwt[0])
+	return ns, err == nil
 }
 
-func authorizePreds(userId string, groupIds, preds []string,
+func authorizePreds(cache *aclCache, userId string, groupIds, preds []string,
 	aclOp *acl.Operation) (map[string]struct{}, []string) {
 
 	blockedPreds := make(map[string]struct{})
 	for _, pred := range preds {
-		if err := aclCachePtr.authorizePredicate(groupIds, pred, aclOp); err != nil {
+		if err := cache.authorizePredicate(groupIds, pred, aclOp); err != nil {
 			logAccess(&accessEntry{
 				userId:    userId,
 				groups:    groupIds,
@@ -576,16 +928,26 @@ func authorizePreds(userId string, groupIds, preds []string,
 			blockedPreds[pred] = struct{}{}
 		}
 	}
-	aclCachePtr.RLock()
-	allowedPreds := make([]string, len(aclCachePtr.userPredPerms[userId]))
+	cache.RLock()
+	allowedPreds := make([]string, len(cache.userPredPerms[userId]))
 	// User can have multiple permission for same predicate, add predicate
 	// only if the acl.Op is covered in the set of permissions for the user
-	for predicate, perm := range aclCachePtr.userPredPerms[userId] {
+	for predicate, perm := range cache.userPredPerms[userId] {
 		if (perm & aclOp.Code) > 0 {
 			allowedPreds = append(allowedPreds, predicate)
 		}
 	}
-	aclCachePtr.RUnlock()
+	if _, ok := cache.userPredPerms[userId]; !ok {
+		// The external users aren't members of the groups in the DB, so their permissions
+		// come from the groups of their jwt.
+		for predicate, groupPerms := range cache.predPerms {
+			if hasRequiredAccess(groupPerms, groupIds, aclOp) {
+				allowedPreds = append(allowedPreds, predicate)
+			}
+		}
+	}
+	cache.RUnlock()
+	allowedPreds = append(allowedPreds, cache.patternAllowedPreds(groupIds, aclOp)...)
 	return blockedPreds, allowedPreds
 }
 
@@ -617,7 +979,7 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 	}
 
 	var userId string
-	var groupIds []string
+	var groupIds, denied []string
 
 	// doAuthorizeAlter checks if alter of all the predicates are allowed
 	// as a byproduct, it also sets the userId, groups variables
@@ -630,6 +992,9 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 
 		userId = userData[0]
 		groupIds = userData[1:]
+		if err := AuthorizeWrites(ctx); err != nil {
+			return err
+		}
 
 		if x.IsGuardian(groupIds) {
 			// Members of guardian group are allowed to alter anything.
@@ -642,10 +1007,15 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 				"only guardians are allowed to drop all data, but the current user is %s", userId)
 		}
 
-		blockedPreds, _ := authorizePreds(userId, groupIds, preds, acl.Modify)
+		cache, err := aclCacheOf(ctx)
+		if err != nil {
+			return err
+		}
+		blockedPreds, _ := authorizePreds(cache, userId, groupIds, preds, acl.Modify)
 		if len(blockedPreds) > 0 {
 			var msg strings.Builder
 			for key := range blockedPreds {
+				denied = append(denied, key)
 				x.Check2(msg.WriteString(key))
 				x.Check2(msg.WriteString(" "))
 			}
@@ -656,16 +1026,14 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 	}
 
 	err := doAuthorizeAlter()
-	span := otrace.FromContext(ctx)
-	if span != nil {
-		span.Annotatef(nil, (&accessEntry{
-			userId:    userId,
-			groups:    groupIds,
-			preds:     preds,
-			operation: acl.Modify,
-			allowed:   err == nil,
-		}).String())
-	}
+	traceAccess(ctx, &accessEntry{
+		userId:    userId,
+		groups:    groupIds,
+		preds:     preds,
+		operation: acl.Modify,
+		allowed:   err == nil,
+		denied:    denied,
+	})
 
 	return err
 }
@@ -728,7 +1096,7 @@ func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
 	preds = append(preds, parsePredsFromMutation(gmu.Del)...)
 
 	var userId string
-	var groupIds []string
+	var groupIds, denied []string
 	// doAuthorizeMutation checks if modification of all the predicates are allowed
 	// as a byproduct, it also sets the userId and groups
 	doAuthorizeMutation := func() error {
@@ -740,6 +1108,9 @@ func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
 
 		userId = userData[0]
 		groupIds = userData[1:]
+		if err := AuthorizeWrites(ctx); err != nil {
+			return err
+		}
 
 		if x.IsGuardian(groupIds) {
 			// Members of guardians group are allowed to mutate anything
@@ -753,10 +1124,15 @@ func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
 			return nil
 		}
 
-		blockedPreds, allowedPreds := authorizePreds(userId, groupIds, preds, acl.Write)
+		cache, err := aclCacheOf(ctx)
+		if err != nil {
+			return err
+		}
+		blockedPreds, allowedPreds := authorizePreds(cache, userId, groupIds, preds, acl.Write)
 		if len(blockedPreds) > 0 {
 			var msg strings.Builder
 			for key := range blockedPreds {
+				denied = append(denied, key)
 				x.Check2(msg.WriteString(key))
 				x.Check2(msg.WriteString(" "))
 			}
@@ -764,21 +1140,18 @@ func authorizeMutation(ctx context.Context, gmu *gql.Mutation) error {
 				"unauthorized to mutate following predicates: %s\n", msg.String())
 		}
 		gmu.AllowedPreds = allowedPreds
-		return nil
+		return authorizeNodes(ctx, gmu, policyFilterText(cache.policyFilters(groupIds)), userId)
 	}
 
 	err := doAuthorizeMutation()
-
-	span := otrace.FromContext(ctx)
-	if span != nil {
-		span.Annotatef(nil, (&accessEntry{
-			userId:    userId,
-			groups:    groupIds,
-			preds:     preds,
-			operation: acl.Write,
-			allowed:   err == nil,
-		}).String())
-	}
+	traceAccess(ctx, &accessEntry{
+		userId:    userId,
+		groups:    groupIds,
+		preds:     preds,
+		operation: acl.Write,
+		allowed:   err == nil,
+		denied:    denied,
+	})
 
 	return err
 }
@@ -847,6 +1220,8 @@ type accessEntry struct {
 	preds     []string
 	operation *acl.Operation
 	allowed   bool
+	// denied holds the predicates denied to the user.
+	denied []string
 }
 
 func (log *accessEntry) String() string {
@@ -855,6 +1230,17 @@ func (log *accessEntry) String() string {
 		strings.Join(log.preds, ","), log.operation.Name, log.allowed)
 }
 
+// traceAccess annotates the span of the request with the outcome of its authorization, and
+// records the predicates denied to the user in the audit event of the request.
+func traceAccess(ctx context.Context, entry *accessEntry) {
+	if span := otrace.FromContext(ctx); span != nil {
+		span.Annotatef(nil, entry.String())
+	}
+	if !entry.allowed || len(entry.denied) > 0 {
+		audit.EventFromContext(ctx).Deny(entry.denied)
+	}
+}
+
 func logAccess(log *accessEntry) {
 	if glog.V(1) {
 		glog.Info(log.String())
@@ -869,7 +1255,7 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result, graphql bool) er
 		return nil
 	}
 
-	var userId string
+	var userId, policyFilter string
 	var groupIds []string
 	predsAndvars := parsePredsFromQuery(parsedReq.Query)
 	preds := predsAndvars.preds
@@ -896,21 +1282,33 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result, graphql bool) er
 			return nil, nil, nil
 		}
 
-		blockedPreds, allowedPreds := authorizePreds(userId, groupIds, preds, acl.Read)
+		cache, err := aclCacheOf(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		policyFilter = policyFilterText(cache.policyFilters(groupIds))
+		blockedPreds, allowedPreds := authorizePreds(cache, userId, groupIds, preds, acl.Read)
 		return blockedPreds, allowedPreds, nil
 	}
 
 	blockedPreds, allowedPreds, err := doAuthorizeQuery()
 
-	if span := otrace.FromContext(ctx); span != nil {
-		span.Annotatef(nil, (&accessEntry{
-			userId:    userId,
-			groups:    groupIds,
-			preds:     preds,
-			operation: acl.Read,
-			allowed:   err == nil,
-		}).String())
+	var denied []string
+	for pred := range blockedPreds {
+		// GraphQL requests get filtered access to the ACL predicates, see below.
+		if graphql && (x.IsAclPredicate(pred) || pred == "~dgraph.user.group") {
+			continue
+		}
+		denied = append(denied, pred)
 	}
+	traceAccess(ctx, &accessEntry{
+		userId:    userId,
+		groups:    groupIds,
+		preds:     preds,
+		operation: acl.Read,
+		allowed:   err == nil,
+		denied:    denied,
+	})
 
 	if err != nil {
 		return err
@@ -948,7 +1346,40 @@ func authorizeQuery(ctx context.Context, parsedReq *gql.Result, graphql bool) er
 		parsedReq.Query[i].AllowedPreds = allowedPreds
 	}
 
-	return nil
+	// The node-level policies are added once the denied predicates are dropped from the query,
+	// since their filters may use predicates the user can't read.
+	if policyFilter == "" {
+		return nil
+	}
+	uidPreds, err := uidPredicates(ctx, parsedReq.Query)
+	if err != nil {
+		return err
+	}
+	return addPolicyFilters(parsedReq.Query, policyFilter, userId, uidPreds)
+}
+
+// decrypter returns the function which tells whether the user of the request in ctx has the
+// Decrypt permission on an @encrypted predicate, and can read its values in the clear.
+func decrypter(ctx context.Context) func(pred string) bool {
+	all := func(string) bool { return true }
+	if len(worker.Config.HmacSecret) == 0 {
+		return all
+	}
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return func(string) bool { return false }
+	}
+	groupIds := userData[1:]
+	if x.IsGuardian(groupIds) {
+		return all
+	}
+	cache, err := aclCacheOf(ctx)
+	if err != nil {
+		return func(string) bool { return false }
+	}
+	return func(pred string) bool {
+		return cache.authorizePredicate(groupIds, pred, acl.Decrypt) == nil
+	}
 }
 
 func authorizeSchemaQuery(ctx context.Context, er *query.ExecutionResult) error {
@@ -985,7 +1416,11 @@ func authorizeSchemaQuery(ctx context.Context, er *query.ExecutionResult) error
 			// Members of guardian groups are allowed to query anything.
 			return nil, nil
 		}
-		blockedPreds, _ := authorizePreds(userId, groupIds, preds, acl.Read)
+		cache, err := aclCacheOf(ctx)
+		if err != nil {
+			return nil, err
+		}
+		blockedPreds, _ := authorizePreds(cache, userId, groupIds, preds, acl.Read)
 
 		return blockedPreds, nil
 	}
@@ -1020,8 +1455,19 @@ func authorizeSchemaQuery(ctx context.Context, er *query.ExecutionResult) error
 	return nil
 }
 
-// AuthorizeGuardians authorizes the operation for users which belong to Guardians group.
+// AuthorizeGuardians authorizes the operation for users which belong to Guardians group of the
+// galaxy namespace.
 func AuthorizeGuardians(ctx context.Context) error {
+	return authorizeGuardians(ctx, true)
+}
+
+// AuthorizeNamespaceGuardians authorizes the operation for users which belong to the Guardians
+// group of their namespace, for the operations which only apply to the namespace of the user.
+func AuthorizeNamespaceGuardians(ctx context.Context) error {
+	return authorizeGuardians(ctx, false)
+}
+
+func authorizeGuardians(ctx context.Context, galaxyOnly bool) error {
 	if len(worker.Config.HmacSecret) == 0 {
 		// the user has not turned on the acl feature
 		return nil
@@ -1042,6 +1488,12 @@ func AuthorizeGuardians(ctx context.Context) error {
 			return status.Error(codes.PermissionDenied, fmt.Sprintf("Only guardians are "+
 				"allowed access. User '%v' is not a member of guardians group.", userId))
 		}
+		// The admin operations apply to the whole cluster, so the guardians of the other
+		// namespaces aren't allowed to run them.
+		if ns, _ := namespaceOfJwt(ctx); galaxyOnly && ns != x.GalaxyNamespace {
+			return status.Errorf(codes.PermissionDenied, "Only the guardians of the galaxy "+
+				"namespace are allowed access. User '%v' belongs to namespace %d.", userId, ns)
+		}
 	}
 
 	return nil
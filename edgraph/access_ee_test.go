@@ -0,0 +1,73 @@
+// +build !oss
+
+/*
+ * Copyright 2021 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/ee/idp"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceOfClaims(t *testing.T) {
+	ns, err := namespaceOfClaims(jwt.MapClaims{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), ns)
+
+	ns, err = namespaceOfClaims(jwt.MapClaims{"namespace": "18446744073709551615"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), ns)
+
+	ns, err = namespaceOfClaims(jwt.MapClaims{"namespace": float64(5)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), ns)
+
+	for _, val := range []interface{}{"-1", "1.5", "", "18446744073709551616", float64(1.5),
+		float64(-1), float64(1 << 60), true} {
+		_, err := namespaceOfClaims(jwt.MapClaims{"namespace": val})
+		require.Error(t, err, "%v", val)
+	}
+}
+
+func TestAccessJwtNamespace(t *testing.T) {
+	defer func(c worker.Options) { worker.Config = c }(worker.Config)
+	worker.Config.AccessJwtTtl = time.Minute
+	worker.Config.HmacSecret = []byte("12345678901234567890123456789012")
+
+	// The namespaces above 2^53 aren't rounded to another namespace.
+	ns := uint64(1<<53 + 1)
+	token, err := getAccessJwt("alice", nil, ns)
+	require.NoError(t, err)
+	_, got, err := validateToken(token)
+	require.NoError(t, err)
+	require.Equal(t, ns, got)
+}
+
+func TestExternalNamespace(t *testing.T) {
+	require.NoError(t, idp.Init(idp.Config{Namespace: 2}))
+	defer func() { require.NoError(t, idp.Init(idp.Config{})) }()
+
+	require.NoError(t, checkExternalNamespace(2))
+	// The ID token isn't even checked for the other namespaces.
+	_, err := authenticateExternal(context.Background(),
+		&api.LoginRequest{Password: "id token"}, x.GalaxyNamespace)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "can't log into namespace 0")
+}
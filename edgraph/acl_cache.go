@@ -13,10 +13,17 @@
 package edgraph
 
 import (
+	"context"
+	"regexp"
+	"strings"
 	"sync"
 
+	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
 	"github.com/pkg/errors"
 )
 
@@ -25,6 +32,63 @@ type aclCache struct {
 	sync.RWMutex
 	predPerms     map[string]map[string]int32
 	userPredPerms map[string]map[string]int32
+	// rateLimits maps the groups which have a rate limit to their limit.
+	rateLimits map[string]rateLimit
+	// policies maps the groups which have node-level policies to their policies.
+	policies map[string][]acl.Policy
+	// regexRules are the rules whose predicate is a regular expression, and typeRules maps the
+	// types of the rules whose predicate is a type to the permissions of the groups.
+	regexRules []regexRule
+	typeRules  map[string]map[string]int32
+	// regexPerms memoizes the permissions given to the groups by regexRules, by predicate.
+	regexPerms map[string]map[string]int32
+	// typeMemo memoizes the permissions which depend on the types of the schema too.
+	typeMemo *typeMemo
+	// ns is the namespace of the cache, whose types the type rules refer to.
+	ns uint64
+	// apiKeys maps the hashes of the API keys of the namespace to the keys.
+	apiKeys map[string]*apiKey
+	// userGroups maps the users who belong to a group to their groups, for the users identified
+	// by their client certificates.
+	userGroups map[string][]string
+}
+
+// typeMemo memoizes the permissions which depend on the types of the schema, for a version of the
+// types. See schema.State().TypesVersion().
+type typeMemo struct {
+	version uint64
+	// perms holds the permissions given to the groups by typeRules, by predicate.
+	perms map[string]map[string]int32
+	// allowedPreds holds the result of patternAllowedPreds, by groups and operation.
+	allowedPreds map[string][]string
+}
+
+// regexRule is a rule of a group which applies to the predicates matching a regular expression.
+type regexRule struct {
+	group string
+	re    *regexp.Regexp
+	perm  int32
+}
+
+// parseRulePredicate returns the regular expression of a rule whose predicate is a pattern, like
+// /^billing\./, or the type of a rule whose predicate is a type, like type(Person). Both are
+// empty for the rules of a single predicate.
+func parseRulePredicate(pred string) (*regexp.Regexp, string, error) {
+	switch {
+	case len(pred) > 2 && strings.HasPrefix(pred, "/") && strings.HasSuffix(pred, "/"):
+		re, err := regexp.Compile(pred[1 : len(pred)-1])
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "invalid predicate pattern %s", pred)
+		}
+		return re, "", nil
+	case strings.HasPrefix(pred, "type(") && strings.HasSuffix(pred, ")"):
+		typ := strings.TrimSpace(pred[len("type(") : len(pred)-1])
+		if typ == "" {
+			return nil, "", errors.Errorf("invalid predicate type %s", pred)
+		}
+		return nil, typ, nil
+	}
+	return nil, "", nil
 }
 
 var aclCachePtr = &aclCache{
@@ -66,12 +130,46 @@ func (cache *aclCache) update(groups []acl.Group) {
 
 	predPerms := make(map[string]map[string]int32)
 	userPredPerms := make(map[string]map[string]int32)
+	rateLimits := make(map[string]rateLimit)
+	policies := make(map[string][]acl.Policy)
+	var regexRules []regexRule
+	typeRules := make(map[string]map[string]int32)
+	apiKeys := make(map[string]*apiKey)
+	userGroups := make(map[string][]string)
 	for _, group := range groups {
 		acls := group.Rules
 		users := group.Users
+		if group.RateLimit > 0 {
+			rateLimits[group.GroupID] = rateLimit{rate: group.RateLimit, burst: group.Burst}
+		}
+		for _, policy := range group.Policies {
+			if policy.Type != "" && policy.Filter != "" {
+				policies[group.GroupID] = append(policies[group.GroupID], policy)
+			}
+		}
+		for _, k := range group.ApiKeys {
+			key, err := newApiKey(k, group.GroupID)
+			if err != nil {
+				glog.Errorf("Ignoring the API key %s of group %s: %v", k.Name, group.GroupID, err)
+				continue
+			}
+			apiKeys[k.Hash] = key
+		}
 
 		for _, acl := range acls {
-			if len(acl.Predicate) > 0 {
+			re, typ, err := parseRulePredicate(acl.Predicate)
+			switch {
+			case err != nil:
+				glog.Errorf("Ignoring the rule of group %s: %v", group.GroupID, err)
+			case re != nil:
+				regexRules = append(regexRules, regexRule{group: group.GroupID, re: re,
+					perm: acl.Perm})
+			case typ != "":
+				if typeRules[typ] == nil {
+					typeRules[typ] = make(map[string]int32)
+				}
+				typeRules[typ][group.GroupID] |= acl.Perm
+			case len(acl.Predicate) > 0:
 				if groupPerms, found := predPerms[acl.Predicate]; found {
 					groupPerms[group.GroupID] = acl.Perm
 				} else {
@@ -83,6 +181,7 @@ func (cache *aclCache) update(groups []acl.Group) {
 		}
 
 		for _, user := range users {
+			userGroups[user.UserID] = append(userGroups[user.UserID], group.GroupID)
 			if _, found := userPredPerms[user.UserID]; !found {
 				userPredPerms[user.UserID] = make(map[string]int32)
 			}
@@ -99,10 +198,121 @@ func (cache *aclCache) update(groups []acl.Group) {
 		}
 	}
 
-	aclCachePtr.Lock()
-	defer aclCachePtr.Unlock()
-	aclCachePtr.predPerms = predPerms
-	aclCachePtr.userPredPerms = userPredPerms
+	cache.Lock()
+	defer cache.Unlock()
+	cache.predPerms = predPerms
+	cache.userPredPerms = userPredPerms
+	cache.rateLimits = rateLimits
+	cache.policies = policies
+	cache.regexRules = regexRules
+	cache.typeRules = typeRules
+	cache.regexPerms = make(map[string]map[string]int32)
+	cache.typeMemo = nil
+	cache.apiKeys = apiKeys
+	cache.userGroups = userGroups
+}
+
+// nsAclCache is the ACL cache of a namespace other than the galaxy namespace. It is loaded when
+// the namespace is first used, and again once the ACL predicates of the namespace are written.
+type nsAclCache struct {
+	// The lock is held while the cache is loaded, so that the cache of a namespace is loaded
+	// once at a time, without blocking the other namespaces.
+	sync.Mutex
+	cache  *aclCache
+	readTs uint64
+	// resets is the value of posting.Resets() when the cache was loaded.
+	resets uint64
+}
+
+var nsAclCaches = struct {
+	sync.Mutex
+	m map[uint64]*nsAclCache
+}{m: make(map[uint64]*nsAclCache)}
+
+// aclCacheOf returns the ACL cache of the namespace of the request. The ACL cache of the galaxy
+// namespace is kept up to date by RefreshAcls.
+func aclCacheOf(ctx context.Context) (*aclCache, error) {
+	ns, err := x.ExtractNamespace(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+	case ns == x.GalaxyNamespace:
+		return aclCachePtr, nil
+	}
+
+	nsAclCaches.Lock()
+	c, ok := nsAclCaches.m[ns]
+	if !ok {
+		c = &nsAclCache{}
+		nsAclCaches.m[ns] = c
+	}
+	nsAclCaches.Unlock()
+
+	c.Lock()
+	defer c.Unlock()
+	// The ACL rules are read from the ACL predicates, and the groups from dgraph.type.
+	preds := namespaceAttrs(ns, append(x.AllACLPredicates(), "dgraph.type"))
+	if c.cache != nil && c.resets == posting.Resets() &&
+		posting.Oracle().LatestCommitTs(preds) <= c.readTs {
+		return c.cache, nil
+	}
+
+	resets := posting.Resets()
+	resp, err := (&Server{}).doQuery(ctx, &api.Request{Query: queryAcls, ReadOnly: true},
+		NoAuthorize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while retrieving the acls of namespace %d", ns)
+	}
+	groups, err := acl.UnmarshalGroups(resp.GetJson(), "allAcls")
+	if err != nil {
+		return nil, err
+	}
+	cache := &aclCache{ns: ns}
+	cache.update(groups)
+	c.cache, c.readTs, c.resets = cache, resp.GetTxn().GetStartTs(), resets
+	return cache, nil
+}
+
+// rateLimit returns the rate limit of a user who belongs to the given groups, which has the
+// highest rate and burst of the groups. The user has no limit, and false is returned, if any of
+// the groups has no limit.
+func (cache *aclCache) rateLimit(groups []string) (rateLimit, bool) {
+	cache.RLock()
+	defer cache.RUnlock()
+
+	var limit rateLimit
+	for _, group := range groups {
+		l, ok := cache.rateLimits[group]
+		if !ok {
+			return rateLimit{}, false
+		}
+		if l.rate > limit.rate {
+			limit.rate = l.rate
+		}
+		if burst := int64(l.capacity()); burst > limit.burst {
+			limit.burst = burst
+		}
+	}
+	return limit, limit.rate > 0
+}
+
+// policyFilters returns the filters of the node-level policies of a user who belongs to the given
+// groups, by type. The user gets the nodes of a type which match any of its filters, and all the
+// nodes of the types which have no policy.
+func (cache *aclCache) policyFilters(groups []string) map[string][]string {
+	cache.RLock()
+	defer cache.RUnlock()
+
+	var filters map[string][]string
+	for _, group := range groups {
+		for _, policy := range cache.policies[group] {
+			if filters == nil {
+				filters = make(map[string][]string)
+			}
+			filters[policy.Type] = append(filters[policy.Type], policy.Filter)
+		}
+	}
+	return filters
 }
 
 func (cache *aclCache) authorizePredicate(groups []string, predicate string,
@@ -111,14 +321,8 @@ func (cache *aclCache) authorizePredicate(groups []string, predicate string,
 		return errors.Errorf("only groot is allowed to access the ACL predicate: %s", predicate)
 	}
 
-	aclCachePtr.RLock()
-	predPerms := aclCachePtr.predPerms
-	aclCachePtr.RUnlock()
-
-	if groupPerms, found := predPerms[predicate]; found {
-		if hasRequiredAccess(groupPerms, groups, operation) {
-			return nil
-		}
+	if hasRequiredAccess(cache.groupPerms(predicate), groups, operation) {
+		return nil
 	}
 
 	// no rule has been defined that can match the predicate
@@ -128,6 +332,180 @@ func (cache *aclCache) authorizePredicate(groups []string, predicate string,
 
 }
 
+// groupPerms returns the permissions of the groups on a predicate. The rule of a group for the
+// predicate itself overrides its pattern and type rules which match the predicate, whose
+// permissions are combined.
+func (cache *aclCache) groupPerms(predicate string) map[string]int32 {
+	cache.RLock()
+	exact := cache.predPerms[predicate]
+	hasPatterns := len(cache.regexRules) > 0 || len(cache.typeRules) > 0
+	cache.RUnlock()
+	if !hasPatterns {
+		return exact
+	}
+
+	perms := make(map[string]int32)
+	for group, perm := range cache.regexGroupPerms(predicate) {
+		perms[group] |= perm
+	}
+	for group, perm := range cache.typeGroupPerms(predicate) {
+		perms[group] |= perm
+	}
+	for group, perm := range exact {
+		perms[group] = perm
+	}
+	return perms
+}
+
+// maxRegexPerms bounds the number of predicates whose pattern and type permissions are memoized,
+// since the predicates of the requests are chosen by the clients.
+const maxRegexPerms = 1 << 14
+
+// regexGroupPerms returns the permissions given to the groups by the rules whose pattern matches
+// the predicate.
+func (cache *aclCache) regexGroupPerms(predicate string) map[string]int32 {
+	cache.RLock()
+	memo, rules := cache.regexPerms, cache.regexRules
+	perms, ok := memo[predicate]
+	full := len(memo) >= maxRegexPerms
+	cache.RUnlock()
+	if ok {
+		return perms
+	}
+
+	// The patterns are matched without holding the lock. Once the memo is full, the permissions
+	// of the other predicates are matched on every request.
+	perms = make(map[string]int32)
+	for _, rule := range rules {
+		if rule.re.MatchString(predicate) {
+			perms[rule.group] |= rule.perm
+		}
+	}
+	if memo == nil || full {
+		return perms
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	// The memo is replaced along with the rules, so the permissions of outdated rules only go to
+	// an outdated memo.
+	if len(memo) < maxRegexPerms {
+		memo[predicate] = perms
+	}
+	return perms
+}
+
+// currentTypeMemo returns the memo of the current version of the types, replacing the memo of an
+// older version. It is read before the rules, so that the permissions of outdated rules only go
+// to an outdated memo, which update drops.
+func (cache *aclCache) currentTypeMemo() *typeMemo {
+	version := schema.State().TypesVersion()
+	cache.RLock()
+	memo := cache.typeMemo
+	cache.RUnlock()
+	if memo != nil && memo.version >= version {
+		return memo
+	}
+
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.typeMemo == nil || cache.typeMemo.version < version {
+		cache.typeMemo = &typeMemo{
+			version:      version,
+			perms:        make(map[string]map[string]int32),
+			allowedPreds: make(map[string][]string),
+		}
+	}
+	return cache.typeMemo
+}
+
+// typeGroupPerms returns the permissions given to the groups by the rules of the types which have
+// the predicate, or its reverse predicate, as a field. The types are read from the schema, so that
+// the rules follow the changes of the types.
+func (cache *aclCache) typeGroupPerms(predicate string) map[string]int32 {
+	memo := cache.currentTypeMemo()
+	cache.RLock()
+	typeRules, ns := cache.typeRules, cache.ns
+	perms, ok := memo.perms[predicate]
+	full := len(memo.perms) >= maxRegexPerms
+	cache.RUnlock()
+	if ok {
+		return perms
+	}
+
+	perms = make(map[string]int32)
+	field := x.NamespaceAttr(ns, strings.TrimPrefix(predicate, "~"))
+	for typ, groupPerms := range typeRules {
+		typeUpdate, ok := schema.State().GetType(x.NamespaceAttr(ns, typ))
+		if !ok {
+			continue
+		}
+		for _, f := range typeUpdate.Fields {
+			if f.Predicate != field {
+				continue
+			}
+			for group, perm := range groupPerms {
+				perms[group] |= perm
+			}
+			break
+		}
+	}
+	if full {
+		return perms
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	if len(memo.perms) < maxRegexPerms {
+		memo.perms[predicate] = perms
+	}
+	return perms
+}
+
+// patternAllowedPreds returns the fields of the types, which are the predicates expand() can
+// return, on which the pattern and type rules give the operation to any of the groups.
+func (cache *aclCache) patternAllowedPreds(groups []string, operation *acl.Operation) []string {
+	memo := cache.currentTypeMemo()
+	key := strings.Join(append([]string{operation.Name}, groups...), "\x00")
+	cache.RLock()
+	hasPatterns := len(cache.regexRules) > 0 || len(cache.typeRules) > 0
+	ns := cache.ns
+	preds, ok := memo.allowedPreds[key]
+	full := len(memo.allowedPreds) >= maxRegexPerms
+	cache.RUnlock()
+	if !hasPatterns {
+		return nil
+	}
+	if ok {
+		return preds
+	}
+
+	seen := make(map[string]struct{})
+	for _, typ := range schema.State().Types() {
+		if x.ParseNamespace(typ) != ns {
+			continue
+		}
+		typeUpdate, _ := schema.State().GetType(typ)
+		for _, f := range typeUpdate.Fields {
+			pred := x.ParseAttr(f.Predicate)
+			if _, ok := seen[pred]; ok {
+				continue
+			}
+			seen[pred] = struct{}{}
+			if cache.authorizePredicate(groups, pred, operation) == nil {
+				preds = append(preds, pred)
+			}
+		}
+	}
+	if full {
+		return preds
+	}
+	cache.Lock()
+	defer cache.Unlock()
+	if len(memo.allowedPreds) < maxRegexPerms {
+		memo.allowedPreds[key] = preds
+	}
+	return preds
+}
+
 // hasRequiredAccess checks if any group in the passed in groups is allowed to perform the operation
 // according to the acl rules stored in groupPerms
 func hasRequiredAccess(groupPerms map[string]int32, groups []string,
@@ -13,10 +13,26 @@
 package edgraph
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 func TestAclCache(t *testing.T) {
@@ -55,3 +71,268 @@ func TestAclCache(t *testing.T) {
 	require.Error(t, aclCachePtr.authorizePredicate(emptyGroups, predicate, acl.Read),
 		"the anonymous user should not have access when the acl cache is empty")
 }
+
+func TestAclCacheRateLimit(t *testing.T) {
+	aclCachePtr.update([]acl.Group{
+		{GroupID: "dev", RateLimit: 10, Burst: 20},
+		{GroupID: "ops", RateLimit: 100},
+		{GroupID: "sre"},
+	})
+	defer aclCachePtr.update([]acl.Group{})
+
+	_, ok := aclCachePtr.rateLimit(nil)
+	require.False(t, ok, "users without groups have no limit")
+	limit, ok := aclCachePtr.rateLimit([]string{"dev"})
+	require.True(t, ok)
+	require.Equal(t, rateLimit{rate: 10, burst: 20}, limit)
+	limit, ok = aclCachePtr.rateLimit([]string{"dev", "ops"})
+	require.True(t, ok)
+	require.Equal(t, rateLimit{rate: 100, burst: 100}, limit)
+	_, ok = aclCachePtr.rateLimit([]string{"dev", "sre"})
+	require.False(t, ok, "users in a group without limit have no limit")
+}
+
+func TestAclCachePolicies(t *testing.T) {
+	aclCachePtr.update([]acl.Group{
+		{GroupID: "managers", Policies: []acl.Policy{
+			{Type: "Employee", Filter: "eq(manager, $userid)"},
+		}},
+		{GroupID: "hr", Policies: []acl.Policy{
+			{Type: "Employee", Filter: "eq(country, \"FR\")"},
+			{Type: "Payslip", Filter: "has(public)"},
+			{Type: "Incomplete"},
+		}},
+		{GroupID: "dev"},
+	})
+	defer aclCachePtr.update([]acl.Group{})
+
+	require.Nil(t, aclCachePtr.policyFilters([]string{"dev"}))
+	require.Equal(t, map[string][]string{"Employee": {"eq(manager, $userid)"}},
+		aclCachePtr.policyFilters([]string{"managers", "dev"}))
+	require.Equal(t, map[string][]string{
+		"Employee": {"eq(manager, $userid)", "eq(country, \"FR\")"},
+		"Payslip":  {"has(public)"},
+	}, aclCachePtr.policyFilters([]string{"managers", "hr"}))
+}
+
+func TestAclCachePatterns(t *testing.T) {
+	require.NoError(t, schema.ParseBytes(nil, 1))
+	schema.State().SetType("Invoice", pb.TypeUpdate{
+		TypeName: "Invoice",
+		Fields:   []*pb.SchemaUpdate{{Predicate: "amount"}, {Predicate: "customer"}},
+	})
+	defer schema.State().DeleteAll()
+
+	aclCachePtr.update([]acl.Group{
+		{GroupID: "billing", Rules: []acl.Acl{
+			{Predicate: `/^billing\./`, Perm: 4},
+			{Predicate: `/\.secret$/`, Perm: 2},
+			{Predicate: "billing.audit", Perm: 0},
+			{Predicate: "/invalid[/", Perm: 7},
+		}},
+		{GroupID: "sales", Rules: []acl.Acl{
+			{Predicate: "type(Invoice)", Perm: 6},
+		}},
+	})
+	defer aclCachePtr.update([]acl.Group{})
+
+	billing := []string{"billing"}
+	require.NoError(t, aclCachePtr.authorizePredicate(billing, "billing.total", acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(billing, "billing.total", acl.Write))
+	require.Equal(t, int32(6), aclCachePtr.groupPerms("billing.key.secret")["billing"],
+		"the permissions of the matching patterns are combined")
+	require.Error(t, aclCachePtr.authorizePredicate(billing, "billing.audit", acl.Read),
+		"the rule of the predicate overrides the patterns")
+	require.Error(t, aclCachePtr.authorizePredicate(billing, "invalid", acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(billing, "dgraph.xid", acl.Read))
+
+	sales := []string{"sales"}
+	require.NoError(t, aclCachePtr.authorizePredicate(sales, "amount", acl.Write))
+	require.NoError(t, aclCachePtr.authorizePredicate(sales, "~customer", acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(sales, "billing.total", acl.Read))
+
+	// The new fields of the type get the rules of the type.
+	require.Error(t, aclCachePtr.authorizePredicate(sales, "discount", acl.Read))
+	schema.State().SetType("Invoice", pb.TypeUpdate{
+		TypeName: "Invoice",
+		Fields: []*pb.SchemaUpdate{{Predicate: "amount"}, {Predicate: "customer"},
+			{Predicate: "discount"}},
+	})
+	require.NoError(t, aclCachePtr.authorizePredicate(sales, "discount", acl.Read))
+
+	preds := aclCachePtr.patternAllowedPreds(sales, acl.Read)
+	sort.Strings(preds)
+	require.Equal(t, []string{"amount", "customer", "discount"}, preds)
+
+	// The memoized type permissions are dropped when the types change.
+	schema.State().SetType("Invoice", pb.TypeUpdate{
+		TypeName: "Invoice",
+		Fields:   []*pb.SchemaUpdate{{Predicate: "customer"}},
+	})
+	require.Equal(t, []string{"customer"}, aclCachePtr.patternAllowedPreds(sales, acl.Read))
+	require.Error(t, aclCachePtr.authorizePredicate(sales, "amount", acl.Read))
+
+	// The memo of the patterns is bounded, and the patterns still apply once it is full.
+	for i := 0; i < maxRegexPerms+10; i++ {
+		aclCachePtr.groupPerms(fmt.Sprintf("billing.p%d", i))
+	}
+	require.Len(t, aclCachePtr.regexPerms, maxRegexPerms)
+	require.NoError(t, aclCachePtr.authorizePredicate(billing, "billing.unseen", acl.Read))
+}
+
+func TestAuthorizePredsExternalUser(t *testing.T) {
+	aclCachePtr.update([]acl.Group{
+		{GroupID: "dev", Users: []acl.User{{UserID: "alice"}}, Rules: []acl.Acl{
+			{Predicate: "name", Perm: acl.Read.Code},
+			{Predicate: "salary", Perm: acl.Write.Code},
+		}},
+	})
+	defer aclCachePtr.update([]acl.Group{})
+
+	// The permissions of the external users come from the groups of their jwt.
+	blocked, allowed := authorizePreds(aclCachePtr, "bob", []string{"dev"},
+		[]string{"name", "salary"}, acl.Read)
+	require.Equal(t, map[string]struct{}{"salary": {}}, blocked)
+	require.Equal(t, []string{"name"}, allowed)
+
+	_, allowed = authorizePreds(aclCachePtr, "bob", []string{"ops"}, []string{"name"}, acl.Read)
+	require.Empty(t, allowed)
+}
+
+func TestExternalUserJwt(t *testing.T) {
+	defer func(conf worker.Options) { worker.Config = conf }(worker.Config)
+	worker.Config.HmacSecret = []byte("0123456789abcdef0123456789abcdef")
+	worker.Config.RefreshJwtTtl = time.Hour
+
+	jwt, err := getRefreshJwt(externalUser("alice@example.org", []string{"dev", "ops"}, 2))
+	require.NoError(t, err)
+	require.True(t, isExternalJwt(jwt))
+	userData, ns, err := validateToken(jwt)
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice@example.org", "dev", "ops"}, userData)
+	require.Equal(t, uint64(2), ns)
+
+	jwt, err = getRefreshJwt(&acl.User{UserID: "alice", Groups: []acl.Group{{GroupID: "dev"}}})
+	require.NoError(t, err)
+	require.False(t, isExternalJwt(jwt))
+	userData, _, err = validateToken(jwt)
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice"}, userData, "the groups of the DB users are queried")
+}
+
+func TestApiKey(t *testing.T) {
+	defer func(conf worker.Options) { worker.Config = conf }(worker.Config)
+	worker.Config.HmacSecret = []byte("0123456789abcdef0123456789abcdef")
+
+	key := apiKeyPrefix + "0_secret"
+	readOnly := apiKeyPrefix + "0_readonly"
+	expired := apiKeyPrefix + "0_expired"
+	aclCachePtr.update([]acl.Group{
+		{GroupID: "ci", ApiKeys: []acl.ApiKey{
+			{Name: "deploy", Hash: hashApiKey(key), IPs: []string{"10.0.0.0/8", "::1"}},
+			{Name: "reports", Hash: hashApiKey(readOnly), ReadOnly: true},
+			{Name: "old", Hash: hashApiKey(expired), Expiry: time.Now().Add(-time.Minute)},
+			{Name: "invalid", Hash: hashApiKey(apiKeyPrefix + "0_invalid"), IPs: []string{"x"}},
+		}},
+	})
+	defer aclCachePtr.update([]acl.Group{})
+
+	withKey := func(key, ip string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.New(map[string]string{"accessJwt": key}))
+		return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1}})
+	}
+
+	userData, err := extractUserAndGroups(withKey(key, "10.1.2.3"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"apikey:deploy", "ci"}, userData)
+	ns, ok := namespaceOfJwt(withKey(key, "::1"))
+	require.True(t, ok)
+	require.Equal(t, uint64(0), ns)
+	require.NoError(t, AuthorizeWrites(withKey(key, "10.1.2.3")))
+
+	_, err = extractUserAndGroups(withKey(key, "192.168.1.1"))
+	require.Error(t, err, "the key isn't allowed from this IP address")
+	_, err = extractUserAndGroups(withKey(expired, "10.1.2.3"))
+	require.Error(t, err)
+	_, err = extractUserAndGroups(withKey(apiKeyPrefix+"0_invalid", "10.1.2.3"))
+	require.Error(t, err, "the keys with invalid IP addresses are ignored")
+	_, err = extractUserAndGroups(withKey(apiKeyPrefix+"0_unknown", "10.1.2.3"))
+	require.Error(t, err)
+	_, err = extractUserAndGroups(withKey(apiKeyPrefix+"secret", "10.1.2.3"))
+	require.Error(t, err)
+
+	_, err = extractUserAndGroups(withKey(readOnly, "10.1.2.3"))
+	require.NoError(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(AuthorizeWrites(withKey(readOnly,
+		"10.1.2.3"))))
+
+	// A revoked key is rejected once the ACLs are refreshed.
+	aclCachePtr.update([]acl.Group{{GroupID: "ci"}})
+	_, err = extractUserAndGroups(withKey(key, "10.1.2.3"))
+	require.Error(t, err)
+}
+
+func TestCertIdentity(t *testing.T) {
+	defer func(conf worker.Options) { worker.Config = conf }(worker.Config)
+	worker.Config.HmacSecret = []byte("0123456789abcdef0123456789abcdef")
+	require.Error(t, InitCertIdentity("subject", ""))
+	require.Error(t, InitCertIdentity("cn", "alice"))
+	require.NoError(t, InitCertIdentity("san", "ci.example.com=ci, carol@example.com=carol@2"))
+	require.Equal(t, certUser{userId: "carol", ns: 2}, certIdentity.users["carol@example.com"])
+	defer func() { certIdentity.field, certIdentity.users = "", nil }()
+
+	aclCachePtr.update([]acl.Group{
+		{GroupID: "dev", Users: []acl.User{{UserID: "alice@example.com"}, {UserID: "ci"}}},
+		{GroupID: "ops", Users: []acl.User{{UserID: "ci"}}},
+	})
+	defer aclCachePtr.update([]acl.Group{})
+
+	withCert := func(cert *x509.Certificate) context.Context {
+		state := tls.ConnectionState{}
+		if cert != nil {
+			state.VerifiedChains = [][]*x509.Certificate{{cert}}
+		}
+		return peer.NewContext(context.Background(), &peer.Peer{
+			Addr:     &net.TCPAddr{IP: net.ParseIP("10.1.2.3"), Port: 1},
+			AuthInfo: credentials.TLSInfo{State: state},
+		})
+	}
+
+	userData, err := extractUserAndGroups(withCert(&x509.Certificate{
+		EmailAddresses: []string{"alice@example.com"}}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice@example.com", "dev"}, userData)
+	userData, err = extractUserAndGroups(withCert(&x509.Certificate{
+		DNSNames: []string{"ci.example.com"}}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"ci", "dev", "ops"}, userData)
+	ns, ok := namespaceOfJwt(withCert(&x509.Certificate{DNSNames: []string{"ci.example.com"}}))
+	require.True(t, ok)
+	require.Equal(t, uint64(0), ns)
+
+	_, err = extractUserAndGroups(withCert(nil))
+	require.Equal(t, x.ErrNoJwt, err, "the certificate wasn't verified")
+	_, err = extractUserAndGroups(withCert(&x509.Certificate{DNSNames: []string{"bob"}}))
+	require.Error(t, err, "bob isn't a user of a group")
+	_, err = extractUserAndGroups(withCert(&x509.Certificate{}))
+	require.Error(t, err)
+
+	// The access JWT takes precedence over the certificate.
+	ctx := metadata.NewIncomingContext(withCert(&x509.Certificate{DNSNames: []string{"ci"}}),
+		metadata.New(map[string]string{"accessJwt": "invalid"}))
+	_, err = extractUserAndGroups(ctx)
+	require.Error(t, err)
+}
+
+func TestParseApiKeyIPs(t *testing.T) {
+	ips, err := parseApiKeyIPs([]string{"10.0.0.1", " 192.168.0.0/16", "2001:db8::/32"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"10.0.0.1/32", "192.168.0.0/16", "2001:db8::/32"},
+		[]string{ips[0].String(), ips[1].String(), ips[2].String()})
+
+	for _, ip := range []string{"10.0.0.256", "10.0.0.0/33", "localhost"} {
+		_, err = parseApiKeyIPs([]string{ip})
+		require.Error(t, err, ip)
+	}
+}
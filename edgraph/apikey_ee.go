@@ -0,0 +1,253 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyPrefix starts the API keys, which are sent in place of an access JWT. It's followed by the
+// namespace of the key and by its secret, as in dgraph_apikey_0_<secret>.
+const apiKeyPrefix = "dgraph_apikey_"
+
+// apiKey is an API key of the ACL cache, which authenticates the requests sent with it as a
+// member of its group.
+type apiKey struct {
+	name     string
+	group    string
+	expiry   time.Time
+	ips      []*net.IPNet
+	readOnly bool
+}
+
+func newApiKey(k acl.ApiKey, group string) (*apiKey, error) {
+	ips, err := parseApiKeyIPs(k.IPs)
+	if err != nil {
+		return nil, err
+	}
+	return &apiKey{name: k.Name, group: group, expiry: k.Expiry, ips: ips,
+		readOnly: k.ReadOnly}, nil
+}
+
+// parseApiKeyIPs parses the IP addresses and CIDR blocks of an API key. An IP address is the
+// block of that address alone.
+func parseApiKeyIPs(ips []string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, s := range ips {
+		s = strings.TrimSpace(s)
+		if ip := net.ParseIP(s); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, errors.Errorf("invalid IP address or CIDR block %q", s)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// userId returns the user id of the requests sent with the key.
+func (k *apiKey) userId() string {
+	return "apikey:" + k.name
+}
+
+func (k *apiKey) allowsIP(ctx context.Context) bool {
+	if len(k.ips) == 0 {
+		return true
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return false
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range k.ips {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// isApiKey returns whether the token sent as the access JWT is an API key.
+func isApiKey(token string) bool {
+	return strings.HasPrefix(token, apiKeyPrefix)
+}
+
+func hashApiKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// authenticateApiKey returns the API key of the token and its namespace, if the key exists, hasn't
+// expired and is sent from one of its IP addresses.
+func authenticateApiKey(ctx context.Context, token string) (*apiKey, uint64, error) {
+	rest := strings.TrimPrefix(token, apiKeyPrefix)
+	idx := strings.IndexByte(rest, '_')
+	if idx < 0 {
+		return nil, 0, errors.Errorf("invalid API key")
+	}
+	ns, err := strconv.ParseUint(rest[:idx], 10, 64)
+	if err != nil || !namespaceExists(ns) {
+		return nil, 0, errors.Errorf("invalid API key")
+	}
+
+	// The cache of the namespace is loaded without the key in the context, which would be
+	// authenticated again by the query loading it.
+	cache := aclCachePtr
+	if ns != x.GalaxyNamespace {
+		if cache, err = aclCacheOf(x.AttachNamespace(context.Background(), ns)); err != nil {
+			return nil, 0, err
+		}
+	}
+	cache.RLock()
+	key, ok := cache.apiKeys[hashApiKey(token)]
+	cache.RUnlock()
+	switch {
+	case !ok:
+		return nil, 0, errors.Errorf("invalid API key")
+	case !key.expiry.IsZero() && time.Now().After(key.expiry):
+		return nil, 0, errors.Errorf("the API key %s has expired", key.name)
+	case !key.allowsIP(ctx):
+		return nil, 0, errors.Errorf("the API key %s isn't allowed from this IP address", key.name)
+	}
+	return key, ns, nil
+}
+
+// AuthorizeWrites returns a PermissionDenied error if the request is sent with a read-only API
+// key, which can't be used for mutations, alter operations or admin mutations.
+func AuthorizeWrites(ctx context.Context) error {
+	if len(worker.Config.HmacSecret) == 0 {
+		return nil
+	}
+	accessJwt, err := x.ExtractJwt(ctx)
+	if err != nil || !isApiKey(accessJwt[0]) {
+		return nil
+	}
+	key, _, err := authenticateApiKey(ctx, accessJwt[0])
+	switch {
+	case err != nil:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case key.readOnly:
+		return status.Errorf(codes.PermissionDenied, "the API key %s is read-only", key.name)
+	}
+	return nil
+}
+
+// AddApiKey creates an API key of the group in the namespace of the request, which expires at
+// the given time unless it is zero and is only accepted from the given IP addresses and CIDR
+// blocks, if any. It returns the key, which can't be retrieved later as only its hash is stored.
+func AddApiKey(ctx context.Context, name, group string, expiry time.Time, ips []string,
+	readOnly bool) (string, error) {
+	if name == "" || group == "" {
+		return "", errors.Errorf("the name and the group of an API key should be set")
+	}
+	if _, err := parseApiKeyIPs(ips); err != nil {
+		return "", err
+	}
+	ctx, ns, err := ResolveNamespace(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errors.Wrapf(err, "while generating the API key")
+	}
+	token := fmt.Sprintf("%s%d_%s", apiKeyPrefix, ns, base64.RawURLEncoding.EncodeToString(secret))
+
+	nquads := []*api.NQuad{
+		{Subject: "_:key", Predicate: "dgraph.type",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "dgraph.type.ApiKey"}}},
+		{Subject: "_:key", Predicate: "dgraph.apikey.name",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: name}}},
+		{Subject: "_:key", Predicate: "dgraph.apikey.hash",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: hashApiKey(token)}}},
+		{Subject: "_:key", Predicate: "dgraph.apikey.group", ObjectId: "uid(g)"},
+		{Subject: "_:key", Predicate: "dgraph.apikey.readonly",
+			ObjectValue: &api.Value{Val: &api.Value_BoolVal{BoolVal: readOnly}}},
+	}
+	if !expiry.IsZero() {
+		nquads = append(nquads, &api.NQuad{Subject: "_:key", Predicate: "dgraph.apikey.expiry",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{
+				StrVal: expiry.UTC().Format(time.RFC3339Nano)}}})
+	}
+	for _, ip := range ips {
+		nquads = append(nquads, &api.NQuad{Subject: "_:key", Predicate: "dgraph.apikey.ips",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: strings.TrimSpace(ip)}}})
+	}
+
+	req := &api.Request{
+		Query: `query apikey($group: string, $name: string) {
+			g as var(func: eq(dgraph.xid, $group)) @filter(type(dgraph.type.Group))
+			k as var(func: eq(dgraph.apikey.name, $name))
+			group(func: uid(g)) { uid }
+			key(func: uid(k)) { uid }
+		}`,
+		Vars: map[string]string{"$group": group, "$name": name},
+		Mutations: []*api.Mutation{{
+			Set:  nquads,
+			Cond: "@if(eq(len(g), 1) AND eq(len(k), 0))",
+		}},
+		CommitNow: true,
+	}
+	resp, err := (&Server{}).doQuery(ctx, req, NeedAuthorize)
+	if err != nil {
+		return "", err
+	}
+	var existing struct {
+		Group []struct{} `json:"group"`
+		Key   []struct{} `json:"key"`
+	}
+	if err := json.Unmarshal(resp.GetJson(), &existing); err != nil {
+		return "", err
+	}
+	switch {
+	case len(existing.Group) == 0:
+		return "", errors.Errorf("the group %s doesn't exist", group)
+	case len(existing.Key) > 0:
+		return "", errors.Errorf("an API key named %s already exists", name)
+	}
+	return token, nil
+}
@@ -0,0 +1,177 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"net"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"google.golang.org/grpc/peer"
+
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/ee/audit"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// NewAuditEvent returns the audit event of a request, with the user and the client of the
+// request in ctx.
+func NewAuditEvent(ctx context.Context, endpoint, operation string) *audit.Event {
+	e := audit.NewEvent(endpoint, operation)
+	if len(worker.Config.HmacSecret) > 0 {
+		if userData, err := extractUserAndGroups(ctx); err == nil {
+			e.User, e.Groups = userData[0], userData[1:]
+		}
+	}
+	if ns, ok := namespaceOfJwt(ctx); ok {
+		e.Namespace = ns
+	} else if ns, err := x.ExtractNamespace(ctx); err == nil {
+		e.Namespace = ns
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if ip, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			e.ClientIP = ip
+		} else {
+			e.ClientIP = p.Addr.String()
+		}
+	}
+	return e
+}
+
+// auditRequest returns ctx with the audit event of a query or mutation request, and a function
+// which logs it once the request is served. The requests sent by the GraphQL handler are recorded
+// in the event of the GraphQL request, which is logged by the handler.
+func auditRequest(ctx context.Context, req *api.Request,
+	graphql bool) (context.Context, func(*api.Response, error)) {
+
+	if !audit.Enabled() {
+		return ctx, func(*api.Response, error) {}
+	}
+	e, nested := audit.EventFromContext(ctx), true
+	if e == nil {
+		var operation string
+		switch {
+		case len(req.Mutations) == 0:
+			operation = "query"
+		case req.Query == "":
+			operation = "mutation"
+		default:
+			operation = "upsert"
+		}
+		endpoint := audit.EndpointDQL
+		if graphql {
+			endpoint = audit.EndpointGraphQL
+		}
+		e, nested = NewAuditEvent(ctx, endpoint, operation), false
+		e.Request = req.Query
+		ctx = audit.WithEvent(ctx, e)
+	}
+	return ctx, func(resp *api.Response, err error) {
+		recordTs(e, req, resp)
+		if !nested {
+			audit.Log(e, err)
+		}
+	}
+}
+
+func recordTs(e *audit.Event, req *api.Request, resp *api.Response) {
+	var commitTs uint64
+	if resp != nil && resp.Txn != nil {
+		commitTs = resp.Txn.CommitTs
+	}
+	e.SetTs(req.StartTs, commitTs)
+}
+
+// auditPredicates records the predicates of the request in its audit event. It must be called
+// before the request is authorized, which drops the predicates denied to the user from queries.
+func auditPredicates(ctx context.Context, qc *queryContext) {
+	e := audit.EventFromContext(ctx)
+	if e == nil {
+		return
+	}
+	e.AddPredicates(parsePredsFromQuery(qc.gqlRes.Query).preds)
+	for _, gmu := range qc.gmuList {
+		e.AddPredicates(parsePredsFromMutation(gmu.Set))
+		e.AddPredicates(parsePredsFromMutation(gmu.Del))
+	}
+}
+
+// auditAlter returns ctx with the audit event of an alter operation, and a function which logs it
+// once the operation is done.
+func auditAlter(ctx context.Context, op *api.Operation) (context.Context, func(error)) {
+	if !audit.Enabled() {
+		return ctx, func(error) {}
+	}
+	var operation string
+	var preds []string
+	switch {
+	case isDropAll(op):
+		operation = "drop_all"
+	case op.DropOp == api.Operation_DATA:
+		operation = "drop_data"
+	case len(op.DropAttr) > 0:
+		operation, preds = "drop_attr", []string{op.DropAttr}
+	case op.DropOp == api.Operation_ATTR:
+		operation, preds = "drop_attr", []string{op.DropValue}
+	case op.DropOp == api.Operation_TYPE:
+		operation = "drop_type"
+	default:
+		operation = "alter"
+		if update, err := schema.Parse(op.Schema); err == nil {
+			for _, u := range update.Preds {
+				preds = append(preds, u.Predicate)
+			}
+		}
+	}
+
+	e, nested := audit.EventFromContext(ctx), true
+	if e == nil {
+		e, nested = NewAuditEvent(ctx, audit.EndpointDQL, operation), false
+		e.Request = op.Schema
+		if op.DropOp == api.Operation_TYPE {
+			e.Request = op.DropValue
+		}
+		ctx = audit.WithEvent(ctx, e)
+	}
+	e.AddPredicates(preds)
+	return ctx, func(err error) {
+		if !nested {
+			audit.Log(e, err)
+		}
+	}
+}
+
+// auditLogin records the login of the user of request in its audit event, or in a new one if it
+// is a DQL request. The login failed if err isn't nil.
+func auditLogin(ctx context.Context, request *api.LoginRequest, user *acl.User, err error) {
+	if !audit.Enabled() {
+		return
+	}
+	e, nested := audit.EventFromContext(ctx), true
+	if e == nil {
+		e, nested = NewAuditEvent(ctx, audit.EndpointDQL, "login"), false
+	}
+	if user != nil {
+		e.SetUser(user.UserID, acl.GetGroupIDs(user.Groups), user.Namespace)
+	} else if request.Userid != "" {
+		e.SetUser(request.Userid, nil, e.Namespace)
+	}
+	if err != nil {
+		e.Deny(nil)
+	}
+	if !nested {
+		audit.Log(e, err)
+	}
+}
@@ -0,0 +1,125 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// certUser is the ACL user identified by a client certificate.
+type certUser struct {
+	userId string
+	ns     uint64
+}
+
+// certIdentity holds how the client certificates identify the ACL users. The users aren't
+// identified by their certificates if field is empty.
+var certIdentity struct {
+	// field is the field of the certificates holding the identity: cn or san.
+	field string
+	// users maps the identities to the users. The identities which aren't mapped are the ids of
+	// the users of the galaxy namespace.
+	users map[string]certUser
+}
+
+// InitCertIdentity makes the verified client certificates of the requests without an access JWT
+// identify their ACL user, by the common name of the certificates for the field cn, or by their
+// first subject alternative name for san. The userMap is a comma separated list of identity=user
+// pairs, where the user may end with @ and the namespace of the user, like
+// "ci.example.com=ci@2,alice@example.com=alice".
+func InitCertIdentity(field, userMap string) error {
+	field = strings.ToLower(field)
+	switch field {
+	case "":
+		return nil
+	case "cn", "san":
+	default:
+		return errors.Errorf("invalid client certificate identity %q, it should be cn or san",
+			field)
+	}
+
+	users := make(map[string]certUser)
+	for _, pair := range strings.Split(userMap, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			return errors.Errorf("invalid client certificate user mapping %q, it should be "+
+				"identity=user", pair)
+		}
+		user := certUser{userId: kv[1]}
+		// The user ids may be email addresses, whose domain isn't a namespace.
+		if idx := strings.LastIndexByte(kv[1], '@'); idx > 0 {
+			if ns, err := strconv.ParseUint(kv[1][idx+1:], 10, 64); err == nil {
+				user = certUser{userId: kv[1][:idx], ns: ns}
+			}
+		}
+		users[kv[0]] = user
+	}
+	certIdentity.field = field
+	certIdentity.users = users
+	return nil
+}
+
+// authenticateCert returns the user id and the group ids of the ACL user identified by the
+// verified client certificate of the request, and the namespace of the user. It returns
+// x.ErrNoJwt if the request has no such certificate, or the certificates don't identify users.
+// The groups of the user are read from the ACL cache, so the user must belong to a group.
+func authenticateCert(ctx context.Context) ([]string, uint64, error) {
+	if certIdentity.field == "" || len(worker.Config.HmacSecret) == 0 {
+		return nil, 0, x.ErrNoJwt
+	}
+	cert := x.VerifiedClientCert(ctx)
+	if cert == nil {
+		return nil, 0, x.ErrNoJwt
+	}
+	identity := x.CertIdentity(cert, certIdentity.field)
+	if identity == "" {
+		return nil, 0, errors.Errorf("the client certificate has no %s", certIdentity.field)
+	}
+	user, ok := certIdentity.users[identity]
+	if !ok {
+		user = certUser{userId: identity}
+	}
+	if !namespaceExists(user.ns) {
+		return nil, 0, errors.Errorf("the namespace %d of the client certificate %s doesn't "+
+			"exist", user.ns, identity)
+	}
+
+	// The cache of the namespace is loaded without the certificate in the context, which
+	// would be authenticated again by the query loading it.
+	cache := aclCachePtr
+	if user.ns != x.GalaxyNamespace {
+		var err error
+		if cache, err = aclCacheOf(x.AttachNamespace(context.Background(), user.ns)); err != nil {
+			return nil, 0, err
+		}
+	}
+	cache.RLock()
+	groupIds, ok := cache.userGroups[user.userId]
+	cache.RUnlock()
+	if !ok {
+		return nil, 0, errors.Errorf("the user %s of the client certificate %s doesn't belong "+
+			"to any group", user.userId, identity)
+	}
+	return append([]string{user.userId}, groupIds...), user.ns, nil
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// lockLease is how long the locks of an upsert are held at most, in case the Alpha running it
+	// goes away before releasing them.
+	lockLease = 10 * time.Second
+	// maxLockAttempts is the number of times the query of an upsert is run to find the keys to
+	// lock. If the query still reads keys which are not locked after that, the upsert goes on
+	// without locking them, and relies on Zero to detect the conflicts.
+	maxLockAttempts = 3
+)
+
+// hasLockedBlock returns true if any of the query blocks has the @lock directive.
+func hasLockedBlock(queries []*gql.GraphQuery) bool {
+	for _, gq := range queries {
+		if gq != nil && gq.Lock {
+			return true
+		}
+	}
+	return false
+}
+
+// validateLockedQuery checks that a request whose query has blocks with the @lock directive is an
+// upsert which runs in its own transaction.
+func validateLockedQuery(qc *queryContext) error {
+	if !hasLockedBlock(qc.gqlRes.Query) {
+		return nil
+	}
+	switch {
+	case len(qc.req.Mutations) == 0:
+		return errors.Errorf("The @lock directive can only be used in upserts.")
+	case qc.req.StartTs != 0 || !qc.req.CommitNow:
+		return errors.Errorf("An upsert with the @lock directive must start a new transaction" +
+			" and commit it immediately.")
+	case x.WorkerConfig.LudicrousMode:
+		return errors.Errorf("The @lock directive is not supported in ludicrous mode.")
+	}
+	return nil
+}
+
+// processLockedQuery runs the query of an upsert while holding locks on the keys read by its blocks
+// with the @lock directive. The keys are found by running the query, then locked, and the query
+// is run again at a new timestamp, so that it sees the commits of the upserts which held the locks
+// before. This is repeated if the query reads keys which it didn't read the previous time. The
+// returned function releases the locks, and must be called once the transaction is committed.
+func processLockedQuery(ctx context.Context, qc *queryContext) (*api.Response, func(), error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, func() {}, errors.Wrapf(err, "while generating the owner of locks")
+	}
+	owner := binary.BigEndian.Uint64(b[:])
+
+	// keys are the keys locked by the upsert.
+	var keys []uint64
+	release := func() {
+		if len(keys) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := worker.ReleaseLocks(ctx, keys, owner); err != nil {
+			glog.Warningf("Error while releasing the locks of an upsert: %v", err)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		qc.req.StartTs = worker.State.GetTimestamp(false)
+		qc.latency.AssignTimestamp += time.Since(start)
+		// Forget the uids found by the previous run of the query.
+		for name := range qc.uidRes {
+			qc.uidRes[name] = nil
+		}
+
+		lockCtx := worker.WithLocks(ctx)
+		resp, err := processQuery(lockCtx, qc)
+		if err != nil {
+			return resp, release, err
+		}
+		read := worker.LockedKeys(lockCtx)
+		missing := missingKeys(read, keys)
+		if len(missing) == 0 {
+			return resp, release, nil
+		}
+		if attempt == maxLockAttempts {
+			glog.V(2).Infof("Upsert read %d keys which are not locked after %d attempts",
+				len(missing), attempt)
+			return resp, release, nil
+		}
+
+		// Locks are granted all at once, so release the locks held before asking for more.
+		release()
+		keys = append(keys, missing...)
+		qc.span.Annotatef(nil, "Acquiring %d locks. Attempt: %d", len(keys), attempt)
+		if err := worker.AcquireLocks(ctx, keys, owner, lockLease); err != nil {
+			keys = nil
+			return nil, release, errors.Wrapf(err, "while acquiring locks for upsert")
+		}
+	}
+}
+
+// missingKeys returns the keys in read which are not in locked.
+func missingKeys(read, locked []uint64) []uint64 {
+	held := make(map[uint64]struct{}, len(locked))
+	for _, k := range locked {
+		held[k] = struct{}{}
+	}
+	var missing []uint64
+	for _, k := range read {
+		if _, ok := held[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,407 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The predicates and types of a namespace other than the galaxy namespace are stored with the
+// namespace in their names (see x.NamespaceAttr). The requests are parsed and authorized with the
+// names the clients use, and renamed to their names in the namespace of the request just before
+// they are processed. The names are stripped from the namespace in the responses.
+
+// ResolveNamespace returns the namespace of a request sent by a client, and attaches it to the
+// returned context, so that the internal requests sent on its behalf stay in the namespace. With
+// ACLs, the namespace is the one the user logged in to, which is bound to their access JWT.
+// Otherwise, it is the namespace set in the metadata of the request.
+func ResolveNamespace(ctx context.Context) (context.Context, uint64, error) {
+	ns, ok := namespaceOfJwt(ctx)
+	if !ok {
+		var err error
+		if ns, err = x.ExtractNamespace(ctx); err != nil {
+			return ctx, 0, err
+		}
+	}
+	if !namespaceExists(ns) {
+		return ctx, 0, errors.Errorf("Namespace %d doesn't exist", ns)
+	}
+	if cur, err := x.ExtractNamespace(ctx); err != nil || cur != ns {
+		ctx = x.AttachNamespace(ctx, ns)
+	}
+	return ctx, ns, nil
+}
+
+// namespaceExists returns whether the namespace ns was added. The galaxy namespace always exists.
+func namespaceExists(ns uint64) bool {
+	if ns == x.GalaxyNamespace {
+		return true
+	}
+	// The types are known to every group, and the pre-defined types can't be dropped.
+	_, ok := schema.State().GetType(x.NamespaceAttr(ns, "dgraph.graphql"))
+	return ok
+}
+
+// namespaceAttrs returns the names of the predicates, or of the types, attrs in the namespace ns.
+func namespaceAttrs(ns uint64, attrs []string) []string {
+	if attrs == nil {
+		return nil
+	}
+	res := make([]string, len(attrs))
+	for i, attr := range attrs {
+		res[i] = x.NamespaceAttr(ns, attr)
+	}
+	return res
+}
+
+// namespaceQuery renames the predicates and the types used by the parsed query res to their names
+// in the namespace ns.
+func namespaceQuery(ns uint64, res *gql.Result) {
+	if ns == x.GalaxyNamespace {
+		return
+	}
+	for _, gq := range res.Query {
+		namespaceGraphQuery(ns, gq)
+	}
+	if res.Schema != nil {
+		res.Schema.Predicates = namespaceAttrs(ns, res.Schema.Predicates)
+		res.Schema.Types = namespaceAttrs(ns, res.Schema.Types)
+	}
+}
+
+func namespaceGraphQuery(ns uint64, gq *gql.GraphQuery) {
+	if gq == nil {
+		return
+	}
+	isVar := func(name string) bool {
+		for _, v := range gq.NeedsVar {
+			if v.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	if gq.Attr != "" && !gq.IsInternal && !x.IsInternalPredicate(gq.Attr) {
+		gq.Attr = x.NamespaceAttr(ns, gq.Attr)
+	}
+	namespaceFunc(ns, gq.Func)
+	namespaceFilter(ns, gq.Filter)
+	namespaceFilter(ns, gq.PolicyFilter)
+	if gq.Expand != "" && gq.Expand != "_all_" && !isVar(gq.Expand) {
+		gq.Expand = strings.Join(namespaceAttrs(ns, strings.Split(gq.Expand, ",")), ",")
+	}
+	for _, order := range gq.Order {
+		if !isVar(order.Attr) {
+			order.Attr = x.NamespaceAttr(ns, order.Attr)
+		}
+	}
+	for i := range gq.GroupbyAttrs {
+		gq.GroupbyAttrs[i].Attr = x.NamespaceAttr(ns, gq.GroupbyAttrs[i].Attr)
+	}
+	for i, pred := range gq.Cascade {
+		if pred != "__all__" {
+			gq.Cascade[i] = x.NamespaceAttr(ns, pred)
+		}
+	}
+	// The allowed predicates might be shared by the query blocks.
+	gq.AllowedPreds = namespaceAttrs(ns, gq.AllowedPreds)
+	for _, child := range gq.Children {
+		namespaceGraphQuery(ns, child)
+	}
+}
+
+// namespaceFilter renames the predicates of the functions of a filter. The filters of the facets
+// use the keys of the facets, which don't belong to a namespace.
+func namespaceFilter(ns uint64, f *gql.FilterTree) {
+	if f == nil {
+		return
+	}
+	namespaceFunc(ns, f.Func)
+	for _, child := range f.Child {
+		namespaceFilter(ns, child)
+	}
+}
+
+func namespaceFunc(ns uint64, f *gql.Function) {
+	if f == nil {
+		return
+	}
+	if f.Name == "type" {
+		// type(T) is an alias for eq(dgraph.type, T), whose predicate belongs to the namespace.
+		// The values of dgraph.type are the names of the types in their namespace.
+		f.Name = "eq"
+		f.Attr = x.NamespaceAttr(ns, "dgraph.type")
+		return
+	}
+	if f.Attr == "" || f.IsValueVar || f.IsLenVar || x.IsInternalPredicate(f.Attr) {
+		return
+	}
+	f.Attr = x.NamespaceAttr(ns, f.Attr)
+}
+
+// namespaceMutation renames the predicates of the mutation gmu to their names in the namespace ns.
+func namespaceMutation(ns uint64, gmu *gql.Mutation) {
+	if ns == x.GalaxyNamespace {
+		return
+	}
+	for _, nqs := range [][]*api.NQuad{gmu.Set, gmu.Del} {
+		for _, nq := range nqs {
+			// _STAR_ALL stands for all the predicates of the node, which are found in the
+			// namespace when the mutation is applied.
+			if nq.Predicate != x.Star {
+				nq.Predicate = x.NamespaceAttr(ns, nq.Predicate)
+			}
+		}
+	}
+	gmu.AllowedPreds = namespaceAttrs(ns, gmu.AllowedPreds)
+}
+
+// namespaceSchema renames the predicates and the types of the parsed schema to their names in the
+// namespace ns.
+func namespaceSchema(ns uint64, result *schema.ParsedSchema) {
+	if ns == x.GalaxyNamespace {
+		return
+	}
+	for _, update := range result.Preds {
+		update.Predicate = x.NamespaceAttr(ns, update.Predicate)
+	}
+	for _, typ := range result.Types {
+		typ.TypeName = x.NamespaceAttr(ns, typ.TypeName)
+		for _, field := range typ.Fields {
+			field.Predicate = x.NamespaceAttr(ns, field.Predicate)
+		}
+	}
+}
+
+// schemaOfNamespace keeps the predicates and the types of the namespace ns in the result of a
+// schema query, with their names in the namespace.
+func schemaOfNamespace(ns uint64, er *query.ExecutionResult) {
+	nodes := er.SchemaNode[:0]
+	for _, node := range er.SchemaNode {
+		nodeNs, name := x.ParseNamespaceAttr(node.Predicate)
+		if nodeNs != ns {
+			continue
+		}
+		node.Predicate = name
+		nodes = append(nodes, node)
+	}
+	er.SchemaNode = nodes
+
+	types := make([]*pb.TypeUpdate, 0, len(er.Types))
+	for _, typ := range er.Types {
+		typNs, name := x.ParseNamespaceAttr(typ.TypeName)
+		if typNs != ns {
+			continue
+		}
+		// The types are shared with the schema state, so they are renamed in a copy.
+		res := &pb.TypeUpdate{TypeName: name, Fields: make([]*pb.SchemaUpdate, 0, len(typ.Fields))}
+		for _, field := range typ.Fields {
+			f := *field
+			f.Predicate = x.ParseAttr(field.Predicate)
+			res.Fields = append(res.Fields, &f)
+		}
+		types = append(types, res)
+	}
+	er.Types = types
+}
+
+// applyNamespaceSchema applies the schema of the pre-defined predicates and types to the namespace
+// ns.
+func applyNamespaceSchema(ctx context.Context, ns uint64) error {
+	initial := &schema.ParsedSchema{Preds: schema.InitialSchema(), Types: schema.InitialTypes()}
+	namespaceSchema(ns, initial)
+	m := &pb.Mutations{
+		StartTs: worker.State.GetTimestamp(false),
+		Schema:  initial.Preds,
+		Types:   initial.Types,
+	}
+	if _, err := query.ApplyMutations(ctx, m); err != nil {
+		return errors.Wrapf(err, "while applying the schema of namespace %d", ns)
+	}
+	return nil
+}
+
+// namespaceGuardians holds the uids of the groot user and the guardians group of a namespace,
+// which are kept when the data of the namespace is dropped.
+type namespaceGuardians struct {
+	ns    uint64
+	groot uint64
+	group uint64
+}
+
+// dropNamespace drops the predicates and the types of the namespace ns. With keepSchema, only the
+// data is dropped: the schema of the predicates and the types are applied again once the
+// predicates are dropped. With guardians, the passwords aren't dropped, so that the password of
+// groot can be kept by restoreNamespaceGuardians.
+func dropNamespace(ctx context.Context, ns uint64, keepSchema bool,
+	guardians *namespaceGuardians) error {
+	nodes, err := worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{})
+	if err != nil {
+		return err
+	}
+	var preds []string
+	// The schema is kept as text with the names of the predicates in the namespace, because the
+	// names with a namespace can't be parsed.
+	var sb strings.Builder
+	for _, node := range nodes {
+		nodeNs, name := x.ParseNamespaceAttr(node.Predicate)
+		if nodeNs != ns {
+			continue
+		}
+		writeSchemaNode(&sb, name, node)
+		if guardians != nil && name == "dgraph.password" {
+			continue
+		}
+		preds = append(preds, node.Predicate)
+	}
+	var types []*pb.TypeUpdate
+	for _, name := range schema.State().Types() {
+		if x.ParseNamespace(name) != ns {
+			continue
+		}
+		if typ, ok := schema.State().GetType(name); ok {
+			types = append(types, &typ)
+		}
+	}
+
+	// A proposal drops at most one predicate.
+	for _, pred := range preds {
+		nq := &api.NQuad{
+			Subject:     x.Star,
+			Predicate:   pred,
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: x.Star}},
+		}
+		edge, err := (&gql.NQuad{NQuad: nq}).ToDeletePredEdge()
+		if err != nil {
+			return err
+		}
+		m := &pb.Mutations{StartTs: worker.State.GetTimestamp(false), Edges: []*pb.DirectedEdge{edge}}
+		if _, err := query.ApplyMutations(ctx, m); err != nil {
+			return errors.Wrapf(err, "while dropping predicate %s", pred)
+		}
+		// The backups are taken for the whole cluster, so the predicates of the namespace are
+		// recorded as dropped one by one.
+		if err := insertDropRecord(ctx, "DROP_ATTR;"+pred); err != nil {
+			return err
+		}
+	}
+	for _, typ := range types {
+		m := &pb.Mutations{
+			StartTs:   worker.State.GetTimestamp(false),
+			DropOp:    pb.Mutations_TYPE,
+			DropValue: typ.TypeName,
+		}
+		if _, err := query.ApplyMutations(ctx, m); err != nil {
+			return errors.Wrapf(err, "while dropping type %s", typ.TypeName)
+		}
+	}
+	worker.RecordAlter(ctx, append(preds, x.NamespaceAttr(ns, "dgraph.type")))
+	if !keepSchema {
+		return nil
+	}
+
+	result, err := schema.Parse(sb.String())
+	if err != nil {
+		return errors.Wrapf(err, "while parsing the schema of namespace %d", ns)
+	}
+	namespaceSchema(ns, result)
+	m := &pb.Mutations{
+		StartTs: worker.State.GetTimestamp(false),
+		Schema:  result.Preds,
+		Types:   types,
+	}
+	_, err = query.ApplyMutations(ctx, m)
+	return err
+}
+
+// writeSchemaNode writes the schema of the predicate of node, with the given name, in the format
+// of the schema of an alter operation.
+func writeSchemaNode(sb *strings.Builder, name string, node *pb.SchemaNode) {
+	sb.WriteString("<" + name + ">: ")
+	if node.List {
+		sb.WriteString("[" + node.Type + "]")
+	} else {
+		sb.WriteString(node.Type)
+	}
+	if node.Index {
+		sb.WriteString(" @index(" + strings.Join(node.Tokenizer, ",") + ")")
+	}
+	if node.Reverse {
+		sb.WriteString(" @reverse")
+	}
+	if node.Count {
+		sb.WriteString(" @count")
+	}
+	if node.Upsert {
+		sb.WriteString(" @upsert")
+	}
+	if node.Lang {
+		sb.WriteString(" @lang")
+	}
+	if node.NoConflict {
+		sb.WriteString(" @noconflict")
+	}
+	if node.Storage != "" {
+		sb.WriteString(" @storage(" + node.Storage + ")")
+	}
+	if node.Encrypted {
+		sb.WriteString(" @encrypted")
+	}
+	sb.WriteString(" .\n")
+}
+
+// AddNamespace adds the namespace ns, with the schema of the pre-defined predicates and types.
+// With ACLs, the guardians group and the groot user of the namespace are created too, and the
+// password of groot is set to password.
+func AddNamespace(ctx context.Context, ns uint64, password string) error {
+	if ns == x.GalaxyNamespace || namespaceExists(ns) {
+		return errors.Errorf("Namespace %d already exists", ns)
+	}
+	if err := applyNamespaceSchema(ctx, ns); err != nil {
+		return err
+	}
+	// The alphas which served the GraphQL schema of a namespace deleted before, with the same
+	// number, reset it when the empty schema of the new namespace is inserted.
+	if _, err := UpdateGQLSchema(x.AttachNamespace(ctx, ns), "", ""); err != nil {
+		return err
+	}
+	return createNamespaceGuardians(ctx, ns, password)
+}
+
+// DeleteNamespace drops all the predicates and types of the namespace ns, including its ACL users
+// and groups.
+func DeleteNamespace(ctx context.Context, ns uint64) error {
+	if ns == x.GalaxyNamespace {
+		return errors.Errorf("The galaxy namespace can't be deleted")
+	}
+	if !namespaceExists(ns) {
+		return errors.Errorf("Namespace %d doesn't exist", ns)
+	}
+	return dropNamespace(ctx, ns, false, nil)
+}
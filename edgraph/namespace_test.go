@@ -0,0 +1,122 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/schema"
+)
+
+func TestNamespaceQuery(t *testing.T) {
+	res, err := gql.Parse(gql.Request{Str: `{
+		me(func: type(Person), orderasc: name) @filter(has(age)) @cascade(name) {
+			uid
+			name
+			~friend @filter(eq(count(friend), 2)) {
+				expand(Person,Animal)
+			}
+			v as age
+			ageVal: val(v)
+		}
+		stats(func: has(name)) @groupby(age) {
+			count(uid)
+		}
+	}`})
+	require.NoError(t, err)
+	namespaceQuery(5, &res)
+
+	me := res.Query[0]
+	require.Equal(t, "eq", me.Func.Name)
+	require.Equal(t, "5^dgraph.type", me.Func.Attr)
+	require.Equal(t, "5^name", me.Order[0].Attr)
+	require.Equal(t, "5^age", me.Filter.Func.Attr)
+	require.Equal(t, []string{"5^name"}, me.Cascade)
+	require.Equal(t, "uid", me.Children[0].Attr)
+	require.Equal(t, "5^name", me.Children[1].Attr)
+	friend := me.Children[2]
+	require.Equal(t, "~5^friend", friend.Attr)
+	require.Equal(t, "5^friend", friend.Filter.Func.Attr)
+	require.Equal(t, "5^Person,5^Animal", friend.Children[0].Expand)
+	require.Equal(t, "5^age", me.Children[3].Attr)
+	require.Equal(t, "val", me.Children[4].Attr)
+
+	stats := res.Query[1]
+	require.Equal(t, "5^name", stats.Func.Attr)
+	require.Equal(t, "5^age", stats.GroupbyAttrs[0].Attr)
+	require.Equal(t, "uid", stats.Children[0].Attr)
+}
+
+func TestNamespaceQueryGalaxy(t *testing.T) {
+	res, err := gql.Parse(gql.Request{Str: `{ me(func: type(Person)) { name } }`})
+	require.NoError(t, err)
+	namespaceQuery(0, &res)
+	require.Equal(t, "type", res.Query[0].Func.Name)
+	require.Equal(t, "name", res.Query[0].Children[0].Attr)
+}
+
+func TestNamespaceMutation(t *testing.T) {
+	gmu := &gql.Mutation{
+		Set: []*api.NQuad{makeNquad("_:a", "name", &api.Value{})},
+		Del: []*api.NQuad{makeNquad("0x1", "_STAR_ALL", &api.Value{})},
+	}
+	namespaceMutation(5, gmu)
+	require.Equal(t, "5^name", gmu.Set[0].Predicate)
+	require.Equal(t, "_STAR_ALL", gmu.Del[0].Predicate)
+	require.Nil(t, gmu.AllowedPreds)
+}
+
+func TestSchemaOfNamespace(t *testing.T) {
+	field := &pb.SchemaUpdate{Predicate: "5^name"}
+	er := &query.ExecutionResult{
+		SchemaNode: []*pb.SchemaNode{{Predicate: "name"}, {Predicate: "5^name"},
+			{Predicate: "6^name"}},
+		Types: []*pb.TypeUpdate{{TypeName: "Person"},
+			{TypeName: "5^Person", Fields: []*pb.SchemaUpdate{field}}},
+	}
+	schemaOfNamespace(5, er)
+	require.Equal(t, []*pb.SchemaNode{{Predicate: "name"}}, er.SchemaNode)
+	require.Len(t, er.Types, 1)
+	require.Equal(t, "Person", er.Types[0].TypeName)
+	require.Equal(t, "name", er.Types[0].Fields[0].Predicate)
+	// The types of the schema state are left unchanged.
+	require.Equal(t, "5^name", field.Predicate)
+}
+
+func TestWriteSchemaNode(t *testing.T) {
+	var sb strings.Builder
+	writeSchemaNode(&sb, "name", &pb.SchemaNode{Predicate: "5^name", Type: "string", List: true,
+		Index: true, Tokenizer: []string{"exact", "term"}, Count: true, Upsert: true})
+	writeSchemaNode(&sb, "friend", &pb.SchemaNode{Predicate: "5^friend", Type: "uid",
+		Reverse: true, NoConflict: true})
+	result, err := schema.Parse(sb.String())
+	require.NoError(t, err)
+	require.Len(t, result.Preds, 2)
+	require.Equal(t, "name", result.Preds[0].Predicate)
+	require.True(t, result.Preds[0].List)
+	require.Equal(t, []string{"exact", "term"}, result.Preds[0].Tokenizer)
+	require.True(t, result.Preds[0].Count && result.Preds[0].Upsert)
+	require.Equal(t, pb.SchemaUpdate_REVERSE, result.Preds[1].Directive)
+	require.True(t, result.Preds[1].NoConflict)
+}
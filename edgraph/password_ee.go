@@ -0,0 +1,232 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	ostats "go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// maxLockoutShift caps the backoff of the lockouts at 1024 times the lockout duration.
+	maxLockoutShift = 10
+	// maxLoginFailures is the max number of users whose failed logins are tracked. The users who
+	// aren't locked out are forgotten beyond it, so that logins with random user ids can't
+	// exhaust the memory.
+	maxLoginFailures = 100000
+)
+
+// errPasswordExpired is returned by the logins of the users whose password is older than the max
+// age of the passwords.
+var errPasswordExpired = errors.New("the password has expired, and must be changed by a guardian")
+
+// checkPassword returns an error if the password doesn't satisfy the length and the character
+// classes required by the password policy.
+func checkPassword(password string) error {
+	if len(password) < worker.Config.PasswordMinLength {
+		return errors.Errorf("the password should have at least %d characters",
+			worker.Config.PasswordMinLength)
+	}
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	classes := 0
+	for _, ok := range []bool{lower, upper, digit, other} {
+		if ok {
+			classes++
+		}
+	}
+	if classes < worker.Config.PasswordMinClasses {
+		return errors.Errorf("the password should have at least %d of lower case letters, "+
+			"upper case letters, digits and other characters", worker.Config.PasswordMinClasses)
+	}
+	return nil
+}
+
+// applyPasswordPolicy checks the passwords set by the mutations of the request against the
+// password policy, and records when they were changed. The passwords set by the internal
+// requests, like the default password of groot, aren't checked.
+func applyPasswordPolicy(qc *queryContext, check bool) error {
+	if len(worker.Config.HmacSecret) == 0 {
+		return nil
+	}
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	for _, gmu := range qc.gmuList {
+		var changed []*api.NQuad
+		for _, nq := range gmu.Set {
+			if nq.Predicate != "dgraph.password" {
+				continue
+			}
+			if check {
+				var password string
+				switch val := nq.ObjectValue.GetVal().(type) {
+				case *api.Value_StrVal:
+					password = val.StrVal
+				case *api.Value_DefaultVal:
+					password = val.DefaultVal
+				case *api.Value_PasswordVal:
+					// The value is stored as the hash of the password, so the password itself
+					// can't be checked.
+					return errors.Errorf("the password of a user can't be set as an " +
+						"xs:password hash, since it must satisfy the password policy")
+				}
+				if err := checkPassword(password); err != nil {
+					return err
+				}
+			}
+			changed = append(changed, &api.NQuad{
+				Subject:     nq.Subject,
+				Predicate:   "dgraph.user.password_changed",
+				ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: now}},
+			})
+		}
+		gmu.Set = append(gmu.Set, changed...)
+	}
+	return nil
+}
+
+// passwordExpired returns whether the password of the user is older than the max age of the
+// passwords. The password of groot never expires, so that the guardians can't all be locked out.
+// The users created before the password changes were recorded must change their passwords.
+func passwordExpired(user *acl.User, now time.Time) bool {
+	if worker.Config.PasswordMaxAge == 0 || user.UserID == x.GrootId {
+		return false
+	}
+	return now.Sub(user.PasswordChanged) > worker.Config.PasswordMaxAge
+}
+
+// loginFailures holds the failed logins of a user since their last successful login.
+type loginFailures struct {
+	count       int
+	lockedUntil time.Time
+}
+
+// loginLimiter locks the users out after too many failed logins, in this Alpha.
+type loginLimiter struct {
+	sync.Mutex
+	failures map[string]*loginFailures
+}
+
+var loginLocks = &loginLimiter{failures: make(map[string]*loginFailures)}
+
+// lockedOut returns how long the user is still locked out for.
+func (ll *loginLimiter) lockedOut(user string, now time.Time) time.Duration {
+	ll.Lock()
+	defer ll.Unlock()
+
+	f, ok := ll.failures[user]
+	if !ok || !now.Before(f.lockedUntil) {
+		return 0
+	}
+	return f.lockedUntil.Sub(now)
+}
+
+// fail records a failed login of the user, and returns whether it locked the user out. Once the
+// user has as many failures as the threshold, every further failure locks the user out for twice
+// as long as the previous one.
+func (ll *loginLimiter) fail(user string, threshold int, duration time.Duration,
+	now time.Time) bool {
+	ll.Lock()
+	defer ll.Unlock()
+
+	f, ok := ll.failures[user]
+	if !ok {
+		if len(ll.failures) >= maxLoginFailures {
+			for u, f := range ll.failures {
+				if !now.Before(f.lockedUntil) {
+					delete(ll.failures, u)
+				}
+			}
+		}
+		f = &loginFailures{}
+		ll.failures[user] = f
+	}
+	f.count++
+	if f.count < threshold {
+		return false
+	}
+	shift := f.count - threshold
+	if shift > maxLockoutShift {
+		shift = maxLockoutShift
+	}
+	f.lockedUntil = now.Add(duration << uint(shift))
+	return true
+}
+
+// succeed forgets the failed logins of the user.
+func (ll *loginLimiter) succeed(user string) {
+	ll.Lock()
+	defer ll.Unlock()
+	delete(ll.failures, user)
+}
+
+// checkLockout returns a ResourceExhausted error if the user of the namespace is locked out.
+func checkLockout(userId string, ns uint64) error {
+	if worker.Config.LoginLockoutThreshold == 0 {
+		return nil
+	}
+	// The users of different namespaces might have the same id.
+	if wait := loginLocks.lockedOut(x.NamespaceAttr(ns, userId), time.Now()); wait > 0 {
+		return status.Errorf(codes.ResourceExhausted,
+			"User %s is locked out for %v after too many failed logins", userId,
+			wait.Round(time.Second))
+	}
+	return nil
+}
+
+// recordLogin records the outcome of a password login of the user of the namespace, locking out
+// the user after too many failures.
+func recordLogin(ctx context.Context, userId string, ns uint64, err error) {
+	v := x.TagValueStatusOK
+	if err != nil {
+		v = x.TagValueStatusError
+	}
+	ctx, _ = tag.New(ctx, tag.Upsert(x.KeyStatus, v))
+	ostats.Record(ctx, x.LoginAttempts.M(1))
+
+	if worker.Config.LoginLockoutThreshold == 0 {
+		return
+	}
+	user := x.NamespaceAttr(ns, userId)
+	if err == nil {
+		loginLocks.succeed(user)
+		return
+	}
+	if loginLocks.fail(user, worker.Config.LoginLockoutThreshold,
+		worker.Config.LoginLockoutDuration, time.Now()) {
+		ostats.Record(ctx, x.LoginLockouts.M(1))
+	}
+}
@@ -0,0 +1,112 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/ee/acl"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestCheckPassword(t *testing.T) {
+	defer func(c worker.Options) { worker.Config = c }(worker.Config)
+	worker.Config.PasswordMinLength = 8
+	worker.Config.PasswordMinClasses = 3
+
+	require.Error(t, checkPassword("aB3$"), "too short")
+	require.Error(t, checkPassword("password123"), "two classes")
+	require.NoError(t, checkPassword("Password123"))
+	require.NoError(t, checkPassword("password-123"))
+	require.NoError(t, checkPassword("Pässwörter1"))
+}
+
+func TestApplyPasswordPolicy(t *testing.T) {
+	defer func(c worker.Options) { worker.Config = c }(worker.Config)
+	worker.Config.HmacSecret = []byte("0123456789abcdef0123456789abcdef")
+	worker.Config.PasswordMinLength = 6
+	worker.Config.PasswordMinClasses = 2
+
+	newQc := func(password string) *queryContext {
+		return &queryContext{gmuList: []*gql.Mutation{{Set: []*api.NQuad{
+			{Subject: "_:u", Predicate: "dgraph.xid",
+				ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "alice"}}},
+			{Subject: "_:u", Predicate: "dgraph.password",
+				ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: password}}},
+		}}}}
+	}
+
+	require.Error(t, applyPasswordPolicy(newQc("simplepassword"), true))
+	// The internal requests aren't checked.
+	require.NoError(t, applyPasswordPolicy(newQc("simplepassword"), false))
+
+	// A hash, set with ^^<xs:password>, can't be checked against the policy.
+	hashed := newQc("")
+	hashed.gmuList[0].Set[1].ObjectValue = &api.Value{Val: &api.Value_PasswordVal{
+		PasswordVal: "$2a$10$pvoFe5nl6Oc4uIG3jIo7XOg4Iy31SkDyVfwulEvQXutkBvMmW6E8S"}}
+	require.Error(t, applyPasswordPolicy(hashed, true))
+
+	qc := newQc("password1")
+	require.NoError(t, applyPasswordPolicy(qc, true))
+	set := qc.gmuList[0].Set
+	require.Len(t, set, 3)
+	require.Equal(t, "_:u", set[2].Subject)
+	require.Equal(t, "dgraph.user.password_changed", set[2].Predicate)
+	changed, err := time.Parse(time.RFC3339Nano, set[2].ObjectValue.GetStrVal())
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), changed, time.Minute)
+}
+
+func TestPasswordExpired(t *testing.T) {
+	defer func(c worker.Options) { worker.Config = c }(worker.Config)
+	now := time.Now()
+	user := &acl.User{UserID: "alice", PasswordChanged: now.Add(-48 * time.Hour)}
+
+	require.False(t, passwordExpired(user, now), "the passwords never expire by default")
+	worker.Config.PasswordMaxAge = 72 * time.Hour
+	require.False(t, passwordExpired(user, now))
+	worker.Config.PasswordMaxAge = 24 * time.Hour
+	require.True(t, passwordExpired(user, now))
+	require.True(t, passwordExpired(&acl.User{UserID: "bob"}, now),
+		"the passwords whose changes weren't recorded have expired")
+	require.False(t, passwordExpired(&acl.User{UserID: x.GrootId}, now))
+}
+
+func TestLoginLimiter(t *testing.T) {
+	ll := &loginLimiter{failures: make(map[string]*loginFailures)}
+	now := time.Now()
+
+	require.False(t, ll.fail("alice", 3, time.Minute, now))
+	require.False(t, ll.fail("alice", 3, time.Minute, now))
+	require.Zero(t, ll.lockedOut("alice", now))
+	require.True(t, ll.fail("alice", 3, time.Minute, now))
+	require.Equal(t, time.Minute, ll.lockedOut("alice", now))
+	require.Zero(t, ll.lockedOut("bob", now), "each user has their own failures")
+
+	// Every further failure doubles the lockout.
+	now = now.Add(time.Minute)
+	require.Zero(t, ll.lockedOut("alice", now))
+	require.True(t, ll.fail("alice", 3, time.Minute, now))
+	require.Equal(t, 2*time.Minute, ll.lockedOut("alice", now))
+
+	// A successful login forgets the failures.
+	ll.succeed("alice")
+	require.Zero(t, ll.lockedOut("alice", now))
+	require.False(t, ll.fail("alice", 3, time.Minute, now))
+}
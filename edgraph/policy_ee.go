@@ -0,0 +1,249 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// policyQuery is the query in which the filter of the node-level policies is parsed. The user id
+// of the request is available to the filter as the $userid variable.
+const policyQuery = `query q($userid: string) { q(func: uid(%s)) @filter(%s) { uid } }`
+
+// policyFilterText returns the DQL filter which keeps the nodes allowed by the node-level policies
+// of a user, given their filters by type, or an empty string if the user has no policy.
+//
+// The filter of policies [T1: F1, T1: F2, T2: F3] is:
+//   (NOT type(T1) OR (F1) OR (F2)) AND (NOT type(T2) OR (F3))
+func policyFilterText(filters map[string][]string) string {
+	types := make([]string, 0, len(filters))
+	for typ := range filters {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	parts := make([]string, 0, len(types))
+	for _, typ := range types {
+		parts = append(parts, fmt.Sprintf("(NOT type(%s) OR (%s))", typ,
+			strings.Join(filters[typ], ") OR (")))
+	}
+	return strings.Join(parts, " AND ")
+}
+
+// parsePolicyFilter parses the filter of the node-level policies of user userId.
+func parsePolicyFilter(filter, userId string) (*gql.FilterTree, error) {
+	res, err := gql.Parse(gql.Request{
+		Str:       fmt.Sprintf(policyQuery, "0x1", filter),
+		Variables: map[string]string{"$userid": userId},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing the policy filter %q", filter)
+	}
+	// The filter must not close the query block, to add blocks or variables to the query.
+	if len(res.Query) != 1 || len(res.QueryVars) > 1 || len(res.Query[0].Children) != 1 ||
+		res.Query[0].Filter == nil {
+		return nil, errors.Errorf("invalid policy filter %q", filter)
+	}
+	return res.Query[0].Filter, nil
+}
+
+// uidPredicates returns the predicates of the query blocks which are of type uid, but have no
+// children, like under @recurse or in count(). The ones with children are of type uid anyway.
+func uidPredicates(ctx context.Context, gqs []*gql.GraphQuery) (map[string]bool, error) {
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var preds []string
+	var collect func(gqs []*gql.GraphQuery)
+	collect = func(gqs []*gql.GraphQuery) {
+		for _, gq := range gqs {
+			if len(gq.Children) == 0 && gq.Expand == "" && gq.Attr != "" && gq.Attr != "uid" &&
+				gq.Attr != "val" && !strings.HasPrefix(gq.Attr, "~") {
+				preds = append(preds, x.NamespaceAttr(ns, gq.Attr))
+			}
+			collect(gq.Children)
+		}
+	}
+	for _, gq := range gqs {
+		collect(gq.Children)
+	}
+	uidPreds := make(map[string]bool)
+	if len(preds) == 0 {
+		return uidPreds, nil
+	}
+	nodes, err := worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{
+		Predicates: preds,
+		Fields:     []string{"type"},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the types of the predicates")
+	}
+	for _, node := range nodes {
+		if node.Type == "uid" {
+			uidPreds[x.ParseAttr(node.Predicate)] = true
+		}
+	}
+	return uidPreds, nil
+}
+
+// addPolicyFilters adds the filter of the node-level policies of user userId to the query blocks,
+// to the uid predicates of the query blocks and to the edges of the shortest path blocks. The
+// expand() nodes keep it for the uid predicates they expand to. The uid predicates without
+// children, like under @recurse or in count(), are given by uidPreds.
+func addPolicyFilters(gqs []*gql.GraphQuery, filter, userId string,
+	uidPreds map[string]bool) error {
+	if filter == "" {
+		return nil
+	}
+	var add func(gq *gql.GraphQuery, root, shortest bool) error
+	add = func(gq *gql.GraphQuery, root, shortest bool) error {
+		// The uid predicates with children are filtered, and so are the leaves under @recurse,
+		// which expandRecurse copies with their own filter, and the ones counted by count().
+		// expand() has the children of the expanded predicates. The edges of a shortest path
+		// don't have children, and are all filtered, so that the paths only go through the
+		// allowed nodes.
+		isBlock := root && (gq.Func != nil || len(gq.UID) > 0)
+		isUidPred := !root && gq.Expand == "" && (len(gq.Children) > 0 ||
+			strings.HasPrefix(gq.Attr, "~") || uidPreds[gq.Attr])
+		if isBlock || isUidPred || shortest || gq.Expand != "" {
+			// Each query block gets its own filter, since the filters are rewritten in place.
+			newFilter, err := parsePolicyFilter(filter, userId)
+			if err != nil {
+				return err
+			}
+			if gq.Expand != "" {
+				gq.PolicyFilter = newFilter
+			} else {
+				gq.Filter = parentFilter(newFilter, gq.Filter)
+			}
+		}
+		for _, ch := range gq.Children {
+			if err := add(ch, false, root && gq.Alias == "shortest"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, gq := range gqs {
+		if err := add(gq, true, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authorizeNodes checks that the nodes mutated by gmu are allowed by the filter of the node-level
+// policies of user userId. The nodes given by variables are allowed, since the query blocks of the
+// variables are filtered by the policies, and so are the new nodes.
+func authorizeNodes(ctx context.Context, gmu *gql.Mutation, filter, userId string) error {
+	if filter == "" {
+		return nil
+	}
+	uids := make(map[uint64]struct{})
+	for _, nqs := range [][]*api.NQuad{gmu.Set, gmu.Del} {
+		for _, nq := range nqs {
+			if uid, err := strconv.ParseUint(nq.Subject, 0, 64); err == nil {
+				uids[uid] = struct{}{}
+			}
+		}
+	}
+	if len(uids) == 0 {
+		return nil
+	}
+
+	list := make([]string, 0, len(uids))
+	for uid := range uids {
+		list = append(list, fmt.Sprintf("%#x", uid))
+	}
+	if _, err := parsePolicyFilter(filter, userId); err != nil {
+		return err
+	}
+	resp, err := (&Server{}).doQuery(ctx, &api.Request{
+		Query:    fmt.Sprintf(policyQuery, strings.Join(list, ", "), filter),
+		Vars:     map[string]string{"$userid": userId},
+		ReadOnly: true,
+	}, NoAuthorize)
+	if err != nil {
+		return errors.Wrapf(err, "while checking the policies of the mutated nodes")
+	}
+	var allowed struct {
+		Q []struct {
+			Uid string `json:"uid"`
+		} `json:"q"`
+	}
+	if err := json.Unmarshal(resp.GetJson(), &allowed); err != nil {
+		return err
+	}
+	for _, node := range allowed.Q {
+		if uid, err := strconv.ParseUint(node.Uid, 0, 64); err == nil {
+			delete(uids, uid)
+		}
+	}
+	if len(uids) > 0 {
+		denied := make([]string, 0, len(uids))
+		for uid := range uids {
+			denied = append(denied, fmt.Sprintf("%#x", uid))
+		}
+		sort.Strings(denied)
+		return status.Errorf(codes.PermissionDenied,
+			"unauthorized to mutate the nodes %s, which the policies of the user don't allow",
+			strings.Join(denied, ", "))
+	}
+	return nil
+}
+
+// validateAclNodes checks the predicates of the rules, the node-level policies and the IP
+// addresses of the API keys set by the nquads.
+func validateAclNodes(nquads []*api.NQuad) error {
+	for _, nq := range nquads {
+		val := nq.GetObjectValue().GetStrVal()
+		if val == "" {
+			val = nq.GetObjectValue().GetDefaultVal()
+		}
+		switch nq.Predicate {
+		case "dgraph.rule.predicate":
+			if _, _, err := parseRulePredicate(val); err != nil {
+				return err
+			}
+		case "dgraph.policy.type":
+			if val == "" || strings.ContainsAny(val, "() \t\n") {
+				return errors.Errorf("invalid type %q for a policy", val)
+			}
+		case "dgraph.policy.filter":
+			if _, err := parsePolicyFilter(val, ""); err != nil {
+				return err
+			}
+		case "dgraph.apikey.ips":
+			if _, err := parseApiKeyIPs([]string{val}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+)
+
+func TestPolicyFilterText(t *testing.T) {
+	require.Equal(t, "", policyFilterText(nil))
+	require.Equal(t,
+		`(NOT type(Employee) OR (eq(manager, $userid)) OR (eq(country, "FR"))) AND `+
+			`(NOT type(Payslip) OR (has(public)))`,
+		policyFilterText(map[string][]string{
+			"Payslip":  {"has(public)"},
+			"Employee": {"eq(manager, $userid)", `eq(country, "FR")`},
+		}))
+}
+
+func TestParsePolicyFilter(t *testing.T) {
+	filter, err := parsePolicyFilter("eq(manager, $userid)", "alice")
+	require.NoError(t, err)
+	require.Equal(t, "eq", filter.Func.Name)
+	require.Equal(t, "manager", filter.Func.Attr)
+	require.Equal(t, "alice", filter.Func.Args[0].Value)
+
+	for _, f := range []string{
+		"",
+		"eq(manager",
+		"eq(manager, $userid)) { uid } } { q2(func: has(salary)) { salary } } { q3(func: uid(0x1)",
+		"eq(manager, $userid)) { uid salary } } { q3(func: uid(0x1)",
+	} {
+		_, err := parsePolicyFilter(f, "alice")
+		require.Error(t, err, f)
+	}
+}
+
+func TestAddPolicyFilters(t *testing.T) {
+	res, err := gql.Parse(gql.Request{Str: `{
+		q(func: has(name)) @filter(has(age)) {
+			name
+			reports {
+				name
+			}
+			expand(_all_) {
+				name
+			}
+		}
+	}`})
+	require.NoError(t, err)
+
+	require.NoError(t, addPolicyFilters(res.Query, "", "alice", nil))
+	require.Equal(t, "has", res.Query[0].Filter.Func.Name)
+
+	require.NoError(t, addPolicyFilters(res.Query, "eq(manager, $userid)", "alice", nil))
+	root := res.Query[0]
+	require.Equal(t, "AND", root.Filter.Op)
+	require.Equal(t, "has", root.Filter.Child[0].Func.Name)
+	require.Equal(t, "manager", root.Filter.Child[1].Func.Attr)
+	// Only the uid predicates are filtered.
+	require.Nil(t, root.Children[0].Filter)
+	require.Equal(t, "manager", root.Children[1].Filter.Func.Attr)
+	require.Nil(t, root.Children[2].Filter)
+	// expand() keeps the filter for the uid predicates it expands to.
+	require.Equal(t, "manager", root.Children[2].PolicyFilter.Func.Attr)
+	// Each query block gets its own copy of the filter.
+	require.NotSame(t, root.Filter.Child[1], root.Children[1].Filter)
+
+	res, err = gql.Parse(gql.Request{Str: `{
+		path as shortest(from: 0x1, to: 0x2) {
+			reports
+		}
+		q(func: uid(path)) {
+			name
+		}
+	}`})
+	require.NoError(t, err)
+	require.NoError(t, addPolicyFilters(res.Query, "eq(manager, $userid)", "alice", nil))
+	// The edges of shortest paths are filtered.
+	require.Equal(t, "manager", res.Query[0].Children[0].Filter.Func.Attr)
+	require.Equal(t, "manager", res.Query[1].Filter.Func.Attr)
+
+	// The uid predicates without children, under @recurse or in count(), are filtered too.
+	res, err = gql.Parse(gql.Request{Str: `{
+		q(func: uid(0x1)) @recurse {
+			name
+			reports
+			~manages
+		}
+		c(func: uid(0x1)) {
+			count(reports)
+			count(name)
+		}
+	}`})
+	require.NoError(t, err)
+	require.NoError(t, addPolicyFilters(res.Query, "eq(manager, $userid)", "alice",
+		map[string]bool{"reports": true}))
+	recurse, count := res.Query[0].Children, res.Query[1].Children
+	require.Nil(t, recurse[0].Filter)
+	require.Equal(t, "manager", recurse[1].Filter.Func.Attr)
+	require.Equal(t, "manager", recurse[2].Filter.Func.Attr)
+	require.Equal(t, "manager", count[0].Filter.Func.Attr)
+	require.Nil(t, count[1].Filter)
+}
+
+func TestValidateAclNodes(t *testing.T) {
+	str := func(s string) *api.Value { return &api.Value{Val: &api.Value_StrVal{StrVal: s}} }
+	require.NoError(t, validateAclNodes([]*api.NQuad{
+		{Subject: "_:p", Predicate: "dgraph.policy.type", ObjectValue: str("Employee")},
+		{Subject: "_:p", Predicate: "dgraph.policy.filter", ObjectValue: str("has(name)")},
+		{Subject: "_:p", Predicate: "name", ObjectValue: str("eq(")},
+	}))
+	require.Error(t, validateAclNodes([]*api.NQuad{
+		{Subject: "_:p", Predicate: "dgraph.policy.type", ObjectValue: str("Employee) OR (has(x)")},
+	}))
+	require.Error(t, validateAclNodes([]*api.NQuad{
+		{Subject: "_:p", Predicate: "dgraph.policy.filter", ObjectValue: str("eq(")},
+	}))
+
+	for _, pred := range []string{"name", `/^billing\./`, "type(Person)"} {
+		require.NoError(t, validateAclNodes([]*api.NQuad{
+			{Subject: "_:r", Predicate: "dgraph.rule.predicate", ObjectValue: str(pred)},
+		}), pred)
+	}
+	for _, pred := range []string{"/billing[/", "type( )"} {
+		require.Error(t, validateAclNodes([]*api.NQuad{
+			{Subject: "_:r", Predicate: "dgraph.rule.predicate", ObjectValue: str(pred)},
+		}), pred)
+	}
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/ristretto"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// queryCache caches the results of read-only DQL queries, keyed on the normalized query text and
+// its variables. A result is served as long as none of the predicates read by the query was
+// written after the timestamp it was read at. See worker/query_cache.go.
+type queryCache struct {
+	cache *ristretto.Cache
+}
+
+type cachedResult struct {
+	key    string
+	readTs uint64
+	// resets is the value of posting.Resets() when the result was read.
+	resets  uint64
+	preds   []string
+	json    []byte
+	rdf     []byte
+	numUids map[string]uint64
+}
+
+// qcache is nil unless the query cache is enabled.
+var qcache *queryCache
+
+// InitQueryCache enables the query cache, with the given max size in bytes.
+func InitQueryCache(size int64) error {
+	if size <= 0 {
+		return nil
+	}
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: size / 1000 * 10,
+		MaxCost:     size,
+		BufferItems: 64,
+		Cost: func(val interface{}) int64 {
+			res, ok := val.(*cachedResult)
+			if !ok {
+				return 0
+			}
+			return int64(len(res.key) + len(res.json) + len(res.rdf))
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while creating the query cache")
+	}
+	qcache = &queryCache{cache: cache}
+	return nil
+}
+
+// get returns the cached result for key, if it is still valid.
+func (c *queryCache) get(key string) *cachedResult {
+	val, ok := c.cache.Get(key)
+	if !ok {
+		return nil
+	}
+	res, ok := val.(*cachedResult)
+	if !ok || res.key != key {
+		return nil
+	}
+	if res.resets != posting.Resets() ||
+		posting.Oracle().LatestCommitTs(res.preds) > res.readTs {
+		c.cache.Del(key)
+		return nil
+	}
+	return res
+}
+
+func (c *queryCache) set(res *cachedResult) {
+	// Keep a copy of the metrics, which belong to the response.
+	numUids := make(map[string]uint64, len(res.numUids))
+	for k, v := range res.numUids {
+		numUids[k] = v
+	}
+	res.numUids = numUids
+	c.cache.Set(res.key, res, 0)
+}
+
+// response returns a copy of the cached response.
+func (res *cachedResult) response() *api.Response {
+	numUids := make(map[string]uint64, len(res.numUids))
+	for k, v := range res.numUids {
+		numUids[k] = v
+	}
+	return &api.Response{
+		Json:    res.json,
+		Rdf:     res.rdf,
+		Txn:     &api.TxnContext{StartTs: res.readTs},
+		Metrics: &api.Metrics{NumUids: numUids},
+	}
+}
+
+// queryCacheKey returns the key of the request in the query cache, and false if the result of the
+// request can't be cached. Only the read-only DQL queries which start a new transaction, and
+// which read the latest timestamp known to this Alpha, are cached.
+func queryCacheKey(ctx context.Context, qc *queryContext) (string, bool) {
+	req := qc.req
+	if qcache == nil || qc.graphql || len(req.Mutations) > 0 || req.StartTs != 0 ||
+		!req.ReadOnly || x.WorkerConfig.LudicrousMode || qc.isolation != pb.Query_SNAPSHOT {
+		return "", false
+	}
+	if linearizable, err := x.ExtractLinearizable(ctx); err != nil || linearizable {
+		return "", false
+	}
+	if maxStaleness, err := x.ExtractMaxStaleness(ctx); err != nil || maxStaleness > 0 {
+		return "", false
+	}
+
+	// With ACLs, the result depends on the permissions of the user, which include the predicates
+	// whose @encrypted values are decrypted. The cache is checked before
+	// the request is authorized, so the token must still be valid, which an API key that was
+	// revoked isn't.
+	var identity string
+	if len(worker.Config.HmacSecret) > 0 {
+		jwt, err := x.ExtractJwt(ctx)
+		if err != nil {
+			return "", false
+		}
+		if _, err := extractUserAndGroups(ctx); err != nil {
+			return "", false
+		}
+		identity = jwt[0]
+	}
+
+	vars := make([]string, 0, len(req.Vars))
+	for k, v := range req.Vars {
+		vars = append(vars, k+"="+v)
+	}
+	sort.Strings(vars)
+
+	parts := []string{
+		normalizeQuery(req.Query),
+		strings.Join(vars, "\x00"),
+		req.RespFormat.String(),
+		strconv.FormatBool(query.IsDebug(ctx)),
+		strconv.FormatUint(qc.namespace, 10),
+		identity,
+	}
+	var sb strings.Builder
+	for _, part := range parts {
+		// Prefix the parts with their length, so that the key is unambiguous.
+		sb.WriteString(strconv.Itoa(len(part)))
+		sb.WriteByte(':')
+		sb.WriteString(part)
+	}
+	return sb.String(), true
+}
+
+// normalizeQuery trims the whitespace around the lines of a query, outside of string literals, so
+// that queries which only differ by their indentation share the same cached result.
+func normalizeQuery(q string) string {
+	var sb strings.Builder
+	var inString, escaped bool
+	// space holds the whitespace seen outside of string literals, which is only written if it is
+	// followed by something else than a line break.
+	var space strings.Builder
+	var newline bool
+	for _, r := range strings.TrimSpace(q) {
+		if inString {
+			sb.WriteRune(r)
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"':
+				inString = false
+			}
+			continue
+		}
+		if unicode.IsSpace(r) {
+			if r == '\n' {
+				newline = true
+				space.Reset()
+			} else if !newline {
+				space.WriteRune(r)
+			}
+			continue
+		}
+		if newline {
+			sb.WriteByte('\n')
+		} else {
+			sb.WriteString(space.String())
+		}
+		newline = false
+		space.Reset()
+		if r == '"' {
+			inString = true
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/posting"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	q := `
+	{
+		me(func: eq(name, "Alice  \"in\"
+		  Wonderland")) {
+			name   age
+		}
+	}
+`
+	require.Equal(t, "{\nme(func: eq(name, \"Alice  \\\"in\\\"\n\t\t  Wonderland\")) {\n"+
+		"name   age\n}\n}", normalizeQuery(q))
+	require.Equal(t, normalizeQuery("{ q(func: uid(1)) { name } }"),
+		normalizeQuery("  { q(func: uid(1)) { name } }\n"))
+	require.NotEqual(t, normalizeQuery("{ q(func: uid(1)) { name } }"),
+		normalizeQuery("{ q(func: uid(1)) {  name } }"))
+}
+
+func TestQueryCache(t *testing.T) {
+	require.NoError(t, InitQueryCache(1<<20))
+	defer func() {
+		qcache = nil
+	}()
+
+	qc := &queryContext{req: &api.Request{Query: "{ q(func: has(name)) { name } }", ReadOnly: true}}
+	key, ok := queryCacheKey(context.Background(), qc)
+	require.True(t, ok)
+
+	other := &queryContext{req: &api.Request{Query: qc.req.Query, ReadOnly: true,
+		Vars: map[string]string{"$a": "1"}}}
+	otherKey, ok := queryCacheKey(context.Background(), other)
+	require.True(t, ok)
+	require.NotEqual(t, key, otherKey)
+
+	// Queries which may be followed by mutations in the same transaction are not cached.
+	_, ok = queryCacheKey(context.Background(), &queryContext{req: &api.Request{Query: "{}"}})
+	require.False(t, ok)
+
+	readTs := posting.Oracle().LatestCommitTs([]string{"name"}) + 10
+	qcache.set(&cachedResult{
+		key:     key,
+		readTs:  readTs,
+		resets:  posting.Resets(),
+		preds:   []string{"name"},
+		json:    []byte(`{"q":[]}`),
+		numUids: map[string]uint64{"_total": 0},
+	})
+	var res *cachedResult
+	require.Eventually(t, func() bool {
+		res = qcache.get(key)
+		return res != nil
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, readTs, res.response().Txn.StartTs)
+	require.Equal(t, `{"q":[]}`, string(res.response().Json))
+
+	// Commits on other predicates don't invalidate the result.
+	posting.Oracle().RecordCommit([]string{"age"}, readTs+1)
+	require.NotNil(t, qcache.get(key))
+
+	posting.Oracle().RecordCommit([]string{"name"}, readTs)
+	require.NotNil(t, qcache.get(key))
+	posting.Oracle().RecordCommit([]string{"name"}, readTs+1)
+	require.Nil(t, qcache.get(key))
+}
@@ -0,0 +1,165 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// rateLimit is the rate limit of the users of an ACL group.
+type rateLimit struct {
+	// rate is the number of requests per second.
+	rate float64
+	// burst is the max number of requests allowed at once. If it is zero, it defaults to the
+	// number of requests allowed per second.
+	burst int64
+}
+
+func (l rateLimit) capacity() float64 {
+	if l.burst > 0 {
+		return float64(l.burst)
+	}
+	return math.Max(1, math.Ceil(l.rate))
+}
+
+// tokenBucket holds the tokens of a user. A token is taken by every request, and the tokens are
+// refilled at the rate of the limit of the user.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+	// full is when the bucket is refilled up to its capacity. From then on, it's the same as a
+	// new bucket, so it can be dropped.
+	full time.Time
+}
+
+// sweepInterval is how often the rate limiters drop the buckets which were refilled.
+const sweepInterval = time.Minute
+
+// rateLimiter enforces the rate limits of the users, or of the client IPs, in this Alpha.
+type rateLimiter struct {
+	sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+var (
+	// limiter holds the buckets of the ACL users.
+	limiter = &rateLimiter{buckets: make(map[string]*tokenBucket)}
+	// ipLimiter holds the buckets of the client IPs of the requests without an ACL user.
+	ipLimiter = &rateLimiter{buckets: make(map[string]*tokenBucket)}
+)
+
+// allow takes a token from the bucket of the user, and returns false if there is none left.
+func (rl *rateLimiter) allow(user string, limit rateLimit, now time.Time) bool {
+	rl.Lock()
+	defer rl.Unlock()
+
+	if now.Sub(rl.lastSweep) >= sweepInterval {
+		rl.sweep(now)
+	}
+
+	capacity := limit.capacity()
+	b, ok := rl.buckets[user]
+	if !ok {
+		b = &tokenBucket{tokens: capacity, last: now}
+		rl.buckets[user] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(capacity, b.tokens+elapsed*limit.rate)
+		b.last = now
+	}
+	// The limit of the user might have been lowered since the last request.
+	b.tokens = math.Min(capacity, b.tokens)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	b.full = now.Add(time.Duration((capacity - b.tokens) / limit.rate * float64(time.Second)))
+	return true
+}
+
+// sweep drops the buckets which were refilled since their last request. rl must be locked.
+func (rl *rateLimiter) sweep(now time.Time) {
+	for user, b := range rl.buckets {
+		if !now.Before(b.full) {
+			delete(rl.buckets, user)
+		}
+	}
+	rl.lastSweep = now
+}
+
+// checkIPRateLimit returns a ResourceExhausted error if the client IP of the request has exceeded
+// the rate limit of the client IPs.
+func checkIPRateLimit(ctx context.Context) error {
+	limit := rateLimit{rate: worker.Config.IPRateLimit, burst: worker.Config.IPRateBurst}
+	if limit.rate <= 0 {
+		return nil
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	ip, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		ip = p.Addr.String()
+	}
+	if ipLimiter.allow(ip, limit, time.Now()) {
+		return nil
+	}
+	return status.Errorf(codes.ResourceExhausted,
+		"Rate limit exceeded for client IP %s: %v requests per second", ip, limit.rate)
+}
+
+// CheckRateLimit returns a ResourceExhausted error if the user sending the request has exceeded
+// the rate limit of their ACL groups, in the namespace of the request. The requests whose user
+// can't be authenticated are limited by their client IP, and then left to the authorization of
+// the request.
+func CheckRateLimit(ctx context.Context) error {
+	if len(worker.Config.HmacSecret) == 0 {
+		return checkIPRateLimit(ctx)
+	}
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return checkIPRateLimit(ctx)
+	}
+	userId, groupIds := userData[0], userData[1:]
+	if x.IsGuardian(groupIds) {
+		return nil
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return err
+	}
+	cache, err := aclCacheOf(ctx)
+	if err != nil {
+		return err
+	}
+	// The users of different namespaces might have the same id.
+	limit, ok := cache.rateLimit(groupIds)
+	if !ok || limiter.allow(x.NamespaceAttr(ns, userId), limit, time.Now()) {
+		return nil
+	}
+	return status.Errorf(codes.ResourceExhausted,
+		"Rate limit exceeded for user %s: %v requests per second", userId, limit.rate)
+}
@@ -0,0 +1,107 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package edgraph
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgraph/worker"
+)
+
+func TestRateLimiter(t *testing.T) {
+	rl := &rateLimiter{buckets: make(map[string]*tokenBucket)}
+	limit := rateLimit{rate: 2, burst: 3}
+	now := time.Now()
+
+	// The burst is allowed at once, and then the requests are allowed at the rate of the limit.
+	for i := 0; i < 3; i++ {
+		require.True(t, rl.allow("alice", limit, now))
+	}
+	require.False(t, rl.allow("alice", limit, now))
+	require.True(t, rl.allow("bob", limit, now), "each user has their own bucket")
+
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, rl.allow("alice", limit, now))
+	require.False(t, rl.allow("alice", limit, now))
+
+	// The tokens don't pile up beyond the burst.
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.True(t, rl.allow("alice", limit, now))
+	}
+	require.False(t, rl.allow("alice", limit, now))
+
+	// Without a burst, the burst is the rate.
+	limit = rateLimit{rate: 2}
+	require.Equal(t, float64(2), limit.capacity())
+	require.Equal(t, float64(1), rateLimit{rate: 0.5}.capacity())
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	rl := &rateLimiter{buckets: make(map[string]*tokenBucket)}
+	limit := rateLimit{rate: 0.1, burst: 10}
+	now := time.Now()
+
+	require.True(t, rl.allow("alice", limit, now))
+	for i := 0; i < 10; i++ {
+		rl.allow("bob", limit, now)
+	}
+	require.Len(t, rl.buckets, 2)
+
+	// After a minute, the bucket of alice is full again, but not the one of bob.
+	now = now.Add(sweepInterval)
+	require.True(t, rl.allow("carol", limit, now))
+	require.Len(t, rl.buckets, 2)
+	require.Contains(t, rl.buckets, "bob")
+	require.Contains(t, rl.buckets, "carol")
+
+	// The buckets are only swept once per interval.
+	now = now.Add(sweepInterval / 2)
+	require.True(t, rl.allow("dave", limit, now))
+	require.Len(t, rl.buckets, 3)
+}
+
+func TestCheckIPRateLimit(t *testing.T) {
+	defer func(rate float64, burst int64) {
+		worker.Config.IPRateLimit, worker.Config.IPRateBurst = rate, burst
+	}(worker.Config.IPRateLimit, worker.Config.IPRateBurst)
+
+	ctx := peer.NewContext(context.Background(),
+		&peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}})
+	// Not limited by default.
+	for i := 0; i < 10; i++ {
+		require.NoError(t, checkIPRateLimit(ctx))
+	}
+
+	worker.Config.IPRateLimit, worker.Config.IPRateBurst = 0.001, 2
+	require.NoError(t, checkIPRateLimit(ctx))
+	require.NoError(t, checkIPRateLimit(ctx))
+	err := checkIPRateLimit(ctx)
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Each client IP has its own bucket, whatever its port.
+	other := peer.NewContext(context.Background(),
+		&peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 1234}})
+	require.NoError(t, checkIPRateLimit(other))
+	require.Error(t, checkIPRateLimit(peer.NewContext(context.Background(),
+		&peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 4321}})))
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/dgraph/cmd/zero"
 	"github.com/dgraph-io/dgraph/ee"
+	"github.com/dgraph-io/dgraph/ee/enc"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -140,10 +141,11 @@ func PeriodicallyPostTelemetry() {
 	}
 }
 
-// GetGQLSchema queries for the GraphQL schema node, and returns the uid and the GraphQL schema.
-// If multiple schema nodes were found, it returns an error.
-func GetGQLSchema() (uid, graphQLSchema string, err error) {
-	resp, err := (&Server{}).Query(context.WithValue(context.Background(), Authorize, false),
+// GetGQLSchema queries for the GraphQL schema node of the namespace ns, and returns the uid and
+// the GraphQL schema. If multiple schema nodes were found, it returns an error.
+func GetGQLSchema(ns uint64) (uid, graphQLSchema string, err error) {
+	ctx := x.AttachNamespace(context.WithValue(context.Background(), Authorize, false), ns)
+	resp, err := (&Server{}).Query(ctx,
 		&api.Request{
 			Query: `
 			query {
@@ -188,13 +190,16 @@ func GetGQLSchema() (uid, graphQLSchema string, err error) {
 	return resLast.Uid, resLast.Schema, nil
 }
 
-// UpdateGQLSchema updates the GraphQL and Dgraph schemas using the given inputs.
-// It first validates and parses the dgraphSchema given in input. If that fails,
+// UpdateGQLSchema updates the GraphQL and Dgraph schemas of the namespace of ctx using the given
+// inputs. It first validates and parses the dgraphSchema given in input. If that fails,
 // it returns an error. All this is done on the alpha on which the update request is received.
 // Then it sends an update request to the worker, which is executed only on Group-1 leader.
 func UpdateGQLSchema(ctx context.Context, gqlSchema,
 	dgraphSchema string) (*pb.UpdateGraphQLSchemaResponse, error) {
-	var err error
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
 	parsedDgraphSchema := &schema.ParsedSchema{}
 
 	// The schema could be empty if it only has custom types/queries/mutations.
@@ -206,6 +211,7 @@ func UpdateGQLSchema(ctx context.Context, gqlSchema,
 		if parsedDgraphSchema, err = parseSchemaFromAlterOperation(op); err != nil {
 			return nil, err
 		}
+		namespaceSchema(ns, parsedDgraphSchema)
 	}
 
 	return worker.UpdateGQLSchemaOverNetwork(ctx, &pb.UpdateGraphQLSchemaRequest{
@@ -213,6 +219,7 @@ func UpdateGQLSchema(ctx context.Context, gqlSchema,
 		GraphqlSchema: gqlSchema,
 		DgraphPreds:   parsedDgraphSchema.Preds,
 		DgraphTypes:   parsedDgraphSchema.Types,
+		Namespace:     ns,
 	})
 }
 
@@ -224,6 +231,15 @@ func validateAlterOperation(ctx context.Context, op *api.Operation) error {
 		// to set a field but use the wrong name (could be decoded from JSON).
 		return errors.Errorf("Operation must have at least one field set")
 	}
+	// The names of the dropped predicates and types can't point to another namespace.
+	if err := validatePredName(op.DropAttr); err != nil {
+		return err
+	}
+	if op.DropOp == api.Operation_ATTR || op.DropOp == api.Operation_TYPE {
+		if err := validatePredName(op.DropValue); err != nil {
+			return err
+		}
+	}
 	if err := x.HealthCheck(); err != nil {
 		return err
 	}
@@ -275,6 +291,20 @@ func parseSchemaFromAlterOperation(op *api.Operation) (*schema.ParsedSchema, err
 		if err := validatePredName(update.Predicate); err != nil {
 			return nil, err
 		}
+		if update.Encrypted {
+			key := x.EncryptionKey()
+			if key == nil {
+				return nil, errors.Errorf("Can't encrypt predicate %s as no encryption key is set",
+					update.Predicate)
+			}
+			// The groups serving the predicate keep its data key if it already has one.
+			dataKey, err := enc.NewDataKey(key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while creating the data key of predicate %s",
+					update.Predicate)
+			}
+			update.DataKeys = [][]byte{dataKey}
+		}
 		// Users are not allowed to create a predicate under the reserved `dgraph.` namespace. But,
 		// there are pre-defined predicates (subset of reserved predicates), and for them we allow
 		// the schema update to go through if the update is equal to the existing one.
@@ -330,14 +360,24 @@ func insertDropRecord(ctx context.Context, dropOp string) error {
 }
 
 // Alter handles requests to change the schema or remove parts or all of the data.
-func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, error) {
+func (s *Server) Alter(ctx context.Context, op *api.Operation) (_ *api.Payload, rerr error) {
 	ctx, span := otrace.StartSpan(ctx, "Server.Alter")
 	defer span.End()
 	span.Annotatef(nil, "Alter operation: %+v", op)
 
+	ctx, audited := auditAlter(ctx, op)
+	defer func() { audited(rerr) }()
+
 	// Always print out Alter operations because they are important and rare.
 	glog.Infof("Received ALTER op: %+v", op)
 
+	ctx, ns, err := ResolveNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckRateLimit(ctx); err != nil {
+		return nil, err
+	}
 	// check if the operation is valid
 	if err := validateAlterOperation(ctx, op); err != nil {
 		return nil, err
@@ -355,6 +395,29 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 			return empty, errors.Errorf("If DropOp is set to ALL, DropValue must be empty")
 		}
 
+		// In the galaxy namespace, DropAll drops the data and the schema of the whole cluster,
+		// including the other namespaces. In another namespace, it only drops the namespace.
+		if ns != x.GalaxyNamespace {
+			// The guardians are read before anything is dropped.
+			guardians, err := readNamespaceGuardians(ctx, ns)
+			if err != nil {
+				return empty, err
+			}
+			if err := dropNamespace(ctx, ns, false, guardians); err != nil {
+				return empty, err
+			}
+			if err := applyNamespaceSchema(ctx, ns); err != nil {
+				return empty, err
+			}
+			// insert an empty GraphQL schema, so all alphas reset the GraphQL schema of the
+			// namespace
+			if _, err := UpdateGQLSchema(ctx, "", ""); err != nil {
+				return empty, err
+			}
+			// recreate the admin account of the namespace with its current password
+			return empty, restoreNamespaceGuardians(ctx, guardians)
+		}
+
 		m.DropOp = pb.Mutations_ALL
 		_, err := query.ApplyMutations(ctx, m)
 		if err != nil {
@@ -382,11 +445,25 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		}
 
 		// query the GraphQL schema and keep it in memory, so it can be inserted again
-		_, graphQLSchema, err := GetGQLSchema()
+		_, graphQLSchema, err := GetGQLSchema(ns)
 		if err != nil {
 			return empty, err
 		}
 
+		if ns != x.GalaxyNamespace {
+			guardians, err := readNamespaceGuardians(ctx, ns)
+			if err != nil {
+				return empty, err
+			}
+			if err := dropNamespace(ctx, ns, true, guardians); err != nil {
+				return empty, err
+			}
+			if _, err := UpdateGQLSchema(ctx, graphQLSchema, ""); err != nil {
+				return empty, err
+			}
+			return empty, restoreNamespaceGuardians(ctx, guardians)
+		}
+
 		m.DropOp = pb.Mutations_DATA
 		_, err = query.ApplyMutations(ctx, m)
 		if err != nil {
@@ -424,6 +501,7 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 			return empty, errors.Errorf("predicate %s is pre-defined and is not allowed to be"+
 				" dropped", attr)
 		}
+		attr = x.NamespaceAttr(ns, attr)
 
 		nq := &api.NQuad{
 			Subject:     x.Star,
@@ -441,6 +519,7 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		if err != nil {
 			return empty, err
 		}
+		worker.RecordAlter(ctx, []string{attr})
 
 		// insert a helper record for backup & restore, indicating that drop_attr was done
 		err = insertDropRecord(ctx, "DROP_ATTR;"+attr)
@@ -459,9 +538,13 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		}
 
 		m.DropOp = pb.Mutations_TYPE
-		m.DropValue = op.DropValue
-		_, err := query.ApplyMutations(ctx, m)
-		return empty, err
+		m.DropValue = x.NamespaceAttr(ns, op.DropValue)
+		if _, err := query.ApplyMutations(ctx, m); err != nil {
+			return empty, err
+		}
+		// The results of expand(_all_) depend on the types.
+		worker.RecordAlter(ctx, []string{x.NamespaceAttr(ns, "dgraph.type")})
+		return empty, nil
 	}
 
 	result, err := parseSchemaFromAlterOperation(op)
@@ -475,6 +558,7 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	}
 
 	glog.Infof("Got schema: %+v\n", result)
+	namespaceSchema(ns, result)
 	// TODO: Maybe add some checks about the schema.
 	m.Schema = result.Preds
 	m.Types = result.Types
@@ -482,6 +566,14 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	if err != nil {
 		return empty, err
 	}
+	alteredPreds := make([]string, 0, len(result.Preds)+1)
+	for _, su := range result.Preds {
+		alteredPreds = append(alteredPreds, su.Predicate)
+	}
+	if len(result.Types) > 0 {
+		alteredPreds = append(alteredPreds, x.NamespaceAttr(ns, "dgraph.type"))
+	}
+	worker.RecordAlter(ctx, alteredPreds)
 
 	// wait for indexing to complete or context to be canceled.
 	if err = worker.WaitForIndexingOrCtxError(ctx, !op.RunInBackground); err != nil {
@@ -491,6 +583,16 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	return empty, nil
 }
 
+// CancelIndexBuild cancels the index build running in the background for the given predicate.
+// The schema which was served while building the indexes is kept.
+func CancelIndexBuild(ctx context.Context, predicate string) error {
+	if predicate == "" {
+		return errors.Errorf("Predicate must not be empty")
+	}
+	glog.Infof("Cancelling index build for predicate %s", predicate)
+	return worker.CancelIndexBuildOverNetwork(ctx, predicate)
+}
+
 func annotateStartTs(span *otrace.Span, ts uint64) {
 	span.Annotate([]otrace.Attribute{otrace.Int64Attribute("startTs", int64(ts))}, "")
 }
@@ -550,7 +652,11 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 	}
 
 	qc.span.Annotatef(nil, "Applying mutations: %+v", m)
+	readKeys := resp.Txn.GetKeys()
 	resp.Txn, err = query.ApplyMutations(ctx, m)
+	if resp.Txn != nil {
+		resp.Txn.Keys = append(resp.Txn.Keys, readKeys...)
+	}
 	qc.span.Annotatef(nil, "Txn Context: %+v. Err=%v", resp.Txn, err)
 
 	if x.WorkerConfig.LudicrousMode {
@@ -926,6 +1032,10 @@ type queryContext struct {
 	span *trace.Span
 	// graphql indicates whether the given request is from graphql admin or not.
 	graphql bool
+	// isolation is the isolation level of the transaction, passed in the grpc context metadata.
+	isolation pb.Query_Isolation
+	// namespace is the namespace of the request.
+	namespace uint64
 	// nquadsCount maintains numbers of nquads which would be inserted as part of this request.
 	// In some cases(mostly upserts), numbers of nquads to be inserted can to huge(we have seen upto
 	// 1B) and resulting in OOM. We are limiting number of nquads which can be inserted in
@@ -966,6 +1076,7 @@ func (s *Server) Health(ctx context.Context, all bool) (*api.Response, error) {
 		Indexing:    schema.GetIndexingPredicates(),
 		EeFeatures:  ee.GetEEFeaturesList(),
 		MaxAssigned: posting.Oracle().MaxAssigned(),
+		Learner:     x.WorkerConfig.Raft.GetBool("learner"),
 	})
 
 	var err error
@@ -1052,6 +1163,26 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 	if rerr = x.HealthCheck(); rerr != nil {
 		return
 	}
+	// The internal requests are not audited.
+	if doAuth == NeedAuthorize {
+		var audited func(*api.Response, error)
+		ctx, audited = auditRequest(ctx, req, isGraphQL)
+		defer func() { audited(resp, rerr) }()
+	}
+	var ns uint64
+	if doAuth == NeedAuthorize {
+		if ctx, ns, rerr = ResolveNamespace(ctx); rerr != nil {
+			return
+		}
+	} else if ns, rerr = x.ExtractNamespace(ctx); rerr != nil {
+		return
+	}
+	// The GraphQL requests are rate limited by the GraphQL handler.
+	if doAuth == NeedAuthorize && !isGraphQL {
+		if rerr = CheckRateLimit(ctx); rerr != nil {
+			return
+		}
+	}
 
 	req.Query = strings.TrimSpace(req.Query)
 	isQuery := req.Query != ""
@@ -1071,22 +1202,47 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 		ostats.Record(ctx, x.NumMutations.M(1))
 	}
 
-	qc := &queryContext{req: req, latency: l, span: span, graphql: isGraphQL}
+	qc := &queryContext{req: req, latency: l, span: span, graphql: isGraphQL, namespace: ns}
 	if rerr = parseRequest(qc); rerr != nil {
 		return
 	}
+	if qc.isolation, rerr = x.ExtractIsolation(ctx); rerr != nil {
+		return
+	}
+	if qc.isolation == pb.Query_READ_COMMITTED && isMutation {
+		return nil, errors.Errorf("A read committed request can't have mutations.")
+	}
+	ctx = worker.WithIsolation(ctx, qc.isolation)
+	if rerr = validateLockedQuery(qc); rerr != nil {
+		return
+	}
+	if rerr = applyPasswordPolicy(qc, doAuth == NeedAuthorize); rerr != nil {
+		return
+	}
 
 	if doAuth == NeedAuthorize {
+		// The request is audited with all its predicates, before the ones denied by ACL are
+		// dropped from it.
+		auditPredicates(ctx, qc)
 		if rerr = authorizeRequest(ctx, qc); rerr != nil {
 			return
 		}
+		ctx = query.WithDecrypt(ctx, decrypter(ctx))
+	} else {
+		ctx = query.WithDecrypt(ctx, func(string) bool { return true })
+	}
+	// The request is authorized with the names of the predicates the client uses.
+	namespaceQuery(ns, &qc.gqlRes)
+	for _, gmu := range qc.gmuList {
+		namespaceMutation(ns, gmu)
 	}
 
 	// We use defer here because for queries, startTs will be
 	// assigned in the processQuery function called below.
 	defer annotateStartTs(qc.span, qc.req.StartTs)
 	// For mutations, we update the startTs if necessary.
-	if isMutation && req.StartTs == 0 {
+	locked := hasLockedBlock(qc.gqlRes.Query)
+	if isMutation && req.StartTs == 0 && !locked {
 		if x.WorkerConfig.LudicrousMode {
 			req.StartTs = posting.Oracle().MaxAssigned()
 		} else {
@@ -1096,7 +1252,15 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 		}
 	}
 
-	if resp, rerr = processQuery(ctx, qc); rerr != nil {
+	if locked {
+		// The locks are released once the mutations are committed.
+		var release func()
+		resp, release, rerr = processLockedQuery(ctx, qc)
+		defer release()
+	} else {
+		resp, rerr = processQuery(ctx, qc)
+	}
+	if rerr != nil {
 		return
 	}
 	if rerr = s.doMutate(ctx, qc, resp); rerr != nil {
@@ -1160,6 +1324,57 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 		qr.Cache = worker.NoCache
 	}
 
+	// A bounded staleness query is like a best effort query, which can only be served if this
+	// Alpha is not further behind the leader of its group than the client allows. It lets learners
+	// serve reads without contacting Zero or the group leader.
+	maxStaleness, err := x.ExtractMaxStaleness(ctx)
+	if err != nil {
+		return resp, err
+	}
+	if maxStaleness > 0 && qc.req.StartTs == 0 {
+		if !qc.req.ReadOnly {
+			return resp, errors.Errorf("A bounded staleness query must be read-only.")
+		}
+		if staleness := worker.Staleness(); staleness > maxStaleness {
+			return resp, errors.Errorf("This Alpha is %v behind the leader of its group, which is"+
+				" more than the max staleness of %v. Retry on another Alpha.",
+				staleness.Round(time.Millisecond), maxStaleness)
+		}
+		qc.span.Annotatef(nil, "Bounded staleness query. Max staleness: %v", maxStaleness)
+		qc.req.StartTs = posting.Oracle().MaxAssigned()
+		qr.Cache = worker.NoCache
+	}
+
+	if qc.isolation == pb.Query_READ_COMMITTED {
+		// The tasks read the latest commits, which are not in the cache of the transaction.
+		qr.Cache = worker.NoCache
+	}
+
+	cacheKey, cacheable := queryCacheKey(ctx, qc)
+	if cacheable {
+		if res := qcache.get(cacheKey); res != nil {
+			qc.span.Annotatef(nil, "Serving cached result read at: %d", res.readTs)
+			return res.response(), nil
+		}
+		ctx = worker.WithReadPredicates(ctx)
+	}
+	resets := posting.Resets()
+
+	if qc.req.StartTs == 0 && qc.req.ReadOnly {
+		// Serve read-only queries at the latest timestamp known to this Alpha, without a round
+		// trip to Zero, unless the client asks for a linearizable read.
+		linearizable, err := x.ExtractLinearizable(ctx)
+		if err != nil {
+			return resp, err
+		}
+		if !linearizable {
+			qc.req.StartTs = worker.State.ReadTimestamp()
+			// The timestamp might be the start of a pending transaction, whose cache must not
+			// be read.
+			qr.Cache = worker.NoCache
+		}
+	}
+
 	if qc.req.StartTs == 0 {
 		assignTimestampStart := time.Now()
 		qc.req.StartTs = worker.State.GetTimestamp(qc.req.ReadOnly)
@@ -1174,8 +1389,13 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 	if err != nil {
 		return resp, errors.Wrap(err, "")
 	}
+	// A serializable transaction sends the keys read to Zero at commit, to check them for
+	// conflicts.
+	resp.Txn.Keys = worker.ReadKeys(ctx)
 
-	if len(er.SchemaNode) > 0 || len(er.Types) > 0 {
+	isSchema := len(er.SchemaNode) > 0 || len(er.Types) > 0
+	if isSchema {
+		schemaOfNamespace(qc.namespace, &er)
 		if err = authorizeSchemaQuery(ctx, &er); err != nil {
 			return resp, err
 		}
@@ -1255,6 +1475,22 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 	}
 	resp.Metrics.NumUids["_total"] = total
 
+	if cacheable && !isSchema {
+		preds := worker.ReadPredicates(ctx)
+		if len(worker.Config.HmacSecret) > 0 {
+			// The result depends on the ACL rules too.
+			preds = append(preds, namespaceAttrs(qc.namespace, x.AllACLPredicates())...)
+		}
+		qcache.set(&cachedResult{
+			key:     cacheKey,
+			readTs:  qc.req.StartTs,
+			resets:  resets,
+			preds:   preds,
+			json:    resp.Json,
+			rdf:     resp.Rdf,
+			numUids: resp.Metrics.NumUids,
+		})
+	}
 	return resp, err
 }
 
@@ -1514,6 +1750,9 @@ func validateNQuads(set, del []*api.NQuad, qc *queryContext) error {
 			return err
 		}
 	}
+	if err := validateAclNodes(set); err != nil {
+		return err
+	}
 	for _, nq := range del {
 		if err := validatePredName(nq.Predicate); err != nil {
 			return err
@@ -1540,6 +1779,9 @@ func validateKey(key string) error {
 		return errors.Errorf("Has zero length")
 	case strings.ContainsAny(key, "~@"):
 		return errors.Errorf("Has invalid characters")
+	case strings.Contains(key, x.NamespaceSeparator) || strings.Contains(key, x.SplitTabletSeparator):
+		return errors.Errorf("Must not contain %q or %q, which separate the namespace and the "+
+			"split of a predicate from its name", x.NamespaceSeparator, x.SplitTabletSeparator)
 	case strings.IndexFunc(key, unicode.IsSpace) != -1:
 		return errors.Errorf("Must not contain spaces")
 	}
@@ -1569,6 +1811,11 @@ func validateQuery(queries []*gql.GraphQuery) error {
 		if err := validatePredName(q.Attr); err != nil {
 			return err
 		}
+		if q.Func != nil {
+			if err := validatePredName(q.Func.Attr); err != nil {
+				return err
+			}
+		}
 
 		if err := validateQuery(q.Children); err != nil {
 			return err
@@ -1583,6 +1830,14 @@ func validatePredName(name string) error {
 		return errors.Errorf("Predicate name length cannot be bigger than 2^16. Predicate: %v",
 			name[:80])
 	}
+	if strings.Contains(name, x.NamespaceSeparator) {
+		return errors.Errorf("Predicate name %q can't contain %q, which separates the namespace"+
+			" from the predicate", name, x.NamespaceSeparator)
+	}
+	if strings.Contains(name, x.SplitTabletSeparator) {
+		return errors.Errorf("Predicate name %q can't contain %q, which separates the split of a"+
+			" predicate from the predicate", name, x.SplitTabletSeparator)
+	}
 	return nil
 }
 
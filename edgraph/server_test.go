@@ -17,6 +17,7 @@
 package edgraph
 
 import (
+	"context"
 	"testing"
 
 	"github.com/dgraph-io/dgo/v200/protos/api"
@@ -103,6 +104,7 @@ func TestValidateKeys(t *testing.T) {
 		{name: "test 13", nquad: `_:alice <knows> "stuff" ( key1 = 12 ) .`, noError: true},
 		{name: "test 14", nquad: `_:alice <knows@some> "stuff" .`, noError: true},
 		{name: "test 15", nquad: `_:alice <knows@some@en> "stuff" .`, noError: false},
+		{name: "test 16", nquad: `_:alice <knows> "stuff" ( "1^key" = 12 ) .`, noError: false},
 	}
 
 	for _, tc := range tests {
@@ -119,3 +121,46 @@ func TestValidateKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMutationObjectSeparators(t *testing.T) {
+	for _, json := range []string{
+		`{"12^name": "Alice"}`,
+		`{"name": "Alice", "friend": {"12^name": "Bob"}}`,
+		`{"name": "Alice", "friend": [{"name": "Bob", "0^friend": {"uid": "0x1"}}]}`,
+		`{"name": "Alice", "name|12^since": "2006-01-02T15:04:05Z"}`,
+	} {
+		_, err := parseMutationObject(&api.Mutation{SetJson: []byte(json)}, &queryContext{})
+		require.Error(t, err, json)
+		_, err = parseMutationObject(&api.Mutation{DeleteJson: []byte(json)}, &queryContext{})
+		require.Error(t, err, json)
+	}
+	_, err := parseMutationObject(&api.Mutation{SetJson: []byte(`{"name": "Alice"}`)},
+		&queryContext{})
+	require.NoError(t, err)
+}
+
+func TestMissingKeys(t *testing.T) {
+	require.Nil(t, missingKeys(nil, []uint64{1}))
+	require.Equal(t, []uint64{1, 3}, missingKeys([]uint64{1, 3}, nil))
+	require.Equal(t, []uint64{3}, missingKeys([]uint64{1, 2, 3}, []uint64{2, 1}))
+}
+
+func TestValidateSplitTabletSeparator(t *testing.T) {
+	require.Error(t, validateKey("name|0x10"))
+	require.Error(t, validatePredName("name|0x10"))
+	require.NoError(t, validateKey("name"))
+	require.NoError(t, validatePredName("name"))
+}
+
+func TestValidateAlterDropNames(t *testing.T) {
+	for _, op := range []*api.Operation{
+		{DropAttr: "5^name"},
+		{DropOp: api.Operation_ATTR, DropValue: "5^name"},
+		{DropOp: api.Operation_TYPE, DropValue: "5^Person"},
+		{DropAttr: "name|0x10"},
+	} {
+		err := validateAlterOperation(context.Background(), op)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "can't contain")
+	}
+}
@@ -402,7 +402,7 @@ func userMod(conf *viper.Viper, userId string, groups string) error {
 	1. It will return error if there is no group named <groupName>.
 	2. It will add new rule if group doesn't already have a rule for the predicate.
 	3. It will update the permission if group already have a rule for the predicate and permission
-		is a non-negative integer between 0-7.
+		is a non-negative integer between 0-15.
 	4. It will delete, if group already have a rule for the predicate and the permission is
 		a negative integer.
 */
@@ -416,8 +416,8 @@ func chMod(conf *viper.Viper) error {
 		return errors.Errorf("the group must not be empty")
 	case len(predicate) == 0:
 		return errors.Errorf("no predicates specified")
-	case perm > 7:
-		return errors.Errorf("the perm value must be less than or equal to 7, "+
+	case perm > 15:
+		return errors.Errorf("the perm value must be less than or equal to 15, "+
 			"the provided value is %d", perm)
 	}
 
@@ -1331,6 +1331,119 @@ func TestExpandQueryWithACLPermissions(t *testing.T) {
 		string(resp.GetJson()))
 
 }
+func TestExpandWithNodePolicies(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	defer cancel()
+	dg, err := testutil.DgraphClientWithGroot(testutil.SockAddr)
+	require.NoError(t, err)
+
+	testutil.DropAll(t, dg)
+
+	op := api.Operation{Schema: `
+		name	: string @index(exact) .
+		manager : string .
+		members : [uid] .
+		type Team {
+			name
+			members
+		}
+		type Employee {
+			name
+			manager
+		}
+	`}
+	require.NoError(t, dg.Alter(ctx, &op))
+
+	resetUser(t)
+
+	token, err := testutil.HttpLogin(&testutil.LoginParams{
+		Endpoint: adminEndpoint,
+		UserID:   "groot",
+		Passwd:   "password",
+	})
+	require.NoError(t, err, "login failed")
+
+	createGroup(t, token, devGroup)
+	addRulesToGroup(t, token, devGroup, []rule{{"name", Read.Code}, {"members", Read.Code}})
+	addToGroup(t, token, userid, devGroup)
+	resp := makeRequestAndRefreshTokenIfNecessary(t, token, testutil.GraphQLParams{
+		Query: `
+		mutation updateGroup($name: String!, $policies: [PolicyRef!]){
+			updateGroup(input: {filter: {name: {eq: $name}}, set: {policies: $policies}}) {
+				group {
+					name
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"name": devGroup,
+			"policies": []map[string]string{
+				{"type": "Employee", "filter": "eq(manager, $userid)"},
+			},
+		},
+	})
+	resp.RequireNoGraphQLErrors(t)
+
+	assigned, err := dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`
+			_:t <name> "Team" .
+			_:t <dgraph.type> "Team" .
+			_:t <members> _:a .
+			_:t <members> _:b .
+			_:a <name> "Alice's report" .
+			_:a <manager> "alice" .
+			_:a <dgraph.type> "Employee" .
+			_:b <name> "Bob's report" .
+			_:b <manager> "bob" .
+			_:b <dgraph.type> "Employee" .
+		`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	userClient, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	time.Sleep(defaultTimeToSleep)
+	require.NoError(t, userClient.Login(ctx, userid, userpassword))
+
+	// The members of the team expanded by expand() are filtered by the policy, like the ones
+	// queried by name.
+	for _, query := range []string{
+		`{ q(func: eq(name, "Team")) { name members { name } } }`,
+		`{ q(func: eq(name, "Team")) { name expand(_all_) { name } } }`,
+	} {
+		qr, err := userClient.NewReadOnlyTxn().Query(ctx, query)
+		require.NoError(t, err)
+		testutil.CompareJSON(t, `{"q":[{"name":"Team","members":[{"name":"Alice's report"}]}]}`,
+			string(qr.GetJson()))
+	}
+
+	// The members are filtered under @recurse and in count() too, where they have no children.
+	qr, err := userClient.NewReadOnlyTxn().Query(ctx,
+		`{ q(func: eq(name, "Team")) @recurse { name members } }`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"q":[{"name":"Team","members":[{"name":"Alice's report"}]}]}`,
+		string(qr.GetJson()))
+	qr, err = userClient.NewReadOnlyTxn().Query(ctx,
+		`{ q(func: eq(name, "Team")) { count(members) } }`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"q":[{"count(members)":1}]}`, string(qr.GetJson()))
+
+	// Shortest paths only go through the nodes allowed by the policy.
+	query := fmt.Sprintf(`{
+		path as shortest(from: %s, to: %s) {
+			members
+		}
+		q(func: uid(path)) {
+			name
+		}
+	}`, assigned.Uids["t"], assigned.Uids["b"])
+	qr, err = userClient.NewReadOnlyTxn().Query(ctx, query)
+	require.NoError(t, err)
+	require.NotContains(t, string(qr.GetJson()), "Bob's report")
+	require.NotContains(t, string(qr.GetJson()), assigned.Uids["b"])
+}
+
 func TestDeleteQueryWithACLPermissions(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
 	defer cancel()
@@ -2139,11 +2252,55 @@ func TestSchemaQueryWithACL(t *testing.T) {
 	schemaQuery := "schema{}"
 	grootSchema := `{
   "schema": [
+    {
+      "predicate": "dgraph.acl.burst",
+      "type": "int"
+    },
+    {
+      "predicate": "dgraph.acl.policy",
+      "type": "uid",
+      "list": true
+    },
+    {
+      "predicate": "dgraph.acl.rate_limit",
+      "type": "float"
+    },
     {
       "predicate": "dgraph.acl.rule",
       "type": "uid",
       "list": true
 	},
+    {
+      "predicate": "dgraph.apikey.expiry",
+      "type": "datetime"
+    },
+    {
+      "predicate": "dgraph.apikey.group",
+      "type": "uid",
+      "reverse": true
+    },
+    {
+      "predicate": "dgraph.apikey.hash",
+      "type": "string"
+    },
+    {
+      "predicate": "dgraph.apikey.ips",
+      "type": "string",
+      "list": true
+    },
+    {
+      "predicate": "dgraph.apikey.name",
+      "type": "string",
+      "index": true,
+      "tokenizer": [
+        "exact"
+      ],
+      "upsert": true
+    },
+    {
+      "predicate": "dgraph.apikey.readonly",
+      "type": "bool"
+    },
 	{
 		"predicate": "dgraph.cors",
 		"type": "string",
@@ -2193,6 +2350,19 @@ func TestSchemaQueryWithACL(t *testing.T) {
       "predicate": "dgraph.password",
       "type": "password"
     },
+    {
+      "predicate": "dgraph.policy.filter",
+      "type": "string"
+    },
+    {
+      "predicate": "dgraph.policy.type",
+      "type": "string",
+      "index": true,
+      "tokenizer": [
+        "exact"
+      ],
+      "upsert": true
+    },
     {
       "predicate": "dgraph.rule.permission",
       "type": "int"
@@ -2221,6 +2391,10 @@ func TestSchemaQueryWithACL(t *testing.T) {
       "reverse": true,
       "list": true
     },
+    {
+      "predicate": "dgraph.user.password_changed",
+      "type": "datetime"
+    },
     {
       "predicate": "dgraph.xid",
       "type": "string",
@@ -2264,6 +2438,29 @@ func TestSchemaQueryWithACL(t *testing.T) {
 		],
 		"name": "dgraph.graphql.persisted_query"
 	},
+    {
+      "fields": [
+        {
+          "name": "dgraph.apikey.name"
+        },
+        {
+          "name": "dgraph.apikey.hash"
+        },
+        {
+          "name": "dgraph.apikey.group"
+        },
+        {
+          "name": "dgraph.apikey.expiry"
+        },
+        {
+          "name": "dgraph.apikey.ips"
+        },
+        {
+          "name": "dgraph.apikey.readonly"
+        }
+      ],
+      "name": "dgraph.type.ApiKey"
+    },
     {
       "fields": [
         {
@@ -2271,10 +2468,30 @@ func TestSchemaQueryWithACL(t *testing.T) {
         },
         {
           "name": "dgraph.acl.rule"
+        },
+        {
+          "name": "dgraph.acl.rate_limit"
+        },
+        {
+          "name": "dgraph.acl.burst"
+        },
+        {
+          "name": "dgraph.acl.policy"
         }
       ],
       "name": "dgraph.type.Group"
     },
+    {
+      "fields": [
+        {
+          "name": "dgraph.policy.type"
+        },
+        {
+          "name": "dgraph.policy.filter"
+        }
+      ],
+      "name": "dgraph.type.Policy"
+    },
     {
       "fields": [
         {
@@ -2296,6 +2513,9 @@ func TestSchemaQueryWithACL(t *testing.T) {
         },
         {
           "name": "dgraph.user.group"
+        },
+        {
+          "name": "dgraph.user.password_changed"
         }
       ],
       "name": "dgraph.type.User"
@@ -2534,7 +2754,7 @@ func TestWrongPermission(t *testing.T) {
 		_:dev <dgraph.xid> "dev" .
 		_:dev <dgraph.acl.rule> _:rule1 .
 		_:rule1 <dgraph.rule.predicate> "name" .
-		_:rule1 <dgraph.rule.permission> "9" .
+		_:rule1 <dgraph.rule.permission> "16" .
 	`
 
 	_, err = dg.NewTxn().Mutate(ctx, &api.Mutation{
@@ -2542,8 +2762,8 @@ func TestWrongPermission(t *testing.T) {
 		CommitNow: true,
 	})
 
-	require.Error(t, err, "Setting permission to 9 should have returned error")
-	require.Contains(t, err.Error(), "Value for this predicate should be between 0 and 7")
+	require.Error(t, err, "Setting permission to 16 should have returned error")
+	require.Contains(t, err.Error(), "Value for this predicate should be between 0 and 15")
 
 	ruleMutation = `
 		_:dev <dgraph.type> "dgraph.type.Group" .
@@ -2559,7 +2779,7 @@ func TestWrongPermission(t *testing.T) {
 	})
 
 	require.Error(t, err, "Setting permission to -1 should have returned error")
-	require.Contains(t, err.Error(), "Value for this predicate should be between 0 and 7")
+	require.Contains(t, err.Error(), "Value for this predicate should be between 0 and 15")
 }
 
 func TestHealthForAcl(t *testing.T) {
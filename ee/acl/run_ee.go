@@ -112,10 +112,12 @@ func initSubcommands() []*x.SubCommand {
 	modFlags.StringP("group_list", "l", defaultGroupList,
 		"The list of groups to be set for the user")
 	modFlags.StringP("group", "g", "", "The group whose permission is to be changed")
-	modFlags.StringP("pred", "p", "", "The predicates whose acls are to be changed")
+	modFlags.StringP("pred", "p", "", "The predicates whose acls are to be changed: a "+
+		"predicate, a regular expression matching predicates like /^billing\\./, or all the "+
+		"predicates of a type like type(Person)")
 	modFlags.IntP("perm", "m", 0, "The acl represented using "+
-		"an integer: 4 for read, 2 for write, and 1 for modify. Use a negative value to remove a "+
-		"predicate from the group")
+		"an integer: 4 for read, 2 for write, 1 for modify, and 8 for decrypting the values of an "+
+		"@encrypted predicate. Use a negative value to remove a predicate from the group")
 
 	var cmdInfo x.SubCommand
 	cmdInfo.Cmd = &cobra.Command{
@@ -14,6 +14,7 @@ package acl
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/dgraph-io/dgo/v200"
 	"github.com/dgraph-io/dgo/v200/protos/api"
@@ -41,6 +42,8 @@ var (
 	OpRead   = "Read"
 	OpWrite  = "Write"
 	OpModify = "Modify"
+	// OpDecrypt is the operation of reading the decrypted values of an @encrypted predicate.
+	OpDecrypt = "Decrypt"
 )
 
 // Operation represents a Dgraph data operation (e.g write or read).
@@ -65,6 +68,12 @@ var (
 		Code: 1,
 		Name: OpModify,
 	}
+	// Decrypt is used when reading the values of an @encrypted predicate. The users without it
+	// read the encrypted values.
+	Decrypt = &Operation{
+		Code: 8,
+		Name: OpDecrypt,
+	}
 )
 
 // User represents a user in the ACL system.
@@ -74,6 +83,14 @@ type User struct {
 	Password      string  `json:"dgraph.password"`
 	PasswordMatch bool    `json:"password_match"`
 	Groups        []Group `json:"dgraph.user.group"`
+	// PasswordChanged is when the password was last changed. It is zero for the users created
+	// before the password changes were recorded.
+	PasswordChanged time.Time `json:"dgraph.user.password_changed"`
+	// Namespace is the namespace the user belongs to.
+	Namespace uint64 `json:"-"`
+	// External is true for the users of an external identity provider, which aren't stored in
+	// the DB, and whose groups come from the identity provider.
+	External bool `json:"-"`
 }
 
 // GetUid returns the UID of the user.
@@ -110,12 +127,39 @@ type Acl struct {
 	Perm      int32  `json:"dgraph.rule.permission"`
 }
 
+// Policy represents a node-level policy in the ACL system. The users of a group with a policy
+// for a type only get the nodes of the type which match the DQL filter of the policy.
+type Policy struct {
+	Type   string `json:"dgraph.policy.type"`
+	Filter string `json:"dgraph.policy.filter"`
+}
+
+// ApiKey represents an API key in the ACL system, which authenticates the requests of a service
+// as a member of the group of the key. Only the SHA-256 hash of the key is stored.
+type ApiKey struct {
+	Name string `json:"dgraph.apikey.name"`
+	Hash string `json:"dgraph.apikey.hash"`
+	// Expiry is the time after which the key is rejected. The key never expires if it is zero.
+	Expiry time.Time `json:"dgraph.apikey.expiry"`
+	// IPs are the IP addresses and CIDR blocks from which the key is accepted. The key is
+	// accepted from anywhere if there are none.
+	IPs []string `json:"dgraph.apikey.ips"`
+	// ReadOnly keys can't be used for mutations, alter operations and admin mutations.
+	ReadOnly bool `json:"dgraph.apikey.readonly"`
+}
+
 // Group represents a group in the ACL system.
 type Group struct {
 	Uid     string `json:"uid"`
 	GroupID string `json:"dgraph.xid"`
 	Users   []User `json:"~dgraph.user.group"`
 	Rules   []Acl  `json:"dgraph.acl.rule"`
+	// RateLimit is the max number of requests per second allowed to each user of the group, and
+	// Burst the max number of requests allowed at once. A RateLimit of zero means no limit.
+	RateLimit float64  `json:"dgraph.acl.rate_limit"`
+	Burst     int64    `json:"dgraph.acl.burst"`
+	Policies  []Policy `json:"dgraph.acl.policy"`
+	ApiKeys   []ApiKey `json:"~dgraph.apikey.group"`
 }
 
 // GetUid returns the UID of the group.
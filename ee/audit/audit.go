@@ -0,0 +1,68 @@
+// +build oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"io"
+
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Init does nothing since audit logging is only supported in the enterprise version.
+func Init(conf Config) error {
+	if conf.Dir != "" {
+		glog.Warningf("Audit logging is an enterprise feature. Not writing the audit log.")
+	}
+	return nil
+}
+
+// Enabled always returns false since audit logging is only supported in the enterprise version.
+func Enabled() bool {
+	return false
+}
+
+// Log does nothing since audit logging is only supported in the enterprise version.
+func Log(e *Event, err error) {
+}
+
+// Close does nothing since audit logging is only supported in the enterprise version.
+func Close() {
+}
+
+// Verify returns an error since audit logging is only supported in the enterprise version.
+func Verify(_ io.Reader, _ x.SensitiveByteSlice, _ string) (string, error) {
+	return "", x.ErrNotSupported
+}
+
+// VerifyDir returns an error since audit logging is only supported in the enterprise version.
+func VerifyDir(_ Config) (int, error) {
+	return 0, x.ErrNotSupported
+}
+
+// RotateKey does nothing since audit logging is only supported in the enterprise version.
+func RotateKey(_ x.SensitiveByteSlice) error {
+	return nil
+}
+
+// ReencryptDir returns an error since audit logging is only supported in the enterprise version.
+func ReencryptDir(_ string, _, _ x.SensitiveByteSlice) error {
+	return x.ErrNotSupported
+}
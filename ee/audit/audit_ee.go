@@ -0,0 +1,511 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	logName    = "dgraph_audit.log"
+	anchorName = "dgraph_audit.anchor"
+	// rotatedTimeFormat is the format of the time of the rotation in the names of the rotated
+	// audit log files, which sorts them by time.
+	rotatedTimeFormat = "2006-01-02T15-04-05.000000000"
+)
+
+// logger writes the audit events as JSON lines, each of which holds the hash of the previous one.
+// The hash of the last line is kept in the anchor file, so that removed lines at the end of the
+// audit log are detected too.
+type logger struct {
+	sync.Mutex
+	conf   Config
+	file   *os.File
+	w      io.Writer
+	anchor *os.File
+	// size is the number of bytes of events written to the current file.
+	size     int64
+	prevHash string
+}
+
+// auditor is nil unless audit logging is enabled.
+var auditor *logger
+
+// Init enables audit logging with the given configuration. If there is an audit log file left by
+// a previous run, it is checked against the anchor and rotated, and the chain of hashes goes on
+// from its last line.
+func Init(conf Config) error {
+	if conf.Dir == "" {
+		return nil
+	}
+	if len(conf.Secret) < 32 {
+		return errors.Errorf("the audit secret should contain at least 256 bits " +
+			"(32 ascii chars)")
+	}
+	if err := os.MkdirAll(conf.Dir, 0700); err != nil {
+		return errors.Wrapf(err, "while creating the audit log directory")
+	}
+	anchor, err := os.OpenFile(conf.anchorPath(), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "while opening the audit log anchor")
+	}
+	l := &logger{conf: conf, anchor: anchor}
+	path := filepath.Join(conf.Dir, logName)
+	if fi, err := anchor.Stat(); err == nil && fi.Size() == 0 {
+		// A new anchor is only written for a new audit log, since it would hide the truncation
+		// of an existing one.
+		if _, err := os.Stat(path); err == nil {
+			_ = anchor.Close()
+			return errors.Errorf("the audit log anchor %s is missing", conf.anchorPath())
+		}
+		if err := writeAnchor(anchor, conf.Secret, ""); err != nil {
+			_ = anchor.Close()
+			return errors.Wrapf(err, "while writing the audit log anchor")
+		}
+	}
+	if l.prevHash, err = readAnchor(anchor, conf.Secret); err != nil {
+		_ = anchor.Close()
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		// An encrypted file can't be appended to, since its stream starts with a new IV.
+		last, err := lastLine(path, conf.EncryptionKey)
+		if err != nil {
+			_ = anchor.Close()
+			return errors.Wrapf(err, "while reading the audit log %s", path)
+		}
+		if last != nil {
+			hash, err := checkAnchor(last, conf.Secret, l.prevHash)
+			if err != nil {
+				_ = anchor.Close()
+				return errors.Wrapf(err, "while checking the audit log %s", path)
+			}
+			l.prevHash = hash
+		}
+		if err := os.Rename(path, l.rotatedPath(time.Now())); err != nil {
+			_ = anchor.Close()
+			return errors.Wrapf(err, "while rotating the audit log %s", path)
+		}
+	}
+	if err := l.open(); err != nil {
+		_ = anchor.Close()
+		return err
+	}
+	auditor = l
+	glog.Infof("Writing the audit log to %s", path)
+	return nil
+}
+
+// Enabled returns whether audit logging is enabled.
+func Enabled() bool {
+	return auditor != nil
+}
+
+// Log writes the event of a request which failed with err, or succeeded if err is nil, to the
+// audit log. The errors writing the audit log are logged, but don't fail the request.
+func Log(e *Event, err error) {
+	if auditor == nil || e == nil {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	e.finish(time.Now(), err)
+	if err := auditor.write(e); err != nil {
+		glog.Errorf("Error while writing the audit log: %v", err)
+	}
+}
+
+// Close closes the audit log. The events logged after are dropped.
+func Close() {
+	if auditor == nil {
+		return
+	}
+	auditor.Lock()
+	defer auditor.Unlock()
+	if auditor.file != nil {
+		if err := auditor.file.Close(); err != nil {
+			glog.Errorf("Error while closing the audit log: %v", err)
+		}
+	}
+	if err := auditor.anchor.Close(); err != nil {
+		glog.Errorf("Error while closing the audit log anchor: %v", err)
+	}
+	auditor.file, auditor.w = nil, nil
+}
+
+// RotateKey re-encrypts the audit log with newKey, which encrypts the audit log from then on. The
+// current file is rotated first, and a new one is opened with the key the audit log ends up
+// encrypted with. It does nothing if the audit log isn't encrypted.
+func RotateKey(newKey x.SensitiveByteSlice) error {
+	if auditor == nil {
+		return nil
+	}
+	auditor.Lock()
+	defer auditor.Unlock()
+	if len(auditor.conf.EncryptionKey) == 0 {
+		return nil
+	}
+	if auditor.w == nil {
+		return errors.Errorf("the audit log is closed")
+	}
+	if err := auditor.closeFile(); err != nil {
+		return errors.Wrapf(err, "while rotating the audit log")
+	}
+	err := ReencryptDir(auditor.conf.Dir, auditor.conf.EncryptionKey, newKey)
+	if err == nil {
+		auditor.conf.EncryptionKey = newKey
+	}
+	if openErr := auditor.open(); openErr != nil {
+		return openErr
+	}
+	return err
+}
+
+// ReencryptDir re-encrypts the files of the audit log in dir, which are encrypted with oldKey,
+// with newKey. The files are only replaced once they are all re-encrypted. The chain of hashes
+// and the anchor are kept, as they don't depend on the encryption.
+func ReencryptDir(dir string, oldKey, newKey x.SensitiveByteSlice) error {
+	files, err := filepath.Glob(filepath.Join(dir, "dgraph_audit*.log"))
+	if err != nil {
+		return err
+	}
+	var tmps []string
+	defer func() {
+		for _, tmp := range tmps {
+			_ = os.Remove(tmp)
+		}
+	}()
+	for _, file := range files {
+		tmp := file + ".tmp"
+		tmps = append(tmps, tmp)
+		if err := reencryptFile(file, tmp, oldKey, newKey); err != nil {
+			return errors.Wrapf(err, "while re-encrypting %s", file)
+		}
+	}
+	for i, file := range files {
+		if err := os.Rename(tmps[i], file); err != nil {
+			return errors.Wrapf(err, "while replacing %s", file)
+		}
+	}
+	return nil
+}
+
+func reencryptFile(path, tmp string, oldKey, newKey x.SensitiveByteSlice) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if fi, err := in.Stat(); err != nil || fi.Size() == 0 {
+		return err
+	}
+	r, err := enc.GetReader(oldKey, in)
+	if err != nil {
+		return err
+	}
+	w, err := enc.GetWriter(newKey, out)
+	if err != nil {
+		return err
+	}
+	br := bufio.NewReader(r)
+	// The lines of the audit log are JSON objects, so the file isn't encrypted with oldKey if it
+	// doesn't start with one.
+	if first, err := br.Peek(1); err != nil || first[0] != '{' {
+		return errors.Errorf("the file isn't encrypted with the current key")
+	}
+	if _, err := io.Copy(w, br); err != nil {
+		return err
+	}
+	return out.Sync()
+}
+
+func (conf Config) anchorPath() string {
+	if conf.AnchorFile != "" {
+		return conf.AnchorFile
+	}
+	return filepath.Join(conf.Dir, anchorName)
+}
+
+func (l *logger) rotatedPath(now time.Time) string {
+	name := "dgraph_audit-" + now.UTC().Format(rotatedTimeFormat) + ".log"
+	return filepath.Join(l.conf.Dir, name)
+}
+
+func (l *logger) open() error {
+	f, err := os.OpenFile(filepath.Join(l.conf.Dir, logName),
+		os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "while opening the audit log")
+	}
+	w, err := enc.GetWriter(l.conf.EncryptionKey, f)
+	if err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "while encrypting the audit log")
+	}
+	l.file, l.w, l.size = f, w, 0
+	return nil
+}
+
+// rotate closes the current file, renames it after the current time, and opens a new one.
+func (l *logger) rotate() error {
+	if err := l.closeFile(); err != nil {
+		return err
+	}
+	return l.open()
+}
+
+// closeFile closes the current file and renames it after the current time.
+func (l *logger) closeFile() error {
+	if err := l.file.Sync(); err != nil {
+		return err
+	}
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	l.file, l.w = nil, nil
+	return os.Rename(filepath.Join(l.conf.Dir, logName), l.rotatedPath(time.Now()))
+}
+
+func (l *logger) write(e *Event) error {
+	l.Lock()
+	defer l.Unlock()
+	if l.w == nil {
+		return errors.Errorf("the audit log is closed")
+	}
+
+	e.PrevHash = l.prevHash
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	// The hash is only moved forward once the line is written, so that the chain is never broken
+	// by a line which failed to be written.
+	if _, err := l.w.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	l.prevHash = hashLine(l.conf.Secret, line)
+	l.size += int64(len(line)) + 1
+	if err := writeAnchor(l.anchor, l.conf.Secret, l.prevHash); err != nil {
+		return errors.Wrapf(err, "while writing the audit log anchor")
+	}
+	if l.conf.MaxSize > 0 && l.size >= l.conf.MaxSize {
+		return errors.Wrapf(l.rotate(), "while rotating the audit log")
+	}
+	return nil
+}
+
+// hashLine returns the hex encoded HMAC-SHA256 of the line, keyed with the audit secret, so that
+// the chain can't be recomputed by someone who changes the audit log.
+func hashLine(secret, line []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(line)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// anchorMAC returns the MAC of the hash of the last line, which is written to the anchor. The hash
+// alone can't be trusted, as it is also held by the next line of a truncated audit log.
+func anchorMAC(secret []byte, hash string) string {
+	return hashLine(secret, []byte("anchor:"+hash))
+}
+
+// writeAnchor overwrites the anchor with the hash of the last line of the audit log and its MAC.
+// The anchor always has the same length, so it is rewritten in place.
+func writeAnchor(f *os.File, secret []byte, hash string) error {
+	_, err := f.WriteAt([]byte(fmt.Sprintf("%s %s\n", hash, anchorMAC(secret, hash))), 0)
+	return err
+}
+
+// readAnchor returns the hash held by the anchor, which is empty if no line was written yet.
+func readAnchor(r io.Reader, secret []byte) (string, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", errors.Wrapf(err, "while reading the audit log anchor")
+	}
+	fields := strings.SplitN(strings.TrimSuffix(string(data), "\n"), " ", 2)
+	if len(fields) != 2 || !hmac.Equal([]byte(fields[1]), []byte(anchorMAC(secret, fields[0]))) {
+		return "", errors.Errorf("the audit log anchor is invalid")
+	}
+	return fields[0], nil
+}
+
+// checkAnchor returns the hash of the last line of the audit log, if it matches the hash of the
+// anchor. The anchor may also hold the hash of the line before, if the Alpha stopped between
+// writing the line and the anchor.
+func checkAnchor(last, secret []byte, anchor string) (string, error) {
+	hash := hashLine(secret, last)
+	if hash == anchor {
+		return hash, nil
+	}
+	var e struct {
+		PrevHash string `json:"prev_hash"`
+	}
+	if err := json.Unmarshal(last, &e); err == nil && e.PrevHash == anchor {
+		return hash, nil
+	}
+	return "", errors.Errorf("the last line of the audit log doesn't match its anchor, the " +
+		"audit log might have been truncated")
+}
+
+// scanLines calls fn with every complete line read from r, without its line break. A last line
+// which isn't complete, like one cut by a crash, is ignored.
+func scanLines(r io.Reader, fn func(line []byte) error) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+		if err := fn(bytes.TrimSuffix(line, []byte{'\n'})); err != nil {
+			return err
+		}
+	}
+}
+
+// lastLine returns the last complete line of the audit log file at path, or nil if it has none.
+func lastLine(path string, key []byte) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil || fi.Size() == 0 {
+		return nil, err
+	}
+	r, err := enc.GetReader(key, f)
+	if err != nil {
+		return nil, err
+	}
+	var last []byte
+	err = scanLines(r, func(line []byte) error {
+		last = append(last[:0], line...)
+		return nil
+	})
+	return last, err
+}
+
+// Verify checks the chain of hashes of the audit log read from r, whose first line must hold
+// prevHash, and returns the hash of its last line. The files of an audit log are verified in the
+// order they were written, passing the hash returned for a file to the next one.
+func Verify(r io.Reader, secret x.SensitiveByteSlice, prevHash string) (string, error) {
+	hash, _, err := verify(r, secret, prevHash)
+	return hash, err
+}
+
+// verify is Verify, which also returns the hash of the line before the last one.
+func verify(r io.Reader, secret x.SensitiveByteSlice, prevHash string) (string, string, error) {
+	var n int
+	var beforeLast string
+	err := scanLines(r, func(line []byte) error {
+		n++
+		var e struct {
+			PrevHash string `json:"prev_hash"`
+		}
+		if err := json.Unmarshal(line, &e); err != nil {
+			return errors.Wrapf(err, "while parsing line %d of the audit log", n)
+		}
+		if e.PrevHash != prevHash {
+			return errors.Errorf("line %d of the audit log doesn't follow the previous line", n)
+		}
+		beforeLast, prevHash = prevHash, hashLine(secret, line)
+		return nil
+	})
+	return prevHash, beforeLast, err
+}
+
+// VerifyDir checks the chain of hashes of all the files of the audit log in conf.Dir, in the
+// order they were written, and checks that the audit log ends with the line held by the anchor.
+// It returns the number of files verified.
+func VerifyDir(conf Config) (int, error) {
+	files, err := filepath.Glob(filepath.Join(conf.Dir, "dgraph_audit-*.log"))
+	if err != nil {
+		return 0, err
+	}
+	// The names of the rotated files sort them by time.
+	sort.Strings(files)
+	if _, err := os.Stat(filepath.Join(conf.Dir, logName)); err == nil {
+		files = append(files, filepath.Join(conf.Dir, logName))
+	}
+
+	var prevHash, beforeLast string
+	for _, file := range files {
+		hash, before, err := verifyFile(file, conf, prevHash)
+		if err != nil {
+			return 0, errors.Wrapf(err, "while verifying %s", file)
+		}
+		if hash != prevHash {
+			prevHash, beforeLast = hash, before
+		}
+	}
+
+	f, err := os.Open(conf.anchorPath())
+	if err != nil {
+		return 0, errors.Wrapf(err, "while opening the audit log anchor")
+	}
+	defer f.Close()
+	anchor, err := readAnchor(f, conf.Secret)
+	if err != nil {
+		return 0, err
+	}
+	// The anchor may lag by one line if the Alpha stopped between writing the line and the anchor.
+	if anchor != prevHash && anchor != beforeLast {
+		return 0, errors.Errorf("the audit log doesn't end with the line of its anchor, it " +
+			"might have been truncated")
+	}
+	return len(files), nil
+}
+
+func verifyFile(path string, conf Config, prevHash string) (string, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil || fi.Size() == 0 {
+		return prevHash, "", err
+	}
+	r, err := enc.GetReader(conf.EncryptionKey, f)
+	if err != nil {
+		return "", "", err
+	}
+	return verify(r, conf.Secret, prevHash)
+}
@@ -0,0 +1,189 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/ee/enc"
+)
+
+// readLog returns the lines of the audit log files in dir, in the order they were written.
+func readLog(t *testing.T, dir string, key []byte) [][]byte {
+	files, err := filepath.Glob(filepath.Join(dir, "dgraph_audit-*.log"))
+	require.NoError(t, err)
+	sort.Strings(files)
+	files = append(files, filepath.Join(dir, logName))
+
+	var lines [][]byte
+	for _, file := range files {
+		f, err := os.Open(file)
+		require.NoError(t, err)
+		r, err := enc.GetReader(key, f)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		for _, line := range bytes.Split(data, []byte{'\n'}) {
+			if len(line) > 0 {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
+}
+
+var secret = []byte("0123456789abcdef0123456789abcdef")
+
+func verifyLog(lines [][]byte) error {
+	r := bytes.NewReader(append(bytes.Join(lines, []byte{'\n'}), '\n'))
+	_, err := Verify(r, secret, "")
+	return err
+}
+
+func TestAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	key := []byte("1234567890123456")
+	defer func() { auditor = nil }()
+
+	conf := Config{Dir: dir, MaxSize: 512, EncryptionKey: key, Secret: secret}
+	require.NoError(t, Init(conf))
+	for i := 0; i < 10; i++ {
+		e := NewEvent(EndpointDQL, "query")
+		e.User = "alice"
+		e.AddPredicates([]string{"name", "age", "name"})
+		e.Deny([]string{"age"})
+		Log(e, nil)
+	}
+	Log(NewEvent(EndpointGraphQL, "mutation"), errors.New("failed"))
+	Close()
+
+	// The chain goes on after a restart.
+	require.NoError(t, Init(conf))
+	Log(NewEvent(EndpointAdmin, "login"), nil)
+	Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "dgraph_audit-*.log"))
+	require.NoError(t, err)
+	require.Greater(t, len(files), 1)
+
+	lines := readLog(t, dir, key)
+	require.Len(t, lines, 12)
+	require.NoError(t, verifyLog(lines))
+	n, err := VerifyDir(conf)
+	require.NoError(t, err)
+	require.Equal(t, len(files)+1, n)
+
+	var e Event
+	require.NoError(t, json.Unmarshal(lines[0], &e))
+	require.Equal(t, "alice", e.User)
+	require.Equal(t, []string{"age", "name"}, e.Predicates)
+	require.Equal(t, []string{"age"}, e.Denied)
+	require.False(t, e.Allowed)
+	require.Equal(t, StatusOK, e.Status)
+	require.Empty(t, e.PrevHash)
+	require.NoError(t, json.Unmarshal(lines[10], &e))
+	require.Equal(t, StatusError, e.Status)
+	require.Equal(t, "failed", e.Error)
+	require.NoError(t, json.Unmarshal(lines[11], &e))
+	require.Equal(t, EndpointAdmin, e.Endpoint)
+	require.True(t, e.Allowed)
+}
+
+func TestVerifyTampered(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer func() { auditor = nil }()
+
+	conf := Config{Dir: dir, Secret: secret}
+	require.NoError(t, Init(conf))
+	for _, user := range []string{"alice", "bob", "carol"} {
+		e := NewEvent(EndpointDQL, "mutation")
+		e.User = user
+		Log(e, nil)
+	}
+	Close()
+	lines := readLog(t, dir, nil)
+	require.Len(t, lines, 3)
+	require.NoError(t, verifyLog(lines))
+
+	// A changed line breaks the chain.
+	changed := append([][]byte{}, lines...)
+	changed[1] = bytes.Replace(changed[1], []byte("bob"), []byte("eve"), 1)
+	require.Error(t, verifyLog(changed))
+
+	// So does a removed line.
+	require.Error(t, verifyLog([][]byte{lines[0], lines[2]}))
+
+	// The chain can't be recomputed without the secret.
+	_, err = Verify(bytes.NewReader(append(bytes.Join(lines, []byte{'\n'}), '\n')),
+		[]byte("another secret of the same length"), "")
+	require.Error(t, err)
+
+	// A removed last line doesn't match the anchor.
+	path := filepath.Join(dir, logName)
+	require.NoError(t, ioutil.WriteFile(path, append(bytes.Join(lines[:2], []byte{'\n'}), '\n'),
+		0600))
+	_, err = VerifyDir(conf)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "truncated")
+	err = Init(conf)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "truncated")
+
+	// Neither does an audit log without its anchor.
+	require.NoError(t, os.Remove(conf.anchorPath()))
+	require.Error(t, Init(conf))
+}
+
+func TestRotateKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	key := []byte("1234567890123456")
+	newKey := []byte("6543210987654321")
+	defer func() { auditor = nil }()
+
+	conf := Config{Dir: dir, MaxSize: 512, EncryptionKey: key, Secret: secret}
+	require.NoError(t, Init(conf))
+	for i := 0; i < 5; i++ {
+		Log(NewEvent(EndpointDQL, "query"), nil)
+	}
+	require.NoError(t, RotateKey(newKey))
+	Log(NewEvent(EndpointAdmin, "login"), nil)
+	Close()
+
+	// The whole audit log is encrypted with the new key, and its chain is intact.
+	lines := readLog(t, dir, newKey)
+	require.Len(t, lines, 6)
+	require.NoError(t, verifyLog(lines))
+	conf.EncryptionKey = newKey
+	_, err = VerifyDir(conf)
+	require.NoError(t, err)
+
+	// A file which isn't encrypted with the given key is rejected, and nothing is replaced.
+	require.Error(t, ReencryptDir(dir, key, newKey))
+	require.Len(t, readLog(t, dir, newKey), 6)
+}
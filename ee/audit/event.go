@@ -0,0 +1,186 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Config is the configuration of the audit log.
+type Config struct {
+	// Dir is the directory of the audit log files. Audit logging is disabled if it is empty.
+	Dir string
+	// MaxSize is the size in bytes after which the audit log file is rotated.
+	MaxSize int64
+	// EncryptionKey encrypts the audit log files, if it is set.
+	EncryptionKey x.SensitiveByteSlice
+	// Secret keys the HMAC which chains the lines of the audit log.
+	Secret x.SensitiveByteSlice
+	// AnchorFile is the file which holds the hash of the last line of the audit log, so that
+	// its truncation is detected. It is dgraph_audit.anchor in Dir if it is empty.
+	AnchorFile string
+}
+
+// Endpoints of the audited requests.
+const (
+	EndpointDQL     = "dql"
+	EndpointGraphQL = "graphql"
+	EndpointAdmin   = "admin"
+)
+
+// Event is the audit record of a request. It is written to the audit log as one line of JSON.
+type Event struct {
+	sync.Mutex `json:"-"`
+
+	Ts        time.Time `json:"ts"`
+	Endpoint  string    `json:"endpoint"`
+	Operation string    `json:"operation"`
+	User      string    `json:"user,omitempty"`
+	Groups    []string  `json:"groups,omitempty"`
+	Namespace uint64    `json:"namespace"`
+	ClientIP  string    `json:"client_ip,omitempty"`
+	// Request is the text of the DQL query or of the schema of the request, or the fields of a
+	// GraphQL request. The values of the mutations, the arguments of the GraphQL fields and the
+	// variables of the requests are never audited, since they might hold secrets.
+	Request    string   `json:"request,omitempty"`
+	Predicates []string `json:"predicates,omitempty"`
+	// Denied holds the predicates which were denied to the user by ACL.
+	Denied   []string `json:"denied,omitempty"`
+	Allowed  bool     `json:"allowed"`
+	StartTs  uint64   `json:"start_ts,omitempty"`
+	CommitTs uint64   `json:"commit_ts,omitempty"`
+	Status   string   `json:"status"`
+	Error    string   `json:"error,omitempty"`
+	// PrevHash is the hex encoded HMAC-SHA256 of the previous line of the audit log, keyed with the
+	// audit secret, which chains the lines together so that a line can't be changed or removed
+	// without it being detected.
+	PrevHash string `json:"prev_hash"`
+}
+
+// Status of the audited requests.
+const (
+	StatusOK    = "OK"
+	StatusError = "ERROR"
+)
+
+// NewEvent returns the event of a request, which is allowed until it is denied.
+func NewEvent(endpoint, operation string) *Event {
+	return &Event{Endpoint: endpoint, Operation: operation, Allowed: true}
+}
+
+// SetOperation sets the operation of the request and its text, for the requests which are only
+// known once they are parsed.
+func (e *Event) SetOperation(operation, request string) {
+	if e == nil {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	e.Operation, e.Request = operation, request
+}
+
+// SetUser sets the user of the request, for the requests which authenticate it.
+func (e *Event) SetUser(user string, groups []string, ns uint64) {
+	if e == nil {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	e.User, e.Groups, e.Namespace = user, groups, ns
+}
+
+// AddPredicates adds the predicates touched by the request to the event.
+func (e *Event) AddPredicates(preds []string) {
+	if e == nil {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	e.Predicates = append(e.Predicates, preds...)
+}
+
+// Deny records that the request, or some of its predicates, were denied by ACL.
+func (e *Event) Deny(preds []string) {
+	if e == nil {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	e.Allowed = false
+	e.Denied = append(e.Denied, preds...)
+}
+
+// SetTs records the timestamps of the transaction of the request. A request served in many
+// transactions keeps the last ones.
+func (e *Event) SetTs(startTs, commitTs uint64) {
+	if e == nil {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	if startTs != 0 {
+		e.StartTs = startTs
+	}
+	if commitTs != 0 {
+		e.CommitTs = commitTs
+	}
+}
+
+// finish sets the time and the outcome of the request, and sorts and dedups its predicates.
+func (e *Event) finish(now time.Time, err error) {
+	e.Ts = now.UTC()
+	e.Status = StatusOK
+	if err != nil {
+		e.Status = StatusError
+		e.Error = err.Error()
+	}
+	e.Predicates = dedup(e.Predicates)
+	e.Denied = dedup(e.Denied)
+}
+
+func dedup(list []string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	sort.Strings(list)
+	out := list[:1]
+	for _, s := range list[1:] {
+		if s != out[len(out)-1] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+type eventKey struct{}
+
+// WithEvent returns a context holding the event of the request, so that the parts of the request
+// served deeper in the stack are recorded in it.
+func WithEvent(ctx context.Context, e *Event) context.Context {
+	return context.WithValue(ctx, eventKey{}, e)
+}
+
+// EventFromContext returns the event of the request of ctx, or nil if there is none.
+func EventFromContext(ctx context.Context) *Event {
+	e, _ := ctx.Value(eventKey{}).(*Event)
+	return e
+}
@@ -264,9 +264,10 @@ func runLsbackupCmd() error {
 		return errors.Wrapf(err, "while listing manifests")
 	}
 
-	fmt.Printf("Name\tSince\tGroups\tEncrypted\n")
+	fmt.Printf("Name\tSince\tGroups\tEncrypted\tEncryption Key ID\n")
 	for path, manifest := range manifests {
-		fmt.Printf("%v\t%v\t%v\t%v\n", path, manifest.Since, manifest.Groups, manifest.Encrypted)
+		fmt.Printf("%v\t%v\t%v\t%v\t%v\n", path, manifest.Since, manifest.Groups,
+			manifest.Encrypted, manifest.EncryptionKeyId)
 	}
 
 	return nil
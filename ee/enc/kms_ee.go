@@ -0,0 +1,195 @@
+// +build !oss
+
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package enc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Configuration options of KMS.
+const (
+	kmsAddr              = "kms_addr"
+	kmsKeyID             = "kms_key_id"
+	kmsCiphertextFile    = "kms_ciphertext_file"
+	kmsACLCiphertextFile = "kms_acl_ciphertext_file"
+)
+
+// registerKMSFlags registers the required flags to integrate with a KMS.
+func registerKMSFlags(flag *pflag.FlagSet) {
+	flag.String(kmsAddr, "",
+		"Address of the KMS endpoint in the form http://ip:port. The KMS must accept the "+
+			"JSON API of AWS KMS without request signing, like local-kms does.")
+	flag.String(kmsKeyID, "alias/dgraph",
+		"The id, ARN or alias of the KMS key that encrypts the keys. With "+
+			"key_refresh_interval, the keys are re-encrypted when the alias moves to another key.")
+	flag.String(kmsCiphertextFile, "",
+		"The file that stores the encryption key, as encrypted by the KMS.")
+}
+
+// registerKMSACLFlags registers the flags to read the ACL secret with a KMS.
+func registerKMSACLFlags(flag *pflag.FlagSet) {
+	flag.String(kmsACLCiphertextFile, "",
+		"The file that stores the ACL secret, as encrypted by the KMS. The other kms_* "+
+			"options apply as for the encryption key.")
+}
+
+// kmsKeyReader implements the keyReader interface. The key is stored in a local file, encrypted
+// by a KMS, and kmsKeyReader asks the KMS to decrypt it. It speaks the JSON API of AWS KMS, but
+// doesn't sign its requests, so it's meant for KMS compatible servers like local-kms.
+type kmsKeyReader struct {
+	addr           string
+	keyID          string
+	ciphertextFile string
+	validate       func(x.SensitiveByteSlice) error
+	client         *http.Client
+}
+
+func newKMSKeyReader(cfg *viper.Viper, ciphertextFile string,
+	validate func(x.SensitiveByteSlice) error) (*kmsKeyReader, error) {
+	k := &kmsKeyReader{
+		addr:           cfg.GetString(kmsAddr),
+		keyID:          cfg.GetString(kmsKeyID),
+		ciphertextFile: ciphertextFile,
+		validate:       validate,
+		client:         &http.Client{Timeout: 30 * time.Second},
+	}
+	if k.addr == "" || k.keyID == "" {
+		return nil, errors.Errorf("%v and %v must both be specified", kmsAddr, kmsKeyID)
+	}
+	return k, nil
+}
+
+// kmsError is the body of the error responses of the KMS.
+type kmsError struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+// call invokes the KMS operation with the request req, and decodes the response into resp.
+func (kkr *kmsKeyReader) call(operation string, req, resp interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	hreq, err := http.NewRequest(http.MethodPost, kkr.addr, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "while creating the %s request", operation)
+	}
+	hreq.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	hreq.Header.Set("X-Amz-Target", "TrentService."+operation)
+
+	hresp, err := kkr.client.Do(hreq)
+	if err != nil {
+		return errors.Wrapf(err, "while calling %s on the kms", operation)
+	}
+	defer hresp.Body.Close()
+	body, err = ioutil.ReadAll(hresp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "while reading the %s response", operation)
+	}
+	if hresp.StatusCode != http.StatusOK {
+		var kerr kmsError
+		if json.Unmarshal(body, &kerr) == nil && kerr.Type != "" {
+			return errors.Errorf("kms %s failed: %s: %s", operation, kerr.Type, kerr.Message)
+		}
+		return errors.Errorf("kms %s failed with status %s", operation, hresp.Status)
+	}
+	return errors.Wrapf(json.Unmarshal(body, resp), "while decoding the %s response", operation)
+}
+
+// readKey decrypts the key in the ciphertext file with the KMS.
+func (kkr *kmsKeyReader) readKey() (x.SensitiveByteSlice, error) {
+	if kkr == nil {
+		return nil, errors.Errorf("nil kmsKeyReader")
+	}
+	blob, err := ioutil.ReadFile(kkr.ciphertextFile)
+	if err != nil {
+		return nil, errors.Errorf("error reading file %v", err)
+	}
+
+	// []byte fields are base64 encoded in JSON, as KMS expects them.
+	req := struct {
+		CiphertextBlob []byte
+	}{blob}
+	var resp struct {
+		KeyId     string
+		Plaintext []byte
+	}
+	if err := kkr.call("Decrypt", &req, &resp); err != nil {
+		return nil, err
+	}
+	if err := kkr.validate(resp.Plaintext); err != nil {
+		return nil, errors.Wrapf(err, "bad key from kms")
+	}
+	return resp.Plaintext, nil
+}
+
+// rewrapKey re-encrypts the key in the ciphertext file with the key kms_key_id refers to now.
+// The file is only replaced when that's a different key than the one the key was encrypted
+// with, e.g. after its alias was moved to a new key.
+func (kkr *kmsKeyReader) rewrapKey() (bool, error) {
+	blob, err := ioutil.ReadFile(kkr.ciphertextFile)
+	if err != nil {
+		return false, errors.Errorf("error reading file %v", err)
+	}
+
+	req := struct {
+		CiphertextBlob   []byte
+		DestinationKeyId string
+	}{blob, kkr.keyID}
+	var resp struct {
+		CiphertextBlob []byte
+		KeyId          string
+		SourceKeyId    string
+	}
+	if err := kkr.call("ReEncrypt", &req, &resp); err != nil {
+		return false, err
+	}
+	if resp.KeyId == resp.SourceKeyId || len(resp.CiphertextBlob) == 0 {
+		return false, nil
+	}
+
+	// Write the new ciphertext next to the old one and rename it, so that the file always holds
+	// a complete ciphertext.
+	tmp, err := ioutil.TempFile(filepath.Dir(kkr.ciphertextFile), ".kms-rewrap-")
+	if err != nil {
+		return false, errors.Wrapf(err, "while creating the rewrapped key file")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(resp.CiphertextBlob); err != nil {
+		tmp.Close()
+		return false, errors.Wrapf(err, "while writing the rewrapped key")
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return false, errors.Wrapf(err, "while syncing the rewrapped key")
+	}
+	if err := tmp.Close(); err != nil {
+		return false, err
+	}
+	if err := os.Rename(tmp.Name(), kkr.ciphertextFile); err != nil {
+		return false, errors.Wrapf(err, "while replacing the key file")
+	}
+	return true, nil
+}
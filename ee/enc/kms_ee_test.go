@@ -0,0 +1,239 @@
+// +build !oss
+
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package enc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/ristretto/z"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKMS is a KMS stand-in. Its ciphertexts are the id of the key that encrypted them, a
+// colon and the plaintext.
+type fakeKMS struct {
+	sync.Mutex
+	// aliases maps the aliases to the key ids.
+	aliases map[string]string
+}
+
+func (f *fakeKMS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		CiphertextBlob   []byte
+		DestinationKeyId string
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	parts := bytes.SplitN(req.CiphertextBlob, []byte(":"), 2)
+	if len(parts) != 2 {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(kmsError{"InvalidCiphertextException", "bad ciphertext"})
+		return
+	}
+	keyID, plaintext := string(parts[0]), parts[1]
+
+	f.Lock()
+	defer f.Unlock()
+	switch r.Header.Get("X-Amz-Target") {
+	case "TrentService.Decrypt":
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"KeyId": keyID, "Plaintext": plaintext})
+	case "TrentService.ReEncrypt":
+		dest := f.aliases[req.DestinationKeyId]
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"KeyId":          dest,
+			"SourceKeyId":    keyID,
+			"CiphertextBlob": append([]byte(dest+":"), plaintext...),
+		})
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func (f *fakeKMS) setAlias(alias, keyID string) {
+	f.Lock()
+	defer f.Unlock()
+	f.aliases[alias] = keyID
+}
+
+func TestKMSKeyReader(t *testing.T) {
+	kms := &fakeKMS{aliases: map[string]string{"alias/dgraph": "key-1"}}
+	srv := httptest.NewServer(kms)
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "kms")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ciphertextFile := filepath.Join(dir, "enc-key.kms")
+	key := []byte("1234567890123456")
+	require.NoError(t, ioutil.WriteFile(ciphertextFile, append([]byte("key-1:"), key...), 0600))
+
+	config := getEncConfig()
+	resetConfig(config)
+	config.Set(kmsCiphertextFile, ciphertextFile)
+
+	// kms_addr is required.
+	_, err = newKeyReader(config)
+	require.Error(t, err)
+
+	config.Set(kmsAddr, srv.URL)
+	kr, err := newKeyReader(config)
+	require.NoError(t, err)
+	require.IsType(t, &kmsKeyReader{}, kr)
+	k, err := kr.readKey()
+	require.NoError(t, err)
+	require.Equal(t, x.SensitiveByteSlice(key), k)
+
+	// The key is only rewrapped once the alias moves to another key.
+	rw := kr.(keyRewrapper)
+	rewrapped, err := rw.rewrapKey()
+	require.NoError(t, err)
+	require.False(t, rewrapped)
+
+	kms.setAlias("alias/dgraph", "key-2")
+	rewrapped, err = rw.rewrapKey()
+	require.NoError(t, err)
+	require.True(t, rewrapped)
+	blob, err := ioutil.ReadFile(ciphertextFile)
+	require.NoError(t, err)
+	require.Equal(t, append([]byte("key-2:"), key...), blob)
+	k, err = kr.readKey()
+	require.NoError(t, err)
+	require.Equal(t, x.SensitiveByteSlice(key), k)
+
+	// A key with a bad length is rejected.
+	require.NoError(t, ioutil.WriteFile(ciphertextFile, []byte("key-2:short"), 0600))
+	_, err = kr.readKey()
+	require.Error(t, err)
+
+	// Local and KMS options are invalid together.
+	config.Set(encKeyFile, "./test-fixtures/enc-key")
+	_, err = newKeyReader(config)
+	require.Error(t, err)
+}
+
+func TestReadACLSecret(t *testing.T) {
+	config := getEncConfig()
+	resetConfig(config)
+	config.Set(aclSecretFile, "")
+	config.Set(kmsACLCiphertextFile, "")
+
+	// No ACL secret.
+	k, err := ReadACLSecret(config)
+	require.NoError(t, err)
+	require.Nil(t, k)
+
+	// An ACL secret that's too short.
+	config.Set(aclSecretFile, "./test-fixtures/enc-key")
+	_, err = ReadACLSecret(config)
+	require.Error(t, err)
+
+	dir, err := ioutil.TempDir("", "acl")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	secretFile := filepath.Join(dir, "hmac-secret")
+	secret := []byte("12345678901234567890123456789012")
+	require.NoError(t, ioutil.WriteFile(secretFile, secret, 0600))
+	config.Set(aclSecretFile, secretFile)
+	k, err = ReadACLSecret(config)
+	require.NoError(t, err)
+	require.Equal(t, x.SensitiveByteSlice(secret), k)
+
+	// The encryption key is independent of the ACL secret.
+	k, err = ReadKey(config)
+	require.NoError(t, err)
+	require.Nil(t, k)
+}
+
+func TestWatchKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	secretFile := filepath.Join(dir, "hmac-secret")
+	secret := []byte("12345678901234567890123456789012")
+	require.NoError(t, ioutil.WriteFile(secretFile, secret, 0600))
+
+	config := getEncConfig()
+	resetConfig(config)
+	config.Set(aclSecretFile, secretFile)
+	config.Set(kmsACLCiphertextFile, "")
+	config.Set(keyRefreshInterval, 10*time.Millisecond)
+
+	secrets := make(chan x.SensitiveByteSlice, 1)
+	closer := z.NewCloser(1)
+	go WatchKeys(config, closer, nil, secret, nil, func(k x.SensitiveByteSlice) {
+		secrets <- k
+	})
+
+	newSecret := []byte("abcdefghijklmnopqrstuvwxyz012345")
+	require.NoError(t, ioutil.WriteFile(secretFile, newSecret, 0600))
+	select {
+	case k := <-secrets:
+		require.Equal(t, x.SensitiveByteSlice(newSecret), k)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the new ACL secret wasn't picked up")
+	}
+	closer.SignalAndWait()
+}
+
+func TestWatchEncryptionKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	keyFile := filepath.Join(dir, "enc-key")
+	key := []byte("0123456789abcdef")
+	require.NoError(t, ioutil.WriteFile(keyFile, key, 0600))
+
+	config := getEncConfig()
+	resetConfig(config)
+	config.Set(encKeyFile, keyFile)
+	config.Set(keyRefreshInterval, 10*time.Millisecond)
+
+	// The first rotation fails, so the key is passed again at the next refresh.
+	keys := make(chan x.SensitiveByteSlice, 2)
+	calls := 0
+	closer := z.NewCloser(1)
+	go WatchKeys(config, closer, key, nil, func(k x.SensitiveByteSlice) error {
+		calls++
+		keys <- k
+		if calls == 1 {
+			return errors.New("rotation failed")
+		}
+		return nil
+	}, nil)
+
+	newKey := []byte("fedcba9876543210")
+	require.NoError(t, ioutil.WriteFile(keyFile, newKey, 0600))
+	for i := 0; i < 2; i++ {
+		select {
+		case k := <-keys:
+			require.Equal(t, x.SensitiveByteSlice(newKey), k)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the new encryption key wasn't picked up")
+		}
+	}
+	closer.SignalAndWait()
+}
@@ -0,0 +1,106 @@
+// +build !oss
+
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package enc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+)
+
+// previousKeyFile is the file of a Badger directory which holds its previous encryption key,
+// encrypted with the new one, while its key registry is still to be re-encrypted with the new key.
+const previousKeyFile = "PREVIOUS-KEY"
+
+func keyRegistryOptions(dir string, key x.SensitiveByteSlice) badger.KeyRegistryOptions {
+	return badger.KeyRegistryOptions{
+		Dir:                           dir,
+		ReadOnly:                      true,
+		EncryptionKey:                 key,
+		EncryptionKeyRotationDuration: 10 * 24 * time.Hour,
+	}
+}
+
+// RotateKeyRegistry re-encrypts the data keys in the key registry of the Badger directory dir
+// with newKey. The data itself isn't rewritten. Badger must not have dir open.
+func RotateKeyRegistry(dir string, oldKey, newKey x.SensitiveByteSlice) error {
+	// A wrong directory isn't mistaken for one without data keys.
+	if _, err := os.Stat(filepath.Join(dir, badger.KeyRegistryFileName)); err != nil {
+		return errors.Wrapf(err, "while looking for the key registry")
+	}
+	opt := keyRegistryOptions(dir, oldKey)
+	kr, err := badger.OpenKeyRegistry(opt)
+	if err != nil {
+		return errors.Wrapf(err, "while opening the key registry")
+	}
+	opt.EncryptionKey = newKey
+	return badger.WriteKeyRegistry(kr, opt)
+}
+
+// DeferKeyRotation records that the key registry of the Badger directory dir must be
+// re-encrypted with newKey by FinishKeyRotation, since Badger keeps it open while it has dir open,
+// and writes the new data keys to it with the key it was opened with. The previous key is kept
+// in dir, encrypted with newKey, until then.
+func DeferKeyRotation(dir string, oldKey, newKey x.SensitiveByteSlice) error {
+	if _, err := os.Stat(filepath.Join(dir, previousKeyFile)); err == nil {
+		return errors.Errorf("a rotation of the encryption key of %s is already pending, "+
+			"restart the Alpha to finish it first", dir)
+	}
+	data, err := EncryptValue(newKey, oldKey)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(dir, previousKeyFile+".tmp")
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return errors.Wrapf(err, "while writing the previous encryption key")
+	}
+	return os.Rename(tmp, filepath.Join(dir, previousKeyFile))
+}
+
+// CancelKeyRotation forgets the rotation recorded by DeferKeyRotation for dir.
+func CancelKeyRotation(dir string) error {
+	if err := os.Remove(filepath.Join(dir, previousKeyFile)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// FinishKeyRotation re-encrypts the key registry of the Badger directory dir with key, if
+// DeferKeyRotation recorded a rotation to key. Badger must not have dir open.
+func FinishKeyRotation(dir string, key x.SensitiveByteSlice) error {
+	data, err := ioutil.ReadFile(filepath.Join(dir, previousKeyFile))
+	switch {
+	case os.IsNotExist(err):
+		return nil
+	case err != nil:
+		return errors.Wrapf(err, "while reading the previous encryption key")
+	}
+	oldKey, err := DecryptValue(key, data)
+	if err != nil {
+		return errors.Errorf("the encryption key of %s was rotated to another key than the "+
+			"given one", dir)
+	}
+	// The registry might have been rewritten before the previous key could be removed.
+	if _, err := badger.OpenKeyRegistry(keyRegistryOptions(dir, key)); err != nil {
+		if err := RotateKeyRegistry(dir, oldKey, key); err != nil {
+			return errors.Wrapf(err, "while finishing the rotation of the encryption key of %s",
+				dir)
+		}
+	}
+	return CancelKeyRotation(dir)
+}
@@ -0,0 +1,64 @@
+// +build !oss
+
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package enc
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func openDB(dir string, key []byte) (*badger.DB, error) {
+	return badger.Open(badger.DefaultOptions(dir).
+		WithEncryptionKey(key).
+		WithIndexCacheSize(1 << 20).
+		WithLogger(nil))
+}
+
+func TestDeferKeyRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	oldKey := []byte("0123456789abcdef")
+	newKey := []byte("fedcba9876543210")
+	db, err := openDB(dir, oldKey)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte("key"), []byte("value"))
+	}))
+	require.NoError(t, DeferKeyRotation(dir, oldKey, newKey))
+	require.Error(t, DeferKeyRotation(dir, oldKey, newKey))
+	require.NoError(t, db.Close())
+
+	require.Error(t, FinishKeyRotation(dir, oldKey))
+	require.NoError(t, FinishKeyRotation(dir, newKey))
+	_, err = os.Stat(dir + "/" + previousKeyFile)
+	require.True(t, os.IsNotExist(err))
+
+	db, err = openDB(dir, newKey)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte("key"))
+		if err != nil {
+			return err
+		}
+		val, err := item.ValueCopy(nil)
+		require.Equal(t, "value", string(val))
+		return err
+	}))
+}
@@ -19,10 +19,14 @@
 package enc
 
 import (
+	"io"
+	"io/ioutil"
+
 	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/ristretto/z"
+	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
-	"io"
 )
 
 // Eebuild indicates if this is a Enterprise build.
@@ -43,7 +47,80 @@ func RegisterFlags(_ *pflag.FlagSet) {
 	return
 }
 
+// RegisterACLFlags registers the flags to read the ACL secret. None for OSS.
+func RegisterACLFlags(_ *pflag.FlagSet) {
+	return
+}
+
 // ReadKey reads the key. Nil for OSS.
 func ReadKey(_ *viper.Viper) (x.SensitiveByteSlice, error) {
 	return nil, nil
 }
+
+// ReadACLSecret reads the ACL secret from acl_secret_file for OSS Builds.
+func ReadACLSecret(cfg *viper.Viper) (x.SensitiveByteSlice, error) {
+	secretFile := cfg.GetString("acl_secret_file")
+	if secretFile == "" {
+		return nil, nil
+	}
+	k, err := ioutil.ReadFile(secretFile)
+	if err != nil {
+		return nil, errors.Errorf("error reading file %v", err)
+	}
+	if len(k) < 32 {
+		return nil, errors.Errorf("the HMAC secret should contain at least 256 bits " +
+			"(32 ascii chars)")
+	}
+	return k, nil
+}
+
+// WatchKeys doesn't watch the keys for OSS Builds.
+func WatchKeys(_ *viper.Viper, closer *z.Closer, _, _ x.SensitiveByteSlice,
+	_ func(x.SensitiveByteSlice) error, _ func(x.SensitiveByteSlice)) {
+	closer.Done()
+}
+
+// EncryptValue returns an error for OSS Builds.
+func EncryptValue(_ x.SensitiveByteSlice, _ []byte) ([]byte, error) {
+	return nil, x.ErrNotSupported
+}
+
+// DecryptValue returns an error for OSS Builds.
+func DecryptValue(_ x.SensitiveByteSlice, _ []byte) ([]byte, error) {
+	return nil, x.ErrNotSupported
+}
+
+// NewDataKey returns an error for OSS Builds.
+func NewDataKey(_ x.SensitiveByteSlice) ([]byte, error) {
+	return nil, x.ErrNotSupported
+}
+
+// UnwrapDataKey returns an error for OSS Builds.
+func UnwrapDataKey(_ x.SensitiveByteSlice, _ [][]byte) (x.SensitiveByteSlice, error) {
+	return nil, x.ErrNotSupported
+}
+
+// RewrapDataKey returns an error for OSS Builds.
+func RewrapDataKey(_, _ x.SensitiveByteSlice, _ [][]byte) ([][]byte, error) {
+	return nil, x.ErrNotSupported
+}
+
+// RotateKeyRegistry returns an error for OSS Builds.
+func RotateKeyRegistry(_ string, _, _ x.SensitiveByteSlice) error {
+	return x.ErrNotSupported
+}
+
+// DeferKeyRotation returns an error for OSS Builds.
+func DeferKeyRotation(_ string, _, _ x.SensitiveByteSlice) error {
+	return x.ErrNotSupported
+}
+
+// CancelKeyRotation does nothing for OSS Builds.
+func CancelKeyRotation(_ string) error {
+	return nil
+}
+
+// FinishKeyRotation does nothing for OSS Builds.
+func FinishKeyRotation(_ string, _ x.SensitiveByteSlice) error {
+	return nil
+}
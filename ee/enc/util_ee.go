@@ -13,13 +13,17 @@
 package enc
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"io"
 	"io/ioutil"
+	"time"
 
 	"github.com/dgraph-io/badger/v3/y"
 	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/ristretto/z"
+	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -29,7 +33,9 @@ import (
 var EeBuild = true
 
 const (
-	encKeyFile = "encryption_key_file"
+	encKeyFile         = "encryption_key_file"
+	aclSecretFile      = "acl_secret_file"
+	keyRefreshInterval = "key_refresh_interval"
 )
 
 // RegisterFlags registers the required encryption flags.
@@ -38,18 +44,82 @@ func RegisterFlags(flag *pflag.FlagSet) {
 		"The file that stores the symmetric key of length 16, 24, or 32 bytes. "+
 			"The key size determines the chosen AES cipher "+
 			"(AES-128, AES-192, and AES-256 respectively). Enterprise feature.")
+	flag.Duration(keyRefreshInterval, 0,
+		"How often the keys are read again from their provider, to pick up rotated keys. "+
+			"0 turns it off. Enterprise feature.")
 
 	// Register options for Vault stuff.
 	registerVaultFlags(flag)
+	// Register options for KMS stuff.
+	registerKMSFlags(flag)
 }
 
+// RegisterACLFlags registers the flags to read the ACL secret from Vault or KMS, instead of
+// acl_secret_file.
+func RegisterACLFlags(flag *pflag.FlagSet) {
+	registerVaultACLFlags(flag)
+	registerKMSACLFlags(flag)
+}
+
+// keySpec describes a key that the keyReaders can read: the options that locate it and what
+// makes it valid.
+type keySpec struct {
+	name string
+	// fileFlag, vaultFieldFlag and kmsFileFlag are the options giving the key file, the Vault
+	// field and the KMS ciphertext file of the key.
+	fileFlag       string
+	vaultFieldFlag string
+	kmsFileFlag    string
+	validate       func(x.SensitiveByteSlice) error
+}
+
+var (
+	encKeySpec = keySpec{
+		name:           "encryption key",
+		fileFlag:       encKeyFile,
+		vaultFieldFlag: vaultField,
+		kmsFileFlag:    kmsCiphertextFile,
+		validate: func(k x.SensitiveByteSlice) error {
+			// len must be 16,24,32 bytes if given. All other lengths are invalid.
+			klen := len(k)
+			if klen != 16 && klen != 24 && klen != 32 {
+				return errors.Errorf("invalid key length %d", klen)
+			}
+			return nil
+		},
+	}
+	aclSecretSpec = keySpec{
+		name:           "ACL secret",
+		fileFlag:       aclSecretFile,
+		vaultFieldFlag: vaultACLField,
+		kmsFileFlag:    kmsACLCiphertextFile,
+		validate: func(k x.SensitiveByteSlice) error {
+			if len(k) < 32 {
+				return errors.Errorf("the HMAC secret should contain at least 256 bits " +
+					"(32 ascii chars)")
+			}
+			return nil
+		},
+	}
+)
+
+// keyReader is the interface of the key providers. Each implementation reads a key from one
+// kind of store, like a local file, Vault or a KMS.
 type keyReader interface {
 	readKey() (x.SensitiveByteSlice, error)
 }
 
+// keyRewrapper is implemented by the keyReaders that store their key encrypted with another
+// key, like the KMS one. rewrapKey re-encrypts the stored key with the latest version of that
+// other key, without changing the key itself. It reports whether the stored key changed.
+type keyRewrapper interface {
+	rewrapKey() (bool, error)
+}
+
 // localKeyReader implements the keyReader interface. It reads the key from local files.
 type localKeyReader struct {
-	keyFile string
+	keyFile  string
+	validate func(x.SensitiveByteSlice) error
 }
 
 func (lkr *localKeyReader) readKey() (x.SensitiveByteSlice, error) {
@@ -63,22 +133,30 @@ func (lkr *localKeyReader) readKey() (x.SensitiveByteSlice, error) {
 	if err != nil {
 		return nil, errors.Errorf("error reading file %v", err)
 	}
-	// len must be 16,24,32 bytes if given. All other lengths are invalid.
-	klen := len(k)
-	if klen != 16 && klen != 24 && klen != 32 {
-		return nil, errors.Errorf("invalid key length %d", klen)
+	if err := lkr.validate(k); err != nil {
+		return nil, err
 	}
 	return k, nil
 }
 
 // ReadKey obtains the key using the configured options.
 func ReadKey(cfg *viper.Viper) (x.SensitiveByteSlice, error) {
+	return readKeyFor(cfg, encKeySpec)
+}
+
+// ReadACLSecret obtains the HMAC secret of the ACLs using the configured options. It's nil when
+// no ACL secret is configured.
+func ReadACLSecret(cfg *viper.Viper) (x.SensitiveByteSlice, error) {
+	return readKeyFor(cfg, aclSecretSpec)
+}
+
+func readKeyFor(cfg *viper.Viper, spec keySpec) (x.SensitiveByteSlice, error) {
 	var (
 		kr  keyReader
 		err error
 	)
 	// Get the keyReader interface.
-	if kr, err = newKeyReader(cfg); err != nil {
+	if kr, err = newKeyReaderFor(cfg, spec); err != nil {
 		return nil, err
 	}
 	var key x.SensitiveByteSlice
@@ -91,40 +169,134 @@ func ReadKey(cfg *viper.Viper) (x.SensitiveByteSlice, error) {
 	return key, nil
 }
 
-// newKeyReader returns a keyReader interface based on the configuration options.
+// newKeyReader returns the keyReader of the encryption key.
+func newKeyReader(cfg *viper.Viper) (keyReader, error) {
+	return newKeyReaderFor(cfg, encKeySpec)
+}
+
+// newKeyReaderFor returns a keyReader interface based on the configuration options.
 // Valid KeyReaders are:
 // 1. Local to read key from local filesystem. .
 // 2. Vault to read key from vault.
-// 3. Nil when encryption is turned off.
-func newKeyReader(cfg *viper.Viper) (keyReader, error) {
+// 3. KMS to decrypt the key, stored in a local file, with a KMS.
+// 4. Nil when the key isn't configured, e.g. encryption is turned off.
+func newKeyReaderFor(cfg *viper.Viper, spec keySpec) (keyReader, error) {
 	var keyReaders int
 	var keyReader keyReader
 	var err error
 
-	keyFile := cfg.GetString(encKeyFile)
+	keyFile := cfg.GetString(spec.fileFlag)
 	roleID := cfg.GetString(vaultRoleIDFile)
 	secretID := cfg.GetString(vaultSecretIDFile)
+	field := cfg.GetString(spec.vaultFieldFlag)
+	ciphertextFile := cfg.GetString(spec.kmsFileFlag)
 
 	if keyFile != "" {
 		keyReader = &localKeyReader{
-			keyFile: keyFile,
+			keyFile:  keyFile,
+			validate: spec.validate,
+		}
+		keyReaders++
+	}
+	if (roleID != "" || secretID != "") && field != "" {
+		keyReader, err = newVaultKeyReader(cfg, field, spec.validate)
+		if err != nil {
+			return nil, err
 		}
 		keyReaders++
 	}
-	if roleID != "" || secretID != "" {
-		keyReader, err = newVaultKeyReader(cfg)
+	if ciphertextFile != "" {
+		keyReader, err = newKMSKeyReader(cfg, ciphertextFile, spec.validate)
 		if err != nil {
 			return nil, err
 		}
 		keyReaders++
 	}
-	if keyReaders == 2 {
-		return nil, errors.Errorf("cannot have local and vault key readers. " +
-			"re-check the configuration")
+	if keyReaders > 1 {
+		return nil, errors.Errorf("cannot have more than one of local, vault and kms key "+
+			"readers for the %s. re-check the configuration", spec.name)
 	}
 	return keyReader, nil
 }
 
+// WatchKeys reads the encryption key and the ACL secret again every key_refresh_interval, until
+// the closer is signalled. The key readers that store their key encrypted, like the KMS one,
+// first re-encrypt it with the latest version of the key that wraps it. A changed encryption key
+// is passed to onEncKey, which rotates the key of the data, and is tried again at the next refresh
+// if it fails. A changed ACL secret is passed to onACLSecret.
+func WatchKeys(cfg *viper.Viper, closer *z.Closer, encKey, aclSecret x.SensitiveByteSlice,
+	onEncKey func(x.SensitiveByteSlice) error, onACLSecret func(x.SensitiveByteSlice)) {
+	defer closer.Done()
+
+	interval := cfg.GetDuration(keyRefreshInterval)
+	if interval <= 0 {
+		return
+	}
+	encReader, err := newKeyReaderFor(cfg, encKeySpec)
+	if err != nil {
+		glog.Errorf("Unable to watch the encryption key: %v", err)
+		return
+	}
+	aclReader, err := newKeyReaderFor(cfg, aclSecretSpec)
+	if err != nil {
+		glog.Errorf("Unable to watch the ACL secret: %v", err)
+		return
+	}
+	if encReader == nil && aclReader == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closer.HasBeenClosed():
+			return
+		case <-ticker.C:
+			if k, changed := refreshKey(encReader, encKeySpec, encKey); changed {
+				if err := onEncKey(k); err != nil {
+					glog.Errorf("The encryption key has changed, but it couldn't be rotated. "+
+						"Keep providing the current key until it's fixed: %v", err)
+				} else {
+					glog.Infof("The encryption key has changed. Rotated the data keys to it.")
+					encKey = k
+				}
+			}
+			if k, changed := refreshKey(aclReader, aclSecretSpec, aclSecret); changed {
+				glog.Infof("The ACL secret has changed. Signing JWTs with the new secret.")
+				onACLSecret(k)
+				aclSecret = k
+			}
+		}
+	}
+}
+
+// refreshKey rewraps and reads the key of kr again, and reports whether it differs from key.
+func refreshKey(kr keyReader, spec keySpec, key x.SensitiveByteSlice) (
+	x.SensitiveByteSlice, bool) {
+	if kr == nil {
+		return nil, false
+	}
+	if rw, ok := kr.(keyRewrapper); ok {
+		rewrapped, err := rw.rewrapKey()
+		switch {
+		case err != nil:
+			glog.Errorf("Unable to re-encrypt the %s: %v", spec.name, err)
+		case rewrapped:
+			glog.Infof("Re-encrypted the %s with the latest key version.", spec.name)
+		}
+	}
+	k, err := kr.readKey()
+	if err != nil {
+		glog.Errorf("Unable to read the %s again: %v", spec.name, err)
+		return nil, false
+	}
+	if bytes.Equal(k, key) {
+		return nil, false
+	}
+	return k, true
+}
+
 // GetWriter wraps a crypto StreamWriter using the input key on the input Writer.
 func GetWriter(key x.SensitiveByteSlice, w io.Writer) (io.Writer, error) {
 	// No encryption, return the input writer as is.
@@ -0,0 +1,124 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package enc
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// valuePrefix starts the encrypted values of the @encrypted predicates. It is followed by the
+// base64 encoding of the nonce and of the AES-GCM sealed value, so that the encrypted values are
+// still valid strings.
+var valuePrefix = []byte("dgraph.enc.v1:")
+
+func valueCipher(key x.SensitiveByteSlice) (cipher.AEAD, error) {
+	if key == nil {
+		return nil, errors.Errorf("no encryption key is set")
+	}
+	c, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(c)
+}
+
+// EncryptValue encrypts the value of an @encrypted predicate with the key.
+func EncryptValue(key x.SensitiveByteSlice, val []byte) ([]byte, error) {
+	gcm, err := valueCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	sealed := gcm.Seal(nonce, nonce, val, nil)
+	out := make([]byte, len(valuePrefix)+base64.StdEncoding.EncodedLen(len(sealed)))
+	copy(out, valuePrefix)
+	base64.StdEncoding.Encode(out[len(valuePrefix):], sealed)
+	return out, nil
+}
+
+// DecryptValue decrypts a value encrypted by EncryptValue with the key. It returns an error if the
+// value wasn't encrypted with the key.
+func DecryptValue(key x.SensitiveByteSlice, val []byte) ([]byte, error) {
+	gcm, err := valueCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(val, valuePrefix) {
+		return nil, errors.Errorf("the value is not encrypted")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(string(val[len(valuePrefix):]))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while decoding the encrypted value")
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.Errorf("the encrypted value is too short")
+	}
+	nonce, sealed := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, sealed, nil)
+}
+
+// dataKeySize is the size of the data keys of the @encrypted predicates, for AES-256.
+const dataKeySize = 32
+
+// NewDataKey returns a new data key for the values of an @encrypted predicate, wrapped with the
+// encryption key.
+func NewDataKey(key x.SensitiveByteSlice) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	return EncryptValue(key, dataKey)
+}
+
+// UnwrapDataKey returns the data key of an @encrypted predicate, given its wrapped data keys. It
+// returns an error if none of them was wrapped with the key.
+func UnwrapDataKey(key x.SensitiveByteSlice, wrapped [][]byte) (x.SensitiveByteSlice, error) {
+	for _, w := range wrapped {
+		if dataKey, err := DecryptValue(key, w); err == nil {
+			return dataKey, nil
+		}
+	}
+	return nil, errors.Errorf("the data key isn't wrapped with the encryption key")
+}
+
+// RewrapDataKey returns the data key wrapped with newKey, followed by the one wrapped with oldKey,
+// so that it can still be unwrapped with either key while the key is rotated. It returns nil if
+// the data key is already wrapped with newKey.
+func RewrapDataKey(oldKey, newKey x.SensitiveByteSlice, wrapped [][]byte) ([][]byte, error) {
+	if _, err := UnwrapDataKey(newKey, wrapped); err == nil {
+		return nil, nil
+	}
+	for _, w := range wrapped {
+		dataKey, err := DecryptValue(oldKey, w)
+		if err != nil {
+			continue
+		}
+		rewrapped, err := EncryptValue(newKey, dataKey)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{rewrapped, w}, nil
+	}
+	return nil, errors.Errorf("the data key isn't wrapped with the previous encryption key")
+}
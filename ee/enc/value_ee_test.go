@@ -0,0 +1,81 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package enc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptValue(t *testing.T) {
+	key := []byte("123456789012345678901234")
+	val := []byte("078-05-1120")
+
+	_, err := EncryptValue(nil, val)
+	require.Error(t, err)
+
+	enc1, err := EncryptValue(key, val)
+	require.NoError(t, err)
+	enc2, err := EncryptValue(key, val)
+	require.NoError(t, err)
+	require.NotContains(t, string(enc1), string(val))
+	// Every value is encrypted with a new nonce.
+	require.NotEqual(t, enc1, enc2)
+
+	dec, err := DecryptValue(key, enc1)
+	require.NoError(t, err)
+	require.Equal(t, val, dec)
+
+	// A plaintext value, or one encrypted with another key, fails to be decrypted.
+	_, err = DecryptValue(key, val)
+	require.Error(t, err)
+	_, err = DecryptValue([]byte("abcdefghijklmnopqrstuvwx"), enc1)
+	require.Error(t, err)
+	// So does a value which was tampered with.
+	tampered := append([]byte{}, enc1...)
+	tampered[len(tampered)-3] ^= 1
+	_, err = DecryptValue(key, tampered)
+	require.Error(t, err)
+}
+
+func TestDataKey(t *testing.T) {
+	oldKey := []byte("123456789012345678901234")
+	newKey := []byte("abcdefghijklmnopqrstuvwx")
+
+	wrapped, err := NewDataKey(oldKey)
+	require.NoError(t, err)
+	dataKey, err := UnwrapDataKey(oldKey, [][]byte{wrapped})
+	require.NoError(t, err)
+	require.Len(t, dataKey, dataKeySize)
+	_, err = UnwrapDataKey(newKey, [][]byte{wrapped})
+	require.Error(t, err)
+
+	// The rotated data key can be unwrapped with both keys, and is the same data key.
+	rewrapped, err := RewrapDataKey(oldKey, newKey, [][]byte{wrapped})
+	require.NoError(t, err)
+	require.Len(t, rewrapped, 2)
+	for _, key := range [][]byte{oldKey, newKey} {
+		got, err := UnwrapDataKey(key, rewrapped)
+		require.NoError(t, err)
+		require.Equal(t, dataKey, got)
+	}
+
+	// It isn't wrapped again with a key it is already wrapped with.
+	again, err := RewrapDataKey(oldKey, newKey, rewrapped)
+	require.NoError(t, err)
+	require.Nil(t, again)
+
+	_, err = RewrapDataKey([]byte("ABCDEFGHIJKLMNOPQRSTUVWX"), newKey, [][]byte{wrapped})
+	require.Error(t, err)
+}
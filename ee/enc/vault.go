@@ -26,3 +26,8 @@ import (
 func registerVaultFlags(_ *pflag.FlagSet) {
 	return
 }
+
+// registerVaultACLFlags registers the flags to read the ACL secret from Vault.
+func registerVaultACLFlags(_ *pflag.FlagSet) {
+	return
+}
@@ -38,6 +38,7 @@ const (
 	vaultPath         = "vault_path"
 	vaultField        = "vault_field"
 	vaultFormat       = "vault_format"
+	vaultACLField     = "vault_acl_field"
 )
 
 // RegisterVaultFlags registers the required flags to integrate with Vault.
@@ -51,12 +52,20 @@ func registerVaultFlags(flag *pflag.FlagSet) {
 		"File containing Vault secret-id used for approle auth.")
 	flag.String(vaultPath, "secret/data/dgraph",
 		"Vault kv store path. e.g. secret/data/dgraph for kv-v2, kv/dgraph for kv-v1.")
-	flag.String(vaultField, "enc_key",
-		"Vault kv store field whose value is the Base64 encoded encryption key.")
+	flag.String(vaultField, "",
+		"Vault kv store field whose value is the encryption key, in the format given by "+
+			"vault_format. The encryption key is only read from Vault when it's set.")
 	flag.String(vaultFormat, "base64",
 		"Vault field format. raw or base64")
 }
 
+// registerVaultACLFlags registers the flags to read the ACL secret from Vault.
+func registerVaultACLFlags(flag *pflag.FlagSet) {
+	flag.String(vaultACLField, "",
+		"Vault kv store field whose value is the ACL secret, in the format given by "+
+			"vault_format. The other vault_* options apply as for the encryption key.")
+}
+
 // vaultKeyReader implements the KeyReader interface. It reads the key from vault server.
 type vaultKeyReader struct {
 	addr     string
@@ -65,16 +74,19 @@ type vaultKeyReader struct {
 	path     string
 	field    string
 	format   string
+	validate func(x.SensitiveByteSlice) error
 }
 
-func newVaultKeyReader(cfg *viper.Viper) (*vaultKeyReader, error) {
+func newVaultKeyReader(cfg *viper.Viper, field string,
+	validate func(x.SensitiveByteSlice) error) (*vaultKeyReader, error) {
 	v := &vaultKeyReader{
 		addr:     cfg.GetString(vaultAddr),
 		roleID:   cfg.GetString(vaultRoleIDFile),
 		secretID: cfg.GetString(vaultSecretIDFile),
 		path:     cfg.GetString(vaultPath),
-		field:    cfg.GetString(vaultField),
+		field:    field,
 		format:   cfg.GetString(vaultFormat),
+		validate: validate,
 	}
 
 	if v.addr == "" || v.path == "" || v.field == "" || v.format == "" {
@@ -156,10 +168,8 @@ func (vkr *vaultKeyReader) readKey() (x.SensitiveByteSlice, error) {
 			return nil, errors.Errorf("Unable to decode the Base64 Encoded key: err %v", err)
 		}
 	}
-	// Validate key length suitable for AES.
-	klen := len(kbyte)
-	if klen != 16 && klen != 32 && klen != 64 {
-		return nil, errors.Errorf("bad key length %v from vault", klen)
+	if err := vkr.validate(kbyte); err != nil {
+		return nil, errors.Wrapf(err, "bad key from vault")
 	}
 	return kbyte, nil
 }
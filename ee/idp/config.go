@@ -0,0 +1,78 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package idp authenticates the ACL logins of the users of external identity providers.
+package idp
+
+import (
+	"context"
+	"time"
+)
+
+// Config is the configuration of the external identity providers. A provider is disabled unless
+// its URL, or its JWKS for OIDC, is set.
+type Config struct {
+	LDAP LDAPConfig
+	OIDC OIDCConfig
+	// GroupMap maps the groups of the identity providers to ACL groups, like
+	// "admins=guardians,eng=dev". The groups are used as they are if it is empty, except that
+	// guardians must be mapped explicitly.
+	GroupMap string
+	// Namespace is the namespace which the users of the identity providers log into. Their logins
+	// into the other namespaces are rejected.
+	Namespace uint64
+}
+
+// LDAPConfig is the configuration of the LDAP directory against which the users bind.
+type LDAPConfig struct {
+	// URL is the ldap:// or ldaps:// URL of the directory.
+	URL string
+	// UserDN is the template of the DN of a user, like "uid=%s,ou=people,dc=example,dc=org".
+	UserDN string
+	// GroupAttr is the attribute of a user which holds the DNs or names of its groups.
+	GroupAttr string
+	// Timeout bounds each request to the directory.
+	Timeout time.Duration
+}
+
+// OIDCConfig is the configuration of the OpenID Connect provider whose ID tokens log users in.
+type OIDCConfig struct {
+	// Issuer and Audience must match the iss and aud claims of the ID tokens.
+	Issuer   string
+	Audience string
+	// JWKS is the file or the http(s) URL of the JSON Web Key Set which signs the ID tokens.
+	JWKS string
+	// UserClaim is the claim which holds the user id, and GroupsClaim the one holding the groups.
+	UserClaim   string
+	GroupsClaim string
+}
+
+// Identity is a user authenticated by an external identity provider.
+type Identity struct {
+	UserID string
+	// Groups are the ACL groups of the user, mapped from its groups in the identity provider.
+	Groups []string
+}
+
+// Authenticator authenticates the users of an external identity provider.
+type Authenticator interface {
+	// Name is the name of the identity provider, which shows up in the logs.
+	Name() string
+	// Authenticate returns the identity of the user of the given credentials, whose groups are
+	// the groups of the identity provider. It returns nil and no error if the credentials aren't
+	// of the kind the authenticator handles.
+	Authenticate(ctx context.Context, userId, secret string) (*Identity, error)
+}
@@ -0,0 +1,32 @@
+// +build oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package idp
+
+import (
+	"github.com/golang/glog"
+)
+
+// Init does nothing since the external identity providers are only supported in the enterprise
+// version.
+func Init(conf Config) error {
+	if conf.LDAP.URL != "" || conf.OIDC.JWKS != "" {
+		glog.Warningf("External identity providers are an enterprise feature. Ignoring them.")
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package idp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+var (
+	// authenticators are the enabled identity providers, which are tried in order.
+	authenticators []Authenticator
+	// groupMap maps the groups of the identity providers to ACL groups. It is nil if the groups
+	// are used as they are.
+	groupMap map[string][]string
+	// namespace is the namespace which the users of the identity providers log into.
+	namespace uint64
+)
+
+// Init enables the identity providers of the given configuration.
+func Init(conf Config) error {
+	m, err := parseGroupMap(conf.GroupMap)
+	if err != nil {
+		return err
+	}
+	var auths []Authenticator
+	if conf.LDAP.URL != "" {
+		a, err := newLDAPAuthenticator(conf.LDAP)
+		if err != nil {
+			return err
+		}
+		auths = append(auths, a)
+	}
+	if conf.OIDC.JWKS != "" {
+		a, err := newOIDCAuthenticator(conf.OIDC)
+		if err != nil {
+			return err
+		}
+		auths = append(auths, a)
+	}
+	for _, a := range auths {
+		glog.Infof("Authenticating the ACL logins of the users of %s", a.Name())
+	}
+	authenticators, groupMap, namespace = auths, m, conf.Namespace
+	return nil
+}
+
+// Enabled returns whether any identity provider is enabled.
+func Enabled() bool {
+	return len(authenticators) > 0
+}
+
+// Namespace returns the namespace which the users of the identity providers log into.
+func Namespace() uint64 {
+	return namespace
+}
+
+// Authenticate returns the identity of the user of the given credentials, whose groups are mapped
+// to ACL groups, from the first identity provider which handles them. It returns nil and no error
+// if none does.
+func Authenticate(ctx context.Context, userId, secret string) (*Identity, error) {
+	if secret == "" {
+		// An LDAP bind with an empty password is an unauthenticated bind, which always succeeds.
+		return nil, errors.Errorf("the password should not be empty")
+	}
+	for _, a := range authenticators {
+		id, err := a.Authenticate(ctx, userId, secret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while authenticating against %s", a.Name())
+		}
+		if id != nil {
+			id.Groups = mapGroups(id.Groups)
+			return id, nil
+		}
+	}
+	return nil, nil
+}
+
+// parseGroupMap parses a comma separated list of external=acl pairs. An external group may be
+// mapped to several ACL groups.
+func parseGroupMap(s string) (map[string][]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	m := make(map[string][]string)
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" || strings.TrimSpace(kv[1]) == "" {
+			return nil, errors.Errorf("invalid group mapping %q, expected external=acl", pair)
+		}
+		ext := strings.TrimSpace(kv[0])
+		m[ext] = append(m[ext], strings.TrimSpace(kv[1]))
+	}
+	return m, nil
+}
+
+// mapGroups maps the groups of an identity provider to ACL groups. Without a group map, the
+// groups are used as they are, except guardians, which is only granted by an explicit mapping.
+func mapGroups(groups []string) []string {
+	var mapped []string
+	seen := make(map[string]struct{})
+	add := func(group string) {
+		if _, ok := seen[group]; !ok {
+			seen[group] = struct{}{}
+			mapped = append(mapped, group)
+		}
+	}
+	for _, group := range groups {
+		switch {
+		case groupMap != nil:
+			for _, g := range groupMap[group] {
+				add(g)
+			}
+		case group != x.GuardiansId:
+			add(group)
+		}
+	}
+	return mapped
+}
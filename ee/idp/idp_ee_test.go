@@ -0,0 +1,38 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package idp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapGroups(t *testing.T) {
+	defer func() { groupMap = nil }()
+
+	// Without a group map, the groups are used as they are, except guardians.
+	require.Equal(t, []string{"dev", "ops"}, mapGroups([]string{"dev", "guardians", "ops", "dev"}))
+
+	var err error
+	groupMap, err = parseGroupMap("admins=guardians, eng=dev,eng=ops")
+	require.NoError(t, err)
+	require.Equal(t, []string{"guardians", "dev", "ops"},
+		mapGroups([]string{"admins", "eng", "sales"}))
+	require.Empty(t, mapGroups([]string{"sales"}))
+
+	for _, m := range []string{"admins", "admins=", "=dev", "eng=dev,"} {
+		_, err = parseGroupMap(m)
+		require.Error(t, err, m)
+	}
+}
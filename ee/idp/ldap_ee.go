@@ -0,0 +1,475 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package idp
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The LDAPv3 (RFC 4511) operations and result codes used to bind as a user and to read its groups.
+const (
+	ldapBindRequest       = 0
+	ldapBindResponse      = 1
+	ldapUnbindRequest     = 2
+	ldapSearchRequest     = 3
+	ldapSearchResultEntry = 4
+	ldapSearchResultDone  = 5
+
+	ldapSuccess            = 0
+	ldapInvalidCredentials = 49
+
+	// ldapMaxMessageSize bounds the size of a response, so that a broken server can't exhaust
+	// the memory.
+	ldapMaxMessageSize = 1 << 20
+)
+
+// ldapAuthenticator authenticates a user by a simple bind to the DN of the user, then reads the
+// groups of the user from its entry.
+type ldapAuthenticator struct {
+	conf LDAPConfig
+	addr string
+	tls  bool
+}
+
+func newLDAPAuthenticator(conf LDAPConfig) (*ldapAuthenticator, error) {
+	u, err := url.Parse(conf.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid LDAP URL %s", conf.URL)
+	}
+	a := &ldapAuthenticator{conf: conf, addr: u.Host}
+	switch u.Scheme {
+	case "ldap":
+		if u.Port() == "" {
+			a.addr = net.JoinHostPort(u.Hostname(), "389")
+		}
+	case "ldaps":
+		if u.Port() == "" {
+			a.addr = net.JoinHostPort(u.Hostname(), "636")
+		}
+		a.tls = true
+	default:
+		return nil, errors.Errorf("invalid LDAP URL %s, expected ldap:// or ldaps://", conf.URL)
+	}
+	if strings.Count(conf.UserDN, "%s") != 1 {
+		return nil, errors.Errorf("the LDAP user DN %q should hold one %%s for the user id",
+			conf.UserDN)
+	}
+	if a.conf.GroupAttr == "" {
+		a.conf.GroupAttr = "memberOf"
+	}
+	if a.conf.Timeout == 0 {
+		a.conf.Timeout = 10 * time.Second
+	}
+	return a, nil
+}
+
+func (a *ldapAuthenticator) Name() string {
+	return "LDAP " + a.conf.URL
+}
+
+// Authenticate binds as the user, and reads its groups. The ID tokens, which come without a user
+// id, are left to the other identity providers.
+func (a *ldapAuthenticator) Authenticate(ctx context.Context, userId,
+	password string) (*Identity, error) {
+	if userId == "" {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.conf.Timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", a.addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while connecting to %s", a.addr)
+	}
+	if a.tls {
+		host, _, _ := net.SplitHostPort(a.addr)
+		conn = tls.Client(conn, &tls.Config{ServerName: host})
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	c := &ldapConn{rw: conn, r: bufio.NewReader(conn)}
+	defer c.unbind()
+	dn := fmt.Sprintf(a.conf.UserDN, escapeDN(userId))
+	ok, err := c.bind(dn, password)
+	if err != nil || !ok {
+		return nil, err
+	}
+	values, err := c.readAttribute(dn, a.conf.GroupAttr, int(a.conf.Timeout/time.Second))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the groups of %s", dn)
+	}
+	id := &Identity{UserID: userId}
+	for _, v := range values {
+		id.Groups = append(id.Groups, groupName(v))
+	}
+	return id, nil
+}
+
+// ldapConn is a connection to an LDAP server, over which the requests are sent one at a time.
+type ldapConn struct {
+	rw    io.ReadWriter
+	r     *bufio.Reader
+	msgId int
+}
+
+// bind does a simple bind, and returns whether the credentials are valid.
+func (c *ldapConn) bind(dn, password string) (bool, error) {
+	req := berTLV(asn1.ClassApplication, ldapBindRequest, true,
+		berInt(3), berString(dn), berTLV(asn1.ClassContextSpecific, 0, false, []byte(password)))
+	ops, err := c.roundTrip(req, ldapBindResponse)
+	if err != nil {
+		return false, err
+	}
+	code, msg, err := parseLDAPResult(ops[len(ops)-1])
+	switch {
+	case err != nil:
+		return false, err
+	case code == ldapInvalidCredentials:
+		return false, nil
+	case code != ldapSuccess:
+		return false, errors.Errorf("bind failed with result code %d: %s", code, msg)
+	}
+	return true, nil
+}
+
+// readAttribute returns the values of an attribute of the entry of the given DN.
+func (c *ldapConn) readAttribute(dn, attr string, timeLimit int) ([]string, error) {
+	req := berTLV(asn1.ClassApplication, ldapSearchRequest, true,
+		berString(dn),
+		berEnum(0), // baseObject
+		berEnum(0), // neverDerefAliases
+		berInt(0),
+		berInt(timeLimit),
+		mustMarshal(false),
+		berTLV(asn1.ClassContextSpecific, 7, false, []byte("objectClass")), // present filter
+		berTLV(asn1.ClassUniversal, asn1.TagSequence, true, berString(attr)))
+	ops, err := c.roundTrip(req, ldapSearchResultDone)
+	if err != nil {
+		return nil, err
+	}
+	code, msg, err := parseLDAPResult(ops[len(ops)-1])
+	switch {
+	case err != nil:
+		return nil, err
+	case code != ldapSuccess:
+		return nil, errors.Errorf("search failed with result code %d: %s", code, msg)
+	}
+
+	var values []string
+	for _, op := range ops[:len(ops)-1] {
+		if op.tag != ldapSearchResultEntry {
+			continue
+		}
+		vals, err := parseEntryAttribute(op, attr)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, vals...)
+	}
+	return values, nil
+}
+
+func (c *ldapConn) unbind() {
+	c.msgId++
+	_, _ = c.rw.Write(berTLV(asn1.ClassUniversal, asn1.TagSequence, true,
+		berInt(c.msgId), berTLV(asn1.ClassApplication, ldapUnbindRequest, false)))
+}
+
+// roundTrip sends a request, and returns the operations of the responses up to the one of the
+// given tag.
+func (c *ldapConn) roundTrip(req []byte, last int) ([]berElement, error) {
+	c.msgId++
+	msg := berTLV(asn1.ClassUniversal, asn1.TagSequence, true, berInt(c.msgId), req)
+	if _, err := c.rw.Write(msg); err != nil {
+		return nil, err
+	}
+	var ops []berElement
+	for {
+		id, op, err := readLDAPMessage(c.r)
+		if err != nil {
+			return nil, err
+		}
+		if id != c.msgId {
+			return nil, errors.Errorf("unexpected LDAP message id %d, expected %d", id, c.msgId)
+		}
+		if op.class != asn1.ClassApplication {
+			return nil, errors.Errorf("unexpected LDAP operation of class %d", op.class)
+		}
+		ops = append(ops, op)
+		if op.tag == last {
+			return ops, nil
+		}
+	}
+}
+
+// readLDAPMessage reads an LDAPMessage, and returns its id and operation.
+func readLDAPMessage(r *bufio.Reader) (int, berElement, error) {
+	packet, err := readBER(r)
+	if err != nil {
+		return 0, berElement{}, err
+	}
+	msg, _, err := parseBER(packet)
+	if err != nil {
+		return 0, berElement{}, errors.Wrapf(err, "invalid LDAP message")
+	}
+	id, rest, err := parseBERInt(msg.content)
+	if err != nil {
+		return 0, berElement{}, errors.Wrapf(err, "invalid LDAP message id")
+	}
+	op, _, err := parseBER(rest)
+	if err != nil {
+		return 0, berElement{}, errors.Wrapf(err, "invalid LDAP operation")
+	}
+	return id, op, nil
+}
+
+// readBER reads the bytes of a single BER element of definite length.
+func readBER(r *bufio.Reader) ([]byte, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	size := int(header[1])
+	if size&0x80 != 0 {
+		n := size & 0x7f
+		if n == 0 || n > 4 {
+			return nil, errors.Errorf("unsupported BER length of %d bytes", n)
+		}
+		lenBytes := make([]byte, n)
+		if _, err := io.ReadFull(r, lenBytes); err != nil {
+			return nil, err
+		}
+		header = append(header, lenBytes...)
+		size = 0
+		for _, b := range lenBytes {
+			size = size<<8 | int(b)
+		}
+	}
+	if size > ldapMaxMessageSize {
+		return nil, errors.Errorf("LDAP message of %d bytes is too large", size)
+	}
+	packet := make([]byte, len(header)+size)
+	copy(packet, header)
+	if _, err := io.ReadFull(r, packet[len(header):]); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+// berElement is a decoded BER element. The LDAP servers encode the lengths in BER, which isn't
+// always the minimal DER encoding that encoding/asn1 requires, so the responses are decoded here.
+type berElement struct {
+	class    int
+	tag      int
+	compound bool
+	content  []byte
+}
+
+// parseBER decodes the first BER element of b, of a single byte tag, and returns the rest of b.
+func parseBER(b []byte) (berElement, []byte, error) {
+	if len(b) < 2 {
+		return berElement{}, nil, errors.Errorf("truncated BER element")
+	}
+	e := berElement{class: int(b[0] >> 6), compound: b[0]&0x20 != 0, tag: int(b[0] & 0x1f)}
+	if e.tag == 0x1f {
+		return berElement{}, nil, errors.Errorf("unsupported BER tag")
+	}
+	size, b := int(b[1]), b[2:]
+	if size&0x80 != 0 {
+		n := size & 0x7f
+		if n == 0 || n > 4 || n > len(b) {
+			return berElement{}, nil, errors.Errorf("invalid BER length")
+		}
+		size = 0
+		for _, c := range b[:n] {
+			size = size<<8 | int(c)
+		}
+		b = b[n:]
+	}
+	if size > len(b) {
+		return berElement{}, nil, errors.Errorf("truncated BER element")
+	}
+	e.content = b[:size]
+	return e, b[size:], nil
+}
+
+// parseBERInt decodes an INTEGER or ENUMERATED element.
+func parseBERInt(b []byte) (int, []byte, error) {
+	e, rest, err := parseBER(b)
+	if err != nil {
+		return 0, nil, err
+	}
+	if e.class != asn1.ClassUniversal || (e.tag != asn1.TagInteger && e.tag != asn1.TagEnum) ||
+		len(e.content) == 0 || len(e.content) > 4 {
+		return 0, nil, errors.Errorf("invalid BER integer")
+	}
+	i := int(int8(e.content[0]))
+	for _, c := range e.content[1:] {
+		i = i<<8 | int(c)
+	}
+	return i, rest, nil
+}
+
+// parseBERString decodes an OCTET STRING element.
+func parseBERString(b []byte) (string, []byte, error) {
+	e, rest, err := parseBER(b)
+	if err != nil {
+		return "", nil, err
+	}
+	if e.compound {
+		return "", nil, errors.Errorf("unsupported constructed BER string")
+	}
+	return string(e.content), rest, nil
+}
+
+// parseLDAPResult returns the result code and the diagnostic message of an LDAPResult.
+func parseLDAPResult(op berElement) (int, string, error) {
+	code, rest, err := parseBERInt(op.content)
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "invalid LDAP result code")
+	}
+	if _, rest, err = parseBERString(rest); err != nil {
+		return 0, "", errors.Wrapf(err, "invalid LDAP result")
+	}
+	msg, _, err := parseBERString(rest)
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "invalid LDAP result")
+	}
+	return code, msg, nil
+}
+
+// parseEntryAttribute returns the values of an attribute of a SearchResultEntry.
+func parseEntryAttribute(op berElement, attr string) ([]string, error) {
+	_, rest, err := parseBERString(op.content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid LDAP entry")
+	}
+	attrs, _, err := parseBER(rest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid LDAP entry attributes")
+	}
+	var values []string
+	for rest := attrs.content; len(rest) > 0; {
+		var a berElement
+		if a, rest, err = parseBER(rest); err != nil {
+			return nil, errors.Wrapf(err, "invalid LDAP attribute")
+		}
+		typ, vals, err := parseBERString(a.content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid LDAP attribute type")
+		}
+		if !strings.EqualFold(typ, attr) {
+			continue
+		}
+		set, _, err := parseBER(vals)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid LDAP attribute values")
+		}
+		for vs := set.content; len(vs) > 0; {
+			var v string
+			if v, vs, err = parseBERString(vs); err != nil {
+				return nil, errors.Wrapf(err, "invalid LDAP attribute value")
+			}
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+// escapeDN escapes the special characters of an attribute value of a DN, as of RFC 4514.
+func escapeDN(value string) string {
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case strings.IndexByte(`,+"\<>;=`, c) >= 0,
+			c == '#' && i == 0, c == ' ' && (i == 0 || i == len(value)-1):
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case c == 0:
+			sb.WriteString(`\00`)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// groupName returns the value of the first RDN of the DN of a group, like devs for
+// cn=devs,ou=groups,dc=example,dc=org. The values which aren't DNs are group names already.
+func groupName(dn string) string {
+	eq := strings.IndexByte(dn, '=')
+	if eq < 0 {
+		return dn
+	}
+	var sb strings.Builder
+	for i := eq + 1; i < len(dn); i++ {
+		switch c := dn[i]; {
+		case c == '\\' && i+1 < len(dn):
+			i++
+			sb.WriteByte(dn[i])
+		case c == ',' || c == '+':
+			return strings.TrimSpace(sb.String())
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// berTLV encodes a BER element out of the encodings of its children, or out of its content if it
+// is primitive.
+func berTLV(class, tag int, compound bool, content ...[]byte) []byte {
+	var b []byte
+	for _, c := range content {
+		b = append(b, c...)
+	}
+	return mustMarshal(asn1.RawValue{Class: class, Tag: tag, IsCompound: compound, Bytes: b})
+}
+
+func berInt(i int) []byte {
+	return mustMarshal(i)
+}
+
+func berString(s string) []byte {
+	return mustMarshal([]byte(s))
+}
+
+func berEnum(i int) []byte {
+	return mustMarshal(asn1.Enumerated(i))
+}
+
+func mustMarshal(v interface{}) []byte {
+	b, err := asn1.Marshal(v)
+	if err != nil {
+		// The values are all of types asn1 knows how to encode.
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,170 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package idp
+
+import (
+	"bufio"
+	"context"
+	"encoding/asn1"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ldapEntry is an entry of the stand-in directory.
+type ldapEntry struct {
+	password string
+	memberOf []string
+}
+
+// serveLDAP runs a stand-in LDAP directory which supports the simple binds and the base object
+// searches of the given entries, by DN, until its listener is closed.
+func serveLDAP(t *testing.T, entries map[string]ldapEntry) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveLDAPConn(conn, entries)
+		}
+	}()
+	return l
+}
+
+func serveLDAPConn(conn net.Conn, entries map[string]ldapEntry) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	// The responses encode their lengths in the long form, like OpenLDAP does, which isn't DER.
+	reply := func(id int, tag int, content ...[]byte) {
+		var b []byte
+		for _, c := range content {
+			b = append(b, c...)
+		}
+		op := append([]byte{0x60 | byte(tag), 0x84, 0, 0, byte(len(b) >> 8), byte(len(b))}, b...)
+		msg := append(berInt(id), op...)
+		_, _ = conn.Write(append([]byte{0x30, 0x84, 0, 0, byte(len(msg) >> 8), byte(len(msg))},
+			msg...))
+	}
+	result := func(code int, msg string) []byte {
+		return append(append(berEnum(code), berString("")...), berString(msg)...)
+	}
+
+	var bound string
+	for {
+		id, op, err := readLDAPMessage(r)
+		if err != nil {
+			return
+		}
+		switch op.tag {
+		case ldapBindRequest:
+			_, rest, _ := parseBERInt(op.content)
+			dn, rest, _ := parseBERString(rest)
+			password, _, _ := parseBERString(rest)
+			if e, ok := entries[dn]; ok && e.password == password && password != "" {
+				bound = dn
+				reply(id, ldapBindResponse, result(ldapSuccess, ""))
+			} else {
+				reply(id, ldapBindResponse, result(ldapInvalidCredentials, "invalid credentials"))
+			}
+		case ldapSearchRequest:
+			dn, _, _ := parseBERString(op.content)
+			e, ok := entries[dn]
+			if !ok || bound != dn {
+				reply(id, ldapSearchResultDone, result(32, "no such object"))
+				continue
+			}
+			var vals [][]byte
+			for _, v := range e.memberOf {
+				vals = append(vals, berString(v))
+			}
+			attr := berTLV(asn1.ClassUniversal, asn1.TagSequence, true, berString("memberOf"),
+				berTLV(asn1.ClassUniversal, asn1.TagSet, true, vals...))
+			reply(id, ldapSearchResultEntry, berString(dn),
+				berTLV(asn1.ClassUniversal, asn1.TagSequence, true, attr))
+			reply(id, ldapSearchResultDone, result(ldapSuccess, ""))
+		case ldapUnbindRequest:
+			return
+		}
+	}
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	l := serveLDAP(t, map[string]ldapEntry{
+		"uid=alice,ou=people,dc=example,dc=org": {
+			password: "secret",
+			memberOf: []string{"cn=dev,ou=groups,dc=example,dc=org",
+				`cn=ops\, east,ou=groups,dc=example,dc=org`},
+		},
+		`uid=bob\,admin,ou=people,dc=example,dc=org`: {password: "password"},
+	})
+	defer l.Close()
+	a, err := newLDAPAuthenticator(LDAPConfig{
+		URL:    "ldap://" + l.Addr().String(),
+		UserDN: "uid=%s,ou=people,dc=example,dc=org",
+	})
+	require.NoError(t, err)
+
+	id, err := a.Authenticate(context.Background(), "alice", "secret")
+	require.NoError(t, err)
+	require.Equal(t, &Identity{UserID: "alice", Groups: []string{"dev", "ops, east"}}, id)
+
+	id, err = a.Authenticate(context.Background(), "bob,admin", "password")
+	require.NoError(t, err)
+	require.Equal(t, &Identity{UserID: "bob,admin"}, id)
+
+	id, err = a.Authenticate(context.Background(), "alice", "wrong")
+	require.NoError(t, err)
+	require.Nil(t, id)
+
+	id, err = a.Authenticate(context.Background(), "carol", "secret")
+	require.NoError(t, err)
+	require.Nil(t, id)
+
+	// The ID tokens are left to the other identity providers.
+	id, err = a.Authenticate(context.Background(), "", "token")
+	require.NoError(t, err)
+	require.Nil(t, id)
+}
+
+func TestNewLDAPAuthenticator(t *testing.T) {
+	a, err := newLDAPAuthenticator(LDAPConfig{URL: "ldaps://ldap.example.org",
+		UserDN: "uid=%s,dc=example,dc=org"})
+	require.NoError(t, err)
+	require.Equal(t, "ldap.example.org:636", a.addr)
+	require.True(t, a.tls)
+	require.Equal(t, "memberOf", a.conf.GroupAttr)
+
+	_, err = newLDAPAuthenticator(LDAPConfig{URL: "http://ldap.example.org",
+		UserDN: "uid=%s,dc=example,dc=org"})
+	require.Error(t, err)
+	_, err = newLDAPAuthenticator(LDAPConfig{URL: "ldap://ldap.example.org",
+		UserDN: "dc=example,dc=org"})
+	require.Error(t, err)
+}
+
+func TestEscapeDN(t *testing.T) {
+	require.Equal(t, "alice", escapeDN("alice"))
+	require.Equal(t, `bob\,admin\=1`, escapeDN("bob,admin=1"))
+	require.Equal(t, `\# x\ `, escapeDN("# x "))
+}
+
+func TestGroupName(t *testing.T) {
+	require.Equal(t, "dev", groupName("cn=dev,ou=groups,dc=example,dc=org"))
+	require.Equal(t, "ops, east", groupName(`CN=ops\, east,OU=groups`))
+	require.Equal(t, "dev", groupName("dev"))
+}
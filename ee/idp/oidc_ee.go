@@ -0,0 +1,184 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package idp
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/pkg/errors"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// jwksRefreshInterval is the shortest interval between two loads of the JWKS, which is reloaded
+// when an ID token is signed by a key it doesn't hold, so that the keys can be rotated.
+const jwksRefreshInterval = time.Minute
+
+// oidcAlgorithms are the signing algorithms of the ID tokens, whose keys are all public keys.
+var oidcAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256",
+	"ES384", "ES512"}
+
+// oidcAuthenticator authenticates a user by an ID token of an OpenID Connect provider.
+type oidcAuthenticator struct {
+	conf   OIDCConfig
+	client *http.Client
+
+	sync.Mutex
+	keys     *jose.JSONWebKeySet
+	loadedAt time.Time
+}
+
+func newOIDCAuthenticator(conf OIDCConfig) (*oidcAuthenticator, error) {
+	if conf.Issuer == "" || conf.Audience == "" {
+		return nil, errors.Errorf("the OIDC issuer and audience should be set along with the JWKS")
+	}
+	if conf.UserClaim == "" {
+		conf.UserClaim = "sub"
+	}
+	if conf.GroupsClaim == "" {
+		conf.GroupsClaim = "groups"
+	}
+	a := &oidcAuthenticator{conf: conf, client: &http.Client{Timeout: 10 * time.Second}}
+	if _, err := a.keySet(false); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *oidcAuthenticator) Name() string {
+	return "OIDC " + a.conf.Issuer
+}
+
+// Authenticate verifies the ID token given as the secret of a login without a user id, and
+// returns the user and the groups of its claims.
+func (a *oidcAuthenticator) Authenticate(ctx context.Context, userId,
+	token string) (*Identity, error) {
+	if userId != "" {
+		return nil, nil
+	}
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, &claims, a.key, jwt.WithValidMethods(oidcAlgorithms),
+		jwt.WithIssuer(a.conf.Issuer), jwt.WithAudience(a.conf.Audience))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid ID token")
+	}
+	// The claims are only validated if they are present, but an ID token must have them.
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.Errorf("invalid ID token: exp is missing")
+	}
+	if _, ok := claims["aud"]; !ok {
+		return nil, errors.Errorf("invalid ID token: aud is missing")
+	}
+
+	user, ok := claims[a.conf.UserClaim].(string)
+	if !ok || user == "" {
+		return nil, errors.Errorf("invalid ID token: %s is not a string", a.conf.UserClaim)
+	}
+	id := &Identity{UserID: user}
+	switch groups := claims[a.conf.GroupsClaim].(type) {
+	case nil:
+	case string:
+		id.Groups = []string{groups}
+	case []interface{}:
+		for _, g := range groups {
+			group, ok := g.(string)
+			if !ok {
+				return nil, errors.Errorf("invalid ID token: %s holds %v, which is not a string",
+					a.conf.GroupsClaim, g)
+			}
+			id.Groups = append(id.Groups, group)
+		}
+	default:
+		return nil, errors.Errorf("invalid ID token: %s is not a list of strings",
+			a.conf.GroupsClaim)
+	}
+	return id, nil
+}
+
+// key returns the key of the JWKS which signed the token, by its kid. A JWKS of a single key
+// also verifies the tokens without a kid.
+func (a *oidcAuthenticator) key(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	find := func(keys *jose.JSONWebKeySet) interface{} {
+		if kid == "" {
+			if len(keys.Keys) == 1 {
+				return keys.Keys[0].Key
+			}
+			return nil
+		}
+		if k := keys.Key(kid); len(k) > 0 {
+			return k[0].Key
+		}
+		return nil
+	}
+
+	keys, err := a.keySet(false)
+	if err != nil {
+		return nil, err
+	}
+	if k := find(keys); k != nil {
+		return k, nil
+	}
+	if keys, err = a.keySet(true); err != nil {
+		return nil, err
+	}
+	if k := find(keys); k != nil {
+		return k, nil
+	}
+	return nil, errors.Errorf("no key of the JWKS has the kid %q", kid)
+}
+
+// keySet returns the JWKS, which is loaded again if reload is true and it was last loaded long
+// enough ago.
+func (a *oidcAuthenticator) keySet(reload bool) (*jose.JSONWebKeySet, error) {
+	a.Lock()
+	defer a.Unlock()
+	if a.keys != nil && (!reload || time.Since(a.loadedAt) < jwksRefreshInterval) {
+		return a.keys, nil
+	}
+
+	var data []byte
+	var err error
+	if strings.HasPrefix(a.conf.JWKS, "http://") || strings.HasPrefix(a.conf.JWKS, "https://") {
+		data, err = a.fetch(a.conf.JWKS)
+	} else {
+		data, err = ioutil.ReadFile(a.conf.JWKS)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading the JWKS %s", a.conf.JWKS)
+	}
+	keys := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal(data, keys); err != nil {
+		return nil, errors.Wrapf(err, "while parsing the JWKS %s", a.conf.JWKS)
+	}
+	a.keys, a.loadedAt = keys, time.Now()
+	return keys, nil
+}
+
+func (a *oidcAuthenticator) fetch(url string) ([]byte, error) {
+	resp, err := a.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %s", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
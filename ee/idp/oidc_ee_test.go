@@ -0,0 +1,155 @@
+// +build !oss
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. All rights reserved.
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package idp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// signIdToken signs an ID token of the given claims, with the issuer and the audience of the tests
+// unless they are given.
+func signIdToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	base := jwt.MapClaims{
+		"iss": "https://idp.example.org",
+		"aud": []string{"dgraph"},
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		if v == nil {
+			delete(base, k)
+		} else {
+			base[k] = v
+		}
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, base)
+	token.Header["kid"] = kid
+	s, err := token.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func jwks(t *testing.T, kid string, key *rsa.PrivateKey) []byte {
+	b, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+		Key: &key.PublicKey, KeyID: kid, Algorithm: "RS256", Use: "sig"}}})
+	require.NoError(t, err)
+	return b
+}
+
+func TestOIDCAuthenticate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "jwks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	jwksFile := filepath.Join(dir, "jwks.json")
+	require.NoError(t, ioutil.WriteFile(jwksFile, jwks(t, "k1", key), 0600))
+
+	a, err := newOIDCAuthenticator(OIDCConfig{
+		Issuer:    "https://idp.example.org",
+		Audience:  "dgraph",
+		JWKS:      jwksFile,
+		UserClaim: "email",
+	})
+	require.NoError(t, err)
+
+	token := signIdToken(t, key, "k1", jwt.MapClaims{"email": "alice@example.org",
+		"groups": []string{"dev", "ops"}})
+	id, err := a.Authenticate(context.Background(), "", token)
+	require.NoError(t, err)
+	require.Equal(t, &Identity{UserID: "alice@example.org", Groups: []string{"dev", "ops"}}, id)
+
+	// The logins with a user id are left to the other identity providers.
+	id, err = a.Authenticate(context.Background(), "alice", token)
+	require.NoError(t, err)
+	require.Nil(t, id)
+
+	invalid := []jwt.MapClaims{
+		{"email": "alice@example.org", "iss": "https://other.example.org"},
+		{"email": "alice@example.org", "aud": "other"},
+		{"email": "alice@example.org", "aud": nil},
+		{"email": "alice@example.org", "exp": nil},
+		{"email": "alice@example.org", "exp": time.Now().Add(-time.Hour).Unix()},
+		{"email": "alice@example.org", "groups": 1},
+		{"sub": "alice"},
+	}
+	for _, claims := range invalid {
+		_, err = a.Authenticate(context.Background(), "", signIdToken(t, key, "k1", claims))
+		require.Error(t, err, "%v", claims)
+	}
+
+	// A token signed by a key the JWKS doesn't hold is rejected, even with a known kid.
+	_, err = a.Authenticate(context.Background(), "",
+		signIdToken(t, other, "k1", jwt.MapClaims{"email": "alice@example.org"}))
+	require.Error(t, err)
+
+	// The tokens signed with the HMAC of the public key are rejected.
+	hmacToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"email": "alice@example.org", "iss": "https://idp.example.org", "aud": "dgraph",
+		"exp": time.Now().Add(time.Hour).Unix()})
+	hmacToken.Header["kid"] = "k1"
+	s, err := hmacToken.SignedString(jwks(t, "k1", key))
+	require.NoError(t, err)
+	_, err = a.Authenticate(context.Background(), "", s)
+	require.Error(t, err)
+}
+
+func TestOIDCJWKSRotation(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rotated, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keys := jwks(t, "k1", key)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(keys)
+	}))
+	defer srv.Close()
+
+	a, err := newOIDCAuthenticator(OIDCConfig{
+		Issuer:   "https://idp.example.org",
+		Audience: "dgraph",
+		JWKS:     srv.URL,
+	})
+	require.NoError(t, err)
+	id, err := a.Authenticate(context.Background(), "",
+		signIdToken(t, key, "k1", jwt.MapClaims{"sub": "alice", "groups": "dev"}))
+	require.NoError(t, err)
+	require.Equal(t, &Identity{UserID: "alice", Groups: []string{"dev"}}, id)
+
+	// The JWKS is loaded again for an unknown kid once it was last loaded long enough ago.
+	keys = jwks(t, "k2", rotated)
+	token := signIdToken(t, rotated, "k2", jwt.MapClaims{"sub": "alice"})
+	_, err = a.Authenticate(context.Background(), "", token)
+	require.Error(t, err)
+	a.loadedAt = a.loadedAt.Add(-jwksRefreshInterval)
+	id, err = a.Authenticate(context.Background(), "", token)
+	require.NoError(t, err)
+	require.Equal(t, &Identity{UserID: "alice"}, id)
+}
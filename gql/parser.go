@@ -70,6 +70,7 @@ type GraphQuery struct {
 	ShortestPathArgs ShortestPathArgs
 	Cascade          []string
 	IgnoreReflex     bool
+	Lock             bool
 	Facets           *pb.FacetParams
 	FacetsFilter     *FilterTree
 	GroupbyAttrs     []GroupByAttr
@@ -78,6 +79,9 @@ type GraphQuery struct {
 
 	// Used for ACL enabled queries to curtail results to only accessible params
 	AllowedPreds []string
+	// PolicyFilter is the filter of the node-level policies of the user on an expand() node, for
+	// ACL enabled queries. It is added to the uid predicates expanded by expand().
+	PolicyFilter *FilterTree
 
 	// Internal fields below.
 	// If gq.fragment is nonempty, then it is a fragment reference / spread.
@@ -969,6 +973,8 @@ L:
 				}
 			case "ignorereflex":
 				gq.IgnoreReflex = true
+			case "lock":
+				gq.Lock = true
 			case "recurse":
 				gq.Recurse = true
 				if err := parseRecurseArgs(it, gq); err != nil {
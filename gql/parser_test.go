@@ -3123,6 +3123,25 @@ func TestParseNormalize(t *testing.T) {
 	require.True(t, res.Query[0].Normalize)
 }
 
+func TestParseLock(t *testing.T) {
+	query := `
+	query {
+		me(func: eq(email, "alice@example.com")) @lock {
+			v as uid
+			balance
+		}
+		other(func: uid(v)) {
+			name
+		}
+}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Len(t, res.Query, 2)
+	require.True(t, res.Query[0].Lock)
+	require.False(t, res.Query[1].Lock)
+}
+
 func TestParseGroupbyRoot(t *testing.T) {
 	query := `
 	query {
@@ -18,18 +18,23 @@ func NewAddGroupRewriter() resolve.MutationRewriter {
 
 // Rewrite rewrites schema.Mutation into dql upsert mutations only for Group type.
 // It ensures that only the last rule out of all duplicate rules in input is preserved.
-// A rule is duplicate if it has same predicate name as another rule.
+// A rule is duplicate if it has same predicate name as another rule. The same goes for the
+// policies, a policy being duplicate if it has the same type as another policy.
 func (mrw *addGroupRewriter) Rewrite(
 	ctx context.Context,
 	m schema.Mutation) ([]*resolve.UpsertMutation, error) {
 
 	addGroupInput, _ := m.ArgValue(schema.InputArgName).([]interface{})
 
-	// remove rules with same predicate name for each group input
+	// remove rules with same predicate name, and policies with same type, for each group input
 	for i, groupInput := range addGroupInput {
 		rules, _ := groupInput.(map[string]interface{})["rules"].([]interface{})
-		rules, _ = removeDuplicateRuleRef(rules)
+		rules, _ = removeDuplicateRefs(rules, "predicate")
 		addGroupInput[i].(map[string]interface{})["rules"] = rules
+		if policies, ok := groupInput.(map[string]interface{})["policies"].([]interface{}); ok {
+			policies, _ = removeDuplicateRefs(policies, "type")
+			addGroupInput[i].(map[string]interface{})["policies"] = policies
+		}
 	}
 
 	m.SetArgTo(schema.InputArgName, addGroupInput)
@@ -47,18 +52,19 @@ func (mrw *addGroupRewriter) FromMutationResult(
 	return ((*resolve.AddRewriter)(mrw)).FromMutationResult(ctx, mutation, assigned, result)
 }
 
-// removeDuplicateRuleRef removes duplicate rules based on predicate value.
+// removeDuplicateRefs removes duplicate rules based on predicate value, or duplicate policies
+// based on type value, as given by key.
 // for duplicate rules, only the last rule with duplicate predicate name is preserved.
-func removeDuplicateRuleRef(rules []interface{}) ([]interface{}, x.GqlErrorList) {
+func removeDuplicateRefs(rules []interface{}, key string) ([]interface{}, x.GqlErrorList) {
 	var errs x.GqlErrorList
 	predicateMap := make(map[string]int, len(rules))
 	i := 0
 
 	for j, rule := range rules {
-		predicate, _ := rule.(map[string]interface{})["predicate"].(string)
+		predicate, _ := rule.(map[string]interface{})[key].(string)
 
 		if predicate == "" {
-			errs = appendEmptyPredicateError(errs, j)
+			errs = appendEmptyValueError(errs, key, j)
 			continue
 		}
 
@@ -75,8 +81,8 @@ func removeDuplicateRuleRef(rules []interface{}) ([]interface{}, x.GqlErrorList)
 	return rules[:i], errs
 }
 
-func appendEmptyPredicateError(errs x.GqlErrorList, i int) x.GqlErrorList {
-	err := fmt.Errorf("at index %d: predicate value can't be empty string", i)
+func appendEmptyValueError(errs x.GqlErrorList, key string, i int) x.GqlErrorList {
+	err := fmt.Errorf("at index %d: %s value can't be empty string", i, key)
 	errs = append(errs, schema.AsGQLErrors(err)...)
 
 	return errs
@@ -30,6 +30,7 @@ import (
 	"github.com/dgraph-io/dgraph/graphql/resolve"
 	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/graphql/web"
+	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/worker"
@@ -165,6 +166,7 @@ const (
 		lastUpdate: Int
 		clusterInfoOnly: Boolean
 		forceGroupId: Boolean
+		zone: String
 	}
 
 	type Tablet {
@@ -231,6 +233,11 @@ const (
 		Set to true to allow backing up to S3 or Minio bucket that requires no credentials.
 		"""
 		anonymous: Boolean
+
+		"""
+		The namespace to export (default: 0, the galaxy namespace)
+		"""
+		namespace: Int
 	}
 
 	type Response {
@@ -251,6 +258,33 @@ const (
 		response: Response
 	}
 
+	"""
+	An IndexBuild is the progress of an index build running in the background on this node.
+	"""
+	type IndexBuild {
+		predicate: String
+
+		"""
+		The index being built, and whether it is being built or written to disk.
+		"""
+		stage: String
+
+		"""
+		Number of keys processed in the current stage.
+		"""
+		keysProcessed: Int
+
+		startedAt: DateTime
+	}
+
+	type CancelIndexBuildPayload {
+		response: Response
+	}
+
+	type NamespacePayload {
+		response: Response
+	}
+
 	input ConfigInput {
 		"""
 		Estimated memory the caches can take. Actual usage by the process would be
@@ -283,6 +317,11 @@ const (
 		config: Config
 		getAllowedCORSOrigins: Cors
 		querySchemaHistory(first: Int, offset: Int): [SchemaHistory]
+
+		"""
+		List the index builds running in the background on this node.
+		"""
+		indexBuilds: [IndexBuild]
 		` + adminQueries + `
 	}
 
@@ -291,6 +330,7 @@ const (
 		"""
 		Update the Dgraph cluster to serve the input schema.  This may change the GraphQL
 		schema, the types and predicates in the Dgraph schema, and cause indexes to be recomputed.
+		The schema of the namespace of the request is updated.
 		"""
 		updateGQLSchema(input: UpdateGQLSchemaInput!) : UpdateGQLSchemaPayload
 
@@ -318,6 +358,24 @@ const (
 
 		replaceAllowedCORSOrigins(origins: [String]): Cors
 
+		"""
+		Cancel the index build running in the background for a predicate, on the group serving it.
+		The schema that was served while building the index is kept.
+		"""
+		cancelIndexBuild(predicate: String!): CancelIndexBuildPayload
+
+		"""
+		Add a namespace, whose predicates, types and ACL users are isolated from the other
+		namespaces. When ACLs are enabled, the groot user of the namespace is created with the
+		given password.
+		"""
+		addNamespace(namespace: Int!, password: String): NamespacePayload
+
+		"""
+		Delete a namespace, and drop all its data and schema.
+		"""
+		deleteNamespace(namespace: Int!): NamespacePayload
+
 		` + adminMutations + `
 	}
  `
@@ -338,31 +396,51 @@ var (
 		resolve.GuardianAuthMW4Mutation,
 		resolve.LoggingMWMutation,
 	}
+	// namespaceAdminQueryMWs and namespaceAdminMutationMWs are applied to the queries and the
+	// mutations which only apply to the namespace of the request, and so are allowed to the
+	// guardians of every namespace
+	namespaceAdminQueryMWs = resolve.QueryMiddlewares{
+		resolve.IpWhitelistingMW4Query,
+		resolve.NamespaceGuardianAuthMW4Query,
+		resolve.LoggingMWQuery,
+	}
+	namespaceAdminMutationMWs = resolve.MutationMiddlewares{
+		resolve.IpWhitelistingMW4Mutation,
+		resolve.NamespaceGuardianAuthMW4Mutation,
+		resolve.LoggingMWMutation,
+	}
 	adminQueryMWConfig = map[string]resolve.QueryMiddlewares{
 		"health":       {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery}, // dgraph checks Guardian auth for health
 		"state":        {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery}, // dgraph checks Guardian auth for state
 		"config":       commonAdminQueryMWs,
 		"listBackups":  commonAdminQueryMWs,
-		"getGQLSchema": commonAdminQueryMWs,
+		"getGQLSchema": namespaceAdminQueryMWs,
+		"indexBuilds":  commonAdminQueryMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"queryGroup":            {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
 		"queryUser":             {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
 		"getGroup":              {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
+		"getApiKey":             {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
+		"queryApiKey":           {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
 		"getCurrentUser":        {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
 		"getUser":               {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
 		"querySchemaHistory":    {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
 		"getAllowedCORSOrigins": {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
 	}
 	adminMutationMWConfig = map[string]resolve.MutationMiddlewares{
-		"backup":          commonAdminMutationMWs,
-		"config":          commonAdminMutationMWs,
-		"draining":        commonAdminMutationMWs,
-		"export":          commonAdminMutationMWs,
-		"login":           {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
-		"restore":         commonAdminMutationMWs,
-		"shutdown":        commonAdminMutationMWs,
-		"updateGQLSchema": commonAdminMutationMWs,
+		"addNamespace":        commonAdminMutationMWs,
+		"backup":              commonAdminMutationMWs,
+		"cancelIndexBuild":    commonAdminMutationMWs,
+		"config":              commonAdminMutationMWs,
+		"deleteNamespace":     commonAdminMutationMWs,
+		"draining":            commonAdminMutationMWs,
+		"export":              commonAdminMutationMWs,
+		"login":               {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
+		"restore":             commonAdminMutationMWs,
+		"rotateEncryptionKey": commonAdminMutationMWs,
+		"shutdown":            commonAdminMutationMWs,
+		"updateGQLSchema":     namespaceAdminMutationMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"addUser":                   {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
@@ -371,6 +449,8 @@ var (
 		"updateGroup":               {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
 		"deleteUser":                {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
 		"deleteGroup":               {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
+		"addApiKey":                 {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
+		"deleteApiKey":              {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
 		"replaceAllowedCORSOrigins": {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
 	}
 	// mainHealthStore stores the health of the main GraphQL server.
@@ -432,7 +512,11 @@ type adminServer struct {
 	// The GraphQL server that's being admin'd
 	gqlServer web.IServeGraphQL
 
-	schema *gqlSchema
+	// schemas holds the GraphQL schemas of the namespaces served by gqlServer. The schema of the
+	// galaxy namespace is loaded when the server starts, and the schemas of the other namespaces
+	// the first time a request is sent to them.
+	schemas map[uint64]*gqlSchema
+	closer  *z.Closer
 
 	// When the schema changes, we use these to create a new RequestResolver for
 	// the main graphql endpoint (gqlServer) and thus refresh the API.
@@ -488,20 +572,34 @@ func newAdminResolver(
 		fns:               fns,
 		withIntrospection: withIntrospection,
 		globalEpoch:       epoch,
+		schemas:           make(map[uint64]*gqlSchema),
+		closer:            closer,
 	}
 
-	prefix := x.DataKey(worker.GqlSchemaPred, 0)
+	// Listen for graphql schema changes of the galaxy namespace in group 1.
+	go server.subscribeSchema(x.GalaxyNamespace)
+	gqlServer.LoadNamespacesWith(server.loadNamespace)
+
+	go server.initServer()
+
+	return server.resolver
+}
+
+// subscribeSchema listens for the changes of the GraphQL schema of the namespace ns, and serves
+// the new schema on the main endpoint. The reserved predicates of every namespace are served by
+// group 1.
+func (as *adminServer) subscribeSchema(ns uint64) {
+	prefix := x.DataKey(x.NamespaceAttr(ns, worker.GqlSchemaPred), 0)
 	// Remove uid from the key, to get the correct prefix
 	prefix = prefix[:len(prefix)-8]
-	// Listen for graphql schema changes in group 1.
-	go worker.SubscribeForUpdates([][]byte{prefix}, func(kvs *badgerpb.KVList) {
+	worker.SubscribeForUpdates([][]byte{prefix}, func(kvs *badgerpb.KVList) {
 
 		kv := x.KvWithMaxVersion(kvs, [][]byte{prefix}, "GraphQL Schema Subscription")
-		glog.Infof("Updating GraphQL schema from subscription.")
+		glog.Infof("Updating GraphQL schema of namespace %d from subscription.", ns)
 
-		// Unmarshal the incoming posting list.
+		// Unmarshal the incoming posting list, which may be compressed as per its @storage hint.
 		pl := &pb.PostingList{}
-		err := pl.Unmarshal(kv.GetValue())
+		err := posting.UnmarshalPostingList(kv.GetValue(), pl)
 		if err != nil {
 			glog.Errorf("Unable to unmarshal the posting list for graphql schema update %s", err)
 			return
@@ -525,13 +623,14 @@ func newAdminResolver(
 			Version: kv.GetVersion(),
 			Schema:  string(pl.Postings[0].Value),
 		}
-		server.mux.RLock()
-		if newSchema.Version <= server.schema.Version || newSchema.Schema == server.schema.Schema {
-			glog.Infof("Skipping GraphQL schema update, new badger key version is %d, the old version was %d.", newSchema.Version, server.schema.Version)
-			server.mux.RUnlock()
+		as.mux.RLock()
+		if old, ok := as.schemas[ns]; ok &&
+			(newSchema.Version <= old.Version || newSchema.Schema == old.Schema) {
+			glog.Infof("Skipping GraphQL schema update, new badger key version is %d, the old version was %d.", newSchema.Version, old.Version)
+			as.mux.RUnlock()
 			return
 		}
-		server.mux.RUnlock()
+		as.mux.RUnlock()
 		var gqlSchema schema.Schema
 		// on drop_all, we will receive an empty string as the schema update
 		if newSchema.Schema != "" {
@@ -542,30 +641,79 @@ func newAdminResolver(
 			}
 		}
 
-		server.mux.Lock()
-		defer server.mux.Unlock()
+		as.mux.Lock()
+		defer as.mux.Unlock()
 
-		server.schema = newSchema
-		server.resetSchema(gqlSchema)
+		as.schemas[ns] = newSchema
+		as.resetSchema(ns, gqlSchema)
 
-		glog.Infof("Successfully updated GraphQL schema. Serving New GraphQL API.")
-	}, 1, closer)
+		glog.Infof("Successfully updated GraphQL schema of namespace %d. Serving New GraphQL API.",
+			ns)
+	}, 1, as.closer)
+}
 
-	go server.initServer()
+// loadNamespace serves the GraphQL schema of the namespace ns on the main endpoint, and listens
+// for its changes. It waits for the server to be initialized.
+func (as *adminServer) loadNamespace(ns uint64) error {
+	as.mux.Lock()
+	defer as.mux.Unlock()
+	if _, ok := as.schemas[ns]; ok {
+		// loaded by a concurrent request
+		return nil
+	}
 
-	return server.resolver
+	// The changes made while the schema is read are received once the lock is released.
+	as.closer.AddRunning(1)
+	go as.subscribeSchema(ns)
+
+	sch, err := getCurrentGraphQLSchema(ns)
+	if err != nil {
+		return err
+	}
+	as.schemas[ns] = sch
+
+	var generatedSchema schema.Schema
+	if sch.Schema != "" {
+		if generatedSchema, err = generateGQLSchema(sch); err != nil {
+			glog.Errorf("Error processing GraphQL schema of namespace %d: %s.", ns, err)
+		}
+	}
+	as.resetSchema(ns, generatedSchema)
+	return nil
+}
+
+// schemaOf returns the GraphQL schema of the namespace ns, which is loaded if it isn't served yet.
+func (as *adminServer) schemaOf(ns uint64) (*gqlSchema, error) {
+	as.mux.RLock()
+	sch, ok := as.schemas[ns]
+	as.mux.RUnlock()
+	if ok {
+		return sch, nil
+	}
+	if err := as.loadNamespace(ns); err != nil {
+		return nil, err
+	}
+
+	as.mux.RLock()
+	defer as.mux.RUnlock()
+	return as.schemas[ns], nil
 }
 
 func newAdminResolverFactory() resolve.ResolverFactory {
 
 	adminMutationResolvers := map[string]resolve.MutationResolverFunc{
-		"backup":   resolveBackup,
-		"config":   resolveUpdateConfig,
-		"draining": resolveDraining,
-		"export":   resolveExport,
-		"login":    resolveLogin,
-		"restore":  resolveRestore,
-		"shutdown": resolveShutdown,
+		"addApiKey":           resolveAddApiKey,
+		"addNamespace":        resolveAddNamespace,
+		"backup":              resolveBackup,
+		"cancelIndexBuild":    resolveCancelIndexBuild,
+		"config":              resolveUpdateConfig,
+		"deleteNamespace":     resolveDeleteNamespace,
+		"draining":            resolveDraining,
+		"export":              resolveExport,
+		"login":               resolveLogin,
+		"restore":             resolveRestore,
+		"rotateEncryptionKey": resolveRotateEncryptionKey,
+		"shutdown":            resolveShutdown,
 	}
 
 	rf := resolverFactoryWithErrorMsg(errResolverNotFound).
@@ -583,6 +731,9 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 		WithQueryResolver("listBackups", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveListBackups)
 		}).
+		WithQueryResolver("indexBuilds", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveIndexBuilds)
+		}).
 		WithMutationResolver("updateGQLSchema", func(m schema.Mutation) resolve.MutationResolver {
 			return resolve.MutationResolverFunc(
 				func(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
@@ -628,8 +779,8 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 	return rf.WithSchemaIntrospection()
 }
 
-func getCurrentGraphQLSchema() (*gqlSchema, error) {
-	uid, graphQLSchema, err := edgraph.GetGQLSchema()
+func getCurrentGraphQLSchema(ns uint64) (*gqlSchema, error) {
+	uid, graphQLSchema, err := edgraph.GetGQLSchema(ns)
 	if err != nil {
 		return nil, err
 	}
@@ -669,13 +820,13 @@ func (as *adminServer) initServer() {
 	for {
 		<-time.After(waitFor)
 
-		sch, err := getCurrentGraphQLSchema()
+		sch, err := getCurrentGraphQLSchema(x.GalaxyNamespace)
 		if err != nil {
 			glog.Infof("Error reading GraphQL schema: %s.", err)
 			continue
 		}
 
-		as.schema = sch
+		as.schemas[x.GalaxyNamespace] = sch
 		// adding the actual resolvers for updateGQLSchema and getGQLSchema only after server has
 		// current GraphQL schema, if there was any.
 		as.addConnectedAdminResolvers()
@@ -692,7 +843,7 @@ func (as *adminServer) initServer() {
 			break
 		}
 
-		as.resetSchema(generatedSchema)
+		as.resetSchema(x.GalaxyNamespace, generatedSchema)
 
 		glog.Infof("Successfully loaded GraphQL schema.  Serving GraphQL API.")
 
@@ -744,6 +895,20 @@ func (as *adminServer) addConnectedAdminResolvers() {
 					dgEx,
 					resolve.StdQueryCompletion())
 			}).
+		WithQueryResolver("queryApiKey",
+			func(q schema.Query) resolve.QueryResolver {
+				return resolve.NewQueryResolver(
+					qryRw,
+					dgEx,
+					resolve.StdQueryCompletion())
+			}).
+		WithQueryResolver("getApiKey",
+			func(q schema.Query) resolve.QueryResolver {
+				return resolve.NewQueryResolver(
+					qryRw,
+					dgEx,
+					resolve.StdQueryCompletion())
+			}).
 		WithQueryResolver("getCurrentUser",
 			func(q schema.Query) resolve.QueryResolver {
 				cuResolver := &currentUserResolver{
@@ -816,6 +981,13 @@ func (as *adminServer) addConnectedAdminResolvers() {
 					dgEx,
 					resolve.StdDeleteCompletion(m.Name()))
 			}).
+		WithMutationResolver("deleteApiKey",
+			func(m schema.Mutation) resolve.MutationResolver {
+				return resolve.NewDgraphResolver(
+					resolve.NewDeleteRewriter(),
+					dgEx,
+					resolve.StdDeleteCompletion(m.Name()))
+			}).
 		WithMutationResolver("replaceAllowedCORSOrigins", func(m schema.Mutation) resolve.MutationResolver {
 			return resolve.MutationResolverFunc(resolveReplaceAllowedCORSOrigins)
 		})
@@ -836,7 +1008,8 @@ func resolverFactoryWithErrorMsg(msg string) resolve.ResolverFactory {
 	return resolve.NewResolverFactory(qErr, mErr)
 }
 
-func (as *adminServer) resetSchema(gqlSchema schema.Schema) {
+// resetSchema serves gqlSchema as the GraphQL schema of the namespace ns.
+func (as *adminServer) resetSchema(ns uint64, gqlSchema schema.Schema) {
 	// set status as updating schema
 	mainHealthStore.updatingSchema()
 
@@ -857,7 +1030,7 @@ func (as *adminServer) resetSchema(gqlSchema schema.Schema) {
 	// Increment the Epoch when you get a new schema. So, that subscription's local epoch
 	// will match against global epoch to terminate the current subscriptions.
 	atomic.AddUint64(as.globalEpoch, 1)
-	as.gqlServer.ServeGQL(resolve.New(gqlSchema, resolverFactory))
+	as.gqlServer.ServeGQL(ns, resolve.New(gqlSchema, resolverFactory))
 
 	// reset status to up, as now we are serving the new schema
 	mainHealthStore.up()
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+)
+
+type apiKeyInput struct {
+	Name      string
+	Group     string
+	ExpiresAt time.Time
+	IPs       []string
+	ReadOnly  bool
+}
+
+func resolveAddApiKey(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	glog.Info("Got add API key request through GraphQL admin API")
+
+	input, err := getApiKeyInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	key, err := edgraph.AddApiKey(ctx, input.Name, input.Group, input.ExpiresAt, input.IPs,
+		input.ReadOnly)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return &resolve.Resolved{
+		Data: map[string]interface{}{
+			m.Name(): map[string]interface{}{"name": input.Name, "key": key}},
+		Field: m,
+	}, true
+}
+
+func getApiKeyInput(m schema.Mutation) (*apiKeyInput, error) {
+	inputArg := m.ArgValue(schema.InputArgName)
+	inputByts, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+
+	var input apiKeyInput
+	err = json.Unmarshal(inputByts, &input)
+	return &input, schema.GQLWrapf(err, "couldn't get input argument")
+}
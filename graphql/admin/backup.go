@@ -30,6 +30,7 @@ import (
 type backupInput struct {
 	DestinationFields
 	ForceFull bool
+	Namespace *uint64
 }
 
 func resolveBackup(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
@@ -40,13 +41,17 @@ func resolveBackup(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 		return resolve.EmptyResult(m, err), false
 	}
 
-	err = worker.ProcessBackupRequest(context.Background(), &pb.BackupRequest{
+	req := &pb.BackupRequest{
 		Destination:  input.Destination,
 		AccessKey:    input.AccessKey,
 		SecretKey:    input.SecretKey,
 		SessionToken: input.SessionToken,
 		Anonymous:    input.Anonymous,
-	}, input.ForceFull)
+	}
+	if input.Namespace != nil {
+		req.Namespaces = []uint64{*input.Namespace}
+	}
+	err = worker.ProcessBackupRequest(context.Background(), req, input.ForceFull)
 
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
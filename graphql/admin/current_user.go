@@ -39,14 +39,25 @@ func extractName(ctx context.Context) (string, error) {
 	}
 
 	// Code copied from access_ee.go. Couldn't put the code in x, because of dependency on
-	// worker. (worker.Config.HmacSecret)
-	token, err := jwt.Parse(accessJwt[0], func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.Errorf("unexpected signing method: %v",
-				token.Header["alg"])
+	// worker. (worker.HmacSecrets)
+	var token *jwt.Token
+	for _, secret := range worker.HmacSecrets() {
+		var parseErr error
+		token, parseErr = jwt.Parse(accessJwt[0], func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, errors.Errorf("unexpected signing method: %v",
+					token.Header["alg"])
+			}
+			return []byte(secret), nil
+		})
+		if parseErr == nil {
+			err = nil
+			break
 		}
-		return []byte(worker.Config.HmacSecret), nil
-	})
+		if err == nil {
+			err = parseErr
+		}
+	}
 
 	if err != nil {
 		return "", errors.Wrapf(err, "unable to parse jwt token")
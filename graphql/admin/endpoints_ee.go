@@ -50,6 +50,11 @@ const adminTypes = `
 		Force a full backup instead of an incremental backup.
 		"""	
 		forceFull: Boolean
+
+		"""
+		The namespace to backup. All the namespaces are backed up if it's not given.
+		"""
+		namespace: Int
 	}
 
 	type BackupPayload {
@@ -104,7 +109,7 @@ const adminTypes = `
 		vaultPath: String
 
 		"""
-		Vault kv store field whose value is the key. Default "enc_key".
+		Vault kv store field whose value is the key. Required to read the key from Vault.
 		"""
 		vaultField: String
 
@@ -202,6 +207,11 @@ const adminTypes = `
 		"""
 		encrypted: Boolean
 
+		"""
+		ID of the encryption key that encrypted this backup. Restoring it needs that key.
+		"""
+		encryptionKeyId: String
+
 		"""
 		List of groups and the predicates they store in this backup.
 		"""
@@ -259,12 +269,81 @@ const adminTypes = `
 		name: String! @id @dgraph(pred: "dgraph.xid")
 		users: [User] @dgraph(pred: "~dgraph.user.group")
 		rules: [Rule] @dgraph(pred: "dgraph.acl.rule")
+
+		"""
+		Max number of requests per second allowed to each user of the group. Users who belong to
+		several groups get the highest limit of their groups, and no limit if any of their groups
+		has none. Zero, or no value, means no limit.
+		"""
+		rateLimit: Float @dgraph(pred: "dgraph.acl.rate_limit")
+
+		"""
+		Max number of requests allowed at once to each user of the group, when they haven't sent
+		requests for a while. Defaults to the rate limit.
+		"""
+		burst: Int @dgraph(pred: "dgraph.acl.burst")
+
+		"""
+		Node-level policies of the group. The users of the group only get the nodes of the type of
+		a policy which match its filter.
+		"""
+		policies: [Policy] @dgraph(pred: "dgraph.acl.policy")
+
+		"""
+		API keys which authenticate the requests sent with them as members of the group.
+		"""
+		apiKeys: [ApiKey] @dgraph(pred: "~dgraph.apikey.group")
+	}
+
+	type ApiKey @dgraph(type: "dgraph.type.ApiKey") {
+
+		"""
+		Name of the API key.  Dgraph ensures uniqueness of the names of the API keys.
+		"""
+		name: String! @id @dgraph(pred: "dgraph.apikey.name")
+		group: Group @dgraph(pred: "dgraph.apikey.group")
+
+		"""
+		Time after which the key is rejected.  The key never expires if it isn't set.
+		"""
+		expiresAt: DateTime @dgraph(pred: "dgraph.apikey.expiry")
+
+		"""
+		IP addresses and CIDR blocks from which the key is accepted.  The key is accepted from
+		anywhere if there are none.
+		"""
+		ips: [String] @dgraph(pred: "dgraph.apikey.ips")
+
+		"""
+		Read-only keys can't be used for mutations, schema changes and admin mutations.
+		"""
+		readOnly: Boolean @dgraph(pred: "dgraph.apikey.readonly")
+	}
+
+	type Policy @dgraph(type: "dgraph.type.Policy") {
+
+		"""
+		Type of the nodes to which the policy applies. Dgraph ensures uniqueness of the types
+		of the policies of a group.
+		"""
+		type: String! @dgraph(pred: "dgraph.policy.type")
+
+		"""
+		DQL filter of the nodes of the type which the users of the group are allowed to query
+		and mutate, like eq(manager, $userid). The $userid variable holds the id of the user.
+		Users who belong to several groups get the nodes which match the policy of any of their
+		groups for the type.
+		"""
+		filter: String! @dgraph(pred: "dgraph.policy.filter")
 	}
 
 	type Rule @dgraph(type: "dgraph.type.Rule") {
 
 		"""
-		Predicate to which the rule applies.
+		Predicate to which the rule applies. It can also be a regular expression between
+		slashes, like /^billing\./, for all the predicates which match it, or a type, like
+		type(Person), for all the fields of the type. The rule of a predicate overrides the
+		other rules of the group which apply to it.
 		"""	
 		predicate: String! @dgraph(pred: "dgraph.rule.predicate")
 
@@ -282,6 +361,10 @@ const adminTypes = `
 		* 6 (110) : READ+WRITE
 		* 7 (111) : READ+WRITE+MODIFY
 
+		8 (binary 1000) represents DECRYPT (the permission to read the decrypted values of an
+		@encrypted predicate), which can be added to the options above. For example, 12 (1100)
+		is READ+DECRYPT.
+
 		Permission 0, which is equal to no permission for a predicate, blocks all read, 
 		write and modify operations.
 		"""	
@@ -309,6 +392,17 @@ const adminTypes = `
 	input AddGroupInput {
 		name: String!
 		rules: [RuleRef]
+		rateLimit: Float
+		burst: Int
+		policies: [PolicyRef]
+	}
+
+	input AddApiKeyInput {
+		name: String!
+		group: String!
+		expiresAt: DateTime
+		ips: [String!]
+		readOnly: Boolean
 	}
 
 	input UserRef {
@@ -321,7 +415,8 @@ const adminTypes = `
 
 	input RuleRef {
 		"""
-		Predicate to which the rule applies.
+		Predicate to which the rule applies, a regular expression between slashes, or a type,
+		like type(Person).
 		"""	
 		predicate: String!
 
@@ -339,12 +434,29 @@ const adminTypes = `
 		* 6 (110) : READ+WRITE
 		* 7 (111) : READ+WRITE+MODIFY
 
+		8 (binary 1000) represents DECRYPT (the permission to read the decrypted values of an
+		@encrypted predicate), which can be added to the options above. For example, 12 (1100)
+		is READ+DECRYPT.
+
 		Permission 0, which is equal to no permission for a predicate, blocks all read, 
 		write and modify operations.
 		"""
 		permission: Int!
 	}
 
+	input PolicyRef {
+		"""
+		Type of the nodes to which the policy applies.
+		"""
+		type: String!
+
+		"""
+		DQL filter of the nodes of the type which the users of the group are allowed to query
+		and mutate. The $userid variable holds the id of the user.
+		"""
+		filter: String!
+	}
+
 	input UserFilter {
 		name: StringHashFilter
 		and: UserFilter
@@ -382,12 +494,23 @@ const adminTypes = `
 		not: UserFilter
 	}
 
+	input ApiKeyFilter {
+		name: StringHashFilter
+		and: ApiKeyFilter
+		or: ApiKeyFilter
+		not: ApiKeyFilter
+	}
+
 	input SetGroupPatch {
-		rules: [RuleRef!]!
+		rules: [RuleRef!]
+		rateLimit: Float
+		burst: Int
+		policies: [PolicyRef!]
 	}
 
 	input RemoveGroupPatch {
-		rules: [String!]!
+		rules: [String!]
+		policies: [String!]
 	}
 
 	input UpdateGroupInput {
@@ -412,6 +535,32 @@ const adminTypes = `
 	type DeleteGroupPayload {
 		msg: String
 		numUids: Int
+	}
+
+	type AddApiKeyPayload {
+		name: String
+
+		"""
+		The API key, to be sent in place of an access JWT.  It can't be retrieved again.
+		"""
+		key: String
+	}
+
+	type DeleteApiKeyPayload {
+		msg: String
+		numUids: Int
+	}
+
+	input RotateEncryptionKeyInput {
+
+		"""
+		File on the Alpha that stores the new key, of length 16, 24, or 32 bytes.
+		"""
+		newEncryptionKeyFile: String!
+	}
+
+	type RotateEncryptionKeyPayload {
+		response: Response
 	}`
 
 const adminMutations = `
@@ -429,9 +578,10 @@ const adminMutations = `
 
 	"""
 	Login to Dgraph.  Successful login results in a JWT that can be used in future requests.
-	If login is not successful an error is returned.
+	If login is not successful an error is returned.  The user logs in to the given namespace
+	(default: 0, the galaxy namespace), unless a refresh token is given.
 	"""
-	login(userId: String, password: String, refreshToken: String): LoginPayload
+	login(userId: String, password: String, refreshToken: String, namespace: Int): LoginPayload
 
 	"""
 	Add a user.  When linking to groups: if the group doesn't exist it is created; if the group
@@ -462,11 +612,30 @@ const adminMutations = `
 	updateGroup(input: UpdateGroupInput!): AddGroupPayload
 
 	deleteGroup(filter: GroupFilter!): DeleteGroupPayload
-	deleteUser(filter: UserFilter!): DeleteUserPayload`
+	deleteUser(filter: UserFilter!): DeleteUserPayload
+
+	"""
+	Add an API key of a group, which authenticates the requests sent with it in place of an
+	access JWT.  The key is only returned by this mutation.
+	"""
+	addApiKey(input: AddApiKeyInput!): AddApiKeyPayload
+
+	"""
+	Revoke API keys.  The requests sent with them are rejected from then on.
+	"""
+	deleteApiKey(filter: ApiKeyFilter!): DeleteApiKeyPayload
+
+	"""
+	Rotate the encryption key of this Alpha to the given key, without restarting it.  The
+	encryption key configuration of the Alpha must be changed to the new key before it's
+	restarted.  The key can't be rotated while there are @encrypted predicates.
+	"""
+	rotateEncryptionKey(input: RotateEncryptionKeyInput!): RotateEncryptionKeyPayload`
 
 const adminQueries = `
 	getUser(name: String!): User
 	getGroup(name: String!): Group
+	getApiKey(name: String!): ApiKey
 
 	"""
 	Get the currently logged in user.
@@ -475,6 +644,7 @@ const adminQueries = `
 
 	queryUser(filter: UserFilter, order: UserOrder, first: Int, offset: Int): [User]
 	queryGroup(filter: GroupFilter, order: GroupOrder, first: Int, offset: Int): [Group]
+	queryApiKey(filter: ApiKeyFilter, first: Int, offset: Int): [ApiKey]
 
 	"""
 	Get the information about the backups at a given location.
@@ -29,7 +29,8 @@ import (
 )
 
 type exportInput struct {
-	Format string
+	Format    string
+	Namespace uint64
 	DestinationFields
 }
 
@@ -57,6 +58,7 @@ func resolveExport(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 		SecretKey:    input.SecretKey,
 		SessionToken: input.SessionToken,
 		Anonymous:    input.Anonymous,
+		Namespace:    input.Namespace,
 	})
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
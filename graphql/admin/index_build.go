@@ -0,0 +1,60 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/golang/glog"
+)
+
+func resolveIndexBuilds(ctx context.Context, q schema.Query) *resolve.Resolved {
+	b, err := json.Marshal(posting.IndexBuilds())
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	var builds []map[string]interface{}
+	err = json.Unmarshal(b, &builds)
+
+	return &resolve.Resolved{
+		Data:  map[string]interface{}{q.Name(): builds},
+		Field: q,
+		Err:   err,
+	}
+}
+
+func resolveCancelIndexBuild(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	glog.Info("Got cancel index build request through GraphQL admin API")
+
+	predicate, _ := m.ArgValue("predicate").(string)
+	if err := edgraph.CancelIndexBuild(ctx, predicate); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return &resolve.Resolved{
+		Data: map[string]interface{}{
+			m.Name(): response("Success",
+				fmt.Sprintf("Index build for predicate %s has been cancelled", predicate))},
+		Field: m,
+	}, true
+}
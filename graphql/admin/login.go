@@ -18,11 +18,14 @@ package admin
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	dgoapi "github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/graphql/resolve"
 	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 )
 
@@ -30,12 +33,16 @@ type loginInput struct {
 	UserId       string
 	Password     string
 	RefreshToken string
+	Namespace    uint64
 }
 
 func resolveLogin(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
 	glog.Info("Got login request")
 
 	input := getLoginInput(m)
+	if input.Namespace != x.GalaxyNamespace {
+		ctx = x.AttachNamespace(ctx, input.Namespace)
+	}
 	resp, err := (&edgraph.Server{}).Login(ctx, &dgoapi.LoginRequest{
 		Userid:       input.UserId,
 		Password:     input.Password,
@@ -68,10 +75,15 @@ func getLoginInput(m schema.Mutation) *loginInput {
 	userID, _ := m.ArgValue("userId").(string)
 	password, _ := m.ArgValue("password").(string)
 	refreshToken, _ := m.ArgValue("refreshToken").(string)
+	var namespace uint64
+	if ns := m.ArgValue("namespace"); ns != nil {
+		namespace, _ = strconv.ParseUint(fmt.Sprintf("%v", ns), 10, 64)
+	}
 
 	return &loginInput{
 		userID,
 		password,
 		refreshToken,
+		namespace,
 	}
 }
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+func resolveAddNamespace(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	glog.Info("Got add namespace request through GraphQL admin API")
+
+	ns, err := getNamespaceInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	password, _ := m.ArgValue("password").(string)
+	if err = edgraph.AddNamespace(ctx, ns, password); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return &resolve.Resolved{
+		Data: map[string]interface{}{
+			m.Name(): response("Success", fmt.Sprintf("Namespace %d has been added", ns))},
+		Field: m,
+	}, true
+}
+
+func resolveDeleteNamespace(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	glog.Info("Got delete namespace request through GraphQL admin API")
+
+	ns, err := getNamespaceInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	if err = edgraph.DeleteNamespace(ctx, ns); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return &resolve.Resolved{
+		Data: map[string]interface{}{
+			m.Name(): response("Success", fmt.Sprintf("Namespace %d has been deleted", ns))},
+		Field: m,
+	}, true
+}
+
+func getNamespaceInput(m schema.Mutation) (uint64, error) {
+	ns, err := strconv.ParseUint(fmt.Sprintf("%v", m.ArgValue("namespace")), 10, 64)
+	return ns, errors.Wrapf(err, "invalid namespace")
+}
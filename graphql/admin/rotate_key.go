@@ -0,0 +1,58 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+func resolveRotateEncryptionKey(ctx context.Context, m schema.Mutation) (*resolve.Resolved,
+	bool) {
+	glog.Info("Got rotate encryption key request through GraphQL admin API")
+
+	input, _ := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	file, _ := input["newEncryptionKeyFile"].(string)
+	conf := viper.New()
+	conf.Set("encryption_key_file", file)
+	key, err := enc.ReadKey(conf)
+	if err != nil {
+		return resolve.EmptyResult(m, errors.Wrapf(err, "while reading the new encryption key")),
+			false
+	}
+	if len(key) == 0 {
+		return resolve.EmptyResult(m, errors.Errorf("the new encryption key is empty")), false
+	}
+	if err := worker.RotateEncryptionKey(key); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return &resolve.Resolved{
+		Data: map[string]interface{}{
+			m.Name(): response("Success", fmt.Sprintf("the encryption key has been rotated, "+
+				"the current key ID is %s", key.KeyId()))},
+		Field: m,
+	}, true
+}
@@ -66,9 +66,16 @@ func (usr *updateSchemaResolver) Resolve(ctx context.Context, m schema.Mutation)
 		return resolve.EmptyResult(m, err), false
 	}
 
-	usr.admin.mux.RLock()
-	oldSchemaHash := farm.Fingerprint64([]byte(usr.admin.schema.Schema))
-	usr.admin.mux.RUnlock()
+	// The schema of the namespace of the request is updated.
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	oldSchema, err := usr.admin.schemaOf(ns)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	oldSchemaHash := farm.Fingerprint64([]byte(oldSchema.Schema))
 
 	newSchemaHash := farm.Fingerprint64([]byte(input.Set.Schema))
 	updateHistory := oldSchemaHash != newSchemaHash
@@ -106,9 +113,15 @@ func (gsr *getSchemaResolver) Rewrite(ctx context.Context,
 func (gsr *getSchemaResolver) Execute(
 	ctx context.Context,
 	req *dgoapi.Request) (*dgoapi.Response, error) {
-	gsr.admin.mux.RLock()
-	defer gsr.admin.mux.RUnlock()
-	b, err := doQuery(gsr.admin.schema, gsr.gqlQuery)
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := gsr.admin.schemaOf(ns)
+	if err != nil {
+		return nil, err
+	}
+	b, err := doQuery(sch, gsr.gqlQuery)
 	return &dgoapi.Response{Json: b}, err
 }
 
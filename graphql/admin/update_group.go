@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	dgoapi "github.com/dgraph-io/dgo/v200/protos/api"
@@ -13,6 +14,29 @@ import (
 
 type updateGroupRewriter resolve.UpdateRewriter
 
+// aclRef describes the nodes which a group refers to, its rules and its policies. They are
+// upserted by the value of their key field.
+type aclRef struct {
+	// field is the field of the group in the GraphQL schema, and pred its predicate.
+	field, pred string
+	// key and value are the fields of the node, and keyPred and valuePred their predicates.
+	key, keyPred     string
+	value, valuePred string
+}
+
+var aclRefs = []aclRef{
+	{
+		field: "rules", pred: "dgraph.acl.rule",
+		key: "predicate", keyPred: "dgraph.rule.predicate",
+		value: "permission", valuePred: "dgraph.rule.permission",
+	},
+	{
+		field: "policies", pred: "dgraph.acl.policy",
+		key: "type", keyPred: "dgraph.policy.type",
+		value: "filter", valuePred: "dgraph.policy.filter",
+	},
+}
+
 func NewUpdateGroupRewriter() resolve.MutationRewriter {
 	return &updateGroupRewriter{}
 }
@@ -21,7 +45,8 @@ func NewUpdateGroupRewriter() resolve.MutationRewriter {
 // only for Group type. It ensures that if a rule already exists in db, it is updated;
 // otherwise, it is created. It also ensures that only the last rule out of all
 // duplicate rules in input is preserved. A rule is duplicate if it has same predicate
-// name as another rule.
+// name as another rule. The same goes for the policies, whose type is their name. The rate limit
+// and the burst of the group are set as they are in the set patch.
 func (urw *updateGroupRewriter) Rewrite(
 	ctx context.Context,
 	m schema.Mutation) ([]*resolve.UpsertMutation, error) {
@@ -40,80 +65,103 @@ func (urw *updateGroupRewriter) Rewrite(
 	var errSet, errDel error
 	var mutSet, mutDel []*dgoapi.Mutation
 	varGen := resolve.NewVariableGenerator()
-	ruleType := m.MutatedType().Field("rules").Type()
 
 	if setArg != nil {
-		rules, _ := setArg.(map[string]interface{})["rules"].([]interface{})
-		rules, errs := removeDuplicateRuleRef(rules)
-		if len(errs) != 0 {
-			errSet = schema.GQLWrapf(errs, "failed to rewrite set payload")
+		limits := map[string]interface{}{"uid": srcUID}
+		if rateLimit, ok := setArg.(map[string]interface{})["rateLimit"]; ok {
+			limits["dgraph.acl.rate_limit"] = rateLimit
 		}
-		for _, ruleI := range rules {
-			rule := ruleI.(map[string]interface{})
-			variable := varGen.Next(ruleType, "", "", false)
-			predicate := rule["predicate"]
-			permission := rule["permission"]
-
-			addAclRuleQuery(upsertQuery, predicate.(string), variable)
-
-			nonExistentJson := []byte(fmt.Sprintf(`
-			{
-				"uid": "%s",
-				"dgraph.acl.rule": [
-					{
-						"uid":                    "_:%s",
-						"dgraph.type":            "%s",
-						"dgraph.rule.predicate":  "%s",
-						"dgraph.rule.permission": %v
-					}
-				]
-			}`, srcUID, variable, ruleType.DgraphName(), predicate, permission))
-
-			existsJson := []byte(fmt.Sprintf(`
-			{
-				"uid":                    "uid(%s)",
-				"dgraph.rule.permission": %v
-			}`, variable, permission))
-
+		if burst, ok := setArg.(map[string]interface{})["burst"]; ok {
+			limits["dgraph.acl.burst"] = burst
+		}
+		if len(limits) > 1 {
+			limitsJson, err := json.Marshal(limits)
+			if err != nil {
+				return nil, schema.GQLWrapf(err, "failed to rewrite set payload")
+			}
 			mutSet = append(mutSet, &dgoapi.Mutation{
-				SetJson: nonExistentJson,
-				Cond: fmt.Sprintf(`@if(gt(len(%s),0) AND eq(len(%s),0))`, resolve.MutationQueryVar,
-					variable),
-			}, &dgoapi.Mutation{
-				SetJson: existsJson,
-				Cond: fmt.Sprintf(`@if(gt(len(%s),0) AND gt(len(%s),0))`, resolve.MutationQueryVar,
-					variable),
+				SetJson: limitsJson,
+				Cond:    fmt.Sprintf(`@if(gt(len(%s),0))`, resolve.MutationQueryVar),
 			})
 		}
+
+		var errs x.GqlErrorList
+		for _, ref := range aclRefs {
+			refType := m.MutatedType().Field(ref.field).Type()
+			nodes, _ := setArg.(map[string]interface{})[ref.field].([]interface{})
+			nodes, refErrs := removeDuplicateRefs(nodes, ref.key)
+			errs = append(errs, refErrs...)
+			for _, nodeI := range nodes {
+				node := nodeI.(map[string]interface{})
+				variable := varGen.Next(refType, "", "", false)
+				addAclRefQuery(upsertQuery, ref, node[ref.key].(string), variable)
+
+				nonExistentJson, err := json.Marshal(map[string]interface{}{
+					"uid": srcUID,
+					ref.pred: []map[string]interface{}{{
+						"uid":         "_:" + variable,
+						"dgraph.type": refType.DgraphName(),
+						ref.keyPred:   node[ref.key],
+						ref.valuePred: node[ref.value],
+					}},
+				})
+				if err != nil {
+					return nil, schema.GQLWrapf(err, "failed to rewrite set payload")
+				}
+				existsJson, err := json.Marshal(map[string]interface{}{
+					"uid":         fmt.Sprintf("uid(%s)", variable),
+					ref.valuePred: node[ref.value],
+				})
+				if err != nil {
+					return nil, schema.GQLWrapf(err, "failed to rewrite set payload")
+				}
+
+				mutSet = append(mutSet, &dgoapi.Mutation{
+					SetJson: nonExistentJson,
+					Cond: fmt.Sprintf(`@if(gt(len(%s),0) AND eq(len(%s),0))`,
+						resolve.MutationQueryVar, variable),
+				}, &dgoapi.Mutation{
+					SetJson: existsJson,
+					Cond: fmt.Sprintf(`@if(gt(len(%s),0) AND gt(len(%s),0))`,
+						resolve.MutationQueryVar, variable),
+				})
+			}
+		}
+		if len(errs) != 0 {
+			errSet = schema.GQLWrapf(errs, "failed to rewrite set payload")
+		}
 	}
 
 	if delArg != nil {
-		rules, _ := delArg.(map[string]interface{})["rules"].([]interface{})
 		var errs x.GqlErrorList
-		for i, predicate := range rules {
-			if predicate == "" {
-				errs = appendEmptyPredicateError(errs, i)
-				continue
-			}
+		for _, ref := range aclRefs {
+			refType := m.MutatedType().Field(ref.field).Type()
+			keys, _ := delArg.(map[string]interface{})[ref.field].([]interface{})
+			for i, key := range keys {
+				if key == "" {
+					errs = appendEmptyValueError(errs, ref.key, i)
+					continue
+				}
 
-			variable := varGen.Next(ruleType, "", "", false)
-			addAclRuleQuery(upsertQuery, predicate.(string), variable)
+				variable := varGen.Next(refType, "", "", false)
+				addAclRefQuery(upsertQuery, ref, key.(string), variable)
 
-			deleteJson := []byte(fmt.Sprintf(`[
-				{
-					"uid": "%s",
-					"dgraph.acl.rule": ["uid(%s)"]
-				},
-				{
-					"uid": "uid(%s)"
-				}
-			]`, srcUID, variable, variable))
+				deleteJson := []byte(fmt.Sprintf(`[
+					{
+						"uid": "%s",
+						"%s": ["uid(%s)"]
+					},
+					{
+						"uid": "uid(%s)"
+					}
+				]`, srcUID, ref.pred, variable, variable))
 
-			mutDel = append(mutDel, &dgoapi.Mutation{
-				DeleteJson: deleteJson,
-				Cond: fmt.Sprintf(`@if(gt(len(%s),0) AND gt(len(%s),0))`, resolve.MutationQueryVar,
-					variable),
-			})
+				mutDel = append(mutDel, &dgoapi.Mutation{
+					DeleteJson: deleteJson,
+					Cond: fmt.Sprintf(`@if(gt(len(%s),0) AND gt(len(%s),0))`,
+						resolve.MutationQueryVar, variable),
+				})
+			}
 		}
 		if len(errs) != 0 {
 			errDel = schema.GQLWrapf(errs, "failed to rewrite remove payload")
@@ -142,11 +190,11 @@ func (urw *updateGroupRewriter) FromMutationResult(
 	return ((*resolve.UpdateRewriter)(urw)).FromMutationResult(ctx, mutation, assigned, result)
 }
 
-// addAclRuleQuery adds a *gql.GraphQuery to upsertQuery.Children to query a rule inside a group
-// based on its predicate value.
-func addAclRuleQuery(upsertQuery []*gql.GraphQuery, predicate, variable string) {
+// addAclRefQuery adds a *gql.GraphQuery to upsertQuery.Children to query a rule, or a policy,
+// inside a group based on the value of its key.
+func addAclRefQuery(upsertQuery []*gql.GraphQuery, ref aclRef, key, variable string) {
 	upsertQuery[0].Children = append(upsertQuery[0].Children, &gql.GraphQuery{
-		Attr:  "dgraph.acl.rule",
+		Attr:  ref.pred,
 		Alias: variable,
 		Var:   variable,
 		Filter: &gql.FilterTree{
@@ -156,10 +204,10 @@ func addAclRuleQuery(upsertQuery []*gql.GraphQuery, predicate, variable string)
 				Name: "eq",
 				Args: []gql.Arg{
 					{
-						Value: "dgraph.rule.predicate",
+						Value: ref.keyPred,
 					},
 					{
-						Value: predicate,
+						Value: key,
 					},
 				},
 			},
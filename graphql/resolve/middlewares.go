@@ -117,6 +117,15 @@ func resolveGuardianAuth(ctx context.Context, f schema.Field) *Resolved {
 	return nil
 }
 
+// resolveNamespaceGuardianAuth returns a Resolved with error if the context doesn't contain the
+// auth of a Guardian of any namespace, otherwise it returns nil
+func resolveNamespaceGuardianAuth(ctx context.Context, f schema.Field) *Resolved {
+	if err := edgraph.AuthorizeNamespaceGuardians(ctx); err != nil {
+		return EmptyResult(f, err)
+	}
+	return nil
+}
+
 func resolveIpWhitelisting(ctx context.Context, f schema.Field) *Resolved {
 	if _, err := x.HasWhitelistedIP(ctx); err != nil {
 		return EmptyResult(f, err)
@@ -135,6 +144,18 @@ func GuardianAuthMW4Query(resolver QueryResolver) QueryResolver {
 	})
 }
 
+// NamespaceGuardianAuthMW4Query blocks the resolution of resolverFunc if there is no auth of a
+// Guardian of any namespace present in context, otherwise it lets the resolverFunc resolve the
+// query. It is used for the queries which only read the namespace of the request.
+func NamespaceGuardianAuthMW4Query(resolver QueryResolver) QueryResolver {
+	return QueryResolverFunc(func(ctx context.Context, query schema.Query) *Resolved {
+		if resolved := resolveNamespaceGuardianAuth(ctx, query); resolved != nil {
+			return resolved
+		}
+		return resolver.Resolve(ctx, query)
+	})
+}
+
 func IpWhitelistingMW4Query(resolver QueryResolver) QueryResolver {
 	return QueryResolverFunc(func(ctx context.Context, query schema.Query) *Resolved {
 		if resolved := resolveIpWhitelisting(ctx, query); resolved != nil {
@@ -158,6 +179,26 @@ func GuardianAuthMW4Mutation(resolver MutationResolver) MutationResolver {
 		if resolved := resolveGuardianAuth(ctx, mutation); resolved != nil {
 			return resolved, false
 		}
+		// The read-only API keys of the guardians can't run the admin mutations.
+		if err := edgraph.AuthorizeWrites(ctx); err != nil {
+			return EmptyResult(mutation, err), false
+		}
+		return resolver.Resolve(ctx, mutation)
+	})
+}
+
+// NamespaceGuardianAuthMW4Mutation blocks the resolution of resolverFunc if there is no auth of a
+// Guardian of any namespace present in context, otherwise it lets the resolverFunc resolve the
+// mutation. It is used for the mutations which only change the namespace of the request.
+func NamespaceGuardianAuthMW4Mutation(resolver MutationResolver) MutationResolver {
+	return MutationResolverFunc(func(ctx context.Context, mutation schema.Mutation) (*Resolved,
+		bool) {
+		if resolved := resolveNamespaceGuardianAuth(ctx, mutation); resolved != nil {
+			return resolved, false
+		}
+		if err := edgraph.AuthorizeWrites(ctx); err != nil {
+			return EmptyResult(mutation, err), false
+		}
 		return resolver.Resolve(ctx, mutation)
 	})
 }
@@ -300,6 +300,21 @@ func (mrw *AddRewriter) Rewrite(ctx context.Context, m schema.Mutation) ([]*Upse
 		return mutationsAll
 	}
 
+	// A JWT that can't be read leaves the auth variables empty, which fails the rules on the
+	// fields, as it fails the add rules of the types once the nodes are created.
+	var authVariables map[string]interface{}
+	if customClaims, err := authorization.ExtractCustomClaims(ctx); err == nil {
+		authVariables = customClaims.AuthVariables
+	}
+
+	for _, i := range val {
+		obj := i.(map[string]interface{})
+		if err := authorizeInputFields(mutatedType, obj, authVariables, addFieldAuthSelector,
+			addFieldAuthSelector); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, i := range val {
 		obj := i.(map[string]interface{})
 		frag := rewriteObject(ctx, nil, mutatedType, nil, "", varGen, true, obj, 0, xidMd)
@@ -450,6 +465,21 @@ func (urw *UpdateRewriter) Rewrite(
 	}
 	authRw.hasAuthRules = hasAuthRules(m.QueryField(), authRw)
 
+	// The objects in set are new nodes or references to existing nodes, while those in remove
+	// are only references.
+	if setArg != nil {
+		if err := authorizeInputFields(mutatedType, setArg.(map[string]interface{}),
+			customClaims.AuthVariables, updateFieldAuthSelector, addFieldAuthSelector); err != nil {
+			return nil, err
+		}
+	}
+	if delArg != nil {
+		if err := authorizeInputFields(mutatedType, delArg.(map[string]interface{}),
+			customClaims.AuthVariables, updateFieldAuthSelector, nil); err != nil {
+			return nil, err
+		}
+	}
+
 	upsertQuery := RewriteUpsertQueryFromMutation(m, authRw)
 	srcUID := MutationQueryVarUID
 
@@ -706,7 +736,7 @@ func RewriteUpsertQueryFromMutation(
 
 	_ = addFilter(dgQuery[0], m.MutatedType(), filter)
 
-	dgQuery = authRw.addAuthQueries(m.MutatedType(), dgQuery, rbac)
+	dgQuery = authRw.addAuthQueries(m.MutatedType(), dgQuery, rbac, nil)
 
 	return dgQuery
 }
@@ -867,6 +897,80 @@ func deleteAuthSelector(t schema.Type) *schema.RuleNode {
 	return auth.Rules.Delete
 }
 
+func addFieldAuthSelector(auth *schema.AuthContainer) *schema.RuleNode {
+	return auth.Add
+}
+
+func updateFieldAuthSelector(auth *schema.AuthContainer) *schema.RuleNode {
+	return auth.Update
+}
+
+// authorizeInputFields evaluates the rules selected by selector on the fields of typ that are set
+// in obj, with the values they are set to. If nested isn't nil, the objects in obj, which are new
+// nodes or references to existing nodes, are checked with the rules it selects.
+//
+// For example, with the schema
+//
+// type Expense {
+//   ...
+//   status: Status @auth(update: { or: [
+//     { rule: "{$ROLE: { eq: \"ADMIN\" } }" },
+//     { not: { rule: "{$INPUT: { eq: \"APPROVED\" } }" } } ] })
+// }
+//
+// an update that sets the status of an expense to APPROVED is only authorized for admins.
+func authorizeInputFields(
+	typ schema.Type,
+	obj map[string]interface{},
+	authVariables map[string]interface{},
+	selector func(*schema.AuthContainer) *schema.RuleNode,
+	nested func(*schema.AuthContainer) *schema.RuleNode) error {
+
+	typAuth := typ.AuthRules()
+	var fields []string
+	for field := range obj {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		val := obj[field]
+		if typAuth != nil && typAuth.Fields[field] != nil {
+			rn := selector(typAuth.Fields[field])
+			if rn != nil && rn.EvaluateInput(authVariables, val) != schema.Positive {
+				return x.GqlErrorf("authorization failed for field %s of type %s", field,
+					typ.Name())
+			}
+		}
+
+		var children []interface{}
+		switch val := val.(type) {
+		case map[string]interface{}:
+			children = []interface{}{val}
+		case []interface{}:
+			children = val
+		}
+		if nested == nil || len(children) == 0 {
+			continue
+		}
+		fieldDef := typ.Field(field)
+		if fieldDef.Type().IsUnion() || fieldDef.Type().IsGeo() {
+			continue
+		}
+		for _, child := range children {
+			childObj, ok := child.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := authorizeInputFields(fieldDef.Type(), childObj, authVariables, nested,
+				nested); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func mutationsFromFragments(
 	frags []*mutationFragment,
 	setBuilder, delBuilder mutationBuilder) ([]*dgoapi.Mutation, error) {
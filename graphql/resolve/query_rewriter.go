@@ -75,6 +75,12 @@ func hasAuthRules(field schema.Field, authRw *authRewriter) bool {
 	if rn != nil {
 		return true
 	}
+	if authRw.fieldQueryRule(field) != nil {
+		return true
+	}
+	if authRw.argFieldRule(field, field.ConstructedFor()) != nil {
+		return true
+	}
 
 	for _, childField := range field.SelectionSet() {
 		if authRules := hasAuthRules(childField, authRw); authRules {
@@ -174,7 +180,7 @@ func aggregateQuery(query schema.Query, authRw *authRewriter) []*gql.GraphQuery
 	filter, _ := query.ArgValue("filter").(map[string]interface{})
 	_ = addFilter(dgQuery[0], mainType, filter)
 
-	dgQuery = authRw.addAuthQueries(mainType, dgQuery, rbac)
+	dgQuery = authRw.addAuthQueries(mainType, dgQuery, rbac, authRw.argFieldRule(query, mainType))
 
 	// mainQuery is the query with Attr: query.Name()
 	// It is the first query in dgQuery list.
@@ -376,6 +382,9 @@ func rewriteAsQueryByIds(
 	uids []uint64,
 	authRw *authRewriter) []*gql.GraphQuery {
 	rbac := authRw.evaluateStaticRules(field.Type())
+	if authRw.evaluateArgFieldRule(field, field.Type()) == schema.Negative {
+		rbac = schema.Negative
+	}
 	dgQuery := []*gql.GraphQuery{{
 		Attr: field.Name(),
 	}}
@@ -409,7 +418,8 @@ func rewriteAsQueryByIds(
 	addUID(dgQuery[0])
 	addCascadeDirective(dgQuery[0], field)
 
-	dgQuery = authRw.addAuthQueries(field.Type(), dgQuery, rbac)
+	dgQuery = authRw.addAuthQueries(field.Type(), dgQuery, rbac,
+		authRw.argFieldRule(field, field.Type()))
 
 	if len(selectionAuth) > 0 {
 		dgQuery = append(dgQuery, selectionAuth...)
@@ -511,7 +521,7 @@ func rewriteAsGet(
 	addTypeFilter(dgQuery[0], query.Type())
 	addCascadeDirective(dgQuery[0], query)
 
-	dgQuery = auth.addAuthQueries(query.Type(), dgQuery, rbac)
+	dgQuery = auth.addAuthQueries(query.Type(), dgQuery, rbac, nil)
 
 	if len(selectionAuth) > 0 {
 		dgQuery = append(dgQuery, selectionAuth...)
@@ -527,6 +537,9 @@ func addCommonRules(
 	fieldType schema.Type,
 	authRw *authRewriter) ([]*gql.GraphQuery, schema.RuleResult) {
 	rbac := authRw.evaluateStaticRules(fieldType)
+	if authRw.evaluateArgFieldRule(field, fieldType) == schema.Negative {
+		rbac = schema.Negative
+	}
 	dgQuery := &gql.GraphQuery{
 		Attr: field.Name(),
 	}
@@ -573,7 +586,8 @@ func rewriteAsQuery(field schema.Field, authRw *authRewriter) []*gql.GraphQuery
 	}
 	addCascadeDirective(dgQuery[0], field)
 
-	dgQuery = authRw.addAuthQueries(field.Type(), dgQuery, rbac)
+	dgQuery = authRw.addAuthQueries(field.Type(), dgQuery, rbac,
+		authRw.argFieldRule(field, field.Type()))
 
 	if len(selectionAuth) > 0 {
 		return append(dgQuery, selectionAuth...)
@@ -594,7 +608,8 @@ func (authRw *authRewriter) writingAuth() bool {
 func (authRw *authRewriter) addAuthQueries(
 	typ schema.Type,
 	dgQuery []*gql.GraphQuery,
-	rbacEval schema.RuleResult) []*gql.GraphQuery {
+	rbacEval schema.RuleResult,
+	argRule *schema.RuleNode) []*gql.GraphQuery {
 
 	// There's no need to recursively inject auth queries into other auth queries, so if
 	// we are already generating an auth query, there's nothing to add.
@@ -704,8 +719,10 @@ func (authRw *authRewriter) addAuthQueries(
 		}
 
 		// Adding the case of Query on interface in which None of the implementing type have
-		// Auth Query Rules, in that case, we also return simple query.
-		if typ.IsInterface() == true && implementingTypesHasAuthRules == false {
+		// Auth Query Rules, in that case, we also return simple query. Unless the fields
+		// below need the root query to start from.
+		if typ.IsInterface() == true && implementingTypesHasAuthRules == false &&
+			!authRw.hasAuthRules {
 			return dgQuery
 		}
 
@@ -720,6 +737,12 @@ func (authRw *authRewriter) addAuthQueries(
 		filter = nil
 	}
 
+	// The nodes must also satisfy the rules of the fields that the query filters, orders or
+	// aggregates by, see argFieldRule.
+	argAuthQueries, argFilter := authRw.rewriteArgFieldAuthQueries(typ, argRule)
+	fldAuthQueries = append(fldAuthQueries, argAuthQueries...)
+	filter = andFilters(filter, argFilter)
+
 	// build a query like
 	//   Todo1 as var(func: ... ) @filter(...)
 	// that has the filter from the user query in it.  This is then used as
@@ -807,6 +830,194 @@ func passwordAuthSelector(t schema.Type) *schema.RuleNode {
 	return auth.Rules.Password
 }
 
+// fieldQueryRule returns the query rule on the definition of field f, if auth is to be added for
+// it. Auth queries don't apply the rules of fields, as they don't apply those of types.
+func (authRw *authRewriter) fieldQueryRule(f schema.Field) *schema.RuleNode {
+	if authRw == nil || authRw.isWritingAuth {
+		return nil
+	}
+	auth := f.AuthRules()
+	if auth == nil {
+		return nil
+	}
+	return auth.Query
+}
+
+// rewriteFieldAuthQueries builds the queries that only keep the values of the scalar field f for
+// the nodes of typ at this level that satisfy the query rule rn of the field. The values are
+// collected into a value variable, which is returned with the queries, like
+//
+//	TodoAuth5 as var(func: uid(TodoRoot)) @cascade { ...auth query... }
+//	var(func: uid(TodoRoot)) @filter(uid(TodoAuth5)) {
+//	  Todo6 as Todo.text
+//	}
+//
+// so that the field can be queried as `text : val(Todo6)`.
+func (authRw *authRewriter) rewriteFieldAuthQueries(
+	typ schema.Type,
+	f schema.Field,
+	rn *schema.RuleNode) (string, []*gql.GraphQuery) {
+
+	ruleQueries, filter := (&authRewriter{
+		authVariables: authRw.authVariables,
+		varGen:        authRw.varGen,
+		isWritingAuth: true,
+		varName:       authRw.parentVarName,
+		selector:      authRw.selector,
+		parentVarName: authRw.parentVarName,
+		hasAuthRules:  authRw.hasAuthRules,
+	}).rewriteRuleNode(typ, rn)
+
+	valueVar := authRw.varGen.Next(typ, "", "", false)
+	valueQry := &gql.GraphQuery{
+		Attr: "var",
+		Func: &gql.Function{
+			Name: "uid",
+			Args: []gql.Arg{{Value: authRw.parentVarName}},
+		},
+		Filter:   filter,
+		Children: []*gql.GraphQuery{{Var: valueVar, Attr: f.DgraphPredicate()}},
+	}
+	return valueVar, append(ruleQueries, valueQry)
+}
+
+// argFieldRule returns the query rules of the fields of typ that field filters or orders by, or
+// that it aggregates if it's an aggregate query or field, joined with an and. The values of these
+// fields decide which nodes are returned, and how, as much as the values that are queried, so
+// the nodes that don't satisfy the rules aren't returned or aggregated. It's nil if none of these
+// fields has a query rule.
+func (authRw *authRewriter) argFieldRule(field schema.Field, typ schema.Type) *schema.RuleNode {
+	if authRw == nil || authRw.isWritingAuth {
+		return nil
+	}
+	auth := typ.AuthRules()
+	if auth == nil || len(auth.Fields) == 0 {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	filter, _ := field.ArgValue("filter").(map[string]interface{})
+	addFilterFieldNames(names, filter)
+	order, ok := field.ArgValue("order").(map[string]interface{})
+	for ok {
+		for _, dir := range []string{"asc", "desc"} {
+			if name, isName := order[dir].(string); isName {
+				names[name] = true
+			}
+		}
+		order, ok = order["then"].(map[string]interface{})
+	}
+	if strings.HasSuffix(field.Type().Name(), "AggregateResult") {
+		for _, f := range field.SelectionSet() {
+			for _, function := range []string{"Max", "Min", "Sum", "Avg"} {
+				if strings.HasSuffix(f.Name(), function) {
+					names[f.Name()[:len(f.Name())-len(function)]] = true
+				}
+			}
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	var rules []*schema.RuleNode
+	for _, name := range sorted {
+		if fieldAuth := auth.Fields[name]; fieldAuth != nil && fieldAuth.Query != nil {
+			rules = append(rules, fieldAuth.Query)
+		}
+	}
+	switch len(rules) {
+	case 0:
+		return nil
+	case 1:
+		return rules[0]
+	}
+	return &schema.RuleNode{And: rules}
+}
+
+// addFilterFieldNames adds the names of the fields that filter is on to names.
+func addFilterFieldNames(names map[string]bool, filter map[string]interface{}) {
+	for key, val := range filter {
+		switch key {
+		case "and", "or":
+			switch v := val.(type) {
+			case map[string]interface{}:
+				addFilterFieldNames(names, v)
+			case []interface{}:
+				for _, obj := range v {
+					obj, _ := obj.(map[string]interface{})
+					addFilterFieldNames(names, obj)
+				}
+			}
+		case "not":
+			not, _ := val.(map[string]interface{})
+			addFilterFieldNames(names, not)
+		case "has":
+			switch v := val.(type) {
+			case []interface{}:
+				for _, name := range v {
+					names[fmt.Sprintf("%v", name)] = true
+				}
+			default:
+				names[fmt.Sprintf("%v", v)] = true
+			}
+		default:
+			names[key] = true
+		}
+	}
+}
+
+// evaluateArgFieldRule statically evaluates the rule returned by argFieldRule, which is
+// Positive if there's no such rule.
+func (authRw *authRewriter) evaluateArgFieldRule(
+	field schema.Field,
+	typ schema.Type) schema.RuleResult {
+
+	rn := authRw.argFieldRule(field, typ)
+	if rn == nil {
+		return schema.Positive
+	}
+	return rn.EvaluateStatic(authRw.authVariables)
+}
+
+// rewriteArgFieldAuthQueries builds the auth queries and filter of the rule rn returned by
+// argFieldRule for the nodes of typ, like rewriteAuthQueries does for the rule of the type. There
+// are none unless the rule is Uncertain.
+func (authRw *authRewriter) rewriteArgFieldAuthQueries(
+	typ schema.Type,
+	rn *schema.RuleNode) ([]*gql.GraphQuery, *gql.FilterTree) {
+
+	if authRw == nil || authRw.isWritingAuth || rn == nil ||
+		rn.EvaluateStatic(authRw.authVariables) != schema.Uncertain {
+		return nil, nil
+	}
+
+	return (&authRewriter{
+		authVariables: authRw.authVariables,
+		varGen:        authRw.varGen,
+		isWritingAuth: true,
+		varName:       authRw.varName,
+		selector:      authRw.selector,
+		parentVarName: authRw.parentVarName,
+		hasAuthRules:  authRw.hasAuthRules,
+	}).rewriteRuleNode(typ, rn)
+}
+
+func andFilters(a, b *gql.FilterTree) *gql.FilterTree {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	return &gql.FilterTree{
+		Op:    "and",
+		Child: []*gql.FilterTree{a, b},
+	}
+}
+
 func (authRw *authRewriter) rewriteAuthQueries(typ schema.Type) ([]*gql.GraphQuery, *gql.FilterTree) {
 	if authRw == nil || authRw.isWritingAuth {
 		return nil, nil
@@ -1114,7 +1325,9 @@ func buildAggregateFields(
 		aggregateChildren = append([]*gql.GraphQuery{mainField}, aggregateChildren...)
 	}
 	rbac := auth.evaluateStaticRules(constructedForType)
-	if rbac == schema.Negative {
+	argRule := auth.argFieldRule(f, constructedForType)
+	if rbac == schema.Negative ||
+		(argRule != nil && argRule.EvaluateStatic(auth.authVariables) == schema.Negative) {
 		return nil, nil
 	}
 	var parentVarName, parentQryName string
@@ -1129,6 +1342,9 @@ func buildAggregateFields(
 	if rbac == schema.Uncertain {
 		fieldAuth, authFilter = auth.rewriteAuthQueries(constructedForType)
 	}
+	argAuth, argFilter := auth.rewriteArgFieldAuthQueries(constructedForType, argRule)
+	fieldAuth = append(fieldAuth, argAuth...)
+	authFilter = andFilters(authFilter, argFilter)
 	// At this stage aggregateChildren only contains the count aggregate fields and
 	// possibly mainField. Auth filters are added to count aggregation fields and
 	// mainField. Adding filters only for mainField is sufficient for other aggregate
@@ -1250,6 +1466,15 @@ func addSelectionSetFrom(
 			continue
 		}
 
+		// A field whose query rule is never satisfied by the JWT isn't queried, so that it is
+		// null in the result, or raises an error if it can't be null.
+		fieldRule := auth.fieldQueryRule(f)
+		fieldRbac := fieldRule.EvaluateStatic(auth.authVariables)
+		if fieldRule != nil && (fieldRbac == schema.Negative ||
+			(fieldRbac == schema.Uncertain && !auth.hasAuthRules)) {
+			continue
+		}
+
 		child := &gql.GraphQuery{
 			Alias: generateUniqueDgraphAlias(f, fieldSeenCount),
 		}
@@ -1269,6 +1494,12 @@ func addSelectionSetFrom(
 		addPagination(child, f)
 		addCascadeDirective(child, f)
 		rbac := auth.evaluateStaticRules(f.Type())
+		// A field that filters or orders by a field that the JWT can never see is dropped, like a
+		// field of a type that it can never see.
+		argRule := auth.argFieldRule(f, f.Type())
+		if argRule != nil && argRule.EvaluateStatic(auth.authVariables) == schema.Negative {
+			rbac = schema.Negative
+		}
 
 		// Since the recursion processes the query in bottom up way, we store the state of the so
 		// that we can restore it later.
@@ -1299,6 +1530,12 @@ func addSelectionSetFrom(
 		}
 		fieldSeenCount[f.DgraphAlias()]++
 
+		if fieldRule != nil && fieldRbac == schema.Uncertain {
+			valueVar, valueAuth := auth.rewriteFieldAuthQueries(field.Type(), f, fieldRule)
+			child.Attr = "val(" + valueVar + ")"
+			authQueries = append(authQueries, valueAuth...)
+		}
+
 		if rbac == schema.Positive || rbac == schema.Uncertain {
 			q.Children = append(q.Children, child)
 		}
@@ -1336,6 +1573,9 @@ func addSelectionSetFrom(
 		if rbac == schema.Uncertain {
 			fieldAuth, authFilter = auth.rewriteAuthQueries(f.Type())
 		}
+		argAuth, argFilter := auth.rewriteArgFieldAuthQueries(f.Type(), argRule)
+		fieldAuth = append(fieldAuth, argAuth...)
+		authFilter = andFilters(authFilter, argFilter)
 
 		if len(f.SelectionSet()) > 0 && !auth.isWritingAuth && auth.hasAuthRules {
 			commonAuthQueryVars := buildCommonAuthQueries(f, auth, parentVarName)
@@ -33,6 +33,7 @@ import (
 	"github.com/dgraph-io/dgraph/graphql/authorization"
 
 	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/ee/audit"
 	"github.com/dgraph-io/dgraph/graphql/dgraph"
 	"github.com/dgraph-io/dgraph/types"
 
@@ -446,6 +447,7 @@ func (r *RequestResolver) Resolve(ctx context.Context, gqlReq *schema.Request) *
 	if err != nil {
 		return schema.ErrorResponse(err)
 	}
+	audit.EventFromContext(ctx).SetOperation(auditedOperation(op))
 
 	if glog.V(3) {
 		// don't log the introspection queries they are sent too frequently
@@ -545,6 +547,26 @@ func (r *RequestResolver) Resolve(ctx context.Context, gqlReq *schema.Request) *
 	return resp
 }
 
+// auditedOperation returns the type of op and the names of its fields, which are recorded in the
+// audit log. The arguments of the fields aren't, since they might hold passwords.
+func auditedOperation(op schema.Operation) (string, string) {
+	var names []string
+	for _, q := range op.Queries() {
+		names = append(names, q.Name())
+	}
+	for _, m := range op.Mutations() {
+		names = append(names, m.Name())
+	}
+	switch {
+	case op.IsMutation():
+		return "mutation", strings.Join(names, ",")
+	case op.IsSubscription():
+		return "subscription", strings.Join(names, ",")
+	default:
+		return "query", strings.Join(names, ",")
+	}
+}
+
 // ValidateSubscription will check the given subscription query is valid or not.
 func (r *RequestResolver) ValidateSubscription(req *schema.Request) error {
 	if r.schema == nil {
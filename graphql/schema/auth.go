@@ -19,9 +19,11 @@ package schema
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/spf13/cast"
 
@@ -35,6 +37,9 @@ import (
 
 const (
 	RBACQueryPrefix = "{"
+	// RBACInputVariable is the variable of the add and update rules on fields which holds the
+	// value that a mutation sets the field to.
+	RBACInputVariable = "INPUT"
 )
 
 type RBACQuery struct {
@@ -42,6 +47,18 @@ type RBACQuery struct {
 	Operator string
 	Operand  interface{}
 	regex    *regexp.Regexp
+	// bounds holds the parsed operand of the comparison operators lt, le, gt, ge and between.
+	bounds []ruleBound
+}
+
+// ruleBound is a bound that a claim is compared against. It's either a number, a fixed time
+// or a time relative to when the rule is evaluated, like now() - 24h.
+type ruleBound struct {
+	isTime  bool
+	num     float64
+	time    time.Time
+	fromNow bool
+	offset  time.Duration
 }
 
 type RuleNode struct {
@@ -80,6 +97,9 @@ func (rq *RBACQuery) checkIfMatchInArray(array []interface{}) RuleResult {
 }
 
 func (rq *RBACQuery) checkIfMatch(value interface{}) RuleResult {
+	if len(rq.bounds) > 0 {
+		return rq.compare(value)
+	}
 	rules, ok := rq.Operand.([]interface{})
 	if ok {
 		// this means rule operand is array slice
@@ -109,6 +129,156 @@ func evaluate(operand interface{}, value interface{}, regex *regexp.Regexp) Rule
 	return Negative
 }
 
+// compare checks a claim value against the bounds of a comparison operator. A value that
+// can't be compared with the bounds, for example a string against a number, doesn't match.
+func (rq *RBACQuery) compare(value interface{}) RuleResult {
+	now := time.Now()
+	cmps := make([]int, len(rq.bounds))
+	for i, b := range rq.bounds {
+		var ok bool
+		if cmps[i], ok = b.compareTo(value, now); !ok {
+			return Negative
+		}
+	}
+
+	var match bool
+	switch rq.Operator {
+	case "lt":
+		match = cmps[0] < 0
+	case "le":
+		match = cmps[0] <= 0
+	case "gt":
+		match = cmps[0] > 0
+	case "ge":
+		match = cmps[0] >= 0
+	case "between":
+		match = cmps[0] >= 0 && cmps[1] <= 0
+	}
+	if match {
+		return Positive
+	}
+	return Negative
+}
+
+// compareTo returns -1, 0 or 1 as value is less than, equal to or greater than the bound.
+// Numbers are compared with numeric bounds. For time bounds, numbers are read as Unix
+// seconds, like the exp and iat claims of a JWT, and strings as RFC3339 times.
+func (b ruleBound) compareTo(value interface{}, now time.Time) (int, bool) {
+	if !b.isTime {
+		num, ok := claimAsNumber(value)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case num < b.num:
+			return -1, true
+		case num > b.num:
+			return 1, true
+		}
+		return 0, true
+	}
+
+	var t time.Time
+	if num, ok := claimAsNumber(value); ok {
+		sec, frac := math.Modf(num)
+		t = time.Unix(int64(sec), int64(frac*float64(time.Second)))
+	} else if str, ok := value.(string); ok {
+		var err error
+		if t, err = time.Parse(time.RFC3339, str); err != nil {
+			return 0, false
+		}
+	} else {
+		return 0, false
+	}
+
+	bound := b.time
+	if b.fromNow {
+		bound = now.Add(b.offset)
+	}
+	switch {
+	case t.Before(bound):
+		return -1, true
+	case t.After(bound):
+		return 1, true
+	}
+	return 0, true
+}
+
+func claimAsNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// contains checks that the claim is an array holding the operand, or every value of the
+// operand if it's an array.
+func (rq *RBACQuery) contains(claim interface{}) RuleResult {
+	values, err := cast.ToSliceE(claim)
+	if err != nil {
+		return Negative
+	}
+	wanted, ok := rq.Operand.([]interface{})
+	if !ok {
+		wanted = []interface{}{rq.Operand}
+	}
+	for _, w := range wanted {
+		found := false
+		for _, v := range values {
+			if reflect.DeepEqual(v, w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return Negative
+		}
+	}
+	return Positive
+}
+
+// claimValue returns the value of the rule's variable in the auth variables. A variable like
+// $entitlements.search.until that isn't itself a claim is looked up as a path into nested
+// claims. Arrays along the path are searched element by element and the values found are
+// collected into one array.
+func (rq *RBACQuery) claimValue(av map[string]interface{}) interface{} {
+	if val, ok := av[rq.Variable]; ok || !strings.Contains(rq.Variable, ".") {
+		return val
+	}
+	return claimAtPath(av, strings.Split(rq.Variable, "."))
+}
+
+func claimAtPath(val interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return val
+	}
+	switch v := val.(type) {
+	case map[string]interface{}:
+		return claimAtPath(v[path[0]], path[1:])
+	case []interface{}:
+		var vals []interface{}
+		for _, elem := range v {
+			switch ev := claimAtPath(elem, path).(type) {
+			case nil:
+			case []interface{}:
+				vals = append(vals, ev...)
+			default:
+				vals = append(vals, ev)
+			}
+		}
+		if len(vals) == 0 {
+			return nil
+		}
+		return vals
+	}
+	return nil
+}
+
 // EvaluateRBACRule evaluates the auth token based on the auth query
 // There are two cases here:
 // 1. Auth token has an array of values for the variable.
@@ -117,14 +287,19 @@ func evaluate(operand interface{}, value interface{}, regex *regexp.Regexp) Rule
 // In case array one match would made the rule positive.
 // For example, Rule {$USER: { eq:"uid"}} and token $USER:["u", "id", "uid"] result in match.
 // Rule {$USER: { in: ["uid", "xid"]}} and token $USER:["u", "id", "uid"]  result in match
+// The contains operator is the exception, it's always evaluated against the whole array.
 func (rq *RBACQuery) EvaluateRBACRule(av map[string]interface{}) RuleResult {
-	tokenValues, tokenCastErr := cast.ToSliceE(av[rq.Variable])
+	claim := rq.claimValue(av)
+	if rq.Operator == "contains" {
+		return rq.contains(claim)
+	}
+	tokenValues, tokenCastErr := cast.ToSliceE(claim)
 	// if eq, auth rule value will be matched completely
 	// if regexp, auth rule value should always be string and so as token values
 	// if in, auth rule will only have array as the value check has to consider that
 	if tokenCastErr != nil {
 		// this means value for variable in token in not an array
-		return rq.checkIfMatch(av[rq.Variable])
+		return rq.checkIfMatch(claim)
 	}
 	return rq.checkIfMatchInArray(tokenValues)
 }
@@ -197,6 +372,34 @@ func (node *RuleNode) EvaluateStatic(av map[string]interface{}) RuleResult {
 	return Uncertain
 }
 
+// EvaluateInput evaluates the add or update rule on a field, which are RBAC rules, against the
+// auth variables and the value that a mutation sets the field to as $INPUT. The integers in the
+// value are compared as the numbers in the rules, which are floats.
+func (node *RuleNode) EvaluateInput(av map[string]interface{}, input interface{}) RuleResult {
+	vars := make(map[string]interface{}, len(av)+1)
+	for k, v := range av {
+		vars[k] = v
+	}
+	vars[RBACInputVariable] = inputAsRuleValue(input)
+	return node.EvaluateStatic(vars)
+}
+
+func inputAsRuleValue(input interface{}) interface{} {
+	switch val := input.(type) {
+	case int64:
+		return float64(val)
+	case int:
+		return float64(val)
+	case []interface{}:
+		vals := make([]interface{}, 0, len(val))
+		for _, v := range val {
+			vals = append(vals, inputAsRuleValue(v))
+		}
+		return vals
+	}
+	return input
+}
+
 type TypeAuth struct {
 	Rules  *AuthContainer
 	Fields map[string]*AuthContainer
@@ -230,10 +433,15 @@ func authRules(s *ast.Schema) (map[string]*TypeAuth, error) {
 
 		for _, field := range typ.Fields {
 			auth := field.Directives.ForName(authDirective)
-			if auth != nil {
-				authRules[name].Fields[field.Name], err = parseAuthDirective(s, typ, auth)
-				errResult = AppendGQLErrs(errResult, err)
+			// The rules of the fields inherited from an interface are merged in from the
+			// interface below, as they are written against the interface.
+			if auth == nil || inheritsFieldAuth(s, typ, field.Name) {
+				continue
 			}
+			fieldAuth, err := parseAuthDirective(s, typ, auth)
+			errResult = AppendGQLErrs(errResult, err)
+			errResult = AppendGQLErrs(errResult, validateFieldAuth(s, typ, field, fieldAuth))
+			authRules[name].Fields[field.Name] = fieldAuth
 		}
 	}
 
@@ -250,23 +458,112 @@ func authRules(s *ast.Schema) (map[string]*TypeAuth, error) {
 						mergeAuthNodeWithAnd,
 					)
 				}
+				if authRules[interfaceName] == nil {
+					continue
+				}
+				for fieldName, fieldAuth := range authRules[interfaceName].Fields {
+					authRules[name].Fields[fieldName] = mergeAuthRules(
+						authRules[name].Fields[fieldName],
+						fieldAuth,
+						mergeAuthNodeWithAnd,
+					)
+				}
 			}
 		}
 	}
 
 	// Reinitialize the Interface's auth to be empty as Any operation on interface
 	// will be broken into an operation on subsequent implementing types and auth rules
-	// will be verified against the types only.
+	// will be verified against the types only. The rules of the fields are kept, as the fields
+	// of an interface can be queried without knowing the implementing types.
 	for _, typ := range s.Types {
 		name := typeName(typ)
 		if typ.Kind == ast.Interface {
-			authRules[name] = &TypeAuth{}
+			authRules[name] = &TypeAuth{Fields: authRules[name].Fields}
 		}
 	}
 
 	return authRules, errResult
 }
 
+// inheritsFieldAuth returns whether the field of typ is inherited from an interface which has
+// @auth rules on that field.
+func inheritsFieldAuth(s *ast.Schema, typ *ast.Definition, fieldName string) bool {
+	for _, name := range typ.Interfaces {
+		intrface := s.Types[name]
+		if intrface == nil {
+			continue
+		}
+		if fld := intrface.Fields.ForName(fieldName); fld != nil &&
+			fld.Directives.ForName(authDirective) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// validateFieldAuth checks that the @auth rules on a field can be applied to it. Fields only have
+// query, add and update rules. The add and update rules are evaluated before the mutation runs,
+// so they can only be RBAC rules. GraphQL query rules decide which nodes can see a value of the
+// field, so they are only supported on scalar fields that aren't lists.
+func validateFieldAuth(
+	s *ast.Schema,
+	typ *ast.Definition,
+	field *ast.FieldDefinition,
+	auth *AuthContainer) error {
+
+	if auth == nil {
+		return nil
+	}
+
+	var errResult error
+	if auth.Password != nil || auth.Delete != nil {
+		errResult = AppendGQLErrs(errResult, gqlerror.Errorf(
+			"Type %s; Field %s: @auth: only query, add and update rules are supported on "+
+				"fields", typ.Name, field.Name))
+	}
+
+	if auth.Add.hasGraphQLRule() || auth.Update.hasGraphQLRule() {
+		errResult = AppendGQLErrs(errResult, gqlerror.Errorf(
+			"Type %s; Field %s: @auth: add and update rules on fields can only be RBAC rules",
+			typ.Name, field.Name))
+	}
+
+	fieldType := s.Types[field.Type.Name()]
+	isScalar := field.Type.Elem == nil && field.Type.Name() != IDType && fieldType != nil &&
+		(fieldType.Kind == ast.Scalar || fieldType.Kind == ast.Enum)
+	if auth.Query.hasGraphQLRule() && !isScalar {
+		errResult = AppendGQLErrs(errResult, gqlerror.Errorf(
+			"Type %s; Field %s: @auth: query rules with GraphQL rules are only supported on "+
+				"scalar fields that aren't lists or IDs, other fields can only have RBAC rules",
+			typ.Name, field.Name))
+	}
+
+	return errResult
+}
+
+// hasGraphQLRule returns whether the rule has any GraphQL query in it, which has to be run
+// against Dgraph to evaluate the rule.
+func (node *RuleNode) hasGraphQLRule() bool {
+	if node == nil {
+		return false
+	}
+	if node.Rule != nil || node.DQLRule != nil || node.Not.hasGraphQLRule() {
+		return true
+	}
+	for _, rn := range node.Or {
+		if rn.hasGraphQLRule() {
+			return true
+		}
+	}
+	for _, rn := range node.And {
+		if rn.hasGraphQLRule() {
+			return true
+		}
+	}
+	return false
+}
+
 func mergeAuthNodeWithOr(objectAuth, interfaceAuth *RuleNode) *RuleNode {
 	if objectAuth == nil {
 		return interfaceAuth
@@ -476,6 +773,10 @@ func getRBACQuery(typ *ast.Definition, rule string) (*RBACQuery, error) {
 				typ.Name, query.Variable)
 		}
 	}
+	if isComparisonOperator(query.Operator) {
+		// The operand has already been validated by parsing it.
+		query.bounds, _ = parseRuleBounds(query)
+	}
 	return query, nil
 }
 
@@ -517,6 +818,20 @@ func validateRBACOperators(typ *ast.Definition, query *RBACQuery) (bool, string)
 			return false, fmt.Sprintf("Type %s: @auth: `%s` operator has invalid value `%v`."+
 				" Value should be an array.", typ.Name, query.Operator, query.Operand)
 		}
+	case "lt", "le", "gt", "ge":
+		if _, err := parseRuleBounds(query); err != nil {
+			return false, fmt.Sprintf("Type %s: @auth: `%s` operator has invalid value `%v`."+
+				" Value should be a number, an RFC3339 time or now() with an optional duration,"+
+				" like now() - 24h.", typ.Name, query.Operator, query.Operand)
+		}
+	case "between":
+		if _, err := parseRuleBounds(query); err != nil {
+			return false, fmt.Sprintf("Type %s: @auth: `%s` operator has invalid value `%v`."+
+				" Value should be an array of two numbers or two times, like"+
+				" [\"now() - 1h\", \"now()\"].", typ.Name, query.Operator, query.Operand)
+		}
+	case "contains":
+		// contains accepts a single value or an array of values, which must all be present.
 	default:
 		return false, fmt.Sprintf("Type %s: @auth: `%s` operator is not supported.",
 			typ.Name, query.Operator)
@@ -525,6 +840,69 @@ func validateRBACOperators(typ *ast.Definition, query *RBACQuery) (bool, string)
 	return true, ""
 }
 
+func isComparisonOperator(op string) bool {
+	switch op {
+	case "lt", "le", "gt", "ge", "between":
+		return true
+	}
+	return false
+}
+
+var nowRegex = regexp.MustCompile(`^now\(\)\s*(?:([+-])\s*(\S+))?$`)
+
+// parseRuleBounds parses the operand of a comparison operator. The between operator takes
+// an array of a lower and an upper bound, the other operators a single bound.
+func parseRuleBounds(query *RBACQuery) ([]ruleBound, error) {
+	operands := []interface{}{query.Operand}
+	if query.Operator == "between" {
+		var ok bool
+		if operands, ok = query.Operand.([]interface{}); !ok || len(operands) != 2 {
+			return nil, fmt.Errorf("between needs an array of two values")
+		}
+	}
+
+	bounds := make([]ruleBound, 0, len(operands))
+	for _, op := range operands {
+		b, err := parseRuleBound(op)
+		if err != nil {
+			return nil, err
+		}
+		bounds = append(bounds, b)
+	}
+	if len(bounds) == 2 && bounds[0].isTime != bounds[1].isTime {
+		return nil, fmt.Errorf("between can't mix numbers and times")
+	}
+	return bounds, nil
+}
+
+func parseRuleBound(operand interface{}) (ruleBound, error) {
+	switch op := operand.(type) {
+	case float64:
+		return ruleBound{num: op}, nil
+	case string:
+		if m := nowRegex.FindStringSubmatch(strings.TrimSpace(op)); m != nil {
+			b := ruleBound{isTime: true, fromNow: true}
+			if m[1] != "" {
+				d, err := time.ParseDuration(m[2])
+				if err != nil {
+					return ruleBound{}, err
+				}
+				if m[1] == "-" {
+					d = -d
+				}
+				b.offset = d
+			}
+			return b, nil
+		}
+		t, err := time.Parse(time.RFC3339, op)
+		if err != nil {
+			return ruleBound{}, err
+		}
+		return ruleBound{isTime: true, time: t}, nil
+	}
+	return ruleBound{}, fmt.Errorf("%v is neither a number nor a time", operand)
+}
+
 func gqlValidateRule(s *ast.Schema, typ *ast.Definition, rule string, node *RuleNode) error {
 	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: rule})
 	if gqlErr != nil {
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/gqlparser/v2/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateRBACRule(t *testing.T) {
+	hourAgo := time.Now().Add(-time.Hour)
+	claims := `{
+		"LEVEL": 3,
+		"SCORES": [1, 5],
+		"ISSUED": ` + strconv.FormatInt(hourAgo.Unix(), 10) + `,
+		"ISSUED_AT": "` + hourAgo.Format(time.RFC3339) + `",
+		"ROLES": ["USER", "EDITOR"],
+		"entitlements": {
+			"features": ["search", "reports"],
+			"reports": { "until": "2100-01-01T00:00:00Z" },
+			"teams": [ { "name": "red", "tags": ["a", "b"] }, { "name": "blue", "tags": ["c"] } ]
+		}
+	}`
+	var av map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(claims), &av))
+
+	tcases := []struct {
+		rule   string
+		result RuleResult
+	}{
+		{`{$LEVEL: { gt: 2 } }`, Positive},
+		{`{$LEVEL: { ge: 3 } }`, Positive},
+		{`{$LEVEL: { lt: 3 } }`, Negative},
+		{`{$LEVEL: { le: 3 } }`, Positive},
+		{`{$LEVEL: { between: [1, 2] } }`, Negative},
+		{`{$LEVEL: { between: [3, 4] } }`, Positive},
+		{`{$SCORES: { gt: 4 } }`, Positive},
+		{`{$SCORES: { gt: 5 } }`, Negative},
+		{`{$ROLES: { gt: 1 } }`, Negative},
+		{`{$MISSING: { gt: 1 } }`, Negative},
+		{`{$ISSUED: { lt: "now()" } }`, Positive},
+		{`{$ISSUED: { lt: "now() - 2h" } }`, Negative},
+		{`{$ISSUED: { between: ["now() - 90m", "now() - 30m"] } }`, Positive},
+		{`{$ISSUED_AT: { between: ["now() - 90m", "now() - 30m"] } }`, Positive},
+		{`{$ISSUED_AT: { gt: "2100-01-01T00:00:00Z" } }`, Negative},
+		{`{$ISSUED_AT: { gt: 1 } }`, Negative},
+		{`{$entitlements.reports.until: { gt: "now() + 24h" } }`, Positive},
+		{`{$entitlements.reports.until: { lt: "now()" } }`, Negative},
+		{`{$entitlements.features: { contains: "reports" } }`, Positive},
+		{`{$entitlements.features: { contains: ["reports", "search"] } }`, Positive},
+		{`{$entitlements.features: { contains: ["reports", "export"] } }`, Negative},
+		{`{$entitlements.reports: { contains: "until" } }`, Negative},
+		{`{$entitlements.teams.name: { eq: "blue" } }`, Positive},
+		{`{$entitlements.teams.tags: { contains: ["a", "c"] } }`, Positive},
+		{`{$entitlements.teams.missing: { eq: "blue" } }`, Negative},
+		{`{$ROLES: { contains: "EDITOR" } }`, Positive},
+		{`{$ROLES: { eq: "EDITOR" } }`, Positive},
+	}
+
+	typ := &ast.Definition{Name: "X"}
+	for _, tcase := range tcases {
+		t.Run(tcase.rule, func(t *testing.T) {
+			query, err := getRBACQuery(typ, tcase.rule)
+			require.NoError(t, err)
+			require.Equal(t, tcase.result, query.EvaluateRBACRule(av))
+		})
+	}
+}
@@ -271,7 +271,7 @@ directive @auth(
 	query: AuthRule,
 	add: AuthRule,
 	update: AuthRule,
-	delete: AuthRule) on OBJECT | INTERFACE
+	delete: AuthRule) on OBJECT | INTERFACE | FIELD_DEFINITION
 directive @custom(http: CustomHTTP, dql: String) on FIELD_DEFINITION
 directive @remote on OBJECT | INTERFACE | UNION | INPUT_OBJECT | ENUM
 directive @cascade(fields: [String]) on FIELD
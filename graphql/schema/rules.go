@@ -41,7 +41,7 @@ func init() {
 		passwordDirectiveValidation, conflictingDirectiveValidation, nonIdFieldsCheck,
 		remoteTypeValidation, generateDirectiveValidation)
 	fieldValidations = append(fieldValidations, listValidityCheck, fieldArgumentCheck,
-		fieldNameCheck, isValidFieldForList, hasAuthDirective)
+		fieldNameCheck, isValidFieldForList)
 
 	validator.AddRule("Check variable type is correct", variableTypeCheck)
 	validator.AddRule("Check arguments of cascade directive", directiveArgumentsCheck)
@@ -725,18 +725,6 @@ func idCountCheck(schema *ast.Schema, typ *ast.Definition) gqlerror.List {
 	return errs
 }
 
-func hasAuthDirective(typ *ast.Definition, field *ast.FieldDefinition) gqlerror.List {
-	for _, directive := range field.Directives {
-		if directive.Name != authDirective {
-			continue
-		}
-		return []*gqlerror.Error{gqlerror.ErrorPosf(field.Position,
-			"Type %s; Field %s: @%s directive is not allowed on fields",
-			typ.Name, field.Name, authDirective)}
-	}
-	return nil
-}
-
 func isValidFieldForList(typ *ast.Definition, field *ast.FieldDefinition) gqlerror.List {
 	if field.Type.Elem == nil && field.Type.NamedType != "" {
 		return nil
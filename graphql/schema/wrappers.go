@@ -139,6 +139,8 @@ type Field interface {
 	TypeName(dgraphTypes []interface{}) string
 	GetObjectName() string
 	IsAuthQuery() bool
+	// AuthRules returns the @auth rules on the definition of the field, or nil if it has none.
+	AuthRules() *AuthContainer
 	CustomHTTPConfig() (FieldHTTPConfig, error)
 	EnumValues() []string
 	ConstructedFor() Type
@@ -874,6 +876,17 @@ func (f *field) IsAuthQuery() bool {
 	return f.field.Arguments.ForName("dgraph.uid") != nil
 }
 
+func (f *field) AuthRules() *AuthContainer {
+	if f.field.ObjectDefinition == nil {
+		return nil
+	}
+	auth := f.op.inSchema.authRules[typeName(f.field.ObjectDefinition)]
+	if auth == nil {
+		return nil
+	}
+	return auth.Fields[f.Name()]
+}
+
 func (f *field) IsAggregateField() bool {
 	return strings.HasSuffix(f.DgraphAlias(), "Aggregate") &&
 		strings.HasSuffix(f.Type().Name(), "AggregateResult")
@@ -1343,6 +1356,10 @@ func (q *query) IsAuthQuery() bool {
 	return (*field)(q).field.Arguments.ForName("dgraph.uid") != nil
 }
 
+func (q *query) AuthRules() *AuthContainer {
+	return (*field)(q).AuthRules()
+}
+
 func (q *query) IsAggregateField() bool {
 	return (*field)(q).IsAggregateField()
 }
@@ -1765,6 +1782,10 @@ func (m *mutation) IsAuthQuery() bool {
 	return (*field)(m).field.Arguments.ForName("dgraph.uid") != nil
 }
 
+func (m *mutation) AuthRules() *AuthContainer {
+	return (*field)(m).AuthRules()
+}
+
 func (m *mutation) IsAggregateField() bool {
 	return (*field)(m).IsAggregateField()
 }
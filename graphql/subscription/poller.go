@@ -39,14 +39,17 @@ type Poller struct {
 	pollRegistry   map[uint64]map[uint64]subscriber
 	subscriptionID uint64
 	globalEpoch    *uint64
+	// namespace is the namespace whose GraphQL API is polled.
+	namespace uint64
 }
 
-// NewPoller returns Poller.
-func NewPoller(globalEpoch *uint64, resolver *resolve.RequestResolver) *Poller {
+// NewPoller returns Poller, which polls the subscriptions of the namespace ns.
+func NewPoller(globalEpoch *uint64, resolver *resolve.RequestResolver, ns uint64) *Poller {
 	return &Poller{
 		resolver:     resolver,
 		pollRegistry: make(map[uint64]map[uint64]subscriber),
 		globalEpoch:  globalEpoch,
+		namespace:    ns,
 	}
 }
 
@@ -94,7 +97,7 @@ func (p *Poller) AddSubscriber(
 	defer p.Unlock()
 
 	ctx := context.WithValue(context.Background(), authorization.AuthVariables, customClaims.AuthVariables)
-	res := resolver.Resolve(ctx, req)
+	res := resolver.Resolve(x.AttachNamespace(ctx, p.namespace), req)
 	if len(res.Errors) != 0 {
 		return nil, res.Errors
 	}
@@ -172,7 +175,7 @@ func (p *Poller) poll(req *pollRequest) {
 		}
 
 		ctx := context.WithValue(context.Background(), authorization.AuthVariables, req.authVariables)
-		res := resolver.Resolve(ctx, req.graphqlReq)
+		res := resolver.Resolve(x.AttachNamespace(ctx, p.namespace), req.graphqlReq)
 
 		currentHash := farm.Fingerprint64(res.Data.Bytes())
 
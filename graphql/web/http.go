@@ -20,15 +20,18 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"mime"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/ee/audit"
 	"github.com/dgraph-io/dgraph/graphql/api"
 	"github.com/dgraph-io/dgraph/graphql/authorization"
 	"github.com/dgraph-io/dgraph/graphql/resolve"
@@ -47,13 +50,18 @@ type Headerkey string
 
 const (
 	touchedUidsHeader = "Graphql-TouchedUids"
+	namespaceHeader   = "X-Dgraph-Namespace"
 )
 
 // An IServeGraphQL can serve a GraphQL endpoint (currently only ons http)
 type IServeGraphQL interface {
 
-	// After ServeGQL is called, this IServeGraphQL serves the new resolvers.
-	ServeGQL(resolver *resolve.RequestResolver)
+	// After ServeGQL is called, this IServeGraphQL serves the new resolvers for the namespace ns.
+	ServeGQL(ns uint64, resolver *resolve.RequestResolver)
+
+	// LoadNamespacesWith sets the function which is called to serve a namespace the first time a
+	// request is sent to it. The function should call ServeGQL for the namespace.
+	LoadNamespacesWith(load func(ns uint64) error)
 
 	// HTTPHandler returns a http.Handler that serves GraphQL.
 	HTTPHandler() http.Handler
@@ -62,18 +70,32 @@ type IServeGraphQL interface {
 	Resolve(ctx context.Context, gqlReq *schema.Request) *schema.Response
 }
 
-type graphqlHandler struct {
+// namespaceServer serves the GraphQL API of a namespace.
+type namespaceServer struct {
 	resolver *resolve.RequestResolver
-	handler  http.Handler
 	poller   *subscription.Poller
 }
 
+type graphqlHandler struct {
+	sync.RWMutex
+	// namespaces holds the servers of the namespaces, each with its own GraphQL schema. The admin
+	// endpoint is served by the server of the galaxy namespace, whatever the namespace of the
+	// request.
+	namespaces    map[uint64]*namespaceServer
+	loadNamespace func(ns uint64) error
+	schemaEpoch   *uint64
+	handler       http.Handler
+	admin         bool
+}
+
 // NewServer returns a new IServeGraphQL that can serve the given resolvers
 func NewServer(schemaEpoch *uint64, resolver *resolve.RequestResolver, admin bool) IServeGraphQL {
 	gh := &graphqlHandler{
-		resolver: resolver,
-		poller:   subscription.NewPoller(schemaEpoch, resolver),
+		namespaces:  make(map[uint64]*namespaceServer),
+		schemaEpoch: schemaEpoch,
+		admin:       admin,
 	}
+	gh.ServeGQL(x.GalaxyNamespace, resolver)
 	gh.handler = recoveryHandler(commonHeaders(admin, gh.Handler()))
 	return gh
 }
@@ -82,13 +104,79 @@ func (gh *graphqlHandler) HTTPHandler() http.Handler {
 	return gh.handler
 }
 
-func (gh *graphqlHandler) ServeGQL(resolver *resolve.RequestResolver) {
-	gh.poller.UpdateResolver(resolver)
-	gh.resolver = resolver
+func (gh *graphqlHandler) ServeGQL(ns uint64, resolver *resolve.RequestResolver) {
+	gh.Lock()
+	defer gh.Unlock()
+	if srv, ok := gh.namespaces[ns]; ok {
+		// The subscriptions of the namespace keep being polled with the new resolver.
+		srv.poller.UpdateResolver(resolver)
+		gh.namespaces[ns] = &namespaceServer{resolver: resolver, poller: srv.poller}
+		return
+	}
+	gh.namespaces[ns] = &namespaceServer{
+		resolver: resolver,
+		poller:   subscription.NewPoller(gh.schemaEpoch, resolver, ns),
+	}
+}
+
+func (gh *graphqlHandler) LoadNamespacesWith(load func(ns uint64) error) {
+	gh.Lock()
+	defer gh.Unlock()
+	gh.loadNamespace = load
+}
+
+// serverOf returns the server of the namespace ns, which is loaded if it isn't served yet.
+func (gh *graphqlHandler) serverOf(ns uint64) (*namespaceServer, error) {
+	if gh.admin {
+		ns = x.GalaxyNamespace
+	}
+	gh.RLock()
+	srv, ok := gh.namespaces[ns]
+	load := gh.loadNamespace
+	gh.RUnlock()
+	if ok {
+		return srv, nil
+	}
+	if load == nil {
+		return nil, errors.Errorf("GraphQL isn't served in namespace %d", ns)
+	}
+	if err := load(ns); err != nil {
+		return nil, err
+	}
+
+	gh.RLock()
+	defer gh.RUnlock()
+	if srv, ok = gh.namespaces[ns]; !ok {
+		return nil, errors.Errorf("GraphQL isn't served in namespace %d", ns)
+	}
+	return srv, nil
 }
 
 func (gh *graphqlHandler) Resolve(ctx context.Context, gqlReq *schema.Request) *schema.Response {
-	return gh.resolver.Resolve(ctx, gqlReq)
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	srv, err := gh.serverOf(ns)
+	if err != nil {
+		return schema.ErrorResponse(err)
+	}
+	if !audit.Enabled() {
+		return srv.resolver.Resolve(ctx, gqlReq)
+	}
+
+	// The DQL requests sent to serve the GraphQL request are recorded in its audit event.
+	endpoint := audit.EndpointGraphQL
+	if gh.admin {
+		endpoint = audit.EndpointAdmin
+	}
+	e := edgraph.NewAuditEvent(ctx, endpoint, "")
+	res := srv.resolver.Resolve(audit.WithEvent(ctx, e), gqlReq)
+	if len(res.Errors) > 0 {
+		err = res.Errors
+	}
+	audit.Log(e, err)
+	return res
 }
 
 // write chooses between the http response writer and gzip writer
@@ -159,6 +247,33 @@ func (gs *graphqlSubscription) Subscribe(
 		}
 
 	}
+	// The namespace of the subscription is set by the namespace header of the INIT payload.
+	if len(header) > 0 {
+		payload := make(map[string]interface{})
+		if err := json.Unmarshal(header, &payload); err != nil {
+			return nil, err
+		}
+		for key, val := range payload {
+			if !strings.EqualFold(key, namespaceHeader) {
+				continue
+			}
+			ns, err := strconv.ParseUint(fmt.Sprintf("%v", val), 0, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while parsing %s", namespaceHeader)
+			}
+			ctx = x.AttachNamespace(ctx, ns)
+			break
+		}
+	}
+	ctx, ns, err := edgraph.ResolveNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	srv, err := gs.graphqlHandler.serverOf(ns)
+	if err != nil {
+		return nil, err
+	}
+
 	// for the cases when no expiry is given in jwt or subscription doesn't have any authorization,
 	// we set their expiry to zero time
 	if customClaims.StandardClaims.ExpiresAt == nil {
@@ -170,7 +285,7 @@ func (gs *graphqlSubscription) Subscribe(
 		Variables:     variableValues,
 	}
 
-	res, err := gs.graphqlHandler.poller.AddSubscriber(req, customClaims)
+	res, err := srv.poller.AddSubscriber(req, customClaims)
 	if err != nil {
 		return nil, err
 	}
@@ -179,7 +294,7 @@ func (gs *graphqlSubscription) Subscribe(
 		// Context is cancelled when a client disconnects, so delete subscription after client
 		// disconnects.
 		<-ctx.Done()
-		gs.graphqlHandler.poller.TerminateSubscription(res.BucketID, res.SubscriptionID)
+		srv.poller.TerminateSubscription(res.BucketID, res.SubscriptionID)
 	}()
 	return res.UpdateCh, ctx.Err()
 }
@@ -211,6 +326,20 @@ func (gh *graphqlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
 	ctx = x.AttachAuthToken(ctx, r)
+	ctx = x.AttachNamespaceHeader(ctx, r)
+
+	if err := edgraph.CheckRateLimit(ctx); err != nil {
+		w.WriteHeader(http.StatusTooManyRequests)
+		write(w, schema.ErrorResponse(err), false)
+		return
+	}
+
+	// The request is served by the GraphQL API of its namespace.
+	ctx, _, err := edgraph.ResolveNamespace(ctx)
+	if err != nil {
+		write(w, schema.ErrorResponse(err), strings.Contains(r.Header.Get("Accept-Encoding"), "gzip"))
+		return
+	}
 
 	var res *schema.Response
 	gqlReq, err := getRequest(ctx, r)
@@ -225,12 +354,17 @@ func (gh *graphqlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res = gh.resolver.Resolve(ctx, gqlReq)
+	res = gh.Resolve(ctx, gqlReq)
 	write(w, res, strings.Contains(r.Header.Get("Accept-Encoding"), "gzip"))
 }
 
 func (gh *graphqlHandler) isValid() bool {
-	return !(gh == nil || gh.resolver == nil)
+	if gh == nil {
+		return false
+	}
+	gh.RLock()
+	defer gh.RUnlock()
+	return gh.namespaces[x.GalaxyNamespace] != nil
 }
 
 type gzreadCloser struct {
@@ -548,6 +548,8 @@ type rebuilder struct {
 	attr    string
 	prefix  []byte
 	startTs uint64
+	// stage describes what is being rebuilt, in the progress of the index build.
+	stage string
 
 	// The posting list passed here is the on disk version. It is not coming
 	// from the LRU cache.
@@ -569,17 +571,18 @@ func (r *rebuilder) Run(ctx context.Context) error {
 	defer os.RemoveAll(tmpIndexDir)
 	glog.V(1).Infof("Rebuilding indexes using the temp folder %s\n", tmpIndexDir)
 
+	encKey := x.EncryptionKey()
 	dbOpts := badger.DefaultOptions(tmpIndexDir).
 		WithSyncWrites(false).
 		WithNumVersionsToKeep(math.MaxInt32).
 		WithLogger(&x.ToGlog{}).
 		WithCompression(options.None).
-		WithEncryptionKey(x.WorkerConfig.EncryptionKey).
+		WithEncryptionKey(encKey).
 		WithLoggingLevel(badger.WARNING)
 
 	// Set cache if we have encryption.
-	if len(x.WorkerConfig.EncryptionKey) > 0 {
-		dbOpts.EncryptionKey = x.WorkerConfig.EncryptionKey
+	if len(encKey) > 0 {
+		dbOpts.EncryptionKey = encKey
 		dbOpts.BlockCacheSize = 100 << 20
 		dbOpts.IndexCacheSize = 100 << 20
 	}
@@ -593,6 +596,9 @@ func (r *rebuilder) Run(ctx context.Context) error {
 		"Rebuilding index for predicate %s: Starting process. StartTs=%d. Prefix=\n%s\n",
 		r.attr, r.startTs, hex.Dump(r.prefix))
 
+	build := indexBuildFor(r.attr)
+	build.setStage(r.stage + ": building")
+
 	// Counter is used here to ensure that all keys are committed at different timestamp.
 	// We set it to 1 in case there are no keys found and NewStreamAt is called with ts=0.
 	var counter uint64 = 1
@@ -629,6 +635,7 @@ func (r *rebuilder) Run(ctx context.Context) error {
 
 		// Convert data into deltas.
 		txn.Update()
+		build.addKey()
 
 		// txn.cache.Lock() is not required because we are the only one making changes to txn.
 		kvs := make([]*bpb.KV, 0, len(txn.cache.deltas))
@@ -665,6 +672,7 @@ func (r *rebuilder) Run(ctx context.Context) error {
 
 	// Now we write all the created posting lists to disk.
 	glog.V(1).Infof("Rebuilding index for predicate %s: writing index to badger", r.attr)
+	build.setStage(r.stage + ": writing")
 	start = time.Now()
 	defer func() {
 		glog.V(1).Infof("Rebuilding index for predicate %s: writing index took: %v\n",
@@ -686,6 +694,7 @@ func (r *rebuilder) Run(ctx context.Context) error {
 		if err != nil {
 			return nil, err
 		}
+		build.addKey()
 
 		return &bpb.KVList{Kv: kvs}, nil
 	}
@@ -736,11 +745,26 @@ const (
 	indexRebuild         = iota // Index should be deleted and rebuilt.
 )
 
+// ServesOldIndexes returns true if the indexes of the old schema can keep being served while the
+// new ones are getting built. That's not the case if the value type changed, since the old
+// indexes were built from values of another type.
+func (rb *IndexRebuild) ServesOldIndexes() bool {
+	return rb.OldSchema != nil && rb.OldSchema.ValueType == rb.CurrentSchema.ValueType
+}
+
 // GetQuerySchema returns the schema that can be served while indexes are getting built.
-// Query schema is defined as current schema minus tokens to delete from current schema.
+// If the old indexes can be served, query schema is defined as current schema with the indexes
+// of the old schema. They are replaced by the new ones once those are built. Otherwise, it is
+// defined as current schema minus tokens to delete from current schema.
 func (rb *IndexRebuild) GetQuerySchema() *pb.SchemaUpdate {
 	// Copy the current schema.
 	querySchema := *rb.CurrentSchema
+	if rb.ServesOldIndexes() {
+		querySchema.Tokenizer = rb.OldSchema.Tokenizer
+		querySchema.Count = rb.OldSchema.Count
+		querySchema.Directive = rb.OldSchema.Directive
+		return &querySchema
+	}
 	info := rb.needsTokIndexRebuild()
 
 	// Compute old.Tokenizer minus info.tokenizersToDelete.
@@ -768,15 +792,89 @@ func (rb *IndexRebuild) GetQuerySchema() *pb.SchemaUpdate {
 	return &querySchema
 }
 
-// DropIndexes drops the indexes that need to be rebuilt.
+// GetWriteSchema returns the schema used by mutations while indexes are getting built. If the
+// old indexes can be served, it keeps both the old and the new indexes up to date, so that
+// queries can be served from the old ones until the new ones replace them.
+func (rb *IndexRebuild) GetWriteSchema() *pb.SchemaUpdate {
+	// Copy the current schema.
+	writeSchema := *rb.CurrentSchema
+	if !rb.ServesOldIndexes() {
+		return &writeSchema
+	}
+
+	writeSchema.Tokenizer = append([]string{}, rb.CurrentSchema.Tokenizer...)
+	for _, t1 := range rb.OldSchema.Tokenizer {
+		found := false
+		for _, t2 := range rb.CurrentSchema.Tokenizer {
+			if t1 == t2 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			writeSchema.Tokenizer = append(writeSchema.Tokenizer, t1)
+		}
+	}
+	writeSchema.Count = rb.CurrentSchema.Count || rb.OldSchema.Count
+	if writeSchema.Directive == pb.SchemaUpdate_NONE {
+		writeSchema.Directive = rb.OldSchema.Directive
+	}
+	return &writeSchema
+}
+
+// DropIndexes drops the indexes that need to be rebuilt. If the old indexes can be served while
+// the new ones are getting built, the indexes to be deleted are left alone until DropOldIndexes
+// is called.
 func (rb *IndexRebuild) DropIndexes(ctx context.Context) error {
-	prefixes, err := prefixesForTokIndexes(ctx, rb)
+	var prefixes [][]byte
+	if rb.ServesOldIndexes() {
+		tokPrefixes, err := prefixesForTokenizers(rb.Attr, rb.needsTokIndexRebuild().tokenizersToRebuild)
+		if err != nil {
+			return err
+		}
+		prefixes = append(prefixes, tokPrefixes...)
+		if rb.needsReverseEdgesRebuild() == indexRebuild {
+			prefixes = append(prefixes, prefixesToDropReverseEdges(ctx, rb)...)
+		}
+		if rb.needsCountIndexRebuild() == indexRebuild {
+			prefixes = append(prefixes, prefixesToDropCountIndex(ctx, rb)...)
+		}
+	} else {
+		tokPrefixes, err := prefixesForTokIndexes(ctx, rb)
+		if err != nil {
+			return err
+		}
+		prefixes = append(prefixes, tokPrefixes...)
+		prefixes = append(prefixes, prefixesToDropReverseEdges(ctx, rb)...)
+		prefixes = append(prefixes, prefixesToDropCountIndex(ctx, rb)...)
+	}
+	if len(prefixes) == 0 {
+		return nil
+	}
+	glog.Infof("Deleting indexes for %s", rb.Attr)
+	return pstore.DropPrefix(prefixes...)
+}
+
+// DropOldIndexes drops the indexes which are no longer part of the schema, once the new schema
+// is being served. It does nothing if they were already dropped by DropIndexes.
+func (rb *IndexRebuild) DropOldIndexes(ctx context.Context) error {
+	if !rb.ServesOldIndexes() {
+		return nil
+	}
+	prefixes, err := prefixesForTokenizers(rb.Attr, rb.needsTokIndexRebuild().tokenizersToDelete)
 	if err != nil {
 		return err
 	}
-	prefixes = append(prefixes, prefixesToDropReverseEdges(ctx, rb)...)
-	prefixes = append(prefixes, prefixesToDropCountIndex(ctx, rb)...)
-	glog.Infof("Deleting indexes for %s", rb.Attr)
+	if rb.needsReverseEdgesRebuild() == indexDelete {
+		prefixes = append(prefixes, prefixesToDropReverseEdges(ctx, rb)...)
+	}
+	if rb.needsCountIndexRebuild() == indexDelete {
+		prefixes = append(prefixes, prefixesToDropCountIndex(ctx, rb)...)
+	}
+	if len(prefixes) == 0 {
+		return nil
+	}
+	glog.Infof("Deleting old indexes for %s", rb.Attr)
 	return pstore.DropPrefix(prefixes...)
 }
 
@@ -888,27 +986,29 @@ func prefixesForTokIndexes(ctx context.Context, rb *IndexRebuild) ([][]byte, err
 
 	glog.Infof("Computing prefix index for attr %s and tokenizers %s", rb.Attr,
 		rebuildInfo.tokenizersToDelete)
-	for _, tokenizer := range rebuildInfo.tokenizersToDelete {
-		prefixesNonLang, err := prefixesToDeleteTokensFor(rb.Attr, tokenizer, false)
-		if err != nil {
-			return nil, err
-		}
-		prefixes = append(prefixes, prefixesNonLang...)
-		if tokenizer != "exact" {
-			continue
-		}
-		prefixesWithLang, err := prefixesToDeleteTokensFor(rb.Attr, tokenizer, true)
-		if err != nil {
-			return nil, err
-		}
-		prefixes = append(prefixes, prefixesWithLang...)
+	deletePrefixes, err := prefixesForTokenizers(rb.Attr, rebuildInfo.tokenizersToDelete)
+	if err != nil {
+		return nil, err
 	}
+	prefixes = append(prefixes, deletePrefixes...)
 
 	glog.Infof("Deleting index for attr %s and tokenizers %s", rb.Attr,
 		rebuildInfo.tokenizersToRebuild)
 	// Before rebuilding, the existing index needs to be deleted.
-	for _, tokenizer := range rebuildInfo.tokenizersToRebuild {
-		prefixesNonLang, err := prefixesToDeleteTokensFor(rb.Attr, tokenizer, false)
+	rebuildPrefixes, err := prefixesForTokenizers(rb.Attr, rebuildInfo.tokenizersToRebuild)
+	if err != nil {
+		return nil, err
+	}
+	prefixes = append(prefixes, rebuildPrefixes...)
+
+	return prefixes, nil
+}
+
+// prefixesForTokenizers returns the prefixes of the index keys of attr for the given tokenizers.
+func prefixesForTokenizers(attr string, tokenizers []string) ([][]byte, error) {
+	var prefixes [][]byte
+	for _, tokenizer := range tokenizers {
+		prefixesNonLang, err := prefixesToDeleteTokensFor(attr, tokenizer, false)
 		if err != nil {
 			return nil, err
 		}
@@ -916,13 +1016,12 @@ func prefixesForTokIndexes(ctx context.Context, rb *IndexRebuild) ([][]byte, err
 		if tokenizer != "exact" {
 			continue
 		}
-		prefixesWithLang, err := prefixesToDeleteTokensFor(rb.Attr, tokenizer, true)
+		prefixesWithLang, err := prefixesToDeleteTokensFor(attr, tokenizer, true)
 		if err != nil {
 			return nil, err
 		}
 		prefixes = append(prefixes, prefixesWithLang...)
 	}
-
 	return prefixes, nil
 }
 
@@ -947,7 +1046,8 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 	}
 
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		stage: fmt.Sprintf("index %v", rebuildInfo.tokenizersToRebuild)}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		edge := pb.DirectedEdge{Attr: rb.Attr, Entity: uid}
 		return pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
@@ -1060,7 +1160,8 @@ func rebuildCountIndex(ctx context.Context, rb *IndexRebuild) error {
 
 	// Create the forward index.
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		stage: "count index"}
 	builder.fn = fn
 	if err := builder.Run(ctx); err != nil {
 		return err
@@ -1071,7 +1172,8 @@ func rebuildCountIndex(ctx context.Context, rb *IndexRebuild) error {
 	// to call builder.Run even if that's not the case as the reverse prefix
 	// will be empty.
 	reverse = true
-	builder = rebuilder{attr: rb.Attr, prefix: pk.ReversePrefix(), startTs: rb.StartTs}
+	builder = rebuilder{attr: rb.Attr, prefix: pk.ReversePrefix(), startTs: rb.StartTs,
+		stage: "reverse count index"}
 	builder.fn = fn
 	return builder.Run(ctx)
 }
@@ -1130,7 +1232,8 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 
 	glog.Infof("Rebuilding reverse index for %s", rb.Attr)
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		stage: "reverse index"}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		edge := pb.DirectedEdge{Attr: rb.Attr, Entity: uid}
 		return pl.Iterate(txn.StartTs, 0, func(pp *pb.Posting) error {
@@ -1184,7 +1287,8 @@ func rebuildListType(ctx context.Context, rb *IndexRebuild) error {
 	}
 
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		stage: "list type"}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		var mpost *pb.Posting
 		err := pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
@@ -1245,6 +1349,42 @@ func DeletePredicate(ctx context.Context, attr string) error {
 	if err := pstore.DropPrefix(prefix); err != nil {
 		return err
 	}
+	atomic.AddUint64(&resets, 1)
 
 	return schema.State().Delete(attr)
 }
+
+// DeleteDataRange deletes the data keys of attr for subject uids in [start, end) as of ts. It is
+// used when a uid range of a predicate moves to another group, so the schema and the rest of the
+// predicate are left intact.
+func DeleteDataRange(ctx context.Context, attr string, start, end, ts uint64) error {
+	glog.Infof("Dropping data of predicate: [%s] for uids in [%#x, %#x)", attr, start, end)
+	txn := pstore.NewTransactionAt(ts, false)
+	defer txn.Discard()
+
+	iopt := badger.DefaultIteratorOptions
+	iopt.PrefetchValues = false
+	iopt.Prefix = x.ParsedKey{Attr: attr}.DataPrefix()
+	itr := txn.NewIterator(iopt)
+	defer itr.Close()
+
+	writer := NewTxnWriter(pstore)
+	for itr.Seek(x.DataKey(attr, start)); itr.Valid(); itr.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		key := itr.Item().KeyCopy(nil)
+		pk, err := x.Parse(key)
+		if err != nil {
+			return err
+		}
+		if pk.Uid >= end {
+			break
+		}
+		if err := writer.SetAt(key, nil, BitEmptyPosting, ts); err != nil {
+			return err
+		}
+		RemoveCacheFor(key)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// IndexBuildStatus is the progress of an index build running in the background.
+type IndexBuildStatus struct {
+	Predicate string `json:"predicate"`
+	Stage     string `json:"stage"`
+	// Keys is the number of keys processed in the current stage.
+	Keys    uint64    `json:"keysProcessed"`
+	Started time.Time `json:"startedAt"`
+}
+
+// indexBuild tracks an index build running in the background, so that its progress can be
+// reported and it can be cancelled.
+type indexBuild struct {
+	sync.Mutex
+	stage   string
+	started time.Time
+	keys    uint64
+	cancel  context.CancelFunc
+}
+
+var indexBuilds = struct {
+	sync.Mutex
+	m map[string]*indexBuild
+}{m: make(map[string]*indexBuild)}
+
+// TrackIndexBuild registers the index build of attr. The returned context is cancelled by
+// CancelIndexBuild, and the returned function must be called once the build is over.
+func TrackIndexBuild(ctx context.Context, attr string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	b := &indexBuild{stage: "waiting", started: time.Now(), cancel: cancel}
+
+	indexBuilds.Lock()
+	indexBuilds.m[attr] = b
+	indexBuilds.Unlock()
+
+	return ctx, func() {
+		cancel()
+		indexBuilds.Lock()
+		defer indexBuilds.Unlock()
+		if indexBuilds.m[attr] == b {
+			delete(indexBuilds.m, attr)
+		}
+	}
+}
+
+// CancelIndexBuild cancels the index build of attr. It returns false if no index is being built
+// for attr.
+func CancelIndexBuild(attr string) bool {
+	indexBuilds.Lock()
+	defer indexBuilds.Unlock()
+	b, ok := indexBuilds.m[attr]
+	if ok {
+		b.cancel()
+	}
+	return ok
+}
+
+// IsIndexBuilding returns true if an index is being built in the background for attr.
+func IsIndexBuilding(attr string) bool {
+	indexBuilds.Lock()
+	defer indexBuilds.Unlock()
+	_, ok := indexBuilds.m[attr]
+	return ok
+}
+
+// IndexBuilds returns the progress of the index builds running in the background.
+func IndexBuilds() []IndexBuildStatus {
+	indexBuilds.Lock()
+	defer indexBuilds.Unlock()
+	builds := make([]IndexBuildStatus, 0, len(indexBuilds.m))
+	for attr, b := range indexBuilds.m {
+		b.Lock()
+		builds = append(builds, IndexBuildStatus{
+			Predicate: attr,
+			Stage:     b.stage,
+			Keys:      atomic.LoadUint64(&b.keys),
+			Started:   b.started,
+		})
+		b.Unlock()
+	}
+	sort.Slice(builds, func(i, j int) bool {
+		return builds[i].Predicate < builds[j].Predicate
+	})
+	return builds
+}
+
+// indexBuildFor returns the index build of attr, or nil if there is none.
+func indexBuildFor(attr string) *indexBuild {
+	indexBuilds.Lock()
+	defer indexBuilds.Unlock()
+	return indexBuilds.m[attr]
+}
+
+func (b *indexBuild) setStage(stage string) {
+	if b == nil {
+		return
+	}
+	b.Lock()
+	defer b.Unlock()
+	b.stage = stage
+	atomic.StoreUint64(&b.keys, 0)
+}
+
+func (b *indexBuild) addKey() {
+	if b == nil {
+		return
+	}
+	atomic.AddUint64(&b.keys, 1)
+}
@@ -538,3 +538,114 @@ func TestNeedsListTypeRebuild(t *testing.T) {
 	require.False(t, rebuild)
 	require.Error(t, err)
 }
+
+func TestQueryAndWriteSchema(t *testing.T) {
+	rb := IndexRebuild{}
+	rb.OldSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"term", "exact"}, Count: true}
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact", "fulltext"}, Upsert: true}
+	require.True(t, rb.ServesOldIndexes())
+
+	// The old indexes are served while the new ones are getting built.
+	querySchema := rb.GetQuerySchema()
+	require.Equal(t, []string{"term", "exact"}, querySchema.Tokenizer)
+	require.True(t, querySchema.Count)
+	require.True(t, querySchema.Upsert)
+
+	// Both the old and the new indexes are kept up to date.
+	writeSchema := rb.GetWriteSchema()
+	require.Equal(t, []string{"exact", "fulltext", "term"}, writeSchema.Tokenizer)
+	require.True(t, writeSchema.Count)
+	require.Equal(t, pb.SchemaUpdate_INDEX, writeSchema.Directive)
+	require.Equal(t, []string{"exact", "fulltext"}, rb.CurrentSchema.Tokenizer)
+
+	// The old indexes can't be served if the value type changed.
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_FLOAT,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"float"}}
+	require.False(t, rb.ServesOldIndexes())
+	querySchema = rb.GetQuerySchema()
+	require.Equal(t, []string{}, querySchema.Tokenizer)
+	require.False(t, querySchema.Count)
+	writeSchema = rb.GetWriteSchema()
+	require.Equal(t, []string{"float"}, writeSchema.Tokenizer)
+	require.False(t, writeSchema.Count)
+}
+
+func indexTermsAt(t *testing.T, attr string, readTs uint64) []string {
+	txn := ps.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	it := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer it.Close()
+	pk := x.ParsedKey{Attr: attr}
+	prefix := pk.IndexPrefix()
+	var terms []string
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		if it.Item().UserMeta()&BitEmptyPosting == BitEmptyPosting {
+			continue
+		}
+		key, err := x.Parse(it.Item().Key())
+		require.NoError(t, err)
+		terms = append(terms, key.Term)
+	}
+	return terms
+}
+
+func TestRebuildKeepsOldIndex(t *testing.T) {
+	addEdgeToValue(t, "name3", 91, "Michonne", uint64(1), uint64(2))
+	addEdgeToValue(t, "name3", 92, "David", uint64(3), uint64(4))
+
+	oldSchema := &pb.SchemaUpdate{Predicate: "name3", ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"term"}}
+	schema.State().Set("name3", oldSchema)
+	rb := IndexRebuild{Attr: "name3", StartTs: 5, CurrentSchema: oldSchema}
+	require.NoError(t, rb.DropIndexes(context.Background()))
+	require.NoError(t, rb.BuildIndexes(context.Background()))
+	require.Equal(t, []string{"\x01david", "\x01michonne"}, indexTermsAt(t, "name3", 6))
+
+	rb = IndexRebuild{
+		Attr:      "name3",
+		StartTs:   6,
+		OldSchema: oldSchema,
+		CurrentSchema: &pb.SchemaUpdate{Predicate: "name3", ValueType: pb.Posting_STRING,
+			Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact"}},
+	}
+	schema.State().Set("name3", rb.GetQuerySchema())
+	schema.State().SetMutSchema("name3", rb.GetWriteSchema())
+	defer schema.State().DeleteMutSchema("name3")
+	ctx, done := TrackIndexBuild(schema.GetWriteContext(context.Background()), "name3")
+	require.NoError(t, rb.DropIndexes(ctx))
+	require.NoError(t, rb.BuildIndexes(ctx))
+
+	// The old index is left alone while the new one is built.
+	require.Equal(t, []string{"\x01david", "\x01michonne", "\x02David", "\x02Michonne"},
+		indexTermsAt(t, "name3", 7))
+	builds := IndexBuilds()
+	require.Len(t, builds, 1)
+	require.Equal(t, "name3", builds[0].Predicate)
+	require.Equal(t, "index [exact]: writing", builds[0].Stage)
+	require.Equal(t, uint64(2), builds[0].Keys)
+	done()
+	require.Len(t, IndexBuilds(), 0)
+
+	require.NoError(t, rb.DropOldIndexes(context.Background()))
+	require.Equal(t, []string{"\x02David", "\x02Michonne"}, indexTermsAt(t, "name3", 7))
+}
+
+func TestCancelIndexBuild(t *testing.T) {
+	require.False(t, CancelIndexBuild("name4"))
+	require.False(t, IsIndexBuilding("name4"))
+
+	ctx, done := TrackIndexBuild(context.Background(), "name4")
+	defer done()
+	require.True(t, IsIndexBuilding("name4"))
+	require.True(t, CancelIndexBuild("name4"))
+	require.Equal(t, context.Canceled, ctx.Err())
+
+	rb := IndexRebuild{
+		Attr:          "name4",
+		StartTs:       5,
+		CurrentSchema: &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Count: true},
+	}
+	require.Error(t, rb.BuildIndexes(ctx))
+}
@@ -443,12 +443,19 @@ func (l *List) addMutation(ctx context.Context, txn *Txn, t *pb.DirectedEdge) er
 	return l.addMutationInternal(ctx, txn, t)
 }
 
+// ListConflictKey returns the conflict key of the whole posting list stored under key. It is used
+// to detect the conflicts between the lists read by serializable transactions and the ones written
+// by other transactions.
+func ListConflictKey(key []byte) uint64 {
+	return farm.Fingerprint64(key)
+}
+
 func GetConflictKey(pk x.ParsedKey, key []byte, t *pb.DirectedEdge) uint64 {
 	getKey := func(key []byte, uid uint64) uint64 {
 		// Instead of creating a string first and then doing a fingerprint, let's do a fingerprint
 		// here to save memory allocations.
 		// Not entirely sure about effect on collision chances due to this simple XOR with uid.
-		return farm.Fingerprint64(key) ^ uid
+		return ListConflictKey(key) ^ uid
 	}
 
 	var conflictKey uint64
@@ -534,7 +541,7 @@ func (l *List) addMutationInternal(ctx context.Context, txn *Txn, t *pb.Directed
 	// We ensure that commit marks are applied to posting lists in the right
 	// order. We can do so by proposing them in the same order as received by the Oracle delta
 	// stream from Zero, instead of in goroutines.
-	txn.addConflictKey(GetConflictKey(pk, l.key, t))
+	txn.addConflictKey(l.key, GetConflictKey(pk, l.key, t))
 	return nil
 }
 
@@ -850,6 +857,13 @@ func (l *List) Rollup(alloc *z.Allocator) ([]*bpb.KV, error) {
 	})
 
 	x.VerifyPostingSplits(kvs, out.plist, out.parts, l.key)
+
+	// Compress the lists as per the storage hint of the predicate, if any.
+	for _, kv := range kvs {
+		if kv.Value, err = encodePostingList(kv.Key, kv.Value); err != nil {
+			return nil, err
+		}
+	}
 	return kvs, nil
 }
 
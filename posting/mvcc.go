@@ -191,14 +191,18 @@ func (txn *Txn) ShouldAbort() bool {
 	return atomic.LoadUint32(&txn.shouldAbort) > 0
 }
 
-func (txn *Txn) addConflictKey(conflictKey uint64) {
+func (txn *Txn) addConflictKey(key []byte, conflictKey uint64) {
 	txn.Lock()
 	defer txn.Unlock()
 	if txn.conflicts == nil {
 		txn.conflicts = make(map[uint64]struct{})
 	}
+	if txn.writes == nil {
+		txn.writes = make(map[uint64]struct{})
+	}
 	if conflictKey > 0 {
 		txn.conflicts[conflictKey] = struct{}{}
+		txn.writes[ListConflictKey(key)] = struct{}{}
 	}
 }
 
@@ -214,6 +218,14 @@ func (txn *Txn) FillContext(ctx *api.TxnContext, gid uint32) {
 		fps := strconv.FormatUint(key, 36)
 		ctx.Keys = append(ctx.Keys, fps)
 	}
+	for key := range txn.writes {
+		// The lists written are only needed by Zero to detect the conflicts with the reads of
+		// serializable transactions, unless they are conflict keys already.
+		if _, ok := txn.conflicts[key]; ok {
+			continue
+		}
+		ctx.Keys = append(ctx.Keys, x.ListConflictKeyPrefix+strconv.FormatUint(key, 36))
+	}
 	ctx.Keys = x.Unique(ctx.Keys)
 
 	txn.Unlock()
@@ -283,9 +295,19 @@ func (txn *Txn) CommitToDisk(writer *TxnWriter, commitTs uint64) error {
 	return nil
 }
 
+// resets counts the changes to the data made outside of transactions, like drops and schema
+// updates. They invalidate all the cached query results.
+var resets uint64
+
 // ResetCache will clear all the cached list.
 func ResetCache() {
 	lCache.Clear()
+	atomic.AddUint64(&resets, 1)
+}
+
+// Resets returns the number of times the data was changed outside of transactions, e.g. dropped.
+func Resets() uint64 {
+	return atomic.LoadUint64(&resets)
 }
 
 // RemoveCacheFor will delete the list corresponding to the given key.
@@ -320,7 +342,7 @@ func unmarshalOrCopy(plist *pb.PostingList, item *badger.Item) error {
 			// empty pl
 			return nil
 		}
-		return plist.Unmarshal(val)
+		return UnmarshalPostingList(val, plist)
 	})
 }
 
@@ -465,6 +487,8 @@ func getNew(key []byte, pstore *badger.DB, readTs uint64) (*List, error) {
 	if err != nil {
 		return l, err
 	}
-	lCache.Set(key, l, 0)
+	if cost, ok := cacheCost(l); ok {
+		lCache.Set(key, l, cost)
+	}
 	return l, nil
 }
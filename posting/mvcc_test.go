@@ -18,9 +18,12 @@ package posting
 
 import (
 	"math"
+	"strconv"
 	"testing"
 
+	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
 )
@@ -97,3 +100,96 @@ func TestPostingListRead(t *testing.T) {
 	addEdgeToUID(t, "emptypl", 1, 7, 15, 16)
 	assertLength(17, 3)
 }
+
+func TestStorageHintCompression(t *testing.T) {
+	plist := &pb.PostingList{}
+	for i := 0; i < 100; i++ {
+		plist.Postings = append(plist.Postings, &pb.Posting{
+			Uid:   uint64(i),
+			Value: []byte("a value which repeats itself a lot"),
+		})
+	}
+	val, err := plist.Marshal()
+	require.NoError(t, err)
+
+	for _, hint := range []*pb.StorageHint{
+		{Compression: pb.StorageHint_SNAPPY},
+		{Compression: pb.StorageHint_ZSTD, Level: 3},
+	} {
+		attr := "compressed_" + hint.Compression.String()
+		key := x.DataKey(attr, 1)
+		schema.State().Set(attr, &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Storage: hint})
+
+		enc, err := encodePostingList(key, val)
+		require.NoError(t, err)
+		require.Equal(t, compressedMarker, enc[0])
+		require.Less(t, len(enc), len(val))
+
+		// Compressed lists are not compressed again.
+		again, err := encodePostingList(key, enc)
+		require.NoError(t, err)
+		require.Equal(t, enc, again)
+
+		var out pb.PostingList
+		require.NoError(t, UnmarshalPostingList(enc, &out))
+		require.Equal(t, len(plist.Postings), len(out.Postings))
+		require.Equal(t, plist.Postings[99].Value, out.Postings[99].Value)
+		schema.State().Delete(attr)
+	}
+
+	// Lists of predicates without a hint are stored as is.
+	enc, err := encodePostingList(x.DataKey("uncompressed", 1), val)
+	require.NoError(t, err)
+	require.Equal(t, val, enc)
+	dec, err := decodePostingList(enc)
+	require.NoError(t, err)
+	require.Equal(t, val, dec)
+}
+
+func TestFillContextListConflictKeys(t *testing.T) {
+	single, list := x.DataKey("name", 1), x.DataKey("friend", 1)
+	txn := NewTxn(5)
+	// The conflict key of a singular predicate is the one of its whole list.
+	txn.addConflictKey(single, ListConflictKey(single))
+	// The conflict key of a list predicate depends on the value written.
+	txn.addConflictKey(list, ListConflictKey(list)^2)
+
+	var ctx api.TxnContext
+	txn.FillContext(&ctx, 1)
+	require.ElementsMatch(t, []string{
+		strconv.FormatUint(ListConflictKey(single), 36),
+		strconv.FormatUint(ListConflictKey(list)^2, 36),
+		x.ListConflictKeyPrefix + strconv.FormatUint(ListConflictKey(list), 36),
+	}, ctx.Keys)
+}
+
+func TestLatestCommitTs(t *testing.T) {
+	o := new(oracle)
+	o.init()
+	require.Zero(t, o.LatestCommitTs([]string{"name"}))
+
+	o.ProcessDelta(&pb.OracleDelta{
+		MaxAssigned: 10,
+		Txns: []*pb.TxnStatus{
+			{StartTs: 1, CommitTs: 5, Preds: []string{"name", "age"}},
+			{StartTs: 2, CommitTs: 7, Preds: []string{"name"}},
+			// Aborted transactions don't write anything.
+			{StartTs: 3, Preds: []string{"friend"}},
+		},
+	})
+	require.Equal(t, uint64(7), o.LatestCommitTs([]string{"name"}))
+	require.Equal(t, uint64(7), o.LatestCommitTs([]string{"age", "name"}))
+	require.Equal(t, uint64(5), o.LatestCommitTs([]string{"age"}))
+	require.Zero(t, o.LatestCommitTs([]string{"friend"}))
+
+	o.RecordCommit([]string{"friend"}, 12)
+	require.Equal(t, uint64(12), o.LatestCommitTs([]string{"friend"}))
+
+	// The commits whose predicates are unknown invalidate all the predicates.
+	o.ProcessDelta(&pb.OracleDelta{
+		MaxAssigned: 20,
+		Txns:        []*pb.TxnStatus{{StartTs: 15, CommitTs: 16}},
+	})
+	require.Equal(t, uint64(16), o.LatestCommitTs([]string{"age"}))
+	require.Equal(t, uint64(16), o.LatestCommitTs(nil))
+}
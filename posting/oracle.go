@@ -56,6 +56,10 @@ type Txn struct {
 	// transaction conflicts with another.
 	conflicts map[uint64]struct{}
 
+	// Keeps track of the conflict keys of the whole posting lists written, which conflict with the
+	// reads of serializable transactions.
+	writes map[uint64]struct{}
+
 	// Keeps track of last update wall clock. We use this fact later to
 	// determine unhealthy, stale txns.
 	lastUpdate time.Time
@@ -108,11 +112,51 @@ type oracle struct {
 	// Used for waiting logic for transactions with startTs > maxpending so that we don't read an
 	// uncommitted transaction.
 	waiters map[uint64][]chan struct{}
+
+	// Latest commit ts of the transactions which wrote to each predicate, used to invalidate the
+	// cached query results. unknownCommitTs is the latest commit ts of the transactions whose
+	// predicates are unknown, which invalidate all the results.
+	predCommits     map[string]uint64
+	unknownCommitTs uint64
 }
 
 func (o *oracle) init() {
 	o.waiters = make(map[uint64][]chan struct{})
 	o.pendingTxns = make(map[uint64]*Txn)
+	o.predCommits = make(map[string]uint64)
+}
+
+// recordCommit records that the given predicates were written at commitTs. o must be locked.
+func (o *oracle) recordCommit(preds []string, commitTs uint64) {
+	if len(preds) == 0 {
+		o.unknownCommitTs = x.Max(o.unknownCommitTs, commitTs)
+		return
+	}
+	for _, pred := range preds {
+		if commitTs > o.predCommits[pred] {
+			o.predCommits[pred] = commitTs
+		}
+	}
+}
+
+// RecordCommit records that a transaction committed through this Alpha at commitTs wrote the
+// given predicates, before the oracle delta for it is applied.
+func (o *oracle) RecordCommit(preds []string, commitTs uint64) {
+	o.Lock()
+	defer o.Unlock()
+	o.recordCommit(preds, commitTs)
+}
+
+// LatestCommitTs returns the latest commit ts known to this Alpha of the transactions which wrote
+// any of the given predicates.
+func (o *oracle) LatestCommitTs(preds []string) uint64 {
+	o.RLock()
+	defer o.RUnlock()
+	ts := o.unknownCommitTs
+	for _, pred := range preds {
+		ts = x.Max(ts, o.predCommits[pred])
+	}
+	return ts
 }
 
 func (o *oracle) RegisterStartTs(ts uint64) *Txn {
@@ -221,6 +265,9 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 	defer o.Unlock()
 	for _, txn := range delta.Txns {
 		delete(o.pendingTxns, txn.StartTs)
+		if txn.CommitTs > 0 {
+			o.recordCommit(txn.Preds, txn.CommitTs)
+		}
 	}
 	curMax := o.MaxAssigned()
 	if delta.MaxAssigned < curMax {
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"github.com/dgraph-io/badger/v3/y"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+/*
+Predicates can be given storage hints in the schema with the @storage directive, e.g.
+@storage(compression: zstd, level: 3, cache: hot).
+
+The compression applies to the complete posting lists written by rollups, on top of the
+compression of the Badger tables. A compressed value starts with compressedMarker followed by the
+compression used, so values written before the hint was set, or with another hint, can always be
+read. Deltas are never compressed, since they are short lived.
+
+The cache hint changes how the posting list cache admits the lists of the predicate. Hot lists
+are charged a fraction of their size, so that they are admitted and kept in preference to other
+lists. Cold lists, like large blobs read once in a while, are never cached.
+*/
+
+const (
+	// compressedMarker is the first byte of a compressed posting list. A marshalled posting list
+	// never starts with it, since zero is not a valid protobuf field number.
+	compressedMarker byte = 0x00
+
+	// hotCostDivisor is the factor by which the cost of hot lists is divided in the cache.
+	hotCostDivisor = 8
+)
+
+// storageHint returns the storage hint of the predicate of key, or nil if there is none.
+func storageHint(key []byte) *pb.StorageHint {
+	if !schema.State().HasStorageHints() {
+		return nil
+	}
+	pk, err := x.Parse(key)
+	if err != nil {
+		return nil
+	}
+	return schema.State().StorageHint(pk.Attr)
+}
+
+// encodePostingList compresses the marshalled posting list stored under key, as per the storage
+// hint of its predicate. The value is returned as is if there is nothing to do.
+func encodePostingList(key, val []byte) ([]byte, error) {
+	if len(val) == 0 || val[0] == compressedMarker {
+		return val, nil
+	}
+	hint := storageHint(key)
+	var out []byte
+	switch hint.GetCompression() {
+	case pb.StorageHint_SNAPPY:
+		out = make([]byte, 2+snappy.MaxEncodedLen(len(val)))
+		out = out[:2+len(snappy.Encode(out[2:], val))]
+	case pb.StorageHint_ZSTD:
+		compressed, err := y.ZSTDCompress(nil, val, int(hint.GetLevel()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "while compressing posting list")
+		}
+		out = make([]byte, 2, 2+len(compressed))
+		out = append(out, compressed...)
+	default:
+		return val, nil
+	}
+	// Don't bother if the compression doesn't save anything.
+	if len(out) >= len(val) {
+		return val, nil
+	}
+	out[0] = compressedMarker
+	out[1] = byte(hint.GetCompression())
+	return out, nil
+}
+
+// decodePostingList returns the marshalled posting list val, decompressing it if needed.
+func decodePostingList(val []byte) ([]byte, error) {
+	if len(val) == 0 || val[0] != compressedMarker {
+		return val, nil
+	}
+	if len(val) < 2 {
+		return nil, errors.Errorf("invalid compressed posting list of length %d", len(val))
+	}
+	switch pb.StorageHint_Compression(val[1]) {
+	case pb.StorageHint_SNAPPY:
+		out, err := snappy.Decode(nil, val[2:])
+		return out, errors.Wrapf(err, "while decompressing posting list")
+	case pb.StorageHint_ZSTD:
+		out, err := y.ZSTDDecompress(nil, val[2:])
+		return out, errors.Wrapf(err, "while decompressing posting list")
+	}
+	return nil, errors.Errorf("unknown compression %d of posting list", val[1])
+}
+
+// UnmarshalPostingList unmarshals the value of a complete posting list into plist, decompressing
+// it if needed.
+func UnmarshalPostingList(val []byte, plist *pb.PostingList) error {
+	val, err := decodePostingList(val)
+	if err != nil {
+		return err
+	}
+	return plist.Unmarshal(val)
+}
+
+// cacheCost returns the cost of keeping l in the posting list cache, and false if it shouldn't be
+// cached at all.
+func cacheCost(l *List) (int64, bool) {
+	switch storageHint(l.key).GetCache() {
+	case pb.StorageHint_HOT:
+		return int64(l.DeepSize())/hotCostDivisor + 1, true
+	case pb.StorageHint_COLD:
+		return 0, false
+	}
+	// Let the cache compute the cost.
+	return 0, true
+}
@@ -30,6 +30,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type Query_Isolation int32
+
+const (
+	Query_SNAPSHOT       Query_Isolation = 0
+	Query_SERIALIZABLE   Query_Isolation = 1
+	Query_READ_COMMITTED Query_Isolation = 2
+)
+
+var Query_Isolation_name = map[int32]string{
+	0: "SNAPSHOT",
+	1: "SERIALIZABLE",
+	2: "READ_COMMITTED",
+}
+
+var Query_Isolation_value = map[string]int32{
+	"SNAPSHOT":       0,
+	"SERIALIZABLE":   1,
+	"READ_COMMITTED": 2,
+}
+
+func (x Query_Isolation) String() string {
+	return proto.EnumName(Query_Isolation_name, int32(x))
+}
+
+func (Query_Isolation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{3, 0}
+}
+
 type DirectedEdge_Op int32
 
 const (
@@ -301,6 +329,62 @@ func (BackupKey_KeyType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f80abaa17e25ccc8, []int{60, 0}
 }
 
+type StorageHint_Compression int32
+
+const (
+	StorageHint_DEFAULT StorageHint_Compression = 0
+	StorageHint_SNAPPY  StorageHint_Compression = 1
+	StorageHint_ZSTD    StorageHint_Compression = 2
+)
+
+var StorageHint_Compression_name = map[int32]string{
+	0: "DEFAULT",
+	1: "SNAPPY",
+	2: "ZSTD",
+}
+
+var StorageHint_Compression_value = map[string]int32{
+	"DEFAULT": 0,
+	"SNAPPY":  1,
+	"ZSTD":    2,
+}
+
+func (x StorageHint_Compression) String() string {
+	return proto.EnumName(StorageHint_Compression_name, int32(x))
+}
+
+func (StorageHint_Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{67, 0}
+}
+
+type StorageHint_Cache int32
+
+const (
+	StorageHint_NORMAL StorageHint_Cache = 0
+	StorageHint_HOT    StorageHint_Cache = 1
+	StorageHint_COLD   StorageHint_Cache = 2
+)
+
+var StorageHint_Cache_name = map[int32]string{
+	0: "NORMAL",
+	1: "HOT",
+	2: "COLD",
+}
+
+var StorageHint_Cache_value = map[string]int32{
+	"NORMAL": 0,
+	"HOT":    1,
+	"COLD":   2,
+}
+
+func (x StorageHint_Cache) String() string {
+	return proto.EnumName(StorageHint_Cache_name, int32(x))
+}
+
+func (StorageHint_Cache) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{67, 1}
+}
+
 type List struct {
 	Uids                 []uint64 `protobuf:"fixed64,1,rep,packed,name=uids,proto3" json:"uids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -474,17 +558,19 @@ type Query struct {
 	// Exactly one of uids and terms is populated.
 	UidList *List `protobuf:"bytes,5,opt,name=uid_list,json=uidList,proto3" json:"uid_list,omitempty"`
 	// Function to generate or filter UIDs.
-	SrcFunc              *SrcFunction `protobuf:"bytes,6,opt,name=src_func,json=srcFunc,proto3" json:"src_func,omitempty"`
-	Reverse              bool         `protobuf:"varint,7,opt,name=reverse,proto3" json:"reverse,omitempty"`
-	FacetParam           *FacetParams `protobuf:"bytes,8,opt,name=facet_param,json=facetParam,proto3" json:"facet_param,omitempty"`
-	FacetsFilter         *FilterTree  `protobuf:"bytes,9,opt,name=facets_filter,json=facetsFilter,proto3" json:"facets_filter,omitempty"`
-	ExpandAll            bool         `protobuf:"varint,10,opt,name=expand_all,json=expandAll,proto3" json:"expand_all,omitempty"`
-	ReadTs               uint64       `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
-	Cache                int32        `protobuf:"varint,14,opt,name=cache,proto3" json:"cache,omitempty"`
-	First                int32        `protobuf:"varint,15,opt,name=first,proto3" json:"first,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	SrcFunc              *SrcFunction    `protobuf:"bytes,6,opt,name=src_func,json=srcFunc,proto3" json:"src_func,omitempty"`
+	Reverse              bool            `protobuf:"varint,7,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	FacetParam           *FacetParams    `protobuf:"bytes,8,opt,name=facet_param,json=facetParam,proto3" json:"facet_param,omitempty"`
+	FacetsFilter         *FilterTree     `protobuf:"bytes,9,opt,name=facets_filter,json=facetsFilter,proto3" json:"facets_filter,omitempty"`
+	ExpandAll            bool            `protobuf:"varint,10,opt,name=expand_all,json=expandAll,proto3" json:"expand_all,omitempty"`
+	ReadTs               uint64          `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	Cache                int32           `protobuf:"varint,14,opt,name=cache,proto3" json:"cache,omitempty"`
+	First                int32           `protobuf:"varint,15,opt,name=first,proto3" json:"first,omitempty"`
+	Isolation            Query_Isolation `protobuf:"varint,16,opt,name=isolation,proto3,enum=pb.Query_Isolation" json:"isolation,omitempty"`
+	Decrypt              bool            `protobuf:"varint,17,opt,name=decrypt,proto3" json:"decrypt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -611,6 +697,20 @@ func (m *Query) GetFirst() int32 {
 	return 0
 }
 
+func (m *Query) GetIsolation() Query_Isolation {
+	if m != nil {
+		return m.Isolation
+	}
+	return Query_SNAPSHOT
+}
+
+func (m *Query) GetDecrypt() bool {
+	if m != nil {
+		return m.Decrypt
+	}
+	return false
+}
+
 type ValueList struct {
 	Values               []*TaskValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -713,6 +813,7 @@ type Result struct {
 	FacetMatrix          []*FacetsList `protobuf:"bytes,5,rep,name=facet_matrix,json=facetMatrix,proto3" json:"facet_matrix,omitempty"`
 	LangMatrix           []*LangList   `protobuf:"bytes,6,rep,name=lang_matrix,json=langMatrix,proto3" json:"lang_matrix,omitempty"`
 	List                 bool          `protobuf:"varint,7,opt,name=list,proto3" json:"list,omitempty"`
+	ReadKeys             []uint64      `protobuf:"fixed64,8,rep,packed,name=read_keys,json=readKeys,proto3" json:"read_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -800,6 +901,13 @@ func (m *Result) GetList() bool {
 	return false
 }
 
+func (m *Result) GetReadKeys() []uint64 {
+	if m != nil {
+		return m.ReadKeys
+	}
+	return nil
+}
+
 type Order struct {
 	Attr                 string   `protobuf:"bytes,1,opt,name=attr,proto3" json:"attr,omitempty"`
 	Desc                 bool     `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
@@ -1072,18 +1180,22 @@ func (m *RaftContext) GetIsLearner() bool {
 // Note that each server can be serving multiple RAFT groups. Each group would have
 // one RAFT node per server serving that group.
 type Member struct {
-	Id                   uint64   `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
-	GroupId              uint32   `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
-	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	Leader               bool     `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
-	AmDead               bool     `protobuf:"varint,5,opt,name=am_dead,json=amDead,proto3" json:"amDead,omitempty"`
-	LastUpdate           uint64   `protobuf:"varint,6,opt,name=last_update,json=lastUpdate,proto3" json:"lastUpdate,omitempty"`
-	Learner              bool     `protobuf:"varint,7,opt,name=learner,proto3" json:"learner,omitempty"`
-	ClusterInfoOnly      bool     `protobuf:"varint,13,opt,name=cluster_info_only,json=clusterInfoOnly,proto3" json:"clusterInfoOnly,omitempty"`
-	ForceGroupId         bool     `protobuf:"varint,14,opt,name=force_group_id,json=forceGroupId,proto3" json:"forceGroupId,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   uint64                 `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId              uint32                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
+	Addr                 string                 `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Leader               bool                   `protobuf:"varint,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	AmDead               bool                   `protobuf:"varint,5,opt,name=am_dead,json=amDead,proto3" json:"amDead,omitempty"`
+	LastUpdate           uint64                 `protobuf:"varint,6,opt,name=last_update,json=lastUpdate,proto3" json:"lastUpdate,omitempty"`
+	Learner              bool                   `protobuf:"varint,7,opt,name=learner,proto3" json:"learner,omitempty"`
+	CpuUsage             float64                `protobuf:"fixed64,8,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
+	MemoryInUse          uint64                 `protobuf:"varint,9,opt,name=memory_in_use,json=memoryInUse,proto3" json:"memory_in_use,omitempty"`
+	TabletLoads          map[string]*TabletLoad `protobuf:"bytes,10,rep,name=tablet_loads,json=tabletLoads,proto3" json:"tablet_loads,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ClusterInfoOnly      bool                   `protobuf:"varint,13,opt,name=cluster_info_only,json=clusterInfoOnly,proto3" json:"clusterInfoOnly,omitempty"`
+	ForceGroupId         bool                   `protobuf:"varint,14,opt,name=force_group_id,json=forceGroupId,proto3" json:"forceGroupId,omitempty"`
+	Zone                 string                 `protobuf:"bytes,15,opt,name=zone,proto3" json:"zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
@@ -1168,6 +1280,27 @@ func (m *Member) GetLearner() bool {
 	return false
 }
 
+func (m *Member) GetCpuUsage() float64 {
+	if m != nil {
+		return m.CpuUsage
+	}
+	return 0
+}
+
+func (m *Member) GetMemoryInUse() uint64 {
+	if m != nil {
+		return m.MemoryInUse
+	}
+	return 0
+}
+
+func (m *Member) GetTabletLoads() map[string]*TabletLoad {
+	if m != nil {
+		return m.TabletLoads
+	}
+	return nil
+}
+
 func (m *Member) GetClusterInfoOnly() bool {
 	if m != nil {
 		return m.ClusterInfoOnly
@@ -1182,6 +1315,13 @@ func (m *Member) GetForceGroupId() bool {
 	return false
 }
 
+func (m *Member) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
 type Group struct {
 	Members              map[uint64]*Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Tablets              map[string]*Tablet `protobuf:"bytes,2,rep,name=tablets,proto3" json:"tablets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -1343,6 +1483,7 @@ type ZeroProposal struct {
 	Cid                  string            `protobuf:"bytes,9,opt,name=cid,proto3" json:"cid,omitempty"`
 	License              *License          `protobuf:"bytes,10,opt,name=license,proto3" json:"license,omitempty"`
 	Snapshot             *ZeroSnapshot     `protobuf:"bytes,11,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Pin                  *TabletPin        `protobuf:"bytes,12,opt,name=pin,proto3" json:"pin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1451,6 +1592,13 @@ func (m *ZeroProposal) GetSnapshot() *ZeroSnapshot {
 	return nil
 }
 
+func (m *ZeroProposal) GetPin() *TabletPin {
+	if m != nil {
+		return m.Pin
+	}
+	return nil
+}
+
 // MembershipState is used to pack together the current membership state of all the nodes
 // in the caller server; and the membership updates recorded by the callee server since
 // the provided lastUpdate.
@@ -1464,6 +1612,7 @@ type MembershipState struct {
 	Removed              []*Member          `protobuf:"bytes,7,rep,name=removed,proto3" json:"removed,omitempty"`
 	Cid                  string             `protobuf:"bytes,8,opt,name=cid,proto3" json:"cid,omitempty"`
 	License              *License           `protobuf:"bytes,9,opt,name=license,proto3" json:"license,omitempty"`
+	PinnedTablets        map[string]uint32  `protobuf:"bytes,10,rep,name=pinned_tablets,json=pinnedTablets,proto3" json:"pinned_tablets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -1565,6 +1714,13 @@ func (m *MembershipState) GetLicense() *License {
 	return nil
 }
 
+func (m *MembershipState) GetPinnedTablets() map[string]uint32 {
+	if m != nil {
+		return m.PinnedTablets
+	}
+	return nil
+}
+
 type ConnectionState struct {
 	Member               *Member          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	State                *MembershipState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
@@ -1640,6 +1796,7 @@ type HealthInfo struct {
 	Indexing             []string `protobuf:"bytes,9,rep,name=indexing,proto3" json:"indexing,omitempty"`
 	EeFeatures           []string `protobuf:"bytes,10,rep,name=ee_features,json=eeFeatures,proto3" json:"ee_features,omitempty"`
 	MaxAssigned          uint64   `protobuf:"varint,11,opt,name=max_assigned,json=maxAssigned,proto3" json:"max_assigned,omitempty"`
+	Learner              bool     `protobuf:"varint,12,opt,name=learner,proto3" json:"learner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1755,6 +1912,13 @@ func (m *HealthInfo) GetMaxAssigned() uint64 {
 	return 0
 }
 
+func (m *HealthInfo) GetLearner() bool {
+	if m != nil {
+		return m.Learner
+	}
+	return false
+}
+
 type Tablet struct {
 	GroupId              uint32   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
 	Predicate            string   `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
@@ -2452,6 +2616,7 @@ type Proposal struct {
 	Index                uint64           `protobuf:"varint,10,opt,name=index,proto3" json:"index,omitempty"`
 	ExpectedChecksum     uint64           `protobuf:"varint,11,opt,name=expected_checksum,json=expectedChecksum,proto3" json:"expected_checksum,omitempty"`
 	Restore              *RestoreRequest  `protobuf:"bytes,12,opt,name=restore,proto3" json:"restore,omitempty"`
+	CancelIndexBuild     string           `protobuf:"bytes,13,opt,name=cancel_index_build,json=cancelIndexBuild,proto3" json:"cancel_index_build,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -2553,6 +2718,13 @@ func (m *Proposal) GetRestore() *RestoreRequest {
 	return nil
 }
 
+func (m *Proposal) GetCancelIndexBuild() string {
+	if m != nil {
+		return m.CancelIndexBuild
+	}
+	return ""
+}
+
 type KVS struct {
 	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 	// done used to indicate if the stream of KVS is over.
@@ -3369,6 +3541,8 @@ type SchemaNode struct {
 	Upsert               bool     `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"`
 	Lang                 bool     `protobuf:"varint,9,opt,name=lang,proto3" json:"lang,omitempty"`
 	NoConflict           bool     `protobuf:"varint,10,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	Storage              string   `protobuf:"bytes,11,opt,name=storage,proto3" json:"storage,omitempty"`
+	Encrypted            bool     `protobuf:"varint,12,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3477,6 +3651,20 @@ func (m *SchemaNode) GetNoConflict() bool {
 	return false
 }
 
+func (m *SchemaNode) GetStorage() string {
+	if m != nil {
+		return m.Storage
+	}
+	return ""
+}
+
+func (m *SchemaNode) GetEncrypted() bool {
+	if m != nil {
+		return m.Encrypted
+	}
+	return false
+}
+
 type SchemaResult struct {
 	Schema               []*SchemaNode `protobuf:"bytes,1,rep,name=schema,proto3" json:"schema,omitempty"` // Deprecated: Do not use.
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -3539,11 +3727,14 @@ type SchemaUpdate struct {
 	NonNullableList bool `protobuf:"varint,11,opt,name=non_nullable_list,json=nonNullableList,proto3" json:"non_nullable_list,omitempty"`
 	// If value_type is OBJECT, then this represents an object type with a
 	// custom name. This field stores said name.
-	ObjectTypeName       string   `protobuf:"bytes,12,opt,name=object_type_name,json=objectTypeName,proto3" json:"object_type_name,omitempty"`
-	NoConflict           bool     `protobuf:"varint,13,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ObjectTypeName       string       `protobuf:"bytes,12,opt,name=object_type_name,json=objectTypeName,proto3" json:"object_type_name,omitempty"`
+	NoConflict           bool         `protobuf:"varint,13,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	Storage              *StorageHint `protobuf:"bytes,14,opt,name=storage,proto3" json:"storage,omitempty"`
+	Encrypted            bool         `protobuf:"varint,15,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	DataKeys             [][]byte     `protobuf:"bytes,16,rep,name=data_keys,json=dataKeys,proto3" json:"data_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *SchemaUpdate) Reset()         { *m = SchemaUpdate{} }
@@ -3663,6 +3854,27 @@ func (m *SchemaUpdate) GetNoConflict() bool {
 	return false
 }
 
+func (m *SchemaUpdate) GetStorage() *StorageHint {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *SchemaUpdate) GetEncrypted() bool {
+	if m != nil {
+		return m.Encrypted
+	}
+	return false
+}
+
+func (m *SchemaUpdate) GetDataKeys() [][]byte {
+	if m != nil {
+		return m.DataKeys
+	}
+	return nil
+}
+
 type TypeUpdate struct {
 	TypeName             string          `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
 	Fields               []*SchemaUpdate `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
@@ -3847,6 +4059,7 @@ func (m *MovePredicatePayload) GetExpectedChecksum() uint64 {
 type TxnStatus struct {
 	StartTs              uint64   `protobuf:"varint,1,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
 	CommitTs             uint64   `protobuf:"varint,2,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	Preds                []string `protobuf:"bytes,3,rep,name=preds,proto3" json:"preds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3899,6 +4112,13 @@ func (m *TxnStatus) GetCommitTs() uint64 {
 	return 0
 }
 
+func (m *TxnStatus) GetPreds() []string {
+	if m != nil {
+		return m.Preds
+	}
+	return nil
+}
+
 type OracleDelta struct {
 	Txns                 []*TxnStatus      `protobuf:"bytes,1,rep,name=txns,proto3" json:"txns,omitempty"`
 	MaxAssigned          uint64            `protobuf:"varint,2,opt,name=max_assigned,json=maxAssigned,proto3" json:"max_assigned,omitempty"`
@@ -4459,6 +4679,7 @@ type BackupRequest struct {
 	// The predicates to backup. All other predicates present in the group (e.g
 	// stale data from a predicate move) will be ignored.
 	Predicates           []string `protobuf:"bytes,10,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	Namespaces           []uint64 `protobuf:"varint,11,rep,packed,name=namespaces,proto3" json:"namespaces,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4567,6 +4788,13 @@ func (m *BackupRequest) GetPredicates() []string {
 	return nil
 }
 
+func (m *BackupRequest) GetNamespaces() []uint64 {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
 type BackupResponse struct {
 	DropOperations       []*DropOperation `protobuf:"bytes,1,rep,name=drop_operations,json=dropOperations,proto3" json:"drop_operations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -4681,6 +4909,7 @@ type ExportRequest struct {
 	SecretKey            string   `protobuf:"bytes,7,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
 	SessionToken         string   `protobuf:"bytes,8,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	Anonymous            bool     `protobuf:"varint,9,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	Namespace            uint64   `protobuf:"varint,10,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4782,6 +5011,13 @@ func (m *ExportRequest) GetAnonymous() bool {
 	return false
 }
 
+func (m *ExportRequest) GetNamespace() uint64 {
+	if m != nil {
+		return m.Namespace
+	}
+	return 0
+}
+
 type ExportResponse struct {
 	// 0 indicates a success, and a non-zero code indicates failure
 	Code                 int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -5019,6 +5255,7 @@ type UpdateGraphQLSchemaRequest struct {
 	GraphqlSchema        string          `protobuf:"bytes,2,opt,name=graphql_schema,json=graphqlSchema,proto3" json:"graphql_schema,omitempty"`
 	DgraphPreds          []*SchemaUpdate `protobuf:"bytes,3,rep,name=dgraph_preds,json=dgraphPreds,proto3" json:"dgraph_preds,omitempty"`
 	DgraphTypes          []*TypeUpdate   `protobuf:"bytes,4,rep,name=dgraph_types,json=dgraphTypes,proto3" json:"dgraph_types,omitempty"`
+	Namespace            uint64          `protobuf:"varint,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -5085,6 +5322,13 @@ func (m *UpdateGraphQLSchemaRequest) GetDgraphTypes() []*TypeUpdate {
 	return nil
 }
 
+func (m *UpdateGraphQLSchemaRequest) GetNamespace() uint64 {
+	if m != nil {
+		return m.Namespace
+	}
+	return 0
+}
+
 type UpdateGraphQLSchemaResponse struct {
 	Uid                  uint64   `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5188,704 +5432,768 @@ func (m *BulkMeta) GetSchemaMap() map[string]*SchemaUpdate {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("pb.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
-	proto.RegisterEnum("pb.Mutations_DropOp", Mutations_DropOp_name, Mutations_DropOp_value)
-	proto.RegisterEnum("pb.Metadata_HintType", Metadata_HintType_name, Metadata_HintType_value)
-	proto.RegisterEnum("pb.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
-	proto.RegisterEnum("pb.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
-	proto.RegisterEnum("pb.SchemaUpdate_Directive", SchemaUpdate_Directive_name, SchemaUpdate_Directive_value)
-	proto.RegisterEnum("pb.DropOperation_DropOp", DropOperation_DropOp_name, DropOperation_DropOp_value)
-	proto.RegisterEnum("pb.BackupKey_KeyType", BackupKey_KeyType_name, BackupKey_KeyType_value)
-	proto.RegisterType((*List)(nil), "pb.List")
-	proto.RegisterType((*TaskValue)(nil), "pb.TaskValue")
-	proto.RegisterType((*SrcFunction)(nil), "pb.SrcFunction")
-	proto.RegisterType((*Query)(nil), "pb.Query")
-	proto.RegisterType((*ValueList)(nil), "pb.ValueList")
-	proto.RegisterType((*LangList)(nil), "pb.LangList")
-	proto.RegisterType((*Result)(nil), "pb.Result")
-	proto.RegisterType((*Order)(nil), "pb.Order")
-	proto.RegisterType((*SortMessage)(nil), "pb.SortMessage")
-	proto.RegisterType((*SortResult)(nil), "pb.SortResult")
-	proto.RegisterType((*RaftContext)(nil), "pb.RaftContext")
-	proto.RegisterType((*Member)(nil), "pb.Member")
-	proto.RegisterType((*Group)(nil), "pb.Group")
-	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.Group.MembersEntry")
-	proto.RegisterMapType((map[string]*Tablet)(nil), "pb.Group.TabletsEntry")
-	proto.RegisterType((*License)(nil), "pb.License")
-	proto.RegisterType((*ZeroProposal)(nil), "pb.ZeroProposal")
-	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.ZeroProposal.SnapshotTsEntry")
-	proto.RegisterType((*MembershipState)(nil), "pb.MembershipState")
-	proto.RegisterMapType((map[uint32]*Group)(nil), "pb.MembershipState.GroupsEntry")
-	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.MembershipState.ZerosEntry")
-	proto.RegisterType((*ConnectionState)(nil), "pb.ConnectionState")
-	proto.RegisterType((*HealthInfo)(nil), "pb.HealthInfo")
-	proto.RegisterType((*Tablet)(nil), "pb.Tablet")
-	proto.RegisterType((*DirectedEdge)(nil), "pb.DirectedEdge")
-	proto.RegisterType((*Mutations)(nil), "pb.Mutations")
-	proto.RegisterType((*Metadata)(nil), "pb.Metadata")
-	proto.RegisterMapType((map[string]Metadata_HintType)(nil), "pb.Metadata.PredHintsEntry")
-	proto.RegisterType((*Snapshot)(nil), "pb.Snapshot")
-	proto.RegisterType((*ZeroSnapshot)(nil), "pb.ZeroSnapshot")
-	proto.RegisterType((*RestoreRequest)(nil), "pb.RestoreRequest")
-	proto.RegisterType((*Proposal)(nil), "pb.Proposal")
-	proto.RegisterType((*KVS)(nil), "pb.KVS")
-	proto.RegisterType((*Posting)(nil), "pb.Posting")
-	proto.RegisterType((*UidBlock)(nil), "pb.UidBlock")
-	proto.RegisterType((*UidPack)(nil), "pb.UidPack")
-	proto.RegisterType((*PostingList)(nil), "pb.PostingList")
-	proto.RegisterType((*FacetParam)(nil), "pb.FacetParam")
-	proto.RegisterType((*FacetParams)(nil), "pb.FacetParams")
-	proto.RegisterType((*Facets)(nil), "pb.Facets")
-	proto.RegisterType((*FacetsList)(nil), "pb.FacetsList")
-	proto.RegisterType((*Function)(nil), "pb.Function")
-	proto.RegisterType((*FilterTree)(nil), "pb.FilterTree")
-	proto.RegisterType((*SchemaRequest)(nil), "pb.SchemaRequest")
-	proto.RegisterType((*SchemaNode)(nil), "pb.SchemaNode")
-	proto.RegisterType((*SchemaResult)(nil), "pb.SchemaResult")
-	proto.RegisterType((*SchemaUpdate)(nil), "pb.SchemaUpdate")
-	proto.RegisterType((*TypeUpdate)(nil), "pb.TypeUpdate")
-	proto.RegisterType((*MapHeader)(nil), "pb.MapHeader")
-	proto.RegisterType((*MovePredicatePayload)(nil), "pb.MovePredicatePayload")
-	proto.RegisterType((*TxnStatus)(nil), "pb.TxnStatus")
-	proto.RegisterType((*OracleDelta)(nil), "pb.OracleDelta")
-	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.OracleDelta.GroupChecksumsEntry")
-	proto.RegisterType((*TxnTimestamps)(nil), "pb.TxnTimestamps")
-	proto.RegisterType((*PeerResponse)(nil), "pb.PeerResponse")
-	proto.RegisterType((*RaftBatch)(nil), "pb.RaftBatch")
-	proto.RegisterType((*SubscriptionRequest)(nil), "pb.SubscriptionRequest")
-	proto.RegisterType((*SubscriptionResponse)(nil), "pb.SubscriptionResponse")
-	proto.RegisterType((*Num)(nil), "pb.Num")
-	proto.RegisterType((*AssignedIds)(nil), "pb.AssignedIds")
-	proto.RegisterType((*SnapshotMeta)(nil), "pb.SnapshotMeta")
-	proto.RegisterType((*Status)(nil), "pb.Status")
-	proto.RegisterType((*BackupRequest)(nil), "pb.BackupRequest")
-	proto.RegisterType((*BackupResponse)(nil), "pb.BackupResponse")
-	proto.RegisterType((*DropOperation)(nil), "pb.DropOperation")
-	proto.RegisterType((*ExportRequest)(nil), "pb.ExportRequest")
-	proto.RegisterType((*ExportResponse)(nil), "pb.ExportResponse")
-	proto.RegisterType((*BackupKey)(nil), "pb.BackupKey")
-	proto.RegisterType((*BackupPostingList)(nil), "pb.BackupPostingList")
-	proto.RegisterType((*UpdateGraphQLSchemaRequest)(nil), "pb.UpdateGraphQLSchemaRequest")
-	proto.RegisterType((*UpdateGraphQLSchemaResponse)(nil), "pb.UpdateGraphQLSchemaResponse")
-	proto.RegisterType((*BulkMeta)(nil), "pb.BulkMeta")
-	proto.RegisterMapType((map[string]*SchemaUpdate)(nil), "pb.BulkMeta.SchemaMapEntry")
+type TabletLoad struct {
+	ReadsPerSec          float64  `protobuf:"fixed64,1,opt,name=reads_per_sec,json=readsPerSec,proto3" json:"reads_per_sec,omitempty"`
+	WritesPerSec         float64  `protobuf:"fixed64,2,opt,name=writes_per_sec,json=writesPerSec,proto3" json:"writes_per_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
-
-var fileDescriptor_f80abaa17e25ccc8 = []byte{
-	// 4947 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7b, 0x4b, 0x6f, 0x1c, 0x49,
-	0x72, 0xb0, 0xba, 0xfa, 0x55, 0x15, 0xfd, 0x50, 0x2b, 0xa5, 0xd5, 0xf4, 0xb6, 0x66, 0x44, 0x4e,
-	0x69, 0x34, 0xc3, 0x95, 0x46, 0x94, 0x44, 0xed, 0x87, 0x6f, 0x47, 0x0b, 0x03, 0x26, 0xc5, 0xa6,
-	0x86, 0x23, 0x8a, 0xe4, 0x26, 0x9b, 0xda, 0xc7, 0xc1, 0x8d, 0x62, 0x55, 0x92, 0xac, 0x65, 0x75,
-	0x55, 0x6d, 0x55, 0x35, 0x97, 0x9c, 0x9b, 0x61, 0xc0, 0xeb, 0x83, 0x7d, 0xf2, 0xc1, 0x7b, 0xb2,
-	0x01, 0xff, 0x01, 0xc3, 0x06, 0x0c, 0x18, 0x06, 0x7c, 0x31, 0x0c, 0xc3, 0xf0, 0xc1, 0xf0, 0x1f,
-	0xb0, 0x6c, 0x8c, 0x7d, 0xd2, 0xd1, 0x06, 0x0c, 0xf8, 0x66, 0x64, 0x44, 0xd6, 0xab, 0xd9, 0x94,
-	0x66, 0x16, 0xd8, 0x83, 0x4f, 0x9d, 0x11, 0x91, 0xcf, 0xc8, 0xc8, 0x78, 0x56, 0x83, 0x1e, 0x1e,
-	0x2c, 0x87, 0x51, 0x90, 0x04, 0x4c, 0x0b, 0x0f, 0x06, 0x86, 0x15, 0xba, 0x04, 0x0e, 0xee, 0x1d,
-	0xb9, 0xc9, 0xf1, 0xf4, 0x60, 0xd9, 0x0e, 0x26, 0x0f, 0x9d, 0xa3, 0xc8, 0x0a, 0x8f, 0x1f, 0xb8,
-	0xc1, 0xc3, 0x03, 0xcb, 0x39, 0x12, 0xd1, 0xc3, 0xd3, 0x27, 0x0f, 0xc3, 0x83, 0x87, 0xe9, 0xd0,
-	0xc1, 0x83, 0x42, 0xdf, 0xa3, 0xe0, 0x28, 0x78, 0x88, 0xe8, 0x83, 0xe9, 0x21, 0x42, 0x08, 0x60,
-	0x8b, 0xba, 0x9b, 0x03, 0xa8, 0x6d, 0xb9, 0x71, 0xc2, 0x18, 0xd4, 0xa6, 0xae, 0x13, 0xf7, 0x2b,
-	0x8b, 0xd5, 0xa5, 0x06, 0xc7, 0xb6, 0xf9, 0x12, 0x8c, 0x91, 0x15, 0x9f, 0xbc, 0xb2, 0xbc, 0xa9,
-	0x60, 0x3d, 0xa8, 0x9e, 0x5a, 0x5e, 0xbf, 0xb2, 0x58, 0x59, 0x6a, 0x73, 0xd9, 0x64, 0xcb, 0xa0,
-	0x9f, 0x5a, 0xde, 0x38, 0x39, 0x0f, 0x45, 0x5f, 0x5b, 0xac, 0x2c, 0x75, 0x57, 0xae, 0x2f, 0x87,
-	0x07, 0xcb, 0xbb, 0x41, 0x9c, 0xb8, 0xfe, 0xd1, 0xf2, 0x2b, 0xcb, 0x1b, 0x9d, 0x87, 0x82, 0x37,
-	0x4f, 0xa9, 0x61, 0xee, 0x40, 0x6b, 0x2f, 0xb2, 0x37, 0xa6, 0xbe, 0x9d, 0xb8, 0x81, 0x2f, 0x57,
-	0xf4, 0xad, 0x89, 0xc0, 0x19, 0x0d, 0x8e, 0x6d, 0x89, 0xb3, 0xa2, 0xa3, 0xb8, 0x5f, 0x5d, 0xac,
-	0x4a, 0x9c, 0x6c, 0xb3, 0x3e, 0x34, 0xdd, 0xf8, 0x59, 0x30, 0xf5, 0x93, 0x7e, 0x6d, 0xb1, 0xb2,
-	0xa4, 0xf3, 0x14, 0x34, 0xff, 0xa4, 0x0a, 0xf5, 0x1f, 0x4c, 0x45, 0x74, 0x8e, 0xe3, 0x92, 0x24,
-	0x4a, 0xe7, 0x92, 0x6d, 0x76, 0x03, 0xea, 0x9e, 0xe5, 0x1f, 0xc5, 0x7d, 0x0d, 0x27, 0x23, 0x80,
-	0xdd, 0x02, 0xc3, 0x3a, 0x4c, 0x44, 0x34, 0x9e, 0xba, 0x4e, 0xbf, 0xba, 0x58, 0x59, 0x6a, 0x70,
-	0x1d, 0x11, 0xfb, 0xae, 0xc3, 0xbe, 0x0d, 0xba, 0x13, 0x8c, 0xed, 0xe2, 0x5a, 0x4e, 0x80, 0x6b,
-	0xb1, 0x3b, 0xa0, 0x4f, 0x5d, 0x67, 0xec, 0xb9, 0x71, 0xd2, 0xaf, 0x2f, 0x56, 0x96, 0x5a, 0x2b,
-	0xba, 0x3c, 0xac, 0xe4, 0x1d, 0x6f, 0x4e, 0x5d, 0x07, 0x99, 0x78, 0x0f, 0xf4, 0x38, 0xb2, 0xc7,
-	0x87, 0x53, 0xdf, 0xee, 0x37, 0xb0, 0xd3, 0x55, 0xd9, 0xa9, 0x70, 0x6a, 0xde, 0x8c, 0x09, 0x90,
-	0xc7, 0x8a, 0xc4, 0xa9, 0x88, 0x62, 0xd1, 0x6f, 0xd2, 0x52, 0x0a, 0x64, 0x8f, 0xa0, 0x75, 0x68,
-	0xd9, 0x22, 0x19, 0x87, 0x56, 0x64, 0x4d, 0xfa, 0x7a, 0x3e, 0xd1, 0x86, 0x44, 0xef, 0x4a, 0x6c,
-	0xcc, 0xe1, 0x30, 0x03, 0xd8, 0x13, 0xe8, 0x20, 0x14, 0x8f, 0x0f, 0x5d, 0x2f, 0x11, 0x51, 0xdf,
-	0xc0, 0x31, 0x5d, 0x1c, 0x83, 0x98, 0x51, 0x24, 0x04, 0x6f, 0x53, 0x27, 0xc2, 0xb0, 0x0f, 0x00,
-	0xc4, 0x59, 0x68, 0xf9, 0xce, 0xd8, 0xf2, 0xbc, 0x3e, 0xe0, 0x1e, 0x0c, 0xc2, 0xac, 0x7a, 0x1e,
-	0x7b, 0x4f, 0xee, 0xcf, 0x72, 0xc6, 0x49, 0xdc, 0xef, 0x2c, 0x56, 0x96, 0x6a, 0xbc, 0x21, 0xc1,
-	0x51, 0x2c, 0xf9, 0x6a, 0x5b, 0xf6, 0xb1, 0xe8, 0x77, 0x17, 0x2b, 0x4b, 0x75, 0x4e, 0x80, 0xc4,
-	0x1e, 0xba, 0x51, 0x9c, 0xf4, 0xaf, 0x12, 0x16, 0x01, 0x73, 0x05, 0x0c, 0x94, 0x1e, 0xe4, 0xce,
-	0x5d, 0x68, 0x9c, 0x4a, 0x80, 0x84, 0xac, 0xb5, 0xd2, 0x91, 0xdb, 0xcb, 0x04, 0x8c, 0x2b, 0xa2,
-	0x79, 0x1b, 0xf4, 0x2d, 0xcb, 0x3f, 0x4a, 0xa5, 0x52, 0x5e, 0x1b, 0x0e, 0x30, 0x38, 0xb6, 0xcd,
-	0x5f, 0x6a, 0xd0, 0xe0, 0x22, 0x9e, 0x7a, 0x09, 0xfb, 0x04, 0x40, 0x5e, 0xca, 0xc4, 0x4a, 0x22,
-	0xf7, 0x4c, 0xcd, 0x9a, 0x5f, 0x8b, 0x31, 0x75, 0x9d, 0x97, 0x48, 0x62, 0x8f, 0xa0, 0x8d, 0xb3,
-	0xa7, 0x5d, 0xb5, 0x7c, 0x03, 0xd9, 0xfe, 0x78, 0x0b, 0xbb, 0xa8, 0x11, 0x37, 0xa1, 0x81, 0x72,
-	0x40, 0xb2, 0xd8, 0xe1, 0x0a, 0x62, 0x77, 0xa1, 0xeb, 0xfa, 0x89, 0xbc, 0x27, 0x3b, 0x19, 0x3b,
-	0x22, 0x4e, 0x05, 0xa5, 0x93, 0x61, 0xd7, 0x45, 0x9c, 0xb0, 0xc7, 0x40, 0xcc, 0x4e, 0x17, 0xac,
-	0xe3, 0x82, 0xdd, 0xec, 0x12, 0x63, 0x5a, 0x11, 0xfb, 0xa8, 0x15, 0x1f, 0x40, 0x4b, 0x9e, 0x2f,
-	0x1d, 0xd1, 0xc0, 0x11, 0x6d, 0x3c, 0x8d, 0x62, 0x07, 0x07, 0xd9, 0x41, 0x75, 0x97, 0xac, 0x91,
-	0xc2, 0x48, 0xc2, 0x83, 0x6d, 0x73, 0x08, 0xf5, 0x9d, 0xc8, 0x11, 0xd1, 0xdc, 0xf7, 0xc0, 0xa0,
-	0xe6, 0x88, 0xd8, 0xc6, 0xa7, 0xaa, 0x73, 0x6c, 0xe7, 0x6f, 0xa4, 0x5a, 0x78, 0x23, 0xe6, 0x1f,
-	0x57, 0xa0, 0xb5, 0x17, 0x44, 0xc9, 0x4b, 0x11, 0xc7, 0xd6, 0x91, 0x60, 0x0b, 0x50, 0x0f, 0xe4,
-	0xb4, 0x8a, 0xc3, 0x86, 0xdc, 0x13, 0xae, 0xc3, 0x09, 0x3f, 0x73, 0x0f, 0xda, 0xe5, 0xf7, 0x20,
-	0x65, 0x07, 0x5f, 0x57, 0x55, 0xc9, 0x0e, 0xbe, 0xad, 0x9b, 0xd0, 0x08, 0x0e, 0x0f, 0x63, 0x41,
-	0xbc, 0xac, 0x73, 0x05, 0x5d, 0x2a, 0x82, 0xe6, 0xff, 0x03, 0x90, 0xfb, 0xfb, 0x86, 0x52, 0x60,
-	0xfe, 0xa2, 0x02, 0x2d, 0x6e, 0x1d, 0x26, 0xcf, 0x02, 0x3f, 0x11, 0x67, 0x09, 0xeb, 0x82, 0xe6,
-	0x3a, 0xc8, 0xa3, 0x06, 0xd7, 0x5c, 0x47, 0xee, 0xee, 0x28, 0x0a, 0xa6, 0x21, 0xb2, 0xa8, 0xc3,
-	0x09, 0x40, 0x5e, 0x3a, 0x4e, 0x84, 0x5b, 0x96, 0xbc, 0x74, 0x9c, 0x88, 0x2d, 0x40, 0x2b, 0xf6,
-	0xad, 0x30, 0x3e, 0x0e, 0x12, 0xb9, 0xbb, 0x1a, 0xee, 0x0e, 0x52, 0xd4, 0x28, 0x96, 0x8f, 0xcb,
-	0x8d, 0xc7, 0x9e, 0xb0, 0x22, 0x5f, 0x44, 0xa8, 0x30, 0x74, 0x6e, 0xb8, 0xf1, 0x16, 0x21, 0xcc,
-	0x5f, 0x54, 0xa1, 0xf1, 0x52, 0x4c, 0x0e, 0x44, 0x74, 0x61, 0x13, 0x8f, 0x40, 0xc7, 0x75, 0xc7,
-	0xae, 0x43, 0xfb, 0x58, 0xfb, 0xd6, 0x9b, 0xd7, 0x0b, 0xd7, 0x10, 0xb7, 0xe9, 0x7c, 0x1a, 0x4c,
-	0xdc, 0x44, 0x4c, 0xc2, 0xe4, 0x9c, 0x37, 0x15, 0x6a, 0xee, 0x06, 0x6f, 0x42, 0xc3, 0x13, 0x96,
-	0xbc, 0x33, 0x12, 0x4f, 0x05, 0xb1, 0x07, 0xd0, 0xb4, 0x26, 0x63, 0x47, 0x58, 0x0e, 0x6d, 0x6a,
-	0xed, 0xc6, 0x9b, 0xd7, 0x0b, 0x3d, 0x6b, 0xb2, 0x2e, 0xac, 0xe2, 0xdc, 0x0d, 0xc2, 0xb0, 0xcf,
-	0xa4, 0x4c, 0xc6, 0xc9, 0x78, 0x1a, 0x3a, 0x56, 0x22, 0x50, 0xa7, 0xd5, 0xd6, 0xfa, 0x6f, 0x5e,
-	0x2f, 0xdc, 0x90, 0xe8, 0x7d, 0xc4, 0x16, 0x86, 0x41, 0x8e, 0x95, 0xfa, 0x2d, 0x3d, 0xbe, 0xd2,
-	0x6f, 0x0a, 0x64, 0x9b, 0x70, 0xcd, 0xf6, 0xa6, 0xb1, 0x54, 0xc2, 0xae, 0x7f, 0x18, 0x8c, 0x03,
-	0xdf, 0x3b, 0xc7, 0x0b, 0xd6, 0xd7, 0x3e, 0x78, 0xf3, 0x7a, 0xe1, 0xdb, 0x8a, 0xb8, 0xe9, 0x1f,
-	0x06, 0x3b, 0xbe, 0x77, 0x5e, 0x98, 0xff, 0xea, 0x0c, 0x89, 0xfd, 0x26, 0x74, 0x0f, 0x83, 0xc8,
-	0x16, 0xe3, 0x8c, 0x65, 0x5d, 0x9c, 0x67, 0xf0, 0xe6, 0xf5, 0xc2, 0x4d, 0xa4, 0x3c, 0xbf, 0xc0,
-	0xb7, 0x76, 0x11, 0x6f, 0xfe, 0x8b, 0x06, 0x75, 0x6c, 0xb3, 0x47, 0xd0, 0x9c, 0xe0, 0x95, 0xa4,
-	0xfa, 0xe9, 0xa6, 0x94, 0x21, 0xa4, 0x2d, 0xd3, 0x5d, 0xc5, 0x43, 0x3f, 0x89, 0xce, 0x79, 0xda,
-	0x4d, 0x8e, 0x48, 0xac, 0x03, 0x4f, 0x24, 0xb1, 0x92, 0xf9, 0xc2, 0x88, 0x11, 0x11, 0xd4, 0x08,
-	0xd5, 0x6d, 0x56, 0x6e, 0xaa, 0x17, 0xe4, 0x66, 0x00, 0xba, 0x7d, 0x2c, 0xec, 0x93, 0x78, 0x3a,
-	0x51, 0x52, 0x95, 0xc1, 0xec, 0x0e, 0x74, 0xb0, 0x1d, 0x06, 0xae, 0x8f, 0xc3, 0xeb, 0xd8, 0xa1,
-	0x9d, 0x23, 0x47, 0xf1, 0x60, 0x03, 0xda, 0xc5, 0xcd, 0x4a, 0xb3, 0x7d, 0x22, 0xce, 0x51, 0xbe,
-	0x6a, 0x5c, 0x36, 0xd9, 0x22, 0xd4, 0x51, 0xd1, 0xa1, 0x74, 0xb5, 0x56, 0x40, 0xee, 0x99, 0x86,
-	0x70, 0x22, 0x3c, 0xd5, 0xbe, 0x57, 0x91, 0xf3, 0x14, 0x8f, 0x50, 0x9c, 0xc7, 0xb8, 0x7c, 0x1e,
-	0x1a, 0x52, 0x98, 0xc7, 0x0c, 0xa0, 0xb9, 0xe5, 0xda, 0xc2, 0x8f, 0xd1, 0xb8, 0x4f, 0x63, 0x91,
-	0x29, 0x25, 0xd9, 0x96, 0xe7, 0x9d, 0x58, 0x67, 0xdb, 0x81, 0x23, 0x62, 0x9c, 0xa7, 0xc6, 0x33,
-	0x58, 0xd2, 0xc4, 0x59, 0xe8, 0x46, 0xe7, 0x23, 0xe2, 0x54, 0x95, 0x67, 0xb0, 0x94, 0x2e, 0xe1,
-	0xcb, 0xc5, 0x9c, 0xd4, 0x50, 0x2b, 0xd0, 0xfc, 0xdb, 0x2a, 0xb4, 0x7f, 0x22, 0xa2, 0x60, 0x37,
-	0x0a, 0xc2, 0x20, 0xb6, 0x3c, 0xb6, 0x5a, 0xe6, 0x39, 0xdd, 0xed, 0xa2, 0xdc, 0x6d, 0xb1, 0xdb,
-	0xf2, 0x5e, 0x76, 0x09, 0x74, 0x67, 0xc5, 0x5b, 0x31, 0xa1, 0x41, 0x77, 0x3e, 0x87, 0x67, 0x8a,
-	0x22, 0xfb, 0xd0, 0x2d, 0xe3, 0x5e, 0xcb, 0xfc, 0x50, 0x14, 0x76, 0x1b, 0x60, 0x62, 0x9d, 0x6d,
-	0x09, 0x2b, 0x16, 0x9b, 0x4e, 0xaa, 0x35, 0x72, 0x8c, 0xe2, 0xc6, 0xe8, 0xcc, 0x1f, 0xa5, 0x97,
-	0x9b, 0xc1, 0xec, 0x7d, 0x30, 0x26, 0xd6, 0x99, 0x54, 0x5f, 0x9b, 0x0e, 0x3d, 0x44, 0x9e, 0x23,
-	0xd8, 0x87, 0x50, 0x4d, 0xce, 0x7c, 0x7c, 0x69, 0xd2, 0x57, 0x90, 0xae, 0xe3, 0xe8, 0xcc, 0x57,
-	0x8a, 0x8e, 0x4b, 0x9a, 0xbc, 0x41, 0xdb, 0x75, 0xd0, 0x35, 0x30, 0xb8, 0x6c, 0xb2, 0xbb, 0xd0,
-	0xf4, 0xe8, 0x6e, 0xd0, 0xfc, 0xb7, 0x56, 0x5a, 0xa4, 0x35, 0x11, 0xc5, 0x53, 0x1a, 0xfb, 0x14,
-	0xf4, 0x94, 0x17, 0xfd, 0x16, 0xf6, 0xeb, 0xa5, 0xdc, 0x4b, 0x99, 0xc6, 0xb3, 0x1e, 0x83, 0xdf,
-	0x80, 0xab, 0x33, 0xac, 0x2c, 0xca, 0x4e, 0x87, 0x64, 0xe7, 0x46, 0x51, 0x76, 0x6a, 0x05, 0x79,
-	0xf9, 0xa2, 0xa6, 0xeb, 0x3d, 0xc3, 0xfc, 0xd7, 0x2a, 0x5c, 0x55, 0x62, 0x7c, 0xec, 0x86, 0x7b,
-	0x89, 0x52, 0x28, 0x68, 0x2e, 0x94, 0x04, 0xd5, 0x78, 0x0a, 0xb2, 0xff, 0x0f, 0x0d, 0x7c, 0xff,
-	0xe9, 0x33, 0x5c, 0xc8, 0xaf, 0x27, 0x1b, 0x4e, 0xcf, 0x52, 0xdd, 0xad, 0xea, 0xce, 0xbe, 0x0b,
-	0xf5, 0x2f, 0x45, 0x14, 0x90, 0xf9, 0x6b, 0xad, 0xdc, 0x9e, 0x37, 0x4e, 0x1e, 0x53, 0x0d, 0xa3,
-	0xce, 0xbf, 0xc6, 0x5b, 0xfc, 0x48, 0x1a, 0xbc, 0x49, 0x70, 0x2a, 0x9c, 0x7e, 0x13, 0x77, 0x54,
-	0x14, 0xb4, 0x94, 0x94, 0x5e, 0xa4, 0x3e, 0xf7, 0x22, 0x8d, 0xcb, 0x2f, 0x72, 0xb0, 0x0e, 0xad,
-	0x02, 0x17, 0xe6, 0x5c, 0xcb, 0x42, 0xf9, 0x49, 0x1b, 0x99, 0x3a, 0x2b, 0x6a, 0x86, 0x75, 0x80,
-	0x9c, 0x27, 0xbf, 0xaa, 0x7e, 0x31, 0x7f, 0xbb, 0x02, 0x57, 0x9f, 0x05, 0xbe, 0x2f, 0xd0, 0x2d,
-	0xa6, 0x1b, 0xce, 0x9f, 0x59, 0xe5, 0xd2, 0x67, 0xf6, 0x1d, 0xa8, 0xc7, 0xb2, 0xb3, 0x9a, 0xfd,
-	0xfa, 0x9c, 0x2b, 0xe3, 0xd4, 0x43, 0x2a, 0xdb, 0x89, 0x75, 0x36, 0x0e, 0x85, 0xef, 0xb8, 0xfe,
-	0x51, 0xaa, 0x6c, 0x27, 0xd6, 0xd9, 0x2e, 0x61, 0xcc, 0xbf, 0xd2, 0x00, 0x3e, 0x17, 0x96, 0x97,
-	0x1c, 0x4b, 0x83, 0x22, 0xef, 0xcd, 0xf5, 0xe3, 0xc4, 0xf2, 0xed, 0x34, 0x28, 0xc9, 0x60, 0x29,
-	0x7c, 0xd2, 0xae, 0x8a, 0x98, 0xd4, 0x94, 0xc1, 0x53, 0x50, 0x5a, 0x5a, 0xb9, 0xdc, 0x34, 0x56,
-	0xf6, 0x57, 0x41, 0xb9, 0x33, 0x51, 0x43, 0xb4, 0x72, 0x26, 0xfa, 0xd0, 0x94, 0x4e, 0xbe, 0x1b,
-	0xf8, 0x28, 0x1a, 0x06, 0x4f, 0x41, 0x39, 0xcf, 0x34, 0x4c, 0xdc, 0x09, 0x59, 0xd9, 0x2a, 0x57,
-	0x90, 0xdc, 0x95, 0xb4, 0xaa, 0x43, 0xfb, 0x38, 0xc0, 0xe7, 0x5d, 0xe5, 0x19, 0x2c, 0x67, 0x0b,
-	0xfc, 0xa3, 0x40, 0x9e, 0x4e, 0x47, 0x07, 0x2e, 0x05, 0xe9, 0x2c, 0x8e, 0x38, 0x93, 0x24, 0x03,
-	0x49, 0x19, 0x2c, 0xf9, 0x22, 0xc4, 0xf8, 0x50, 0x58, 0xc9, 0x34, 0x12, 0x71, 0x1f, 0x90, 0x0c,
-	0x42, 0x6c, 0x28, 0x0c, 0xfb, 0x10, 0xda, 0x92, 0x71, 0x56, 0x1c, 0xbb, 0x47, 0xbe, 0x70, 0xf0,
-	0xd1, 0xd7, 0xb8, 0x64, 0xe6, 0xaa, 0x42, 0x99, 0x7f, 0xa3, 0x41, 0x83, 0x94, 0x5b, 0xc9, 0x61,
-	0xa9, 0x7c, 0x2d, 0x87, 0xe5, 0x7d, 0x30, 0xc2, 0x48, 0x38, 0xae, 0x9d, 0xde, 0xa3, 0xc1, 0x73,
-	0x04, 0x46, 0x12, 0xd2, 0x42, 0x23, 0x3f, 0x75, 0x4e, 0x00, 0x33, 0xa1, 0x13, 0xf8, 0x63, 0xc7,
-	0x8d, 0x4f, 0xc6, 0x07, 0xe7, 0x89, 0x88, 0x15, 0x2f, 0x5a, 0x81, 0xbf, 0xee, 0xc6, 0x27, 0x6b,
-	0x12, 0x25, 0x59, 0x48, 0x6f, 0x04, 0xdf, 0x86, 0xce, 0x15, 0xc4, 0x9e, 0x80, 0x81, 0x7e, 0x24,
-	0x3a, 0x1a, 0x06, 0x3a, 0x08, 0x37, 0xdf, 0xbc, 0x5e, 0x60, 0x12, 0x39, 0xe3, 0x61, 0xe8, 0x29,
-	0x4e, 0x7a, 0x4a, 0x72, 0xb0, 0x34, 0x19, 0x80, 0x6e, 0x0f, 0x7a, 0x4a, 0x12, 0x35, 0x8a, 0x8b,
-	0x9e, 0x12, 0x61, 0xd8, 0x03, 0x60, 0x53, 0xdf, 0x0e, 0x26, 0xa1, 0x14, 0x0a, 0xe1, 0xa8, 0x4d,
-	0xb6, 0x70, 0x93, 0xd7, 0x8a, 0x14, 0xdc, 0xaa, 0xf9, 0x4f, 0x1a, 0xb4, 0xd7, 0xdd, 0x48, 0xd8,
-	0x89, 0x70, 0x86, 0xce, 0x91, 0x90, 0x7b, 0x17, 0x7e, 0xe2, 0x26, 0xe7, 0xca, 0x15, 0x54, 0x50,
-	0xe6, 0xc9, 0x6b, 0xe5, 0xc8, 0x96, 0x5e, 0x58, 0x15, 0x83, 0x71, 0x02, 0xd8, 0x0a, 0x00, 0xc5,
-	0x38, 0x18, 0x90, 0xd7, 0x2e, 0x0f, 0xc8, 0x0d, 0xec, 0x26, 0x9b, 0x32, 0xe0, 0xa5, 0x31, 0x2e,
-	0xf9, 0x83, 0x0d, 0x8c, 0xd6, 0xa7, 0x52, 0x8b, 0x61, 0x68, 0x70, 0x20, 0x3c, 0x14, 0x47, 0x0c,
-	0x0d, 0x0e, 0x84, 0x97, 0x05, 0x64, 0x4d, 0xda, 0x8e, 0x6c, 0xb3, 0x3b, 0xa0, 0x05, 0x21, 0xb2,
-	0x5c, 0x2d, 0x58, 0x3c, 0xd8, 0xf2, 0x4e, 0xc8, 0xb5, 0x20, 0x94, 0x6f, 0x9b, 0xa2, 0x4f, 0x14,
-	0x47, 0xf9, 0xb6, 0xa5, 0x8d, 0xc2, 0x58, 0x88, 0x2b, 0x0a, 0x33, 0xa1, 0x6d, 0x79, 0x5e, 0xf0,
-	0x73, 0xe1, 0xec, 0x46, 0xc2, 0x49, 0x25, 0xb3, 0x84, 0x33, 0x6f, 0x82, 0xb6, 0x13, 0xb2, 0x26,
-	0x54, 0xf7, 0x86, 0xa3, 0xde, 0x15, 0xd9, 0x58, 0x1f, 0x6e, 0xf5, 0x2a, 0xe6, 0x57, 0x1a, 0x18,
-	0x2f, 0xa7, 0x89, 0x25, 0xb5, 0x49, 0x2c, 0xcf, 0x55, 0x96, 0xc9, 0x5c, 0xf8, 0xbe, 0x0d, 0x7a,
-	0x9c, 0x58, 0x11, 0xfa, 0x02, 0x64, 0x7d, 0x9a, 0x08, 0x8f, 0x62, 0xf6, 0x31, 0xd4, 0x85, 0x73,
-	0x24, 0x52, 0x73, 0xd0, 0x9b, 0x3d, 0x0b, 0x27, 0x32, 0x5b, 0x82, 0x46, 0x6c, 0x1f, 0x8b, 0x89,
-	0xd5, 0xaf, 0xe5, 0x1d, 0xf7, 0x10, 0x43, 0xce, 0x2f, 0x57, 0x74, 0xf6, 0x11, 0xd4, 0xe5, 0x6d,
-	0xc4, 0x2a, 0x9a, 0xc3, 0xf8, 0x4f, 0x32, 0x5e, 0x75, 0x23, 0xa2, 0x14, 0x35, 0x27, 0x0a, 0xc2,
-	0x71, 0x10, 0x22, 0x5f, 0xbb, 0x2b, 0x37, 0x50, 0xab, 0xa5, 0xa7, 0x59, 0x5e, 0x8f, 0x82, 0x70,
-	0x27, 0xe4, 0x0d, 0x07, 0x7f, 0x65, 0x6c, 0x81, 0xdd, 0x49, 0x06, 0xc8, 0x0c, 0x18, 0x12, 0x43,
-	0x89, 0x9a, 0x25, 0xd0, 0x27, 0x22, 0xb1, 0x1c, 0x2b, 0xb1, 0x94, 0x35, 0x68, 0x93, 0x92, 0x24,
-	0x1c, 0xcf, 0xa8, 0xe6, 0x43, 0x68, 0xd0, 0xd4, 0x4c, 0x87, 0xda, 0xf6, 0xce, 0xf6, 0x90, 0x18,
-	0xba, 0xba, 0xb5, 0xd5, 0xab, 0x48, 0xd4, 0xfa, 0xea, 0x68, 0xb5, 0xa7, 0xc9, 0xd6, 0xe8, 0xc7,
-	0xbb, 0xc3, 0x5e, 0xd5, 0xfc, 0xc7, 0x0a, 0xe8, 0xe9, 0x3c, 0xec, 0x29, 0x80, 0x7c, 0xb4, 0xe3,
-	0x63, 0xd7, 0xcf, 0xdc, 0xaa, 0x5b, 0xc5, 0x95, 0x96, 0xe5, 0x8d, 0x7d, 0x2e, 0xa9, 0x64, 0x3e,
-	0xf1, 0x8d, 0x23, 0x3c, 0xd8, 0x83, 0x6e, 0x99, 0x38, 0xc7, 0xbf, 0xbc, 0x5f, 0xb4, 0x23, 0xdd,
-	0x95, 0x6f, 0x95, 0xa6, 0x96, 0x23, 0x51, 0x98, 0x0b, 0x26, 0xe5, 0x01, 0xe8, 0x29, 0x9a, 0xb5,
-	0xa0, 0xb9, 0x3e, 0xdc, 0x58, 0xdd, 0xdf, 0x92, 0x42, 0x02, 0xd0, 0xd8, 0xdb, 0xdc, 0x7e, 0xbe,
-	0x35, 0xa4, 0x63, 0x6d, 0x6d, 0xee, 0x8d, 0x7a, 0x9a, 0xf9, 0x87, 0x15, 0xd0, 0x53, 0x4f, 0x85,
-	0x7d, 0x47, 0x3a, 0x17, 0xe8, 0x2c, 0x29, 0xdb, 0x83, 0xf9, 0x96, 0x42, 0xb0, 0xc8, 0x53, 0xba,
-	0x7c, 0x18, 0xa8, 0x4a, 0x53, 0xdf, 0x05, 0x81, 0x62, 0xac, 0x5a, 0x2d, 0xa5, 0x4b, 0x64, 0xd8,
-	0x1d, 0xf8, 0x42, 0xb9, 0xa9, 0xd8, 0x46, 0x19, 0x74, 0x7d, 0x5b, 0xe4, 0x4e, 0x7c, 0x13, 0xe1,
-	0x51, 0x6c, 0x26, 0xe4, 0xbd, 0x66, 0x1b, 0xcb, 0x56, 0xab, 0x14, 0x57, 0xbb, 0x10, 0x0a, 0x68,
-	0x17, 0x43, 0x81, 0xdc, 0x54, 0xd6, 0xdf, 0x65, 0x2a, 0xcd, 0x3f, 0xaf, 0x41, 0x97, 0x8b, 0x38,
-	0x09, 0x22, 0xc1, 0xc5, 0xcf, 0xa6, 0x22, 0x4e, 0xde, 0xf6, 0x84, 0x3e, 0x00, 0x88, 0xa8, 0x73,
-	0xbe, 0xb4, 0xa1, 0x30, 0x14, 0xc3, 0x78, 0x81, 0x8d, 0xb2, 0xab, 0x6c, 0x62, 0x06, 0xb3, 0x5b,
-	0x60, 0x1c, 0x58, 0xf6, 0x09, 0x4d, 0x4b, 0x96, 0x51, 0x27, 0x04, 0xcd, 0x6b, 0xd9, 0xb6, 0x88,
-	0xe3, 0xb1, 0x14, 0x05, 0xb2, 0x8f, 0x06, 0x61, 0x5e, 0x88, 0x73, 0x49, 0x8e, 0x85, 0x1d, 0x89,
-	0x04, 0xc9, 0xa4, 0x96, 0x0c, 0xc2, 0x48, 0xf2, 0x1d, 0xe8, 0xc4, 0x22, 0x96, 0xb6, 0x74, 0x9c,
-	0x04, 0x27, 0xc2, 0x57, 0x3a, 0xaa, 0xad, 0x90, 0x23, 0x89, 0x93, 0xa6, 0xc7, 0xf2, 0x03, 0xff,
-	0x7c, 0x12, 0x4c, 0x63, 0x65, 0x25, 0x72, 0x04, 0x5b, 0x86, 0xeb, 0xc2, 0xb7, 0xa3, 0xf3, 0x50,
-	0xee, 0x55, 0xae, 0x32, 0x3e, 0x74, 0x3d, 0xa1, 0x5c, 0xe6, 0x6b, 0x39, 0xe9, 0x85, 0x38, 0xdf,
-	0x70, 0x3d, 0x21, 0x77, 0x74, 0x6a, 0x4d, 0xbd, 0x64, 0x8c, 0xf1, 0x37, 0xd0, 0x8e, 0x10, 0xb3,
-	0x2a, 0x83, 0xf0, 0x7b, 0x70, 0x8d, 0xc8, 0x51, 0xe0, 0x09, 0xd7, 0xa1, 0xc9, 0x5a, 0xd8, 0xeb,
-	0x2a, 0x12, 0x38, 0xe2, 0x71, 0xaa, 0x65, 0xb8, 0x4e, 0x7d, 0xe9, 0x40, 0x69, 0xef, 0x36, 0x2d,
-	0x8d, 0xa4, 0x3d, 0x45, 0x29, 0x2f, 0x1d, 0x5a, 0xc9, 0x31, 0x46, 0xcf, 0xe9, 0xd2, 0xbb, 0x56,
-	0x72, 0x2c, 0x6d, 0x3c, 0x91, 0x0f, 0x5d, 0xe1, 0x51, 0x54, 0x6c, 0x70, 0x1a, 0xb1, 0x21, 0x31,
-	0xd2, 0xc6, 0xab, 0x0e, 0x41, 0x34, 0xb1, 0x28, 0x6d, 0x67, 0x70, 0x1a, 0xb4, 0x81, 0x28, 0xb9,
-	0x84, 0xba, 0x2b, 0x7f, 0x3a, 0xe9, 0xf7, 0xe8, 0x9a, 0x09, 0xb3, 0x3d, 0x9d, 0x98, 0xff, 0xa9,
-	0x81, 0x9e, 0x05, 0x59, 0xf7, 0xc1, 0x98, 0xa4, 0xfa, 0x4a, 0xb9, 0x66, 0x9d, 0x92, 0x12, 0xe3,
-	0x39, 0x9d, 0x7d, 0x00, 0xda, 0xc9, 0xa9, 0xd2, 0x9d, 0x9d, 0x65, 0x4a, 0x63, 0x87, 0x07, 0x4f,
-	0x96, 0x5f, 0xbc, 0xe2, 0xda, 0xc9, 0xe9, 0x37, 0x90, 0x5b, 0xf6, 0x09, 0x5c, 0xb5, 0x3d, 0x61,
-	0xf9, 0xe3, 0xdc, 0x9f, 0x20, 0xb9, 0xe8, 0x22, 0x7a, 0x37, 0x73, 0x2a, 0xee, 0x42, 0xdd, 0x11,
-	0x5e, 0x62, 0x15, 0xb3, 0xa9, 0x3b, 0x91, 0x65, 0x7b, 0x62, 0x5d, 0xa2, 0x39, 0x51, 0xa5, 0xee,
-	0xcc, 0x42, 0x9d, 0x82, 0xee, 0xbc, 0x18, 0xe6, 0xe4, 0xef, 0x12, 0x8a, 0xef, 0xf2, 0x3e, 0x5c,
-	0x13, 0x67, 0x21, 0x1a, 0x8c, 0x71, 0x16, 0xc7, 0x93, 0xfb, 0xd4, 0x4b, 0x09, 0xcf, 0xd2, 0x78,
-	0xfe, 0x53, 0xa9, 0x32, 0xf0, 0xd1, 0xe0, 0x35, 0xb7, 0x56, 0x18, 0xea, 0x9c, 0xd2, 0x33, 0xe4,
-	0x69, 0x97, 0x2f, 0x6a, 0x7a, 0xb3, 0xa7, 0x9b, 0x36, 0x54, 0x5f, 0xbc, 0xda, 0x43, 0xa5, 0x22,
-	0xf5, 0x7b, 0x1d, 0x1d, 0x00, 0x6c, 0x67, 0x8a, 0x46, 0x2b, 0x28, 0x9a, 0xdb, 0xa4, 0xa3, 0x91,
-	0x07, 0x69, 0x92, 0xaf, 0x80, 0x91, 0xa7, 0x20, 0xfb, 0x54, 0xa3, 0xfc, 0x1f, 0x02, 0xe6, 0x7f,
-	0x57, 0xa1, 0xa9, 0x9c, 0x06, 0xa9, 0x97, 0xa7, 0x59, 0x7e, 0x4a, 0x36, 0xcb, 0xb1, 0x5b, 0xe6,
-	0x7d, 0x14, 0x8b, 0x01, 0xd5, 0x77, 0x17, 0x03, 0xd8, 0x53, 0x68, 0x87, 0x44, 0x2b, 0xfa, 0x2b,
-	0xef, 0x15, 0xc7, 0xa8, 0x5f, 0x1c, 0xd7, 0x0a, 0x73, 0x40, 0xaa, 0x26, 0xcc, 0x94, 0x26, 0xd6,
-	0x91, 0xe2, 0x40, 0x53, 0xc2, 0x23, 0xeb, 0xe8, 0x12, 0xaf, 0xe5, 0xeb, 0x38, 0x1f, 0x5d, 0xf4,
-	0x62, 0xda, 0xa8, 0xe9, 0xa4, 0xc3, 0x52, 0xf4, 0x13, 0x3a, 0x65, 0x3f, 0xe1, 0x16, 0x18, 0x76,
-	0x30, 0x99, 0xb8, 0x48, 0xeb, 0xaa, 0x2c, 0x0d, 0x22, 0x46, 0xb1, 0xf9, 0xbb, 0x15, 0x68, 0xaa,
-	0xd3, 0x5e, 0xb0, 0x42, 0x6b, 0x9b, 0xdb, 0xab, 0xfc, 0xc7, 0xbd, 0x8a, 0xb4, 0xb2, 0x9b, 0xdb,
-	0xa3, 0x9e, 0xc6, 0x0c, 0xa8, 0x6f, 0x6c, 0xed, 0xac, 0x8e, 0x7a, 0x55, 0x69, 0x99, 0xd6, 0x76,
-	0x76, 0xb6, 0x7a, 0x35, 0xd6, 0x06, 0x7d, 0x7d, 0x75, 0x34, 0x1c, 0x6d, 0xbe, 0x1c, 0xf6, 0xea,
-	0xb2, 0xef, 0xf3, 0xe1, 0x4e, 0xaf, 0x21, 0x1b, 0xfb, 0x9b, 0xeb, 0xbd, 0xa6, 0xa4, 0xef, 0xae,
-	0xee, 0xed, 0xfd, 0x70, 0x87, 0xaf, 0xf7, 0x74, 0xb4, 0x6e, 0x23, 0xbe, 0xb9, 0xfd, 0xbc, 0x67,
-	0xc8, 0xf6, 0xce, 0xda, 0x17, 0xc3, 0x67, 0xa3, 0x1e, 0x98, 0x8f, 0xa1, 0x55, 0xe0, 0xa0, 0x1c,
-	0xcd, 0x87, 0x1b, 0xbd, 0x2b, 0x72, 0xc9, 0x57, 0xab, 0x5b, 0xfb, 0xd2, 0x18, 0x76, 0x01, 0xb0,
-	0x39, 0xde, 0x5a, 0xdd, 0x7e, 0xde, 0xd3, 0xcc, 0x1f, 0x80, 0xbe, 0xef, 0x3a, 0x6b, 0x5e, 0x60,
-	0x9f, 0x48, 0x71, 0x3a, 0xb0, 0x62, 0xa1, 0xec, 0x0e, 0xb6, 0xa5, 0x93, 0x8a, 0xef, 0x24, 0x56,
-	0x77, 0xaf, 0x20, 0xc9, 0x2b, 0x7f, 0x3a, 0x19, 0x63, 0x01, 0xa9, 0x4a, 0xb6, 0xc2, 0x9f, 0x4e,
-	0xf6, 0x5d, 0x27, 0x36, 0x4f, 0xa0, 0xb9, 0xef, 0x3a, 0xbb, 0x96, 0x7d, 0x82, 0xfa, 0x44, 0x4e,
-	0x3d, 0x8e, 0xdd, 0x2f, 0x85, 0xb2, 0x29, 0x06, 0x62, 0xf6, 0xdc, 0x2f, 0x05, 0xfb, 0x08, 0x1a,
-	0x08, 0xa4, 0x51, 0x3c, 0xbe, 0xbc, 0x74, 0x3b, 0x5c, 0xd1, 0xb0, 0x7e, 0xe3, 0x79, 0x81, 0x3d,
-	0x8e, 0xc4, 0x61, 0xff, 0x3d, 0xe2, 0x3d, 0x22, 0xb8, 0x38, 0x34, 0x7f, 0xbf, 0x92, 0x9d, 0x19,
-	0xcb, 0x07, 0x0b, 0x50, 0x0b, 0x2d, 0xfb, 0x44, 0x99, 0xf4, 0x96, 0x9a, 0x50, 0x6e, 0x86, 0x23,
-	0x81, 0x7d, 0x02, 0xba, 0x12, 0xac, 0x74, 0xd5, 0x56, 0x41, 0x02, 0x79, 0x46, 0x2c, 0x5f, 0x79,
-	0xb5, 0x7c, 0xe5, 0x18, 0x02, 0x86, 0x9e, 0x9b, 0xd0, 0x33, 0xaa, 0x71, 0x05, 0x99, 0xdf, 0x05,
-	0xc8, 0x2b, 0x36, 0x73, 0x3c, 0x9c, 0x1b, 0x50, 0xb7, 0x3c, 0xd7, 0x4a, 0x43, 0x4a, 0x02, 0xcc,
-	0x6d, 0x68, 0x15, 0xea, 0x3c, 0x92, 0xb7, 0x96, 0xe7, 0x49, 0x63, 0x14, 0xe3, 0x58, 0x9d, 0x37,
-	0x2d, 0xcf, 0x7b, 0x21, 0xce, 0x63, 0xe9, 0x5d, 0x52, 0x89, 0x48, 0x9b, 0xa9, 0x2e, 0xe0, 0x50,
-	0x4e, 0x44, 0xf3, 0x53, 0x68, 0x6c, 0xa4, 0xfe, 0x75, 0xfa, 0x0c, 0x2a, 0x97, 0x3d, 0x03, 0xf3,
-	0x33, 0xb5, 0x67, 0x2c, 0x50, 0xb0, 0xfb, 0xaa, 0x14, 0x15, 0x53, 0xe1, 0xab, 0x92, 0x27, 0x25,
-	0xa8, 0x93, 0xaa, 0x42, 0x61, 0x67, 0x73, 0x1d, 0xf4, 0xb7, 0x16, 0xf7, 0x14, 0x03, 0xb4, 0x9c,
-	0x01, 0x73, 0xca, 0x7d, 0xe6, 0x4f, 0x01, 0xf2, 0x92, 0x95, 0x7a, 0x95, 0x34, 0x8b, 0x7c, 0x95,
-	0xf7, 0x40, 0xb7, 0x8f, 0x5d, 0xcf, 0x89, 0x84, 0x5f, 0x3a, 0x75, 0x5e, 0xe4, 0xca, 0xe8, 0x6c,
-	0x11, 0x6a, 0x58, 0x89, 0xab, 0xe6, 0x8a, 0x3c, 0x2b, 0xc3, 0x21, 0xc5, 0x3c, 0x83, 0x0e, 0xb9,
-	0xed, 0x5f, 0xc3, 0xe9, 0x29, 0xab, 0x52, 0xed, 0x82, 0x2a, 0xbd, 0x09, 0x0d, 0xb4, 0xb5, 0xe9,
-	0x69, 0x14, 0x74, 0x89, 0x8a, 0xfd, 0x1d, 0x0d, 0x80, 0x96, 0xde, 0x0e, 0x1c, 0x51, 0x8e, 0x88,
-	0x2b, 0xb3, 0x11, 0x31, 0x83, 0x5a, 0x56, 0x64, 0x35, 0x38, 0xb6, 0x73, 0xfb, 0xa3, 0xa2, 0x64,
-	0xb2, 0x3f, 0xef, 0x83, 0x81, 0xbe, 0x8f, 0xfb, 0x25, 0x66, 0xfe, 0xe5, 0x82, 0x39, 0xa2, 0x58,
-	0x72, 0xac, 0x97, 0x4b, 0x8e, 0x59, 0x5d, 0xa6, 0x41, 0xb3, 0x51, 0x5d, 0x66, 0x4e, 0x89, 0x89,
-	0xd2, 0x14, 0xb1, 0x88, 0x92, 0x34, 0xc6, 0x26, 0x28, 0x0b, 0x0c, 0x0d, 0xd5, 0xd7, 0xa2, 0x44,
-	0x83, 0x1f, 0x8c, 0xed, 0xc0, 0x3f, 0xf4, 0x5c, 0x3b, 0x51, 0x25, 0x46, 0xf0, 0x83, 0x67, 0x0a,
-	0x63, 0x3e, 0x85, 0x76, 0xca, 0x7f, 0xac, 0xe4, 0xdc, 0xcb, 0x02, 0xab, 0x4a, 0x7e, 0xb7, 0x39,
-	0x9b, 0xd6, 0xb4, 0x7e, 0x25, 0x0d, 0xad, 0xcc, 0xff, 0xaa, 0xa6, 0x83, 0x55, 0xc1, 0xe1, 0xed,
-	0x3c, 0x2c, 0x47, 0xc7, 0xda, 0xd7, 0x8a, 0x8e, 0xbf, 0x07, 0x86, 0x83, 0xe1, 0x9f, 0x7b, 0x9a,
-	0x1a, 0xb5, 0xc1, 0x6c, 0xa8, 0xa7, 0x02, 0x44, 0xf7, 0x54, 0xf0, 0xbc, 0xf3, 0x3b, 0xee, 0x21,
-	0xe3, 0x76, 0x7d, 0x1e, 0xb7, 0x1b, 0xbf, 0x22, 0xb7, 0x3f, 0x84, 0xb6, 0x1f, 0xf8, 0x63, 0x7f,
-	0xea, 0x79, 0xd6, 0x81, 0x27, 0x14, 0xbb, 0x5b, 0x7e, 0xe0, 0x6f, 0x2b, 0x94, 0x74, 0x48, 0x8b,
-	0x5d, 0xe8, 0x51, 0xb7, 0xb0, 0xdf, 0xd5, 0x42, 0x3f, 0x7c, 0xfa, 0x4b, 0xd0, 0x0b, 0x0e, 0x7e,
-	0x2a, 0xec, 0x04, 0x39, 0x36, 0xc6, 0xd7, 0x4c, 0xde, 0x68, 0x97, 0xf0, 0x92, 0x45, 0xdb, 0xf2,
-	0x5d, 0xcf, 0x5c, 0x73, 0xe7, 0xc2, 0x35, 0x7f, 0x06, 0x46, 0xc6, 0xa5, 0x42, 0xa8, 0x69, 0x40,
-	0x7d, 0x73, 0x7b, 0x7d, 0xf8, 0xa3, 0x5e, 0x45, 0x1a, 0x4a, 0x3e, 0x7c, 0x35, 0xe4, 0x7b, 0xc3,
-	0x9e, 0x26, 0x8d, 0xd8, 0xfa, 0x70, 0x6b, 0x38, 0x1a, 0xf6, 0xaa, 0xe4, 0xf5, 0x60, 0xde, 0xdf,
-	0x73, 0x6d, 0x37, 0x31, 0xf7, 0x00, 0xf2, 0xf8, 0x59, 0x6a, 0xe5, 0x7c, 0x73, 0x2a, 0x65, 0x97,
-	0xa4, 0xdb, 0x5a, 0xca, 0x1e, 0xa4, 0x76, 0x59, 0x94, 0x4e, 0x74, 0x73, 0x05, 0x8c, 0x97, 0x56,
-	0xf8, 0x39, 0x55, 0xc8, 0xee, 0x42, 0x37, 0xb4, 0xa2, 0xc4, 0x4d, 0x43, 0x00, 0x52, 0x96, 0x6d,
-	0xde, 0xc9, 0xb0, 0x52, 0xf7, 0x9a, 0x7f, 0x51, 0x81, 0x1b, 0x2f, 0x83, 0x53, 0x91, 0xb9, 0x98,
-	0xbb, 0xd6, 0xb9, 0x17, 0x58, 0xce, 0x3b, 0xc4, 0x50, 0xc6, 0x30, 0xc1, 0x14, 0x2b, 0x56, 0x69,
-	0x7d, 0x8f, 0x1b, 0x84, 0x79, 0xae, 0x3e, 0x40, 0x10, 0x71, 0x82, 0x44, 0x65, 0x48, 0x25, 0x2c,
-	0x49, 0xdf, 0x82, 0x46, 0x72, 0xe6, 0xe7, 0xd5, 0xc6, 0x7a, 0x82, 0x09, 0xe5, 0xb9, 0x1e, 0x67,
-	0x7d, 0xbe, 0xc7, 0x69, 0x3e, 0x03, 0x63, 0x74, 0x86, 0xc9, 0xd6, 0x69, 0x5c, 0x72, 0x70, 0x2a,
-	0x6f, 0x71, 0x70, 0xb4, 0x19, 0x07, 0xe7, 0x3f, 0x2a, 0xd0, 0x2a, 0xb8, 0xce, 0xec, 0x43, 0xa8,
-	0x25, 0x67, 0x7e, 0xb9, 0xa8, 0x9f, 0x2e, 0xc2, 0x91, 0x74, 0x21, 0xa1, 0xa8, 0x5d, 0x48, 0x28,
-	0xb2, 0x2d, 0xb8, 0x4a, 0x9a, 0x37, 0x3d, 0x44, 0x9a, 0x85, 0xb9, 0x33, 0xe3, 0xaa, 0x53, 0x42,
-	0x3a, 0x3d, 0x92, 0x4a, 0x2d, 0x74, 0x8f, 0x4a, 0xc8, 0xc1, 0x2a, 0x5c, 0x9f, 0xd3, 0xed, 0x9b,
-	0x14, 0x22, 0xcc, 0x05, 0xe8, 0x8c, 0xce, 0xfc, 0x91, 0x3b, 0x11, 0x71, 0x62, 0x4d, 0x42, 0x74,
-	0x10, 0x95, 0xe5, 0xac, 0x71, 0x2d, 0x89, 0xcd, 0x8f, 0xa1, 0xbd, 0x2b, 0x44, 0xc4, 0x45, 0x1c,
-	0x06, 0x3e, 0x39, 0x47, 0x2a, 0x11, 0x4c, 0x66, 0x5a, 0x41, 0xe6, 0x6f, 0x81, 0xc1, 0xad, 0xc3,
-	0x64, 0xcd, 0x4a, 0xec, 0xe3, 0x6f, 0x92, 0x67, 0xf8, 0x18, 0x9a, 0x21, 0xc9, 0x94, 0x0a, 0xa8,
-	0xda, 0x68, 0xae, 0x95, 0x9c, 0xf1, 0x94, 0x68, 0x3e, 0x86, 0xeb, 0x7b, 0xd3, 0x83, 0xd8, 0x8e,
-	0x5c, 0x8c, 0x4d, 0x53, 0x53, 0x36, 0x00, 0x3d, 0x8c, 0xc4, 0xa1, 0x7b, 0x26, 0x52, 0x09, 0xce,
-	0x60, 0xf3, 0xfb, 0x70, 0xa3, 0x3c, 0x44, 0x1d, 0xe1, 0x0e, 0x54, 0x4f, 0x4e, 0x63, 0xb5, 0xb3,
-	0x6b, 0xa5, 0xc8, 0x0c, 0x6b, 0xe9, 0x92, 0x6a, 0x72, 0xa8, 0x6e, 0x4f, 0x27, 0xc5, 0xef, 0x81,
-	0x6a, 0xf4, 0x3d, 0xd0, 0xad, 0x62, 0x9a, 0x95, 0xa2, 0x90, 0x3c, 0x9d, 0xfa, 0x3e, 0x18, 0x87,
-	0x41, 0xf4, 0x73, 0x2b, 0x72, 0x84, 0xa3, 0x6c, 0x56, 0x8e, 0x30, 0x7f, 0x02, 0xad, 0x54, 0x12,
-	0x36, 0x1d, 0xac, 0xee, 0xa1, 0x28, 0x6e, 0x3a, 0x25, 0xc9, 0xa4, 0xac, 0xa4, 0xf0, 0x9d, 0xcd,
-	0x54, 0x84, 0x08, 0x28, 0xaf, 0xac, 0x4a, 0x2e, 0xe9, 0xca, 0xe6, 0x06, 0xb4, 0xd3, 0xf8, 0xed,
-	0xa5, 0x48, 0x2c, 0x14, 0x6e, 0xcf, 0x15, 0x7e, 0x41, 0xf0, 0x75, 0x42, 0x8c, 0xca, 0x89, 0x43,
-	0xad, 0xe4, 0x00, 0x98, 0xcb, 0xd0, 0x50, 0x2f, 0x87, 0x41, 0xcd, 0x0e, 0x1c, 0x7a, 0xdd, 0x75,
-	0x8e, 0x6d, 0xc9, 0x8e, 0x49, 0x7c, 0x94, 0x3a, 0x37, 0x93, 0xf8, 0xc8, 0xfc, 0x6b, 0x0d, 0x3a,
-	0x6b, 0x18, 0x2d, 0xa7, 0x57, 0x52, 0xc8, 0x11, 0x55, 0x4a, 0x39, 0xa2, 0x62, 0x3e, 0x48, 0x2b,
-	0xe5, 0x83, 0x4a, 0x1b, 0xaa, 0x96, 0x3d, 0x92, 0xf7, 0xa0, 0x39, 0xf5, 0xdd, 0xb3, 0x54, 0x25,
-	0x18, 0xbc, 0x21, 0xc1, 0x51, 0xcc, 0x16, 0xa1, 0x25, 0xb5, 0x86, 0xeb, 0x53, 0x0e, 0x86, 0x12,
-	0x29, 0x45, 0xd4, 0x4c, 0xa6, 0xa5, 0xf1, 0xf6, 0x4c, 0x4b, 0xf3, 0x9d, 0x99, 0x16, 0xfd, 0x5d,
-	0x99, 0x16, 0x63, 0x36, 0xd3, 0x52, 0xf6, 0xa6, 0x60, 0xd6, 0x9b, 0x32, 0xb7, 0xa0, 0x9b, 0xf2,
-	0x4e, 0xc9, 0xe6, 0x53, 0xb8, 0xaa, 0x92, 0xa4, 0x22, 0x52, 0x79, 0x06, 0xd2, 0x38, 0xd7, 0x30,
-	0x4d, 0x8b, 0x79, 0x4c, 0x45, 0xe1, 0x5d, 0xa7, 0x08, 0xc6, 0xe6, 0xef, 0x55, 0xa0, 0x53, 0xea,
-	0xc1, 0x1e, 0xe7, 0x29, 0xd7, 0x0a, 0x1a, 0xf6, 0xfe, 0x85, 0x59, 0xde, 0x9e, 0x76, 0xd5, 0x66,
-	0xd2, 0xae, 0xe6, 0xdd, 0x2c, 0x99, 0xaa, 0x52, 0xa8, 0x57, 0xb2, 0x14, 0x2a, 0x66, 0x1d, 0x57,
-	0x47, 0x23, 0xde, 0xd3, 0xcc, 0x3f, 0xd2, 0xa0, 0x33, 0x3c, 0x0b, 0xf1, 0xeb, 0x95, 0x77, 0xfa,
-	0x9c, 0x05, 0x81, 0xd1, 0x4a, 0x02, 0x53, 0xb8, 0xfa, 0xaa, 0xaa, 0x16, 0xd1, 0xd5, 0x4b, 0x2f,
-	0x94, 0x12, 0x3a, 0x4a, 0x24, 0x08, 0xfa, 0x3f, 0x20, 0x12, 0xf2, 0xca, 0x53, 0xc6, 0xa8, 0x2b,
-	0xff, 0x5a, 0xef, 0x8c, 0xbe, 0x3c, 0xf3, 0xb2, 0xf4, 0x06, 0x01, 0xe6, 0x1f, 0x68, 0x60, 0x90,
-	0x04, 0xc9, 0xed, 0x7d, 0x47, 0x79, 0xd0, 0x95, 0x3c, 0x95, 0x9c, 0x11, 0x97, 0x5f, 0x88, 0x73,
-	0xf4, 0xfc, 0xc8, 0xb1, 0x9e, 0x57, 0x70, 0x51, 0x49, 0x10, 0x8a, 0xfb, 0x30, 0x09, 0x72, 0x0b,
-	0x0c, 0x32, 0x9e, 0x53, 0x37, 0x2d, 0x01, 0x93, 0x35, 0xdd, 0x77, 0xf1, 0x83, 0x9c, 0x44, 0x44,
-	0x13, 0xc5, 0x65, 0x6c, 0x97, 0x3d, 0xec, 0x8e, 0xf2, 0xf9, 0xcc, 0x63, 0x68, 0xaa, 0xd5, 0xa5,
-	0x0b, 0xb4, 0xbf, 0xfd, 0x62, 0x7b, 0xe7, 0x87, 0xdb, 0x25, 0xc9, 0xc9, 0x9c, 0x24, 0xad, 0xe8,
-	0x24, 0x55, 0x25, 0xfe, 0xd9, 0xce, 0xfe, 0xf6, 0xa8, 0x57, 0x63, 0x1d, 0x30, 0xb0, 0x39, 0xe6,
-	0xc3, 0x57, 0xbd, 0x3a, 0xe6, 0x03, 0x9e, 0x7d, 0x3e, 0x7c, 0xb9, 0xda, 0x6b, 0x64, 0xa9, 0xfb,
-	0xa6, 0xf9, 0xa7, 0x15, 0xb8, 0x46, 0x47, 0x2e, 0x06, 0xc8, 0xc5, 0xaf, 0x3e, 0x6b, 0xf4, 0xd5,
-	0xe7, 0xaf, 0x37, 0x26, 0x96, 0x83, 0xa6, 0x6e, 0x5a, 0x1e, 0xa3, 0xe4, 0x8d, 0x3e, 0x75, 0x55,
-	0x55, 0xec, 0xef, 0x2b, 0x30, 0x20, 0xdf, 0xec, 0x79, 0x64, 0x85, 0xc7, 0x3f, 0xd8, 0xba, 0x10,
-	0x9d, 0x5d, 0xe6, 0xb1, 0xdc, 0x85, 0x2e, 0x7e, 0x17, 0xfb, 0x33, 0x6f, 0xac, 0x22, 0x08, 0xba,
-	0xbf, 0x8e, 0xc2, 0xd2, 0x44, 0xec, 0x09, 0xb4, 0xe9, 0xfb, 0x59, 0x4c, 0x18, 0x96, 0x0a, 0x3d,
-	0x25, 0xcf, 0xb0, 0x45, 0xbd, 0xb0, 0xe4, 0xc4, 0x1e, 0x67, 0x83, 0xf2, 0x40, 0xee, 0x62, 0x2d,
-	0x47, 0x0d, 0x19, 0x61, 0x78, 0xf7, 0x10, 0x6e, 0xcd, 0x3d, 0x87, 0x12, 0xec, 0x42, 0x52, 0x8d,
-	0xe4, 0xc9, 0xfc, 0xcb, 0x0a, 0xe8, 0x6b, 0x53, 0xef, 0x04, 0x2d, 0xd4, 0x07, 0x00, 0xc2, 0x39,
-	0x12, 0xea, 0x43, 0xd4, 0x0a, 0x3e, 0x70, 0x43, 0x62, 0xe8, 0x53, 0xd4, 0xa7, 0x00, 0x74, 0xc6,
-	0xf1, 0xc4, 0x0a, 0xd5, 0x15, 0x61, 0xe1, 0x25, 0x9d, 0x40, 0x9d, 0xe5, 0xa5, 0x15, 0xaa, 0xc2,
-	0x4b, 0x9c, 0xc2, 0x83, 0x6d, 0xe8, 0x96, 0x89, 0x73, 0xd2, 0x12, 0x1f, 0x97, 0x0b, 0xf8, 0x17,
-	0xb9, 0x93, 0x7b, 0x49, 0x2b, 0x7f, 0x57, 0x81, 0x9a, 0xf4, 0x5e, 0xd8, 0x03, 0x30, 0x3e, 0x17,
-	0x56, 0x94, 0x1c, 0x08, 0x2b, 0x61, 0x25, 0x4f, 0x65, 0x80, 0x9c, 0xca, 0xeb, 0xec, 0xe6, 0x95,
-	0x47, 0x15, 0xb6, 0x4c, 0x5f, 0xe2, 0xa5, 0x5f, 0x18, 0x76, 0x52, 0x2f, 0x08, 0xbd, 0xa4, 0x41,
-	0x69, 0xbc, 0x79, 0x65, 0x09, 0xfb, 0x7f, 0x11, 0xb8, 0xfe, 0x33, 0xfa, 0xfe, 0x8b, 0xcd, 0x7a,
-	0x4d, 0xb3, 0x23, 0xd8, 0x03, 0x68, 0x6c, 0xc6, 0xd2, 0x3d, 0xbb, 0xd8, 0x15, 0xcf, 0x53, 0xf4,
-	0xdc, 0xcc, 0x2b, 0x2b, 0x7f, 0x56, 0x85, 0xda, 0x4f, 0x44, 0x14, 0xb0, 0x4f, 0xa1, 0xa9, 0xbe,
-	0x4a, 0x60, 0x85, 0xaf, 0x0f, 0x06, 0x18, 0x29, 0xce, 0x7c, 0xae, 0x80, 0xab, 0xf4, 0x88, 0x25,
-	0x79, 0x7a, 0x9a, 0xe5, 0x1f, 0x4d, 0x5c, 0xd8, 0xd4, 0x67, 0xd0, 0xdb, 0x4b, 0x22, 0x61, 0x4d,
-	0x0a, 0xdd, 0xcb, 0xac, 0x9a, 0x97, 0xeb, 0x46, 0x7e, 0xdd, 0x87, 0x06, 0xf9, 0xc0, 0x33, 0x03,
-	0x66, 0x13, 0xd9, 0xd8, 0xf9, 0x13, 0x68, 0xed, 0x1d, 0x07, 0x53, 0xcf, 0xd9, 0x13, 0xd1, 0xa9,
-	0x60, 0x85, 0x2f, 0x91, 0x06, 0x85, 0xb6, 0x79, 0x85, 0x2d, 0x01, 0x90, 0xdb, 0xb5, 0x2f, 0x1f,
-	0x7e, 0x53, 0xd2, 0xb6, 0xa7, 0x13, 0x9a, 0xb4, 0xe0, 0x8f, 0x51, 0xcf, 0x82, 0x2b, 0xfc, 0xb6,
-	0x9e, 0x4f, 0xa0, 0xf3, 0x0c, 0x95, 0xc0, 0x4e, 0xb4, 0x7a, 0x10, 0x44, 0x09, 0x9b, 0xfd, 0x1a,
-	0x69, 0x30, 0x8b, 0x30, 0xaf, 0xb0, 0x47, 0xa0, 0x8f, 0xa2, 0x73, 0xea, 0x7f, 0x4d, 0x45, 0x10,
-	0xf9, 0x7a, 0x73, 0x4e, 0xb9, 0xf2, 0x3f, 0x35, 0x68, 0xfc, 0x30, 0x88, 0x4e, 0x44, 0xc4, 0xee,
-	0x41, 0x03, 0xcb, 0x0c, 0x4a, 0x8c, 0xb2, 0x92, 0xc3, 0xbc, 0x85, 0x3e, 0x02, 0x03, 0x99, 0x32,
-	0xb2, 0xe2, 0x13, 0xba, 0x2a, 0xfc, 0x80, 0x9c, 0xf8, 0x42, 0x59, 0x08, 0xbc, 0xd7, 0x2e, 0x5d,
-	0x54, 0x56, 0x86, 0x2b, 0x95, 0x01, 0x06, 0x78, 0xfe, 0x17, 0xaf, 0xf6, 0xa4, 0x68, 0x3e, 0xaa,
-	0x48, 0xeb, 0xb2, 0x47, 0x27, 0x95, 0x9d, 0xf2, 0x0f, 0x67, 0x49, 0xf2, 0xf3, 0x2f, 0x55, 0xcd,
-	0x2b, 0xec, 0x21, 0x34, 0x94, 0x2a, 0xba, 0x96, 0x3f, 0x2b, 0xa5, 0xdf, 0x06, 0xbd, 0x22, 0x4a,
-	0x0d, 0x78, 0x0c, 0x0d, 0x52, 0xdb, 0x34, 0xa0, 0xe4, 0x50, 0x0e, 0x58, 0x11, 0x95, 0x0a, 0x33,
-	0xbb, 0x0f, 0x4d, 0x55, 0x44, 0x60, 0x73, 0x2a, 0x0a, 0x74, 0x54, 0xf2, 0x64, 0x69, 0x7e, 0xb2,
-	0xba, 0x34, 0x7f, 0xc9, 0x35, 0xa1, 0xf9, 0xcb, 0x46, 0x99, 0xa4, 0x9e, 0x0b, 0x5b, 0xb8, 0x85,
-	0xe0, 0x97, 0xa5, 0x1c, 0x99, 0xf3, 0x74, 0x3f, 0x83, 0x4e, 0x29, 0x50, 0x66, 0xe8, 0x6a, 0xcd,
-	0x8b, 0x9d, 0x2f, 0x3c, 0x98, 0xef, 0x83, 0xa1, 0xe2, 0x94, 0x03, 0xc1, 0xb0, 0x36, 0x30, 0x27,
-	0xd2, 0x19, 0x5c, 0x0c, 0x54, 0xf0, 0x15, 0xfc, 0x08, 0xae, 0xcf, 0xd1, 0xc1, 0x0c, 0x3f, 0xf2,
-	0xba, 0xdc, 0xc8, 0x0c, 0x16, 0x2e, 0xa5, 0xa7, 0x0c, 0x58, 0xeb, 0xfd, 0xc3, 0x57, 0xb7, 0x2b,
-	0xff, 0xfc, 0xd5, 0xed, 0xca, 0xbf, 0x7d, 0x75, 0xbb, 0xf2, 0xcb, 0x7f, 0xbf, 0x7d, 0xe5, 0xa0,
-	0x81, 0x7f, 0xa6, 0x78, 0xf2, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x2f, 0x99, 0xc3, 0xc2,
-	0x31, 0x00, 0x00,
+func (m *TabletLoad) Reset()         { *m = TabletLoad{} }
+func (m *TabletLoad) String() string { return proto.CompactTextString(m) }
+func (*TabletLoad) ProtoMessage()    {}
+func (*TabletLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{65}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// RaftClient is the client API for Raft service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RaftClient interface {
-	Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error)
-	RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error)
-	JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error)
-	IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error)
+func (m *TabletLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type raftClient struct {
-	cc *grpc.ClientConn
+func (m *TabletLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TabletLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewRaftClient(cc *grpc.ClientConn) RaftClient {
-	return &raftClient{cc}
+func (m *TabletLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TabletLoad.Merge(m, src)
 }
-
-func (c *raftClient) Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[0], "/pb.Raft/Heartbeat", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &raftHeartbeatClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *TabletLoad) XXX_Size() int {
+	return m.Size()
 }
-
-type Raft_HeartbeatClient interface {
-	Recv() (*HealthInfo, error)
-	grpc.ClientStream
+func (m *TabletLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_TabletLoad.DiscardUnknown(m)
 }
 
-type raftHeartbeatClient struct {
-	grpc.ClientStream
-}
+var xxx_messageInfo_TabletLoad proto.InternalMessageInfo
 
-func (x *raftHeartbeatClient) Recv() (*HealthInfo, error) {
-	m := new(HealthInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *TabletLoad) GetReadsPerSec() float64 {
+	if m != nil {
+		return m.ReadsPerSec
 	}
-	return m, nil
+	return 0
 }
 
-func (c *raftClient) RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[1], "/pb.Raft/RaftMessage", opts...)
-	if err != nil {
-		return nil, err
+func (m *TabletLoad) GetWritesPerSec() float64 {
+	if m != nil {
+		return m.WritesPerSec
 	}
-	x := &raftRaftMessageClient{stream}
-	return x, nil
+	return 0
 }
 
-type Raft_RaftMessageClient interface {
-	Send(*RaftBatch) error
-	CloseAndRecv() (*api.Payload, error)
-	grpc.ClientStream
+type TabletPin struct {
+	Predicate            string   `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	GroupId              uint32   `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type raftRaftMessageClient struct {
-	grpc.ClientStream
+func (m *TabletPin) Reset()         { *m = TabletPin{} }
+func (m *TabletPin) String() string { return proto.CompactTextString(m) }
+func (*TabletPin) ProtoMessage()    {}
+func (*TabletPin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{66}
 }
-
-func (x *raftRaftMessageClient) Send(m *RaftBatch) error {
-	return x.ClientStream.SendMsg(m)
+func (m *TabletPin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *raftRaftMessageClient) CloseAndRecv() (*api.Payload, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(api.Payload)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *TabletPin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TabletPin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *TabletPin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TabletPin.Merge(m, src)
+}
+func (m *TabletPin) XXX_Size() int {
+	return m.Size()
+}
+func (m *TabletPin) XXX_DiscardUnknown() {
+	xxx_messageInfo_TabletPin.DiscardUnknown(m)
 }
 
-func (c *raftClient) JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error) {
-	out := new(api.Payload)
-	err := c.cc.Invoke(ctx, "/pb.Raft/JoinCluster", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TabletPin proto.InternalMessageInfo
+
+func (m *TabletPin) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *raftClient) IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error) {
-	out := new(PeerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Raft/IsPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TabletPin) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
 	}
-	return out, nil
+	return 0
 }
 
-// RaftServer is the server API for Raft service.
-type RaftServer interface {
-	Heartbeat(*api.Payload, Raft_HeartbeatServer) error
-	RaftMessage(Raft_RaftMessageServer) error
-	JoinCluster(context.Context, *RaftContext) (*api.Payload, error)
-	IsPeer(context.Context, *RaftContext) (*PeerResponse, error)
+type StorageHint struct {
+	Compression          StorageHint_Compression `protobuf:"varint,1,opt,name=compression,proto3,enum=pb.StorageHint_Compression" json:"compression,omitempty"`
+	Level                int32                   `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Cache                StorageHint_Cache       `protobuf:"varint,3,opt,name=cache,proto3,enum=pb.StorageHint_Cache" json:"cache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-// UnimplementedRaftServer can be embedded to have forward compatible implementations.
-type UnimplementedRaftServer struct {
+func (m *StorageHint) Reset()         { *m = StorageHint{} }
+func (m *StorageHint) String() string { return proto.CompactTextString(m) }
+func (*StorageHint) ProtoMessage()    {}
+func (*StorageHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{67}
 }
-
-func (*UnimplementedRaftServer) Heartbeat(req *api.Payload, srv Raft_HeartbeatServer) error {
-	return status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+func (m *StorageHint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedRaftServer) RaftMessage(srv Raft_RaftMessageServer) error {
-	return status.Errorf(codes.Unimplemented, "method RaftMessage not implemented")
+func (m *StorageHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageHint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedRaftServer) JoinCluster(ctx context.Context, req *RaftContext) (*api.Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinCluster not implemented")
+func (m *StorageHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageHint.Merge(m, src)
 }
-func (*UnimplementedRaftServer) IsPeer(ctx context.Context, req *RaftContext) (*PeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsPeer not implemented")
+func (m *StorageHint) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterRaftServer(s *grpc.Server, srv RaftServer) {
-	s.RegisterService(&_Raft_serviceDesc, srv)
+func (m *StorageHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageHint.DiscardUnknown(m)
 }
 
-func _Raft_Heartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(api.Payload)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_StorageHint proto.InternalMessageInfo
+
+func (m *StorageHint) GetCompression() StorageHint_Compression {
+	if m != nil {
+		return m.Compression
 	}
-	return srv.(RaftServer).Heartbeat(m, &raftHeartbeatServer{stream})
+	return StorageHint_DEFAULT
 }
 
-type Raft_HeartbeatServer interface {
-	Send(*HealthInfo) error
-	grpc.ServerStream
+func (m *StorageHint) GetLevel() int32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
 }
 
-type raftHeartbeatServer struct {
-	grpc.ServerStream
+func (m *StorageHint) GetCache() StorageHint_Cache {
+	if m != nil {
+		return m.Cache
+	}
+	return StorageHint_NORMAL
 }
 
-func (x *raftHeartbeatServer) Send(m *HealthInfo) error {
-	return x.ServerStream.SendMsg(m)
+type LockRequest struct {
+	Keys                 []uint64 `protobuf:"fixed64,1,rep,packed,name=keys,proto3" json:"keys,omitempty"`
+	Owner                uint64   `protobuf:"fixed64,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	LeaseMs              int64    `protobuf:"varint,3,opt,name=lease_ms,json=leaseMs,proto3" json:"lease_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _Raft_RaftMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(RaftServer).RaftMessage(&raftRaftMessageServer{stream})
+func (m *LockRequest) Reset()         { *m = LockRequest{} }
+func (m *LockRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRequest) ProtoMessage()    {}
+func (*LockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{68}
 }
-
-type Raft_RaftMessageServer interface {
-	SendAndClose(*api.Payload) error
-	Recv() (*RaftBatch, error)
-	grpc.ServerStream
+func (m *LockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type raftRaftMessageServer struct {
-	grpc.ServerStream
+func (m *LockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func (x *raftRaftMessageServer) SendAndClose(m *api.Payload) error {
-	return x.ServerStream.SendMsg(m)
+func (m *LockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRequest.Merge(m, src)
+}
+func (m *LockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRequest.DiscardUnknown(m)
 }
 
-func (x *raftRaftMessageServer) Recv() (*RaftBatch, error) {
-	m := new(RaftBatch)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_LockRequest proto.InternalMessageInfo
+
+func (m *LockRequest) GetKeys() []uint64 {
+	if m != nil {
+		return m.Keys
 	}
-	return m, nil
+	return nil
 }
 
-func _Raft_JoinCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RaftContext)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RaftServer).JoinCluster(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Raft/JoinCluster",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftServer).JoinCluster(ctx, req.(*RaftContext))
+func (m *LockRequest) GetOwner() uint64 {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Raft_IsPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RaftContext)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RaftServer).IsPeer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Raft/IsPeer",
+func (m *LockRequest) GetLeaseMs() int64 {
+	if m != nil {
+		return m.LeaseMs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftServer).IsPeer(ctx, req.(*RaftContext))
+	return 0
+}
+
+type CancelIndexBuildRequest struct {
+	Predicate            string   `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelIndexBuildRequest) Reset()         { *m = CancelIndexBuildRequest{} }
+func (m *CancelIndexBuildRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelIndexBuildRequest) ProtoMessage()    {}
+func (*CancelIndexBuildRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{69}
+}
+func (m *CancelIndexBuildRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelIndexBuildRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelIndexBuildRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CancelIndexBuildRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelIndexBuildRequest.Merge(m, src)
+}
+func (m *CancelIndexBuildRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelIndexBuildRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelIndexBuildRequest.DiscardUnknown(m)
 }
 
-var _Raft_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Raft",
-	HandlerType: (*RaftServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "JoinCluster",
-			Handler:    _Raft_JoinCluster_Handler,
-		},
-		{
-			MethodName: "IsPeer",
-			Handler:    _Raft_IsPeer_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Heartbeat",
-			Handler:       _Raft_Heartbeat_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "RaftMessage",
-			Handler:       _Raft_RaftMessage_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "pb.proto",
+var xxx_messageInfo_CancelIndexBuildRequest proto.InternalMessageInfo
+
+func (m *CancelIndexBuildRequest) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
 }
 
-// ZeroClient is the client API for Zero service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ZeroClient interface {
-	// These 3 endpoints are for handling membership.
-	Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error)
-	UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error)
-	StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error)
-	Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error)
-	ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error)
-	AssignUids(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
-	Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
-	CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error)
-	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error)
+func init() {
+	proto.RegisterEnum("pb.Query_Isolation", Query_Isolation_name, Query_Isolation_value)
+	proto.RegisterEnum("pb.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
+	proto.RegisterEnum("pb.Mutations_DropOp", Mutations_DropOp_name, Mutations_DropOp_value)
+	proto.RegisterEnum("pb.Metadata_HintType", Metadata_HintType_name, Metadata_HintType_value)
+	proto.RegisterEnum("pb.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
+	proto.RegisterEnum("pb.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
+	proto.RegisterEnum("pb.SchemaUpdate_Directive", SchemaUpdate_Directive_name, SchemaUpdate_Directive_value)
+	proto.RegisterEnum("pb.DropOperation_DropOp", DropOperation_DropOp_name, DropOperation_DropOp_value)
+	proto.RegisterEnum("pb.BackupKey_KeyType", BackupKey_KeyType_name, BackupKey_KeyType_value)
+	proto.RegisterEnum("pb.StorageHint_Compression", StorageHint_Compression_name, StorageHint_Compression_value)
+	proto.RegisterEnum("pb.StorageHint_Cache", StorageHint_Cache_name, StorageHint_Cache_value)
+	proto.RegisterType((*List)(nil), "pb.List")
+	proto.RegisterType((*TaskValue)(nil), "pb.TaskValue")
+	proto.RegisterType((*SrcFunction)(nil), "pb.SrcFunction")
+	proto.RegisterType((*Query)(nil), "pb.Query")
+	proto.RegisterType((*ValueList)(nil), "pb.ValueList")
+	proto.RegisterType((*LangList)(nil), "pb.LangList")
+	proto.RegisterType((*Result)(nil), "pb.Result")
+	proto.RegisterType((*Order)(nil), "pb.Order")
+	proto.RegisterType((*SortMessage)(nil), "pb.SortMessage")
+	proto.RegisterType((*SortResult)(nil), "pb.SortResult")
+	proto.RegisterType((*RaftContext)(nil), "pb.RaftContext")
+	proto.RegisterType((*Member)(nil), "pb.Member")
+	proto.RegisterMapType((map[string]*TabletLoad)(nil), "pb.Member.TabletLoadsEntry")
+	proto.RegisterType((*Group)(nil), "pb.Group")
+	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.Group.MembersEntry")
+	proto.RegisterMapType((map[string]*Tablet)(nil), "pb.Group.TabletsEntry")
+	proto.RegisterType((*License)(nil), "pb.License")
+	proto.RegisterType((*ZeroProposal)(nil), "pb.ZeroProposal")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.ZeroProposal.SnapshotTsEntry")
+	proto.RegisterType((*MembershipState)(nil), "pb.MembershipState")
+	proto.RegisterMapType((map[uint32]*Group)(nil), "pb.MembershipState.GroupsEntry")
+	proto.RegisterMapType((map[string]uint32)(nil), "pb.MembershipState.PinnedTabletsEntry")
+	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.MembershipState.ZerosEntry")
+	proto.RegisterType((*ConnectionState)(nil), "pb.ConnectionState")
+	proto.RegisterType((*HealthInfo)(nil), "pb.HealthInfo")
+	proto.RegisterType((*Tablet)(nil), "pb.Tablet")
+	proto.RegisterType((*DirectedEdge)(nil), "pb.DirectedEdge")
+	proto.RegisterType((*Mutations)(nil), "pb.Mutations")
+	proto.RegisterType((*Metadata)(nil), "pb.Metadata")
+	proto.RegisterMapType((map[string]Metadata_HintType)(nil), "pb.Metadata.PredHintsEntry")
+	proto.RegisterType((*Snapshot)(nil), "pb.Snapshot")
+	proto.RegisterType((*ZeroSnapshot)(nil), "pb.ZeroSnapshot")
+	proto.RegisterType((*RestoreRequest)(nil), "pb.RestoreRequest")
+	proto.RegisterType((*Proposal)(nil), "pb.Proposal")
+	proto.RegisterType((*KVS)(nil), "pb.KVS")
+	proto.RegisterType((*Posting)(nil), "pb.Posting")
+	proto.RegisterType((*UidBlock)(nil), "pb.UidBlock")
+	proto.RegisterType((*UidPack)(nil), "pb.UidPack")
+	proto.RegisterType((*PostingList)(nil), "pb.PostingList")
+	proto.RegisterType((*FacetParam)(nil), "pb.FacetParam")
+	proto.RegisterType((*FacetParams)(nil), "pb.FacetParams")
+	proto.RegisterType((*Facets)(nil), "pb.Facets")
+	proto.RegisterType((*FacetsList)(nil), "pb.FacetsList")
+	proto.RegisterType((*Function)(nil), "pb.Function")
+	proto.RegisterType((*FilterTree)(nil), "pb.FilterTree")
+	proto.RegisterType((*SchemaRequest)(nil), "pb.SchemaRequest")
+	proto.RegisterType((*SchemaNode)(nil), "pb.SchemaNode")
+	proto.RegisterType((*SchemaResult)(nil), "pb.SchemaResult")
+	proto.RegisterType((*SchemaUpdate)(nil), "pb.SchemaUpdate")
+	proto.RegisterType((*TypeUpdate)(nil), "pb.TypeUpdate")
+	proto.RegisterType((*MapHeader)(nil), "pb.MapHeader")
+	proto.RegisterType((*MovePredicatePayload)(nil), "pb.MovePredicatePayload")
+	proto.RegisterType((*TxnStatus)(nil), "pb.TxnStatus")
+	proto.RegisterType((*OracleDelta)(nil), "pb.OracleDelta")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.OracleDelta.GroupChecksumsEntry")
+	proto.RegisterType((*TxnTimestamps)(nil), "pb.TxnTimestamps")
+	proto.RegisterType((*PeerResponse)(nil), "pb.PeerResponse")
+	proto.RegisterType((*RaftBatch)(nil), "pb.RaftBatch")
+	proto.RegisterType((*SubscriptionRequest)(nil), "pb.SubscriptionRequest")
+	proto.RegisterType((*SubscriptionResponse)(nil), "pb.SubscriptionResponse")
+	proto.RegisterType((*Num)(nil), "pb.Num")
+	proto.RegisterType((*AssignedIds)(nil), "pb.AssignedIds")
+	proto.RegisterType((*SnapshotMeta)(nil), "pb.SnapshotMeta")
+	proto.RegisterType((*Status)(nil), "pb.Status")
+	proto.RegisterType((*BackupRequest)(nil), "pb.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "pb.BackupResponse")
+	proto.RegisterType((*DropOperation)(nil), "pb.DropOperation")
+	proto.RegisterType((*ExportRequest)(nil), "pb.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "pb.ExportResponse")
+	proto.RegisterType((*BackupKey)(nil), "pb.BackupKey")
+	proto.RegisterType((*BackupPostingList)(nil), "pb.BackupPostingList")
+	proto.RegisterType((*UpdateGraphQLSchemaRequest)(nil), "pb.UpdateGraphQLSchemaRequest")
+	proto.RegisterType((*UpdateGraphQLSchemaResponse)(nil), "pb.UpdateGraphQLSchemaResponse")
+	proto.RegisterType((*BulkMeta)(nil), "pb.BulkMeta")
+	proto.RegisterMapType((map[string]*SchemaUpdate)(nil), "pb.BulkMeta.SchemaMapEntry")
+	proto.RegisterType((*TabletLoad)(nil), "pb.TabletLoad")
+	proto.RegisterType((*TabletPin)(nil), "pb.TabletPin")
+	proto.RegisterType((*StorageHint)(nil), "pb.StorageHint")
+	proto.RegisterType((*LockRequest)(nil), "pb.LockRequest")
+	proto.RegisterType((*CancelIndexBuildRequest)(nil), "pb.CancelIndexBuildRequest")
 }
 
-type zeroClient struct {
-	cc *grpc.ClientConn
+func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
+
+var fileDescriptor_f80abaa17e25ccc8 = []byte{
+	// 5542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7b, 0x3b, 0x8c, 0x1c, 0x57,
+	0x76, 0x28, 0xab, 0xfa, 0x5b, 0xa7, 0x3f, 0x6c, 0x5e, 0x72, 0xa9, 0xde, 0x96, 0xc4, 0x19, 0x95,
+	0x44, 0x6a, 0xf4, 0xe1, 0x90, 0x22, 0xf7, 0x61, 0x57, 0x5a, 0xec, 0xdb, 0xed, 0x99, 0x69, 0x52,
+	0x23, 0xf6, 0x7c, 0xf6, 0x76, 0x93, 0xbb, 0x52, 0xf0, 0x1a, 0x35, 0x5d, 0x77, 0x66, 0x6a, 0xa7,
+	0xba, 0xaa, 0xb6, 0xaa, 0x7a, 0x34, 0xa3, 0xe8, 0xbd, 0xe4, 0xd9, 0x81, 0x1d, 0x39, 0x71, 0x60,
+	0x18, 0x86, 0x73, 0xc3, 0xb0, 0x81, 0x8d, 0x0c, 0x67, 0x0e, 0x0c, 0x07, 0x86, 0xe1, 0xcc, 0x06,
+	0x4c, 0x18, 0xda, 0x4d, 0xcc, 0xc8, 0x91, 0x63, 0xe3, 0x9c, 0x7b, 0xeb, 0xd7, 0xd3, 0x43, 0x4a,
+	0x0b, 0x6c, 0xe0, 0xa8, 0xeb, 0x7c, 0xee, 0xef, 0xdc, 0x73, 0xcf, 0x3d, 0x9f, 0xdb, 0x50, 0x0f,
+	0x0e, 0xd6, 0x83, 0xd0, 0x8f, 0x7d, 0xa6, 0x07, 0x07, 0x3d, 0xc3, 0x0a, 0x1c, 0x09, 0xf6, 0xde,
+	0x3f, 0x72, 0xe2, 0xe3, 0xf9, 0xc1, 0xfa, 0xd4, 0x9f, 0xdd, 0xb3, 0x8f, 0x42, 0x2b, 0x38, 0xbe,
+	0xeb, 0xf8, 0xf7, 0x0e, 0x2c, 0xfb, 0x48, 0x84, 0xf7, 0x4e, 0x1f, 0xde, 0x0b, 0x0e, 0xee, 0x25,
+	0x4d, 0x7b, 0x77, 0x73, 0xbc, 0x47, 0xfe, 0x91, 0x7f, 0x8f, 0xd0, 0x07, 0xf3, 0x43, 0x82, 0x08,
+	0xa0, 0x2f, 0xc9, 0x6e, 0xf6, 0xa0, 0x3c, 0x74, 0xa2, 0x98, 0x31, 0x28, 0xcf, 0x1d, 0x3b, 0xea,
+	0x6a, 0xab, 0xa5, 0xb5, 0x2a, 0xa7, 0x6f, 0x73, 0x07, 0x8c, 0xb1, 0x15, 0x9d, 0x3c, 0xb3, 0xdc,
+	0xb9, 0x60, 0x1d, 0x28, 0x9d, 0x5a, 0x6e, 0x57, 0x5b, 0xd5, 0xd6, 0x9a, 0x1c, 0x3f, 0xd9, 0x3a,
+	0xd4, 0x4f, 0x2d, 0x77, 0x12, 0x9f, 0x07, 0xa2, 0xab, 0xaf, 0x6a, 0x6b, 0xed, 0x07, 0xd7, 0xd7,
+	0x83, 0x83, 0xf5, 0x7d, 0x3f, 0x8a, 0x1d, 0xef, 0x68, 0xfd, 0x99, 0xe5, 0x8e, 0xcf, 0x03, 0xc1,
+	0x6b, 0xa7, 0xf2, 0xc3, 0xdc, 0x83, 0xc6, 0x28, 0x9c, 0x3e, 0x9a, 0x7b, 0xd3, 0xd8, 0xf1, 0x3d,
+	0x1c, 0xd1, 0xb3, 0x66, 0x82, 0x7a, 0x34, 0x38, 0x7d, 0x23, 0xce, 0x0a, 0x8f, 0xa2, 0x6e, 0x69,
+	0xb5, 0x84, 0x38, 0xfc, 0x66, 0x5d, 0xa8, 0x39, 0xd1, 0xa6, 0x3f, 0xf7, 0xe2, 0x6e, 0x79, 0x55,
+	0x5b, 0xab, 0xf3, 0x04, 0x34, 0xff, 0xa2, 0x0c, 0x95, 0x9f, 0xce, 0x45, 0x78, 0x4e, 0xed, 0xe2,
+	0x38, 0x4c, 0xfa, 0xc2, 0x6f, 0x76, 0x03, 0x2a, 0xae, 0xe5, 0x1d, 0x45, 0x5d, 0x9d, 0x3a, 0x93,
+	0x00, 0x7b, 0x1d, 0x0c, 0xeb, 0x30, 0x16, 0xe1, 0x64, 0xee, 0xd8, 0xdd, 0xd2, 0xaa, 0xb6, 0x56,
+	0xe5, 0x75, 0x42, 0x3c, 0x75, 0x6c, 0xf6, 0x5d, 0xa8, 0xdb, 0xfe, 0x64, 0x9a, 0x1f, 0xcb, 0xf6,
+	0x69, 0x2c, 0xf6, 0x36, 0xd4, 0xe7, 0x8e, 0x3d, 0x71, 0x9d, 0x28, 0xee, 0x56, 0x56, 0xb5, 0xb5,
+	0xc6, 0x83, 0x3a, 0x2e, 0x16, 0x65, 0xc7, 0x6b, 0x73, 0xc7, 0xc6, 0x0f, 0xf6, 0x3e, 0xd4, 0xa3,
+	0x70, 0x3a, 0x39, 0x9c, 0x7b, 0xd3, 0x6e, 0x95, 0x98, 0xae, 0x22, 0x53, 0x6e, 0xd5, 0xbc, 0x16,
+	0x49, 0x00, 0x97, 0x15, 0x8a, 0x53, 0x11, 0x46, 0xa2, 0x5b, 0x93, 0x43, 0x29, 0x90, 0xdd, 0x87,
+	0xc6, 0xa1, 0x35, 0x15, 0xf1, 0x24, 0xb0, 0x42, 0x6b, 0xd6, 0xad, 0x67, 0x1d, 0x3d, 0x42, 0xf4,
+	0x3e, 0x62, 0x23, 0x0e, 0x87, 0x29, 0xc0, 0x1e, 0x42, 0x8b, 0xa0, 0x68, 0x72, 0xe8, 0xb8, 0xb1,
+	0x08, 0xbb, 0x06, 0xb5, 0x69, 0x53, 0x1b, 0xc2, 0x8c, 0x43, 0x21, 0x78, 0x53, 0x32, 0x49, 0x0c,
+	0x7b, 0x13, 0x40, 0x9c, 0x05, 0x96, 0x67, 0x4f, 0x2c, 0xd7, 0xed, 0x02, 0xcd, 0xc1, 0x90, 0x98,
+	0xbe, 0xeb, 0xb2, 0xd7, 0x70, 0x7e, 0x96, 0x3d, 0x89, 0xa3, 0x6e, 0x6b, 0x55, 0x5b, 0x2b, 0xf3,
+	0x2a, 0x82, 0xe3, 0x08, 0xe5, 0x3a, 0xb5, 0xa6, 0xc7, 0xa2, 0xdb, 0x5e, 0xd5, 0xd6, 0x2a, 0x5c,
+	0x02, 0x88, 0x3d, 0x74, 0xc2, 0x28, 0xee, 0x5e, 0x95, 0x58, 0x02, 0xd8, 0x47, 0x60, 0x38, 0x91,
+	0xef, 0x5a, 0xb8, 0xf4, 0x6e, 0x27, 0xd3, 0x11, 0xda, 0xb5, 0xf5, 0xed, 0x84, 0xc4, 0x33, 0x2e,
+	0x94, 0x8b, 0x2d, 0xa6, 0xe1, 0x79, 0x10, 0x77, 0xaf, 0xa9, 0x2d, 0x90, 0xa0, 0xf9, 0x63, 0x30,
+	0xd2, 0x16, 0xac, 0x09, 0xf5, 0xd1, 0x6e, 0x7f, 0x7f, 0xf4, 0xe9, 0xde, 0xb8, 0x73, 0x85, 0x75,
+	0xa0, 0x39, 0x1a, 0xf0, 0xed, 0xfe, 0x70, 0xfb, 0x8b, 0xfe, 0xc6, 0x70, 0xd0, 0xd1, 0x18, 0x83,
+	0x36, 0x1f, 0xf4, 0xb7, 0x26, 0x9b, 0x7b, 0x3b, 0x3b, 0xdb, 0xe3, 0xf1, 0x60, 0xab, 0xa3, 0x9b,
+	0x0f, 0xc0, 0x20, 0x5d, 0xa6, 0xbd, 0xba, 0x0d, 0xd5, 0x53, 0x04, 0xa4, 0xca, 0x37, 0x1e, 0xb4,
+	0x70, 0x5e, 0xa9, 0xba, 0x73, 0x45, 0x34, 0x6f, 0x41, 0x7d, 0x68, 0x79, 0x47, 0xc9, 0x19, 0x41,
+	0x25, 0xa2, 0x06, 0x06, 0xa7, 0x6f, 0xf3, 0x57, 0x3a, 0x54, 0xb9, 0x88, 0xe6, 0x6e, 0xcc, 0xde,
+	0x05, 0x40, 0x15, 0x99, 0x59, 0x71, 0xe8, 0x9c, 0xa9, 0x5e, 0x33, 0x25, 0x31, 0xe6, 0x8e, 0xbd,
+	0x43, 0x24, 0x76, 0x1f, 0x9a, 0xd4, 0x7b, 0xc2, 0xaa, 0x67, 0x13, 0x48, 0xe7, 0xc7, 0x1b, 0xc4,
+	0xa2, 0x5a, 0xdc, 0x84, 0x2a, 0x69, 0xa5, 0x3c, 0x19, 0x2d, 0xae, 0x20, 0x76, 0x1b, 0xda, 0x8e,
+	0x17, 0xa3, 0xd6, 0x4c, 0xe3, 0x89, 0x2d, 0xa2, 0x44, 0x6d, 0x5b, 0x29, 0x76, 0x4b, 0xd0, 0x36,
+	0xc8, 0xad, 0x4f, 0x06, 0xac, 0xac, 0x96, 0x52, 0xf5, 0x40, 0x7c, 0x24, 0x47, 0x24, 0x1e, 0x35,
+	0xe2, 0x5d, 0x68, 0xe0, 0xfa, 0x92, 0x16, 0x55, 0x6a, 0xd1, 0xa4, 0xd5, 0x28, 0x71, 0x70, 0x40,
+	0x06, 0xc5, 0x8e, 0xa2, 0xc1, 0xa3, 0x21, 0x55, 0x99, 0xbe, 0xf1, 0xa8, 0x91, 0x06, 0x9d, 0x88,
+	0xf3, 0xa8, 0x5b, 0x27, 0xbb, 0x52, 0x47, 0xc4, 0x13, 0x71, 0x1e, 0x99, 0x03, 0xa8, 0xec, 0x85,
+	0xb6, 0x08, 0x97, 0x1e, 0x5d, 0x06, 0x65, 0x5b, 0x44, 0x53, 0xb2, 0x2a, 0x75, 0x4e, 0xdf, 0xd9,
+	0x71, 0x2e, 0xe5, 0x8e, 0xb3, 0xf9, 0xa7, 0x1a, 0x34, 0x46, 0x7e, 0x18, 0xef, 0x88, 0x28, 0xb2,
+	0x8e, 0x04, 0x5b, 0x81, 0x8a, 0x8f, 0xdd, 0x2a, 0xf1, 0x1b, 0x38, 0x61, 0x1a, 0x87, 0x4b, 0xfc,
+	0xc2, 0x26, 0xe9, 0x97, 0x6f, 0x12, 0xaa, 0x39, 0x19, 0x82, 0x92, 0x52, 0x73, 0x04, 0x70, 0x23,
+	0xfc, 0xc3, 0xc3, 0x48, 0x48, 0x41, 0x57, 0xb8, 0x82, 0x2e, 0x3d, 0x2d, 0xe6, 0xff, 0x02, 0xc0,
+	0xf9, 0x7d, 0x4b, 0x15, 0x31, 0x7f, 0x4f, 0x83, 0x06, 0xb7, 0x0e, 0xe3, 0x4d, 0xdf, 0x8b, 0xc5,
+	0x59, 0xcc, 0xda, 0xa0, 0x3b, 0x36, 0xc9, 0xa8, 0xca, 0x75, 0xc7, 0xc6, 0xd9, 0x1d, 0x85, 0xfe,
+	0x3c, 0x20, 0x11, 0xb5, 0xb8, 0x04, 0x48, 0x96, 0xb6, 0x1d, 0x76, 0x4b, 0x4a, 0x96, 0xb6, 0x1d,
+	0xb2, 0x15, 0x68, 0x44, 0x9e, 0x15, 0x44, 0xc7, 0x7e, 0x8c, 0xb3, 0x2b, 0xd3, 0xec, 0x20, 0x41,
+	0x8d, 0x23, 0xb4, 0x03, 0x4e, 0x34, 0x71, 0x85, 0x15, 0x7a, 0x22, 0x24, 0xdb, 0x56, 0xc7, 0xf3,
+	0x38, 0x94, 0x08, 0xf3, 0xff, 0x56, 0xa0, 0xba, 0x23, 0x66, 0x07, 0x22, 0xbc, 0x30, 0x89, 0xfb,
+	0x50, 0xa7, 0x71, 0x27, 0x8e, 0x2d, 0xe7, 0xb1, 0xf1, 0x9d, 0x17, 0xcf, 0x57, 0xae, 0x11, 0x6e,
+	0xdb, 0xfe, 0xd0, 0x9f, 0x39, 0xb1, 0x98, 0x05, 0xf1, 0x39, 0xaf, 0x29, 0xd4, 0xd2, 0x09, 0xde,
+	0x84, 0xaa, 0x2b, 0x2c, 0xdc, 0x33, 0xa9, 0xbb, 0x0a, 0x62, 0x77, 0xa1, 0x66, 0xcd, 0x26, 0xb6,
+	0xb0, 0x6c, 0x39, 0xa9, 0x8d, 0x1b, 0x2f, 0x9e, 0xaf, 0x74, 0xac, 0xd9, 0x96, 0xb0, 0xf2, 0x7d,
+	0x57, 0x25, 0x86, 0x7d, 0x8c, 0x0a, 0x1b, 0xc5, 0x93, 0x79, 0x60, 0x5b, 0xb1, 0x20, 0xf3, 0x5b,
+	0xde, 0xe8, 0xbe, 0x78, 0xbe, 0x72, 0x03, 0xd1, 0x4f, 0x09, 0x9b, 0x6b, 0x06, 0x19, 0x16, 0x4d,
+	0x4e, 0xb2, 0x7c, 0x65, 0x8a, 0x15, 0x88, 0x2a, 0x3c, 0x0d, 0xe6, 0x93, 0x39, 0xea, 0x16, 0x19,
+	0x62, 0x8d, 0xd7, 0xa7, 0xc1, 0xfc, 0x29, 0xc2, 0xcc, 0x84, 0xd6, 0x4c, 0xcc, 0xfc, 0xf0, 0x7c,
+	0xe2, 0x78, 0x93, 0x79, 0x24, 0xc8, 0xea, 0x96, 0x79, 0x43, 0x22, 0xb7, 0xbd, 0xa7, 0x91, 0x60,
+	0xff, 0x1b, 0x9a, 0xb1, 0x75, 0xe0, 0x8a, 0x78, 0xe2, 0xfa, 0x96, 0x1d, 0x75, 0x81, 0xb6, 0xfc,
+	0x75, 0xdc, 0x72, 0x29, 0xd4, 0xf5, 0x31, 0x91, 0x87, 0x48, 0x1d, 0x78, 0x71, 0x78, 0xce, 0x1b,
+	0x71, 0x86, 0x61, 0xdb, 0x70, 0x6d, 0xea, 0xce, 0x23, 0xbc, 0xb0, 0x1c, 0xef, 0xd0, 0x9f, 0xf8,
+	0x9e, 0x7b, 0x4e, 0x1a, 0x56, 0xdf, 0x78, 0xf3, 0xc5, 0xf3, 0x95, 0xef, 0x2a, 0xe2, 0xb6, 0x77,
+	0xe8, 0xef, 0x79, 0xee, 0x79, 0x6e, 0x81, 0x57, 0x17, 0x48, 0xec, 0x27, 0xd0, 0x3e, 0xf4, 0xc3,
+	0xa9, 0x98, 0xa4, 0x7b, 0xd6, 0xa6, 0x7e, 0x7a, 0x2f, 0x9e, 0xaf, 0xdc, 0x24, 0xca, 0xe3, 0x0b,
+	0x1b, 0xd7, 0xcc, 0xe3, 0xd9, 0x1d, 0x28, 0x7f, 0xe5, 0x7b, 0x82, 0x4c, 0xbc, 0xb1, 0xc1, 0x5e,
+	0x3c, 0x5f, 0x69, 0x23, 0x9c, 0xe3, 0x27, 0x7a, 0x6f, 0x17, 0x3a, 0x8b, 0xab, 0x42, 0xf7, 0xe1,
+	0x44, 0x9c, 0xab, 0x53, 0x8e, 0x9f, 0xec, 0x1d, 0xa8, 0x90, 0x89, 0x23, 0xd5, 0x51, 0xd6, 0x28,
+	0x6b, 0xc6, 0x25, 0xf1, 0x13, 0xfd, 0x07, 0x9a, 0xf9, 0x6f, 0x3a, 0x54, 0x68, 0x0e, 0xec, 0x3e,
+	0xd4, 0x66, 0x24, 0xb6, 0xc4, 0x6a, 0xdf, 0xc4, 0x56, 0x44, 0x53, 0xf2, 0x54, 0x42, 0x4c, 0xd8,
+	0xb0, 0x85, 0x94, 0x67, 0xd4, 0xd5, 0x17, 0x5b, 0xc8, 0xd1, 0x92, 0x16, 0x8a, 0x6d, 0xf1, 0xc0,
+	0x94, 0x2e, 0x1c, 0x98, 0x1e, 0xd4, 0xa7, 0xc7, 0x62, 0x7a, 0x12, 0xcd, 0x67, 0xea, 0x38, 0xa5,
+	0x30, 0x7b, 0x1b, 0x5a, 0xf4, 0x1d, 0xf8, 0x8e, 0x47, 0xcd, 0x2b, 0xc4, 0xd0, 0xcc, 0x90, 0xe3,
+	0xa8, 0xf7, 0x08, 0x9a, 0xf9, 0xc9, 0xe6, 0x65, 0x53, 0x96, 0xb2, 0x59, 0x2d, 0xca, 0x06, 0x32,
+	0x7d, 0xc9, 0xc9, 0x05, 0xfb, 0xc9, 0x2f, 0x61, 0x89, 0x8c, 0x97, 0xf5, 0x23, 0x9b, 0xe4, 0xe5,
+	0xeb, 0x43, 0x6d, 0xe8, 0x4c, 0x85, 0x17, 0x91, 0x03, 0x36, 0x8f, 0x44, 0x6a, 0x8d, 0xf1, 0x1b,
+	0xd7, 0x3b, 0xb3, 0xce, 0x76, 0x7d, 0x5b, 0x44, 0xd4, 0x4f, 0x99, 0xa7, 0x30, 0xd2, 0xc4, 0x59,
+	0xe0, 0x84, 0xe7, 0x63, 0x29, 0xa9, 0x12, 0x4f, 0x61, 0x3c, 0x56, 0xc2, 0xc3, 0xc1, 0xec, 0xc4,
+	0x99, 0x52, 0xa0, 0xf9, 0xeb, 0x12, 0x34, 0xbf, 0x10, 0xa1, 0xbf, 0x1f, 0xfa, 0x81, 0x1f, 0x59,
+	0x2e, 0xeb, 0x17, 0x65, 0x2e, 0xf7, 0x76, 0x15, 0x67, 0x9b, 0x67, 0x5b, 0x1f, 0xa5, 0x9b, 0x20,
+	0xf7, 0x2c, 0xbf, 0x2b, 0x26, 0x54, 0xe5, 0x9e, 0x2f, 0x91, 0x99, 0xa2, 0x20, 0x8f, 0xdc, 0xe5,
+	0x6e, 0x29, 0xe3, 0x51, 0xf2, 0x50, 0x14, 0x76, 0x0b, 0x60, 0x66, 0x9d, 0x0d, 0x85, 0x15, 0x89,
+	0x6d, 0x3b, 0x31, 0x97, 0x19, 0x46, 0x49, 0x63, 0x7c, 0xe6, 0x8d, 0x93, 0xcd, 0x4d, 0x61, 0xf6,
+	0x06, 0x18, 0x33, 0xeb, 0x0c, 0xed, 0xf6, 0xb6, 0x2d, 0x2d, 0x10, 0xcf, 0x10, 0xec, 0x2d, 0x28,
+	0xc5, 0x67, 0x5e, 0xb7, 0xa6, 0xfc, 0x39, 0x74, 0xef, 0xc7, 0x67, 0x9e, 0xb2, 0xf0, 0x1c, 0x69,
+	0xb8, 0x83, 0x53, 0xc7, 0x26, 0x43, 0x62, 0x70, 0xfc, 0x64, 0xb7, 0xa1, 0xe6, 0xca, 0xbd, 0x21,
+	0x17, 0xad, 0xf1, 0xa0, 0x21, 0xaf, 0x0b, 0x42, 0xf1, 0x84, 0xc6, 0x3e, 0x84, 0x7a, 0x22, 0x8b,
+	0x6e, 0x83, 0xf8, 0x3a, 0x89, 0xf4, 0x12, 0xa1, 0xf1, 0x94, 0x83, 0xad, 0x40, 0x29, 0x70, 0xbc,
+	0x6e, 0x73, 0x55, 0x4b, 0xfc, 0x0e, 0x29, 0x84, 0x7d, 0xc7, 0xe3, 0x48, 0xe9, 0xfd, 0x08, 0xae,
+	0x2e, 0xc8, 0x3a, 0xaf, 0x5c, 0x2d, 0xa9, 0x5c, 0x37, 0xf2, 0xca, 0x55, 0xce, 0x29, 0xd4, 0x67,
+	0xe5, 0x7a, 0xbd, 0x63, 0x98, 0xff, 0x59, 0x86, 0xab, 0x4a, 0xcf, 0x8f, 0x9d, 0x60, 0x14, 0x2b,
+	0x53, 0x4b, 0x17, 0xa9, 0x52, 0xb1, 0x32, 0x4f, 0x40, 0xf6, 0x7d, 0xa8, 0x92, 0x61, 0x4a, 0xce,
+	0xe9, 0x4a, 0xb6, 0x7f, 0x69, 0x73, 0x79, 0x6e, 0xd5, 0xe6, 0x2b, 0x76, 0xf6, 0x3d, 0xa8, 0x7c,
+	0x25, 0x42, 0x5f, 0x3a, 0x06, 0x8d, 0x07, 0xb7, 0x96, 0xb5, 0x43, 0x39, 0xa8, 0x66, 0x92, 0xf9,
+	0x77, 0xb8, 0xcd, 0xef, 0xa0, 0x2b, 0x30, 0xf3, 0x4f, 0x85, 0xdd, 0xad, 0xad, 0x96, 0x12, 0x2d,
+	0x53, 0x9a, 0x98, 0x90, 0x92, 0x9d, 0xae, 0x2f, 0xdd, 0x69, 0xe3, 0x25, 0x3b, 0xbd, 0x03, 0xed,
+	0xc0, 0xf1, 0x3c, 0x61, 0x4f, 0x12, 0xbb, 0x26, 0xef, 0x94, 0x3b, 0xcb, 0xd6, 0xbd, 0x4f, 0x9c,
+	0x05, 0x3b, 0xd7, 0x0a, 0xf2, 0xb8, 0xde, 0x16, 0x34, 0x72, 0x42, 0x5d, 0xb2, 0xcb, 0x2b, 0x45,
+	0x13, 0x62, 0xa4, 0xe6, 0x33, 0x6f, 0x89, 0xb6, 0x00, 0x32, 0x11, 0xff, 0xd6, 0xf6, 0xec, 0x27,
+	0xc0, 0x2e, 0x4e, 0x78, 0x89, 0x55, 0x2b, 0x28, 0x5e, 0x2b, 0x6f, 0xc9, 0xfe, 0x9f, 0x06, 0x57,
+	0x37, 0x7d, 0xcf, 0x13, 0x14, 0x6c, 0x49, 0x95, 0xcb, 0x0c, 0x83, 0x76, 0xa9, 0x61, 0x78, 0x0f,
+	0x2a, 0x11, 0x32, 0xab, 0xf9, 0x5d, 0x5f, 0x22, 0x4b, 0x2e, 0x39, 0xf0, 0x7a, 0x98, 0x59, 0x67,
+	0x93, 0x40, 0x78, 0xb6, 0xe3, 0x1d, 0x25, 0xd7, 0xc3, 0xcc, 0x3a, 0xdb, 0x97, 0x18, 0xf3, 0x9f,
+	0x75, 0x80, 0x4f, 0x85, 0xe5, 0xc6, 0xc7, 0x78, 0xf5, 0xa2, 0x22, 0x39, 0x5e, 0x14, 0x5b, 0xde,
+	0x34, 0x09, 0x75, 0x53, 0x18, 0x4f, 0x03, 0xba, 0x40, 0x22, 0x92, 0x86, 0xd5, 0xe0, 0x09, 0x88,
+	0x4e, 0x11, 0x0e, 0x37, 0x8f, 0x94, 0xab, 0xa4, 0xa0, 0xcc, 0xef, 0x2b, 0x13, 0x5a, 0x02, 0xd8,
+	0x0f, 0x86, 0x8e, 0x18, 0x64, 0x55, 0x64, 0x3f, 0x0a, 0xc4, 0x7e, 0xe6, 0x41, 0xec, 0xcc, 0xa4,
+	0x43, 0x54, 0xe2, 0x0a, 0xc2, 0x59, 0xa1, 0x03, 0x34, 0x98, 0x1e, 0xfb, 0x64, 0x90, 0x4a, 0x3c,
+	0x85, 0xb1, 0x37, 0xdf, 0x3b, 0xf2, 0x71, 0x75, 0x75, 0xf2, 0xb5, 0x13, 0x50, 0xae, 0xc5, 0x16,
+	0x67, 0x48, 0x32, 0x88, 0x94, 0xc2, 0x28, 0x17, 0x21, 0x26, 0x87, 0xc2, 0x8a, 0xe7, 0xa1, 0x90,
+	0x4a, 0x69, 0x70, 0x10, 0xe2, 0x91, 0xc2, 0xb0, 0xb7, 0xa0, 0x89, 0x82, 0xb3, 0xa2, 0xc8, 0x39,
+	0xf2, 0x84, 0xdd, 0x6d, 0x28, 0x6f, 0xc9, 0x3a, 0xeb, 0x2b, 0x54, 0xde, 0x11, 0x6b, 0x16, 0x1c,
+	0x31, 0xf3, 0x6f, 0x75, 0xa8, 0x4a, 0xad, 0x28, 0x78, 0x9d, 0xda, 0x37, 0xf2, 0x3a, 0xdf, 0x00,
+	0x23, 0x08, 0x85, 0xed, 0x4c, 0x93, 0x1d, 0x36, 0x78, 0x86, 0xa0, 0xc8, 0x15, 0xbd, 0x1c, 0x92,
+	0x74, 0x9d, 0x4b, 0x00, 0x9d, 0x3b, 0xdf, 0x9b, 0xd8, 0x4e, 0x74, 0x32, 0x39, 0x38, 0x8f, 0x45,
+	0xa4, 0xa4, 0xd4, 0xf0, 0xbd, 0x2d, 0x27, 0x3a, 0xd9, 0x40, 0x14, 0x0a, 0x57, 0x1e, 0x67, 0x3a,
+	0xc6, 0x75, 0xae, 0x20, 0xf6, 0x50, 0x05, 0x3e, 0xe4, 0xac, 0x19, 0xe4, 0x64, 0xdd, 0x7c, 0xf1,
+	0x7c, 0x85, 0x21, 0x72, 0xc1, 0x4b, 0xab, 0x27, 0x38, 0x74, 0x77, 0xb1, 0xf1, 0x84, 0x0e, 0x34,
+	0xfa, 0xae, 0xe4, 0xee, 0x22, 0x6a, 0x1c, 0xe5, 0xdd, 0x5d, 0x89, 0x61, 0x77, 0x81, 0xcd, 0xbd,
+	0xa9, 0x3f, 0x0b, 0x50, 0x5d, 0x84, 0xad, 0x26, 0xd9, 0xa0, 0x49, 0x5e, 0xcb, 0x53, 0x68, 0xaa,
+	0xe6, 0x3f, 0xea, 0xd0, 0xdc, 0x72, 0x42, 0x31, 0x8d, 0x85, 0x3d, 0xb0, 0x8f, 0x04, 0xce, 0x5d,
+	0x78, 0xb1, 0x13, 0x9f, 0x2b, 0x7f, 0x5e, 0x41, 0x69, 0x38, 0xa6, 0x17, 0x33, 0x29, 0xf2, 0xbc,
+	0x95, 0x28, 0xf9, 0x23, 0x01, 0xf6, 0x00, 0x80, 0x3e, 0x64, 0x02, 0xa8, 0x7c, 0x79, 0x02, 0xc8,
+	0x20, 0x36, 0xfc, 0xc4, 0x04, 0x8b, 0x6c, 0xe3, 0x48, 0xa7, 0xbe, 0x4a, 0xd9, 0xa1, 0x39, 0x1a,
+	0x5c, 0x8a, 0xef, 0x0e, 0x84, 0x4b, 0x8a, 0x4a, 0xf1, 0xdd, 0x81, 0x70, 0xd3, 0x90, 0xbb, 0x26,
+	0xa7, 0x83, 0xdf, 0xec, 0x6d, 0xd0, 0xfd, 0xa0, 0x5b, 0xcf, 0x06, 0xcc, 0x2f, 0x6c, 0x7d, 0x2f,
+	0xe0, 0xba, 0x1f, 0xe0, 0xa9, 0x97, 0xd9, 0x0e, 0x52, 0x54, 0x3c, 0xf5, 0x78, 0xdf, 0x52, 0xb4,
+	0xcb, 0x15, 0x85, 0x99, 0xd0, 0xb4, 0x5c, 0xd7, 0xff, 0x52, 0xd8, 0xfb, 0xa1, 0xb0, 0x13, 0x9d,
+	0x2d, 0xe0, 0xcc, 0x9b, 0xa0, 0xef, 0x05, 0xac, 0x06, 0xa5, 0xd1, 0x00, 0x13, 0x0d, 0x35, 0x28,
+	0x6d, 0x0d, 0x86, 0x1d, 0xcd, 0xfc, 0x5a, 0x07, 0x63, 0x67, 0x1e, 0x53, 0x32, 0x22, 0xc2, 0x75,
+	0x15, 0x75, 0x32, 0x53, 0xbe, 0xef, 0x42, 0x3d, 0x8a, 0xad, 0x90, 0xfc, 0x1a, 0x79, 0x51, 0xd6,
+	0x08, 0x1e, 0x47, 0xec, 0x0e, 0x54, 0x84, 0x7d, 0x24, 0x92, 0x9b, 0xab, 0xb3, 0xb8, 0x16, 0x2e,
+	0xc9, 0x6c, 0x0d, 0xaa, 0xd1, 0xf4, 0x58, 0xcc, 0xac, 0x6e, 0x39, 0x63, 0x1c, 0x11, 0x46, 0x46,
+	0x30, 0x5c, 0xd1, 0xd1, 0xa7, 0xc6, 0xdd, 0x88, 0x54, 0xbc, 0x2e, 0x7d, 0xea, 0xf3, 0x40, 0x28,
+	0x36, 0x49, 0x44, 0x55, 0xb3, 0x43, 0x3f, 0x98, 0xf8, 0x01, 0xc9, 0xb5, 0xfd, 0xe0, 0x06, 0xd9,
+	0xbb, 0x64, 0x35, 0xeb, 0x5b, 0xa1, 0x1f, 0xec, 0x05, 0xbc, 0x6a, 0xd3, 0x2f, 0x06, 0x88, 0xc4,
+	0x2e, 0x75, 0x40, 0xde, 0x58, 0x06, 0x62, 0x64, 0x62, 0x70, 0x0d, 0xea, 0x33, 0x11, 0x5b, 0xb6,
+	0x15, 0x5b, 0xea, 0xe2, 0xa2, 0x34, 0xc1, 0x8e, 0xc2, 0xf1, 0x94, 0x6a, 0xde, 0x83, 0xaa, 0xec,
+	0x9a, 0xd5, 0xa1, 0xbc, 0xbb, 0xb7, 0x3b, 0x90, 0x02, 0xed, 0x0f, 0x87, 0x1d, 0x0d, 0x51, 0x5b,
+	0xfd, 0x71, 0xbf, 0xa3, 0xe3, 0xd7, 0xf8, 0xf3, 0xfd, 0x41, 0xa7, 0x64, 0xfe, 0x83, 0x06, 0xf5,
+	0xa4, 0x1f, 0xf6, 0x09, 0x00, 0x1e, 0xda, 0xc9, 0xb1, 0xe3, 0xa5, 0x2e, 0xe2, 0xeb, 0xf9, 0x91,
+	0xd6, 0x71, 0xc7, 0x3e, 0x75, 0x3c, 0x75, 0x71, 0xc8, 0x33, 0x4e, 0x70, 0x6f, 0x04, 0xed, 0x22,
+	0x71, 0xc9, 0xad, 0xf2, 0x41, 0xfe, 0x56, 0x69, 0x3f, 0xf8, 0x4e, 0xa1, 0x6b, 0x6c, 0x49, 0xca,
+	0x9c, 0xbb, 0x6c, 0xee, 0x42, 0x3d, 0x41, 0xb3, 0x06, 0xd4, 0xb6, 0x06, 0x8f, 0xfa, 0x4f, 0x87,
+	0xa8, 0x24, 0x00, 0xd5, 0xd1, 0xf6, 0xee, 0x63, 0xca, 0x43, 0xd5, 0xa1, 0x3c, 0xdc, 0x1e, 0x8d,
+	0x3b, 0xba, 0xf9, 0x47, 0x1a, 0xd4, 0x13, 0xa7, 0x8a, 0xbd, 0x87, 0x7e, 0x10, 0x39, 0x7e, 0x5d,
+	0x2d, 0xcb, 0xef, 0xe5, 0x22, 0x7e, 0x9e, 0xd0, 0xf1, 0x60, 0x90, 0x91, 0x4d, 0xdc, 0x2c, 0x02,
+	0xf2, 0x09, 0x87, 0x52, 0x21, 0x3d, 0x87, 0xb9, 0x13, 0xdf, 0x93, 0x07, 0x12, 0x73, 0x27, 0xbe,
+	0x47, 0xc7, 0x2e, 0x72, 0xbc, 0xa9, 0xc8, 0x02, 0x92, 0x1a, 0xc1, 0xe3, 0xc8, 0x8c, 0xa5, 0x27,
+	0x9e, 0x4e, 0x2c, 0x1d, 0x4d, 0xcb, 0x8f, 0x76, 0x21, 0xac, 0xd1, 0x2f, 0x86, 0x35, 0xd9, 0x25,
+	0x5a, 0x79, 0xd5, 0x25, 0x6a, 0xfe, 0x55, 0x19, 0xda, 0x5c, 0x44, 0xb1, 0x1f, 0x0a, 0x2e, 0x7e,
+	0x39, 0x17, 0x51, 0xfc, 0xb2, 0x23, 0xf4, 0x26, 0x40, 0x28, 0x99, 0xb3, 0xa1, 0x0d, 0x85, 0x91,
+	0xf1, 0x98, 0xeb, 0x4f, 0x65, 0x8e, 0x51, 0xde, 0x96, 0x29, 0x8c, 0x01, 0xfc, 0x81, 0x35, 0x3d,
+	0x91, 0xdd, 0xca, 0x3b, 0xb3, 0x2e, 0x11, 0xb2, 0x5f, 0x6b, 0x3a, 0x15, 0x51, 0x84, 0x29, 0x2a,
+	0x75, 0x73, 0x1a, 0x12, 0xf3, 0x44, 0x9c, 0x23, 0x39, 0x12, 0xd3, 0x50, 0xc4, 0x44, 0x96, 0x66,
+	0xc9, 0x90, 0x18, 0x24, 0xbf, 0x0d, 0xad, 0x48, 0x44, 0x78, 0xcb, 0x4e, 0x62, 0xff, 0x44, 0x78,
+	0xca, 0x46, 0x35, 0x15, 0x72, 0x8c, 0x38, 0xbc, 0x7a, 0x2c, 0xcf, 0xf7, 0xce, 0x67, 0xfe, 0x3c,
+	0x52, 0xb7, 0x44, 0x86, 0x60, 0xeb, 0x70, 0x5d, 0x78, 0x94, 0xdc, 0xc4, 0x5e, 0x4e, 0xc4, 0x39,
+	0xe6, 0x6f, 0x85, 0x72, 0xff, 0xaf, 0x65, 0xa4, 0x27, 0xe2, 0xfc, 0x91, 0xe3, 0x0a, 0x9c, 0xd1,
+	0xa9, 0x35, 0x77, 0xe3, 0x09, 0x25, 0x51, 0x40, 0xce, 0x88, 0x30, 0x7d, 0xcc, 0xa4, 0xbc, 0x0f,
+	0xd7, 0x24, 0x39, 0xf4, 0x5d, 0xe1, 0xd8, 0xb2, 0xb3, 0x06, 0x71, 0x5d, 0x25, 0x02, 0x27, 0x3c,
+	0x75, 0xb5, 0x0e, 0xd7, 0x25, 0xaf, 0x5c, 0x50, 0xc2, 0xdd, 0x94, 0x43, 0x13, 0x69, 0xa4, 0x28,
+	0xc5, 0xa1, 0x03, 0x2b, 0x3e, 0xee, 0xb6, 0x72, 0x43, 0xef, 0x5b, 0xf1, 0x31, 0xde, 0xfe, 0x92,
+	0x7c, 0xe8, 0x08, 0x57, 0x66, 0x16, 0x0c, 0x2e, 0x5b, 0x3c, 0x42, 0x0c, 0xde, 0xfe, 0x8a, 0xc1,
+	0x0f, 0x67, 0x96, 0x4c, 0x13, 0x1b, 0x5c, 0x36, 0x7a, 0x44, 0x28, 0x1c, 0x42, 0xed, 0x95, 0x37,
+	0x9f, 0x51, 0xb6, 0xb8, 0xcc, 0xd5, 0xee, 0xed, 0xce, 0x67, 0xe6, 0x9f, 0x95, 0xa0, 0x9e, 0x06,
+	0x8c, 0x1f, 0x80, 0x31, 0x4b, 0xec, 0x55, 0x57, 0xcf, 0xe2, 0x98, 0xd4, 0x88, 0xf1, 0x8c, 0xce,
+	0xde, 0x04, 0xfd, 0xe4, 0x54, 0xd9, 0xce, 0xd6, 0xba, 0x2c, 0x9b, 0x04, 0x07, 0x0f, 0xd7, 0x9f,
+	0x3c, 0xe3, 0xfa, 0xc9, 0xe9, 0xb7, 0xd0, 0x5b, 0xf6, 0x2e, 0x5c, 0x9d, 0xba, 0xc2, 0xf2, 0x26,
+	0x99, 0x3f, 0x21, 0xf5, 0xa2, 0x4d, 0xe8, 0xfd, 0x04, 0xcb, 0x6e, 0x43, 0xc5, 0x16, 0x6e, 0x6c,
+	0xe5, 0xb3, 0xf7, 0x7b, 0xa1, 0x35, 0x75, 0xc5, 0x16, 0xa2, 0xb9, 0xa4, 0xa2, 0xed, 0x4c, 0xc3,
+	0xb6, 0x9c, 0xed, 0x5c, 0x12, 0xb2, 0xa5, 0xe7, 0x12, 0xf2, 0xe7, 0xf2, 0x03, 0xb8, 0x26, 0xce,
+	0x02, 0xba, 0x30, 0x26, 0x69, 0x4e, 0x42, 0x3a, 0x56, 0x9d, 0x84, 0xb0, 0xa9, 0xf0, 0xec, 0x43,
+	0xa8, 0xa9, 0x43, 0xa3, 0x22, 0x3f, 0x46, 0x36, 0xa7, 0x70, 0x0c, 0x79, 0xc2, 0xc2, 0x3e, 0x04,
+	0x36, 0x45, 0x27, 0xd5, 0x9d, 0xd0, 0x50, 0x93, 0x83, 0xb9, 0xe3, 0xda, 0x6a, 0xe3, 0x3b, 0x92,
+	0xb2, 0x8d, 0x84, 0x0d, 0xc4, 0x7f, 0x56, 0xae, 0xd7, 0x3a, 0x75, 0x73, 0x0a, 0xa5, 0x27, 0xcf,
+	0x46, 0x64, 0x82, 0xf0, 0x36, 0xa8, 0x90, 0xbb, 0x40, 0xdf, 0xa9, 0x59, 0xd2, 0x73, 0x66, 0xe9,
+	0x96, 0xb4, 0xe8, 0x24, 0xb1, 0x24, 0xaf, 0x9b, 0xc3, 0xe0, 0x9a, 0xe5, 0x6d, 0x56, 0x26, 0x92,
+	0x04, 0xcc, 0xff, 0x2a, 0x41, 0x4d, 0xb9, 0x18, 0x68, 0xc5, 0xe7, 0x69, 0x4a, 0x12, 0x3f, 0x8b,
+	0xb1, 0x41, 0xea, 0xab, 0xe4, 0x4b, 0x55, 0xa5, 0x57, 0x97, 0xaa, 0xd8, 0x27, 0xd0, 0x0c, 0x24,
+	0x2d, 0xef, 0xdd, 0xbc, 0x96, 0x6f, 0xa3, 0x7e, 0xa9, 0x5d, 0x23, 0xc8, 0x00, 0x34, 0x64, 0x94,
+	0x39, 0x8f, 0xad, 0x23, 0x25, 0x81, 0x1a, 0xc2, 0x63, 0xeb, 0xe8, 0x12, 0x1f, 0xe7, 0x9b, 0xb8,
+	0x2a, 0x6d, 0xf2, 0x79, 0x9a, 0x64, 0x17, 0xd1, 0xbd, 0xc9, 0x7b, 0x15, 0xad, 0xa2, 0x57, 0x81,
+	0x39, 0x4b, 0x7f, 0x36, 0x73, 0x88, 0xd6, 0x56, 0xf9, 0x29, 0x42, 0x8c, 0x23, 0xf3, 0xff, 0x6b,
+	0x50, 0x53, 0xab, 0xbd, 0x70, 0x67, 0x6d, 0x6c, 0xef, 0xf6, 0xf9, 0xe7, 0x1d, 0x0d, 0xef, 0xe4,
+	0xed, 0xdd, 0x71, 0x47, 0x67, 0x06, 0x54, 0x1e, 0x0d, 0xf7, 0xfa, 0xe3, 0x4e, 0x09, 0xef, 0xb1,
+	0x8d, 0xbd, 0xbd, 0x61, 0xa7, 0x8c, 0x95, 0x97, 0xad, 0xfe, 0x78, 0x30, 0xde, 0xde, 0x19, 0x74,
+	0x2a, 0xc8, 0xfb, 0x78, 0xb0, 0xd7, 0xa9, 0xe2, 0xc7, 0xd3, 0xed, 0xad, 0x4e, 0x0d, 0xe9, 0xfb,
+	0xfd, 0xd1, 0xe8, 0x67, 0x7b, 0x7c, 0xab, 0x53, 0xa7, 0xbb, 0x70, 0xcc, 0xb7, 0x77, 0x1f, 0x77,
+	0x0c, 0xfc, 0xde, 0xdb, 0xf8, 0x6c, 0xb0, 0x39, 0xee, 0x80, 0xf9, 0x11, 0x34, 0x72, 0x12, 0xc4,
+	0xd6, 0x7c, 0xf0, 0xa8, 0x73, 0x05, 0x87, 0x7c, 0xd6, 0x1f, 0x3e, 0xc5, 0xab, 0xb3, 0x0d, 0x40,
+	0x9f, 0x93, 0x61, 0x7f, 0xf7, 0x71, 0x47, 0x37, 0x7f, 0x0a, 0xf5, 0xa7, 0x8e, 0xbd, 0xe1, 0xfa,
+	0xd3, 0x13, 0x54, 0xa7, 0x03, 0x2b, 0x12, 0xea, 0x96, 0xa2, 0x6f, 0x74, 0x69, 0xe9, 0x54, 0x45,
+	0x6a, 0xef, 0x15, 0x84, 0xb2, 0xf2, 0xe6, 0xb3, 0x09, 0x95, 0x37, 0x4b, 0xf2, 0x66, 0xf1, 0xe6,
+	0xb3, 0xa7, 0x58, 0xe1, 0x3c, 0x81, 0xda, 0x53, 0xc7, 0xde, 0xb7, 0xa6, 0x27, 0x64, 0x7d, 0xb0,
+	0xeb, 0x49, 0xe4, 0x7c, 0x25, 0xd4, 0x0d, 0x64, 0x10, 0x66, 0xe4, 0x7c, 0x25, 0xd8, 0x3b, 0x50,
+	0x25, 0x20, 0x49, 0x4f, 0xd0, 0x39, 0x4d, 0xa6, 0xc3, 0x15, 0x8d, 0xaa, 0x8b, 0xae, 0xeb, 0x4f,
+	0x27, 0xa1, 0x38, 0xec, 0xbe, 0x26, 0x65, 0x4f, 0x08, 0x2e, 0x0e, 0xcd, 0x3f, 0xd0, 0xd2, 0x35,
+	0x53, 0x39, 0x69, 0x05, 0xca, 0x81, 0x35, 0x3d, 0xe9, 0x6a, 0x59, 0xb4, 0xaf, 0x26, 0xc3, 0x89,
+	0xc0, 0xde, 0x85, 0xba, 0x52, 0xac, 0x64, 0xd4, 0x46, 0x4e, 0x03, 0x79, 0x4a, 0x2c, 0x6e, 0x79,
+	0xa9, 0xb8, 0xe5, 0x14, 0x4a, 0x06, 0xae, 0x13, 0xcb, 0x63, 0x54, 0xe6, 0x0a, 0x32, 0xbf, 0x07,
+	0x90, 0xd5, 0x13, 0x97, 0x47, 0xd9, 0x96, 0xeb, 0x58, 0x49, 0x68, 0x2a, 0x01, 0x73, 0x17, 0x1a,
+	0x59, 0x2b, 0x92, 0xad, 0xe5, 0xba, 0xb2, 0xc4, 0xa3, 0xc9, 0x90, 0xcd, 0x72, 0x5d, 0xac, 0xf0,
+	0xa0, 0x2f, 0x2a, 0x0b, 0x98, 0xfa, 0x42, 0xb5, 0x89, 0x9a, 0x72, 0x49, 0x34, 0x3f, 0x84, 0xea,
+	0xa3, 0xc4, 0x1b, 0x4f, 0x8e, 0x81, 0x76, 0xd9, 0x31, 0x30, 0x3f, 0x06, 0xc8, 0x0a, 0x56, 0xec,
+	0x03, 0x55, 0x28, 0x8d, 0x64, 0x59, 0x56, 0xcb, 0xb2, 0x2d, 0x92, 0x49, 0xd5, 0x48, 0x89, 0xd9,
+	0xdc, 0x82, 0xfa, 0x4b, 0x4b, 0xcf, 0x4a, 0x00, 0x7a, 0x26, 0x80, 0x25, 0xc5, 0x68, 0xf3, 0x17,
+	0x00, 0x59, 0x41, 0x55, 0x9d, 0x4a, 0xd9, 0x0b, 0x9e, 0xca, 0xf7, 0x31, 0x33, 0xec, 0xb8, 0x76,
+	0x28, 0xbc, 0xc2, 0xaa, 0xd3, 0x16, 0x3c, 0xa5, 0xb3, 0x55, 0x28, 0x53, 0x9d, 0xb8, 0x94, 0x99,
+	0xfd, 0x64, 0x7e, 0x9c, 0x28, 0xe6, 0x19, 0xb4, 0xa4, 0x93, 0xff, 0x0d, 0x5c, 0xa4, 0xa2, 0x29,
+	0xd5, 0x2f, 0x98, 0xd2, 0x9b, 0x50, 0xa5, 0x9b, 0x39, 0x59, 0x8d, 0x82, 0x2e, 0x31, 0xb1, 0x7f,
+	0xa3, 0x03, 0xc8, 0xa1, 0x31, 0xcb, 0x5b, 0x8c, 0x9f, 0xb5, 0xc5, 0xf8, 0x99, 0x41, 0x39, 0x7d,
+	0x02, 0x60, 0x70, 0xfa, 0xce, 0x6e, 0x2b, 0x15, 0x53, 0x13, 0x80, 0xfd, 0x90, 0xa7, 0xe4, 0x7c,
+	0x25, 0x42, 0x35, 0x60, 0x86, 0xc8, 0x17, 0xc4, 0x2b, 0xc5, 0x82, 0x78, 0x5a, 0x8a, 0xab, 0xca,
+	0xde, 0x08, 0x58, 0x5a, 0x72, 0xa4, 0x74, 0x47, 0x24, 0xc2, 0x38, 0x89, 0xc8, 0x25, 0x94, 0x86,
+	0x91, 0x86, 0xe2, 0xb5, 0x64, 0xc2, 0xc2, 0xc3, 0x62, 0xbf, 0x77, 0xe8, 0x3a, 0xd3, 0x58, 0x15,
+	0xc0, 0xc1, 0xf3, 0x37, 0x15, 0x06, 0x27, 0x84, 0x57, 0x21, 0x96, 0x7e, 0xa4, 0x13, 0x95, 0x80,
+	0xb8, 0x10, 0xe5, 0x9c, 0x09, 0x5b, 0x65, 0x2a, 0x32, 0x84, 0xf9, 0x09, 0x34, 0x93, 0x7d, 0xa3,
+	0xa2, 0xdf, 0xfb, 0x69, 0xf8, 0xa6, 0x65, 0x3a, 0x91, 0x89, 0x77, 0x43, 0xef, 0x6a, 0x49, 0x00,
+	0x67, 0xfe, 0x4b, 0x39, 0x69, 0xac, 0x6a, 0x53, 0x2f, 0x97, 0x7d, 0x31, 0x06, 0xd7, 0xbf, 0x51,
+	0x0c, 0xfe, 0x03, 0x30, 0x6c, 0x0a, 0x32, 0x9d, 0xd3, 0xe4, 0x32, 0xec, 0x2d, 0x06, 0x94, 0x2a,
+	0x0c, 0x75, 0x4e, 0x05, 0xcf, 0x98, 0x5f, 0xb1, 0x7f, 0xe9, 0x2e, 0x55, 0x96, 0xed, 0x52, 0xf5,
+	0xb7, 0xdc, 0xa5, 0xb7, 0xa0, 0xe9, 0xf9, 0xde, 0xc4, 0x9b, 0xbb, 0x2e, 0xa6, 0x7f, 0xd4, 0x36,
+	0x35, 0x3c, 0xdf, 0xdb, 0x55, 0x28, 0x74, 0x7b, 0xf3, 0x2c, 0xd2, 0x18, 0x34, 0x88, 0xef, 0x6a,
+	0x8e, 0x8f, 0x4c, 0xc6, 0x1a, 0x74, 0xfc, 0x83, 0x5f, 0x60, 0xb5, 0x1c, 0x25, 0x36, 0x21, 0x2b,
+	0x20, 0x7d, 0xde, 0xb6, 0xc4, 0xa3, 0x88, 0x76, 0xd1, 0x1e, 0x2c, 0xa8, 0x47, 0xeb, 0x82, 0x7a,
+	0xbc, 0x97, 0xa9, 0x47, 0x3b, 0xf7, 0xd6, 0x43, 0xa2, 0x30, 0x30, 0xbc, 0x44, 0x5f, 0xae, 0x2e,
+	0xe8, 0x0b, 0x5a, 0x6f, 0x74, 0x91, 0xa4, 0x11, 0xed, 0xac, 0x96, 0xd6, 0x9a, 0xbc, 0x8e, 0x08,
+	0xaa, 0x93, 0x7f, 0x0c, 0x46, 0xba, 0x17, 0xb9, 0xb0, 0xd9, 0x80, 0xca, 0xf6, 0xee, 0xd6, 0xe0,
+	0xe7, 0x1d, 0x0d, 0xaf, 0x71, 0x3e, 0x78, 0x36, 0xe0, 0xa3, 0x41, 0x47, 0xc7, 0x2b, 0x76, 0x6b,
+	0x30, 0x1c, 0x8c, 0x07, 0x9d, 0x92, 0xf4, 0xc9, 0xa8, 0x1e, 0xe3, 0x3a, 0x53, 0x27, 0x36, 0x47,
+	0x00, 0x59, 0x2e, 0x00, 0x47, 0xcd, 0x44, 0xa0, 0x12, 0x93, 0x71, 0xb2, 0xf8, 0xb5, 0xd4, 0x5c,
+	0xe8, 0x97, 0x65, 0x1c, 0x24, 0x1d, 0xdf, 0x54, 0xec, 0x58, 0xc1, 0xa7, 0xb2, 0x64, 0x7b, 0x1b,
+	0xda, 0x81, 0x15, 0xc6, 0x4e, 0x12, 0xce, 0x48, 0x53, 0xde, 0xe4, 0xad, 0x14, 0x4b, 0x6b, 0xfa,
+	0x6b, 0x0d, 0x6e, 0xec, 0xf8, 0xa7, 0x22, 0x75, 0x97, 0xf7, 0xad, 0x73, 0x2c, 0x8f, 0xbe, 0x42,
+	0xd9, 0x31, 0x1e, 0xf3, 0xe7, 0x54, 0xc1, 0x4c, 0x0a, 0xce, 0xdc, 0x90, 0x98, 0xc7, 0xea, 0xf1,
+	0x8e, 0x88, 0x62, 0x22, 0xaa, 0x6b, 0x1e, 0x61, 0x24, 0x7d, 0x07, 0xaa, 0xf1, 0x99, 0x97, 0x95,
+	0xbf, 0x2b, 0x31, 0xe5, 0xf1, 0x97, 0x7a, 0xcf, 0x95, 0xe5, 0xde, 0xb3, 0xf9, 0x39, 0x18, 0xe3,
+	0x33, 0x4a, 0x29, 0xcf, 0xa3, 0x82, 0xfb, 0xa5, 0xbd, 0xc4, 0xfd, 0xd2, 0x17, 0xee, 0xe2, 0x1b,
+	0x50, 0x09, 0x42, 0x91, 0x5a, 0x61, 0x09, 0x98, 0xbf, 0xd1, 0xa0, 0x91, 0x0b, 0x0e, 0xd8, 0x5b,
+	0x50, 0x8e, 0xcf, 0xbc, 0xe2, 0xc3, 0x94, 0x64, 0x68, 0x4e, 0xa4, 0x0b, 0xc9, 0x54, 0xfd, 0x62,
+	0x32, 0x75, 0x08, 0x57, 0xe5, 0x6d, 0x91, 0x2c, 0x2d, 0xc9, 0x33, 0xbd, 0xbd, 0x10, 0x8c, 0xc8,
+	0x74, 0x7e, 0xb2, 0x50, 0x95, 0x3c, 0x69, 0x1f, 0x15, 0x90, 0xbd, 0x3e, 0x5c, 0x5f, 0xc2, 0xf6,
+	0x6d, 0xaa, 0x42, 0xe6, 0x0a, 0xb4, 0xb0, 0x7e, 0xe2, 0xcc, 0x44, 0x14, 0x5b, 0xb3, 0x80, 0x9c,
+	0x5a, 0x75, 0xdb, 0x97, 0xb9, 0x1e, 0x47, 0xe6, 0x1d, 0x68, 0xee, 0x0b, 0x11, 0x72, 0x11, 0x05,
+	0xbe, 0x27, 0x1d, 0x3a, 0x95, 0x04, 0x97, 0xae, 0x85, 0x82, 0xcc, 0xff, 0x03, 0x06, 0x66, 0x4a,
+	0x36, 0xac, 0x78, 0x7a, 0xfc, 0x6d, 0x32, 0x29, 0x77, 0xa0, 0x16, 0x48, 0x4d, 0x53, 0x21, 0x63,
+	0x93, 0x5c, 0x0c, 0xa5, 0x7d, 0x3c, 0x21, 0x9a, 0x1f, 0xc1, 0xf5, 0xd1, 0xfc, 0x20, 0x9a, 0x86,
+	0x0e, 0x45, 0xdf, 0xc9, 0xf5, 0xdb, 0x83, 0x7a, 0x10, 0x8a, 0x43, 0xe7, 0x4c, 0x24, 0x7a, 0x9d,
+	0xc2, 0xe6, 0x0f, 0xe1, 0x46, 0xb1, 0x89, 0x5a, 0xc2, 0xdb, 0x50, 0x3a, 0x39, 0x8d, 0xd4, 0xcc,
+	0xae, 0x15, 0x62, 0x4f, 0x7a, 0xf2, 0x81, 0x54, 0x93, 0x43, 0x69, 0x77, 0x3e, 0xcb, 0xbf, 0xb0,
+	0x2b, 0xcb, 0x17, 0x76, 0xaf, 0xe7, 0x13, 0xc9, 0x32, 0x72, 0xca, 0x12, 0xc6, 0x6f, 0x80, 0x71,
+	0xe8, 0x87, 0x5f, 0x5a, 0xa1, 0x2d, 0x6c, 0x75, 0xcf, 0x66, 0x08, 0xf3, 0x0b, 0x68, 0x24, 0x9a,
+	0xb0, 0x6d, 0x47, 0xf2, 0x2e, 0xb3, 0x42, 0x2c, 0x58, 0xe5, 0xf5, 0x55, 0xe6, 0x5d, 0x85, 0x67,
+	0x6f, 0x27, 0x2a, 0x24, 0x81, 0xe2, 0xc8, 0xaa, 0xfe, 0x95, 0x8c, 0x6c, 0x3e, 0x82, 0x66, 0x12,
+	0xa1, 0x62, 0x82, 0x8c, 0x54, 0xde, 0x75, 0x84, 0x97, 0x3b, 0x0e, 0x75, 0x89, 0x18, 0x17, 0x53,
+	0xa3, 0x7a, 0xc1, 0x69, 0x31, 0xd7, 0xa1, 0xaa, 0xce, 0x13, 0x83, 0xf2, 0xd4, 0xb7, 0xe5, 0x99,
+	0xaf, 0x70, 0xfa, 0x46, 0x71, 0xcc, 0xa2, 0xa3, 0xc4, 0x21, 0x9b, 0x45, 0x47, 0xe6, 0xbf, 0xea,
+	0xd0, 0xda, 0xa0, 0x7c, 0x40, 0xb2, 0x25, 0xb9, 0x2c, 0x98, 0x56, 0xc8, 0x82, 0xe5, 0x33, 0x5e,
+	0x7a, 0x21, 0xe3, 0x55, 0x98, 0x50, 0xa9, 0xe8, 0x45, 0xbd, 0x06, 0xb5, 0xb9, 0xe7, 0x9c, 0x25,
+	0x86, 0xc2, 0xe0, 0x55, 0x04, 0xc7, 0x11, 0x5b, 0x85, 0x06, 0xda, 0x12, 0xc7, 0x93, 0x59, 0x26,
+	0x99, 0x2a, 0xca, 0xa3, 0x16, 0x72, 0x49, 0xd5, 0x97, 0xe7, 0x92, 0x6a, 0xaf, 0xcc, 0x25, 0xd5,
+	0x5f, 0x95, 0x4b, 0x32, 0x16, 0x73, 0x49, 0x45, 0x0f, 0x10, 0x2e, 0x78, 0x80, 0xb7, 0x00, 0xd0,
+	0xd4, 0x47, 0x81, 0x35, 0xa5, 0x42, 0x01, 0x1e, 0xba, 0x1c, 0xc6, 0x1c, 0x42, 0x3b, 0x91, 0xad,
+	0xd2, 0xdd, 0x4f, 0xe0, 0xaa, 0x4a, 0x13, 0x8b, 0x50, 0x65, 0x5a, 0xa4, 0x45, 0xba, 0x46, 0x89,
+	0x6a, 0xca, 0xe4, 0x2a, 0x0a, 0x6f, 0xdb, 0x79, 0x30, 0x32, 0x7f, 0x5f, 0x83, 0x56, 0x81, 0x83,
+	0x7d, 0x94, 0x25, 0x9d, 0x35, 0x72, 0x3a, 0xba, 0x17, 0x7a, 0x79, 0x79, 0xe2, 0x59, 0x5f, 0x48,
+	0x3c, 0x9b, 0xb7, 0xd3, 0x74, 0xb2, 0x4a, 0x22, 0x5f, 0x49, 0x93, 0xc8, 0x94, 0x77, 0xed, 0x8f,
+	0xc7, 0xbc, 0xa3, 0xe3, 0x0b, 0xbd, 0xd6, 0xe0, 0x2c, 0xa0, 0x47, 0x58, 0xaf, 0xf4, 0xa3, 0x73,
+	0x0a, 0xa5, 0x17, 0x14, 0x2a, 0xa7, 0x1a, 0x25, 0x55, 0x49, 0x93, 0xaa, 0x81, 0x9e, 0xb5, 0x4c,
+	0x69, 0x29, 0x95, 0x91, 0xd0, 0xff, 0x04, 0x95, 0x79, 0x03, 0x8c, 0x54, 0x01, 0x54, 0x5e, 0x29,
+	0x43, 0xa0, 0x42, 0x24, 0x62, 0x53, 0x0a, 0xf1, 0x8d, 0x4e, 0xa9, 0x7c, 0x09, 0xea, 0xa6, 0x09,
+	0x1d, 0x09, 0x98, 0x7f, 0xa8, 0x83, 0x21, 0xf5, 0x0b, 0x27, 0xff, 0x9e, 0x8a, 0x19, 0xb4, 0x2c,
+	0xd5, 0x9e, 0x12, 0xd7, 0x9f, 0x88, 0x73, 0xf2, 0x59, 0x89, 0x65, 0x69, 0x41, 0x4a, 0xa5, 0x7d,
+	0x64, 0xa4, 0x8b, 0x9f, 0x68, 0x82, 0xe4, 0x85, 0x3c, 0x77, 0x92, 0x6a, 0xbe, 0xbc, 0xa1, 0xf1,
+	0x59, 0x2f, 0x46, 0x28, 0x22, 0x9c, 0xa9, 0x3d, 0xa0, 0xef, 0x62, 0x4c, 0xd1, 0x52, 0xde, 0xaa,
+	0x79, 0x0c, 0x35, 0x35, 0x3a, 0xba, 0x55, 0x4f, 0x77, 0x9f, 0xec, 0xee, 0xfd, 0x6c, 0xb7, 0xa0,
+	0x57, 0xa9, 0xe3, 0xa5, 0xe7, 0x1d, 0xaf, 0x12, 0xe2, 0x37, 0xf7, 0x9e, 0xee, 0x8e, 0x3b, 0x65,
+	0xd6, 0x02, 0x83, 0x3e, 0x27, 0x7c, 0xf0, 0xac, 0x53, 0xa1, 0x0c, 0xc8, 0xe6, 0xa7, 0x83, 0x9d,
+	0x7e, 0xa7, 0x9a, 0x96, 0x36, 0x6a, 0xe6, 0x9f, 0x6b, 0x70, 0x4d, 0x2e, 0x39, 0x9f, 0x12, 0xc8,
+	0xbf, 0xc2, 0x2e, 0xcb, 0x57, 0xd8, 0xbf, 0xdb, 0x2c, 0x00, 0x36, 0x9a, 0x3b, 0x49, 0xf9, 0x50,
+	0xa6, 0xab, 0xf0, 0xa1, 0xb3, 0xac, 0x1a, 0xfe, 0x46, 0x83, 0x9e, 0xf4, 0xf7, 0x1e, 0xe3, 0xa3,
+	0xf3, 0x9f, 0x0e, 0x2f, 0xc4, 0xa3, 0x97, 0x79, 0x41, 0xb7, 0xa1, 0x4d, 0xef, 0xd4, 0x7f, 0xe9,
+	0x4e, 0x54, 0xec, 0x23, 0xf7, 0xaf, 0xa5, 0xb0, 0xb2, 0x23, 0xf6, 0x10, 0x9a, 0xf2, 0x3d, 0xfb,
+	0x24, 0x73, 0x8b, 0x96, 0x79, 0x9b, 0x0d, 0xc9, 0x45, 0x25, 0x39, 0x7c, 0xcd, 0xaa, 0x1a, 0x65,
+	0xa1, 0xeb, 0xc5, 0x5a, 0x97, 0x6a, 0x82, 0x98, 0x05, 0x4d, 0xaf, 0x2c, 0x6a, 0xfa, 0x3d, 0x78,
+	0x7d, 0xe9, 0x2a, 0x95, 0xda, 0xe7, 0x92, 0x8c, 0x52, 0xdb, 0xcc, 0x5f, 0x69, 0x50, 0xdf, 0x98,
+	0xbb, 0x27, 0x74, 0xfb, 0xe1, 0x3b, 0x6a, 0xfb, 0x48, 0xa8, 0x67, 0xe3, 0x1a, 0x19, 0x07, 0x03,
+	0x31, 0xf2, 0xe1, 0xf8, 0x27, 0x00, 0x52, 0x02, 0x93, 0x99, 0x15, 0x74, 0xf5, 0xac, 0x6c, 0x95,
+	0x74, 0xa0, 0x56, 0xba, 0x63, 0x05, 0xaa, 0x6c, 0x15, 0x25, 0x70, 0x6f, 0x17, 0xda, 0x45, 0xe2,
+	0x92, 0x34, 0xcd, 0x9d, 0xe2, 0xd3, 0x8a, 0x8b, 0xb2, 0xcb, 0x79, 0x60, 0xcf, 0x00, 0xb2, 0x17,
+	0x76, 0x58, 0xe2, 0x46, 0xe3, 0x16, 0x4d, 0x02, 0x11, 0x62, 0x19, 0x80, 0x7a, 0xd5, 0x78, 0x83,
+	0x90, 0xfb, 0x22, 0x1c, 0x89, 0x29, 0x7b, 0x07, 0xda, 0x5f, 0x86, 0x4e, 0x2c, 0x32, 0x26, 0x9d,
+	0x98, 0x9a, 0x12, 0x2b, 0xb9, 0xcc, 0x2d, 0x30, 0x64, 0xbf, 0xfb, 0x8e, 0xf7, 0x0a, 0x27, 0xfe,
+	0x25, 0xee, 0xc0, 0x7f, 0xe0, 0x5b, 0xde, 0x2c, 0x7c, 0x62, 0x3f, 0x82, 0x46, 0x52, 0xc6, 0x46,
+	0x0b, 0x2a, 0x6d, 0xc5, 0xeb, 0x0b, 0x41, 0xd6, 0xfa, 0x66, 0xc6, 0xc2, 0xf3, 0xfc, 0x94, 0x6c,
+	0x15, 0xa7, 0xc2, 0xa5, 0x61, 0x2a, 0x5c, 0x02, 0x58, 0xe5, 0x93, 0xaf, 0xd7, 0x4b, 0x99, 0xe9,
+	0x29, 0x74, 0x87, 0x44, 0xf5, 0xa8, 0xdd, 0xbc, 0x0f, 0x8d, 0x5c, 0xf7, 0x17, 0x8b, 0x7c, 0xbb,
+	0xfd, 0xfd, 0xfd, 0xcf, 0xe5, 0x65, 0xf3, 0xc5, 0x68, 0x8c, 0x4f, 0xcc, 0xef, 0x40, 0x85, 0x7a,
+	0x40, 0xf2, 0xee, 0x1e, 0xdf, 0xe9, 0x0f, 0x65, 0x8d, 0x13, 0x9f, 0xa9, 0x13, 0xdf, 0xe6, 0xde,
+	0x10, 0xf9, 0x38, 0x34, 0x86, 0x98, 0x38, 0x54, 0x27, 0x89, 0x41, 0x39, 0x0d, 0x97, 0xaa, 0x9c,
+	0xbe, 0x71, 0xfe, 0xfe, 0x97, 0x9e, 0x7a, 0xcf, 0x56, 0xe5, 0x12, 0xa0, 0xec, 0xb2, 0xb0, 0x22,
+	0x31, 0x99, 0x25, 0x17, 0x51, 0x8d, 0xe0, 0x9d, 0xc8, 0xfc, 0x3e, 0xbc, 0xb6, 0xb9, 0x90, 0x97,
+	0x4f, 0xfa, 0x7f, 0xe9, 0x9e, 0x3c, 0xf8, 0x3b, 0x0d, 0xca, 0xe8, 0x30, 0xb3, 0xbb, 0x60, 0x7c,
+	0x2a, 0xac, 0x30, 0x3e, 0x10, 0x56, 0xcc, 0x0a, 0xce, 0x71, 0x8f, 0x8e, 0x57, 0xf6, 0xac, 0xc5,
+	0xbc, 0x72, 0x5f, 0x63, 0xeb, 0xf2, 0x8d, 0x72, 0xf2, 0xf6, 0xba, 0x95, 0x38, 0xde, 0xe4, 0x98,
+	0xf7, 0x0a, 0xed, 0xcd, 0x2b, 0x6b, 0xc4, 0xff, 0x99, 0xef, 0x78, 0x9b, 0xf2, 0x61, 0x2a, 0x5b,
+	0x74, 0xd4, 0x17, 0x5b, 0xb0, 0xbb, 0x50, 0xdd, 0x8e, 0xf6, 0xc5, 0x32, 0x56, 0x52, 0xf3, 0x7c,
+	0xb0, 0x60, 0x5e, 0x79, 0xf0, 0x97, 0x25, 0x28, 0x63, 0x2d, 0x13, 0x0b, 0x1d, 0xea, 0x11, 0x10,
+	0xcb, 0x3d, 0xf6, 0xe9, 0x51, 0x62, 0x64, 0xe1, 0x75, 0x10, 0x8d, 0xd2, 0x91, 0x27, 0x25, 0xab,
+	0xf9, 0xb0, 0xec, 0x95, 0xd3, 0x85, 0x49, 0x7d, 0x0c, 0x9d, 0x51, 0x1c, 0x0a, 0x6b, 0x96, 0x63,
+	0x2f, 0x8a, 0x6a, 0x59, 0x01, 0x89, 0xe4, 0xf5, 0x01, 0x54, 0x65, 0xd8, 0xb5, 0xd0, 0x60, 0xb1,
+	0x3a, 0x44, 0xcc, 0xef, 0x42, 0x63, 0x74, 0xec, 0xcf, 0x5d, 0x7b, 0x24, 0xc2, 0x53, 0xc1, 0x72,
+	0x4f, 0x15, 0x7b, 0xb9, 0x6f, 0xf3, 0x0a, 0x5b, 0x03, 0x90, 0x9e, 0x3e, 0x66, 0xb4, 0x59, 0x0d,
+	0x69, 0xbb, 0xf3, 0x99, 0xec, 0x34, 0x17, 0x02, 0x48, 0xce, 0x5c, 0xf4, 0xf5, 0x32, 0xce, 0x87,
+	0xd0, 0xda, 0xa4, 0x9b, 0x63, 0x2f, 0xec, 0x1f, 0xf8, 0x61, 0xcc, 0x16, 0x9f, 0x2b, 0xf6, 0x16,
+	0x11, 0xe6, 0x15, 0x7c, 0x98, 0x33, 0x0e, 0xcf, 0x25, 0xff, 0x35, 0x15, 0xb4, 0x66, 0xe3, 0x2d,
+	0x59, 0xe5, 0x83, 0x3f, 0xa9, 0x42, 0xf5, 0x67, 0x7e, 0x78, 0x22, 0xb0, 0x76, 0x59, 0xa5, 0xda,
+	0x9d, 0x52, 0xa3, 0xb4, 0x8e, 0xb7, 0x6c, 0xa0, 0x77, 0xc0, 0x20, 0xa1, 0xe0, 0xbf, 0x35, 0x98,
+	0x91, 0xfe, 0x9f, 0x44, 0xca, 0x45, 0x26, 0xdd, 0x68, 0x5f, 0xdb, 0x72, 0xa3, 0xd2, 0xda, 0x76,
+	0xa1, 0xb6, 0xd6, 0xa3, 0xf5, 0x3f, 0x79, 0x36, 0x42, 0xd5, 0xbc, 0xaf, 0xa1, 0x4b, 0x32, 0x92,
+	0x2b, 0x45, 0xa6, 0xec, 0x2f, 0x05, 0xbd, 0x76, 0x82, 0x48, 0x7b, 0xbe, 0x07, 0x55, 0x75, 0x7f,
+	0x5d, 0xcb, 0xac, 0xad, 0x3a, 0x6a, 0xbd, 0x4e, 0x1e, 0xa5, 0x1a, 0x7c, 0x04, 0x55, 0x79, 0xd7,
+	0xcb, 0x06, 0x85, 0x18, 0xa6, 0xc7, 0xf2, 0xa8, 0x44, 0x99, 0xd9, 0x07, 0x50, 0x53, 0x95, 0x39,
+	0xb6, 0xa4, 0x4c, 0x27, 0x97, 0x2a, 0x83, 0x27, 0xd9, 0xbf, 0x74, 0xd5, 0x64, 0xff, 0x05, 0x6f,
+	0xb7, 0xc7, 0xf2, 0xa8, 0xb4, 0xff, 0xbb, 0xd0, 0xe1, 0x62, 0x2a, 0x9c, 0x5c, 0x16, 0x86, 0x25,
+	0x12, 0x59, 0x72, 0x74, 0x3f, 0x86, 0x56, 0x21, 0x63, 0xc3, 0xc8, 0x7b, 0x5f, 0x96, 0xc4, 0xb9,
+	0x70, 0x60, 0x7e, 0x08, 0x86, 0x0a, 0x8d, 0x0f, 0x04, 0xa3, 0x12, 0xda, 0x92, 0xe0, 0xba, 0x77,
+	0x31, 0x36, 0xa6, 0x53, 0xf0, 0x73, 0xb8, 0xbe, 0xe4, 0x6a, 0x66, 0xf4, 0xc8, 0xf3, 0x72, 0xcf,
+	0xa4, 0xb7, 0x72, 0x29, 0x3d, 0x15, 0xc0, 0x3d, 0x68, 0xf6, 0xa7, 0xbf, 0x9c, 0x3b, 0xa1, 0x18,
+	0x52, 0xe9, 0x86, 0xf6, 0x3d, 0x67, 0x93, 0x2f, 0xac, 0xe3, 0x1e, 0x34, 0xb9, 0x20, 0x5b, 0xfb,
+	0x0d, 0x1b, 0xfc, 0x18, 0x3a, 0x8b, 0xf6, 0x98, 0xd1, 0xf5, 0x75, 0x89, 0x95, 0x5e, 0xec, 0x60,
+	0xa3, 0xf3, 0xf7, 0x5f, 0xdf, 0xd2, 0xfe, 0xe9, 0xeb, 0x5b, 0xda, 0xbf, 0x7f, 0x7d, 0x4b, 0xfb,
+	0xe3, 0x5f, 0xdf, 0xba, 0x72, 0x50, 0xa5, 0x3f, 0xed, 0x3d, 0xfc, 0xef, 0x01, 0x00, 0x70, 0x6d,
+	0xa3, 0x07, 0x2a, 0x38, 0x00, 0x00,
 }
 
-func NewZeroClient(cc *grpc.ClientConn) ZeroClient {
-	return &zeroClient{cc}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RaftClient is the client API for Raft service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RaftClient interface {
+	Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error)
+	RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error)
+	JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error)
+	IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error)
 }
 
-func (c *zeroClient) Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error) {
-	out := new(ConnectionState)
-	err := c.cc.Invoke(ctx, "/pb.Zero/Connect", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type raftClient struct {
+	cc *grpc.ClientConn
 }
 
-func (c *zeroClient) UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error) {
-	out := new(api.Payload)
-	err := c.cc.Invoke(ctx, "/pb.Zero/UpdateMembership", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewRaftClient(cc *grpc.ClientConn) RaftClient {
+	return &raftClient{cc}
 }
 
-func (c *zeroClient) StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[0], "/pb.Zero/StreamMembership", opts...)
+func (c *raftClient) Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[0], "/pb.Raft/Heartbeat", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &zeroStreamMembershipClient{stream}
+	x := &raftHeartbeatClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -5895,1723 +6203,1305 @@ func (c *zeroClient) StreamMembership(ctx context.Context, in *api.Payload, opts
 	return x, nil
 }
 
-type Zero_StreamMembershipClient interface {
-	Recv() (*MembershipState, error)
+type Raft_HeartbeatClient interface {
+	Recv() (*HealthInfo, error)
 	grpc.ClientStream
 }
 
-type zeroStreamMembershipClient struct {
+type raftHeartbeatClient struct {
 	grpc.ClientStream
 }
 
-func (x *zeroStreamMembershipClient) Recv() (*MembershipState, error) {
-	m := new(MembershipState)
+func (x *raftHeartbeatClient) Recv() (*HealthInfo, error) {
+	m := new(HealthInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *zeroClient) Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[1], "/pb.Zero/Oracle", opts...)
+func (c *raftClient) RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[1], "/pb.Raft/RaftMessage", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &zeroOracleClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
+	x := &raftRaftMessageClient{stream}
 	return x, nil
 }
 
-type Zero_OracleClient interface {
-	Recv() (*OracleDelta, error)
+type Raft_RaftMessageClient interface {
+	Send(*RaftBatch) error
+	CloseAndRecv() (*api.Payload, error)
 	grpc.ClientStream
 }
 
-type zeroOracleClient struct {
+type raftRaftMessageClient struct {
 	grpc.ClientStream
 }
 
-func (x *zeroOracleClient) Recv() (*OracleDelta, error) {
-	m := new(OracleDelta)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (x *raftRaftMessageClient) Send(m *RaftBatch) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func (c *zeroClient) ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error) {
-	out := new(Tablet)
-	err := c.cc.Invoke(ctx, "/pb.Zero/ShouldServe", in, out, opts...)
-	if err != nil {
+func (x *raftRaftMessageClient) CloseAndRecv() (*api.Payload, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *zeroClient) AssignUids(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
-	out := new(AssignedIds)
-	err := c.cc.Invoke(ctx, "/pb.Zero/AssignUids", in, out, opts...)
-	if err != nil {
+	m := new(api.Payload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *zeroClient) Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
-	out := new(AssignedIds)
-	err := c.cc.Invoke(ctx, "/pb.Zero/Timestamps", in, out, opts...)
+func (c *raftClient) JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Raft/JoinCluster", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *zeroClient) CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error) {
-	out := new(api.TxnContext)
-	err := c.cc.Invoke(ctx, "/pb.Zero/CommitOrAbort", in, out, opts...)
+func (c *raftClient) IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error) {
+	out := new(PeerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Raft/IsPeer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *zeroClient) TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error) {
-	out := new(OracleDelta)
-	err := c.cc.Invoke(ctx, "/pb.Zero/TryAbort", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// RaftServer is the server API for Raft service.
+type RaftServer interface {
+	Heartbeat(*api.Payload, Raft_HeartbeatServer) error
+	RaftMessage(Raft_RaftMessageServer) error
+	JoinCluster(context.Context, *RaftContext) (*api.Payload, error)
+	IsPeer(context.Context, *RaftContext) (*PeerResponse, error)
 }
 
-// ZeroServer is the server API for Zero service.
-type ZeroServer interface {
-	// These 3 endpoints are for handling membership.
-	Connect(context.Context, *Member) (*ConnectionState, error)
-	UpdateMembership(context.Context, *Group) (*api.Payload, error)
-	StreamMembership(*api.Payload, Zero_StreamMembershipServer) error
-	Oracle(*api.Payload, Zero_OracleServer) error
-	ShouldServe(context.Context, *Tablet) (*Tablet, error)
-	AssignUids(context.Context, *Num) (*AssignedIds, error)
-	Timestamps(context.Context, *Num) (*AssignedIds, error)
-	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
-	TryAbort(context.Context, *TxnTimestamps) (*OracleDelta, error)
-}
-
-// UnimplementedZeroServer can be embedded to have forward compatible implementations.
-type UnimplementedZeroServer struct {
+// UnimplementedRaftServer can be embedded to have forward compatible implementations.
+type UnimplementedRaftServer struct {
 }
 
-func (*UnimplementedZeroServer) Connect(ctx context.Context, req *Member) (*ConnectionState, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
-}
-func (*UnimplementedZeroServer) UpdateMembership(ctx context.Context, req *Group) (*api.Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembership not implemented")
-}
-func (*UnimplementedZeroServer) StreamMembership(req *api.Payload, srv Zero_StreamMembershipServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamMembership not implemented")
-}
-func (*UnimplementedZeroServer) Oracle(req *api.Payload, srv Zero_OracleServer) error {
-	return status.Errorf(codes.Unimplemented, "method Oracle not implemented")
-}
-func (*UnimplementedZeroServer) ShouldServe(ctx context.Context, req *Tablet) (*Tablet, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShouldServe not implemented")
-}
-func (*UnimplementedZeroServer) AssignUids(ctx context.Context, req *Num) (*AssignedIds, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignUids not implemented")
-}
-func (*UnimplementedZeroServer) Timestamps(ctx context.Context, req *Num) (*AssignedIds, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Timestamps not implemented")
-}
-func (*UnimplementedZeroServer) CommitOrAbort(ctx context.Context, req *api.TxnContext) (*api.TxnContext, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitOrAbort not implemented")
+func (*UnimplementedRaftServer) Heartbeat(req *api.Payload, srv Raft_HeartbeatServer) error {
+	return status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
 }
-func (*UnimplementedZeroServer) TryAbort(ctx context.Context, req *TxnTimestamps) (*OracleDelta, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TryAbort not implemented")
+func (*UnimplementedRaftServer) RaftMessage(srv Raft_RaftMessageServer) error {
+	return status.Errorf(codes.Unimplemented, "method RaftMessage not implemented")
 }
-
-func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
-	s.RegisterService(&_Zero_serviceDesc, srv)
+func (*UnimplementedRaftServer) JoinCluster(ctx context.Context, req *RaftContext) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCluster not implemented")
 }
-
-func _Zero_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Member)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).Connect(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/Connect",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).Connect(ctx, req.(*Member))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedRaftServer) IsPeer(ctx context.Context, req *RaftContext) (*PeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPeer not implemented")
 }
 
-func _Zero_UpdateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Group)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).UpdateMembership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/UpdateMembership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).UpdateMembership(ctx, req.(*Group))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterRaftServer(s *grpc.Server, srv RaftServer) {
+	s.RegisterService(&_Raft_serviceDesc, srv)
 }
 
-func _Zero_StreamMembership_Handler(srv interface{}, stream grpc.ServerStream) error {
+func _Raft_Heartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(api.Payload)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ZeroServer).StreamMembership(m, &zeroStreamMembershipServer{stream})
+	return srv.(RaftServer).Heartbeat(m, &raftHeartbeatServer{stream})
 }
 
-type Zero_StreamMembershipServer interface {
-	Send(*MembershipState) error
+type Raft_HeartbeatServer interface {
+	Send(*HealthInfo) error
 	grpc.ServerStream
 }
 
-type zeroStreamMembershipServer struct {
+type raftHeartbeatServer struct {
 	grpc.ServerStream
 }
 
-func (x *zeroStreamMembershipServer) Send(m *MembershipState) error {
+func (x *raftHeartbeatServer) Send(m *HealthInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Zero_Oracle_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(api.Payload)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ZeroServer).Oracle(m, &zeroOracleServer{stream})
+func _Raft_RaftMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RaftServer).RaftMessage(&raftRaftMessageServer{stream})
 }
 
-type Zero_OracleServer interface {
-	Send(*OracleDelta) error
+type Raft_RaftMessageServer interface {
+	SendAndClose(*api.Payload) error
+	Recv() (*RaftBatch, error)
 	grpc.ServerStream
 }
 
-type zeroOracleServer struct {
+type raftRaftMessageServer struct {
 	grpc.ServerStream
 }
 
-func (x *zeroOracleServer) Send(m *OracleDelta) error {
+func (x *raftRaftMessageServer) SendAndClose(m *api.Payload) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Zero_ShouldServe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tablet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).ShouldServe(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/ShouldServe",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).ShouldServe(ctx, req.(*Tablet))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Zero_AssignUids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Num)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).AssignUids(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/AssignUids",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).AssignUids(ctx, req.(*Num))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Zero_Timestamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Num)
-	if err := dec(in); err != nil {
+func (x *raftRaftMessageServer) Recv() (*RaftBatch, error) {
+	m := new(RaftBatch)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ZeroServer).Timestamps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/Timestamps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).Timestamps(ctx, req.(*Num))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _Zero_CommitOrAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.TxnContext)
+func _Raft_JoinCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftContext)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ZeroServer).CommitOrAbort(ctx, in)
+		return srv.(RaftServer).JoinCluster(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Zero/CommitOrAbort",
+		FullMethod: "/pb.Raft/JoinCluster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).CommitOrAbort(ctx, req.(*api.TxnContext))
+		return srv.(RaftServer).JoinCluster(ctx, req.(*RaftContext))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Zero_TryAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxnTimestamps)
+func _Raft_IsPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftContext)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ZeroServer).TryAbort(ctx, in)
+		return srv.(RaftServer).IsPeer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Zero/TryAbort",
+		FullMethod: "/pb.Raft/IsPeer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).TryAbort(ctx, req.(*TxnTimestamps))
+		return srv.(RaftServer).IsPeer(ctx, req.(*RaftContext))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Zero_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Zero",
-	HandlerType: (*ZeroServer)(nil),
+var _Raft_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Raft",
+	HandlerType: (*RaftServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Connect",
-			Handler:    _Zero_Connect_Handler,
-		},
-		{
-			MethodName: "UpdateMembership",
-			Handler:    _Zero_UpdateMembership_Handler,
-		},
-		{
-			MethodName: "ShouldServe",
-			Handler:    _Zero_ShouldServe_Handler,
-		},
-		{
-			MethodName: "AssignUids",
-			Handler:    _Zero_AssignUids_Handler,
-		},
-		{
-			MethodName: "Timestamps",
-			Handler:    _Zero_Timestamps_Handler,
-		},
-		{
-			MethodName: "CommitOrAbort",
-			Handler:    _Zero_CommitOrAbort_Handler,
+			MethodName: "JoinCluster",
+			Handler:    _Raft_JoinCluster_Handler,
 		},
 		{
-			MethodName: "TryAbort",
-			Handler:    _Zero_TryAbort_Handler,
+			MethodName: "IsPeer",
+			Handler:    _Raft_IsPeer_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "StreamMembership",
-			Handler:       _Zero_StreamMembership_Handler,
+			StreamName:    "Heartbeat",
+			Handler:       _Raft_Heartbeat_Handler,
 			ServerStreams: true,
 		},
 		{
-			StreamName:    "Oracle",
-			Handler:       _Zero_Oracle_Handler,
-			ServerStreams: true,
+			StreamName:    "RaftMessage",
+			Handler:       _Raft_RaftMessage_Handler,
+			ClientStreams: true,
 		},
 	},
 	Metadata: "pb.proto",
 }
 
-// WorkerClient is the client API for Worker service.
+// ZeroClient is the client API for Zero service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WorkerClient interface {
-	// Data serving RPCs.
-	Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error)
-	ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error)
-	StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error)
-	Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error)
-	Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error)
-	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
-	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error)
-	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
-	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
-	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
-	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
-	UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error)
-}
-
-type workerClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
-	return &workerClient{cc}
+type ZeroClient interface {
+	// These 3 endpoints are for handling membership.
+	Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error)
+	UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error)
+	StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error)
+	Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error)
+	ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error)
+	AssignUids(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
+	Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
+	CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error)
+	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error)
 }
 
-func (c *workerClient) Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error) {
-	out := new(api.TxnContext)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Mutate", in, out, opts...)
+type zeroClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewZeroClient(cc *grpc.ClientConn) ZeroClient {
+	return &zeroClient{cc}
+}
+
+func (c *zeroClient) Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error) {
+	out := new(ConnectionState)
+	err := c.cc.Invoke(ctx, "/pb.Zero/Connect", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/pb.Worker/ServeTask", in, out, opts...)
+func (c *zeroClient) UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Zero/UpdateMembership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[0], "/pb.Worker/StreamSnapshot", opts...)
+func (c *zeroClient) StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[0], "/pb.Zero/StreamMembership", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &workerStreamSnapshotClient{stream}
+	x := &zeroStreamMembershipClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
-type Worker_StreamSnapshotClient interface {
-	Send(*Snapshot) error
-	Recv() (*KVS, error)
+type Zero_StreamMembershipClient interface {
+	Recv() (*MembershipState, error)
 	grpc.ClientStream
 }
 
-type workerStreamSnapshotClient struct {
+type zeroStreamMembershipClient struct {
 	grpc.ClientStream
 }
 
-func (x *workerStreamSnapshotClient) Send(m *Snapshot) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *workerStreamSnapshotClient) Recv() (*KVS, error) {
-	m := new(KVS)
+func (x *zeroStreamMembershipClient) Recv() (*MembershipState, error) {
+	m := new(MembershipState)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *workerClient) Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error) {
-	out := new(SortResult)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Sort", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *workerClient) Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error) {
-	out := new(SchemaResult)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Schema", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *workerClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
-	out := new(BackupResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Backup", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *workerClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Restore", in, out, opts...)
+func (c *zeroClient) Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[1], "/pb.Zero/Oracle", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *workerClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
-	out := new(ExportResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Export", in, out, opts...)
-	if err != nil {
+	x := &zeroOracleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *workerClient) ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[1], "/pb.Worker/ReceivePredicate", opts...)
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	x := &workerReceivePredicateClient{stream}
 	return x, nil
 }
 
-type Worker_ReceivePredicateClient interface {
-	Send(*KVS) error
-	CloseAndRecv() (*api.Payload, error)
+type Zero_OracleClient interface {
+	Recv() (*OracleDelta, error)
 	grpc.ClientStream
 }
 
-type workerReceivePredicateClient struct {
+type zeroOracleClient struct {
 	grpc.ClientStream
 }
 
-func (x *workerReceivePredicateClient) Send(m *KVS) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *workerReceivePredicateClient) CloseAndRecv() (*api.Payload, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(api.Payload)
+func (x *zeroOracleClient) Recv() (*OracleDelta, error) {
+	m := new(OracleDelta)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *workerClient) MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
-	out := new(api.Payload)
-	err := c.cc.Invoke(ctx, "/pb.Worker/MovePredicate", in, out, opts...)
+func (c *zeroClient) ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error) {
+	out := new(Tablet)
+	err := c.cc.Invoke(ctx, "/pb.Zero/ShouldServe", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[2], "/pb.Worker/Subscribe", opts...)
+func (c *zeroClient) AssignUids(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
+	out := new(AssignedIds)
+	err := c.cc.Invoke(ctx, "/pb.Zero/AssignUids", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &workerSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type Worker_SubscribeClient interface {
-	Recv() (*pb.KVList, error)
-	grpc.ClientStream
+	return out, nil
 }
 
-type workerSubscribeClient struct {
-	grpc.ClientStream
+func (c *zeroClient) Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
+	out := new(AssignedIds)
+	err := c.cc.Invoke(ctx, "/pb.Zero/Timestamps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *workerSubscribeClient) Recv() (*pb.KVList, error) {
-	m := new(pb.KVList)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *zeroClient) CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error) {
+	out := new(api.TxnContext)
+	err := c.cc.Invoke(ctx, "/pb.Zero/CommitOrAbort", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *workerClient) UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error) {
-	out := new(UpdateGraphQLSchemaResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/UpdateGraphQLSchema", in, out, opts...)
+func (c *zeroClient) TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error) {
+	out := new(OracleDelta)
+	err := c.cc.Invoke(ctx, "/pb.Zero/TryAbort", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// WorkerServer is the server API for Worker service.
-type WorkerServer interface {
-	// Data serving RPCs.
-	Mutate(context.Context, *Mutations) (*api.TxnContext, error)
-	ServeTask(context.Context, *Query) (*Result, error)
-	StreamSnapshot(Worker_StreamSnapshotServer) error
-	Sort(context.Context, *SortMessage) (*SortResult, error)
-	Schema(context.Context, *SchemaRequest) (*SchemaResult, error)
-	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
-	Restore(context.Context, *RestoreRequest) (*Status, error)
-	Export(context.Context, *ExportRequest) (*ExportResponse, error)
-	ReceivePredicate(Worker_ReceivePredicateServer) error
-	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
-	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
-	UpdateGraphQLSchema(context.Context, *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error)
+// ZeroServer is the server API for Zero service.
+type ZeroServer interface {
+	// These 3 endpoints are for handling membership.
+	Connect(context.Context, *Member) (*ConnectionState, error)
+	UpdateMembership(context.Context, *Group) (*api.Payload, error)
+	StreamMembership(*api.Payload, Zero_StreamMembershipServer) error
+	Oracle(*api.Payload, Zero_OracleServer) error
+	ShouldServe(context.Context, *Tablet) (*Tablet, error)
+	AssignUids(context.Context, *Num) (*AssignedIds, error)
+	Timestamps(context.Context, *Num) (*AssignedIds, error)
+	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
+	TryAbort(context.Context, *TxnTimestamps) (*OracleDelta, error)
 }
 
-// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
-type UnimplementedWorkerServer struct {
+// UnimplementedZeroServer can be embedded to have forward compatible implementations.
+type UnimplementedZeroServer struct {
 }
 
-func (*UnimplementedWorkerServer) Mutate(ctx context.Context, req *Mutations) (*api.TxnContext, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mutate not implemented")
-}
-func (*UnimplementedWorkerServer) ServeTask(ctx context.Context, req *Query) (*Result, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServeTask not implemented")
-}
-func (*UnimplementedWorkerServer) StreamSnapshot(srv Worker_StreamSnapshotServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamSnapshot not implemented")
-}
-func (*UnimplementedWorkerServer) Sort(ctx context.Context, req *SortMessage) (*SortResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sort not implemented")
+func (*UnimplementedZeroServer) Connect(ctx context.Context, req *Member) (*ConnectionState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
 }
-func (*UnimplementedWorkerServer) Schema(ctx context.Context, req *SchemaRequest) (*SchemaResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Schema not implemented")
+func (*UnimplementedZeroServer) UpdateMembership(ctx context.Context, req *Group) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembership not implemented")
 }
-func (*UnimplementedWorkerServer) Backup(ctx context.Context, req *BackupRequest) (*BackupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+func (*UnimplementedZeroServer) StreamMembership(req *api.Payload, srv Zero_StreamMembershipServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMembership not implemented")
 }
-func (*UnimplementedWorkerServer) Restore(ctx context.Context, req *RestoreRequest) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+func (*UnimplementedZeroServer) Oracle(req *api.Payload, srv Zero_OracleServer) error {
+	return status.Errorf(codes.Unimplemented, "method Oracle not implemented")
 }
-func (*UnimplementedWorkerServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+func (*UnimplementedZeroServer) ShouldServe(ctx context.Context, req *Tablet) (*Tablet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShouldServe not implemented")
 }
-func (*UnimplementedWorkerServer) ReceivePredicate(srv Worker_ReceivePredicateServer) error {
-	return status.Errorf(codes.Unimplemented, "method ReceivePredicate not implemented")
+func (*UnimplementedZeroServer) AssignUids(ctx context.Context, req *Num) (*AssignedIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignUids not implemented")
 }
-func (*UnimplementedWorkerServer) MovePredicate(ctx context.Context, req *MovePredicatePayload) (*api.Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MovePredicate not implemented")
+func (*UnimplementedZeroServer) Timestamps(ctx context.Context, req *Num) (*AssignedIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Timestamps not implemented")
 }
-func (*UnimplementedWorkerServer) Subscribe(req *SubscriptionRequest, srv Worker_SubscribeServer) error {
-	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+func (*UnimplementedZeroServer) CommitOrAbort(ctx context.Context, req *api.TxnContext) (*api.TxnContext, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitOrAbort not implemented")
 }
-func (*UnimplementedWorkerServer) UpdateGraphQLSchema(ctx context.Context, req *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGraphQLSchema not implemented")
+func (*UnimplementedZeroServer) TryAbort(ctx context.Context, req *TxnTimestamps) (*OracleDelta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TryAbort not implemented")
 }
 
-func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
-	s.RegisterService(&_Worker_serviceDesc, srv)
+func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
+	s.RegisterService(&_Zero_serviceDesc, srv)
 }
 
-func _Worker_Mutate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Mutations)
+func _Zero_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Mutate(ctx, in)
+		return srv.(ZeroServer).Connect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Mutate",
+		FullMethod: "/pb.Zero/Connect",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Mutate(ctx, req.(*Mutations))
+		return srv.(ZeroServer).Connect(ctx, req.(*Member))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_ServeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Query)
+func _Zero_UpdateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Group)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).ServeTask(ctx, in)
+		return srv.(ZeroServer).UpdateMembership(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/ServeTask",
+		FullMethod: "/pb.Zero/UpdateMembership",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).ServeTask(ctx, req.(*Query))
+		return srv.(ZeroServer).UpdateMembership(ctx, req.(*Group))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_StreamSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(WorkerServer).StreamSnapshot(&workerStreamSnapshotServer{stream})
+func _Zero_StreamMembership_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.Payload)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZeroServer).StreamMembership(m, &zeroStreamMembershipServer{stream})
 }
 
-type Worker_StreamSnapshotServer interface {
-	Send(*KVS) error
-	Recv() (*Snapshot, error)
+type Zero_StreamMembershipServer interface {
+	Send(*MembershipState) error
 	grpc.ServerStream
 }
 
-type workerStreamSnapshotServer struct {
+type zeroStreamMembershipServer struct {
 	grpc.ServerStream
 }
 
-func (x *workerStreamSnapshotServer) Send(m *KVS) error {
+func (x *zeroStreamMembershipServer) Send(m *MembershipState) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *workerStreamSnapshotServer) Recv() (*Snapshot, error) {
-	m := new(Snapshot)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func _Zero_Oracle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.Payload)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return m, nil
+	return srv.(ZeroServer).Oracle(m, &zeroOracleServer{stream})
 }
 
-func _Worker_Sort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SortMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).Sort(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/Sort",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Sort(ctx, req.(*SortMessage))
-	}
-	return interceptor(ctx, in, info, handler)
+type Zero_OracleServer interface {
+	Send(*OracleDelta) error
+	grpc.ServerStream
 }
 
-func _Worker_Schema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SchemaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).Schema(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/Schema",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Schema(ctx, req.(*SchemaRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type zeroOracleServer struct {
+	grpc.ServerStream
 }
 
-func _Worker_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BackupRequest)
+func (x *zeroOracleServer) Send(m *OracleDelta) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Zero_ShouldServe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tablet)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Backup(ctx, in)
+		return srv.(ZeroServer).ShouldServe(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Backup",
+		FullMethod: "/pb.Zero/ShouldServe",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Backup(ctx, req.(*BackupRequest))
+		return srv.(ZeroServer).ShouldServe(ctx, req.(*Tablet))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreRequest)
+func _Zero_AssignUids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Num)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Restore(ctx, in)
+		return srv.(ZeroServer).AssignUids(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Restore",
+		FullMethod: "/pb.Zero/AssignUids",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Restore(ctx, req.(*RestoreRequest))
+		return srv.(ZeroServer).AssignUids(ctx, req.(*Num))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportRequest)
+func _Zero_Timestamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Num)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Export(ctx, in)
+		return srv.(ZeroServer).Timestamps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Export",
+		FullMethod: "/pb.Zero/Timestamps",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Export(ctx, req.(*ExportRequest))
+		return srv.(ZeroServer).Timestamps(ctx, req.(*Num))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_ReceivePredicate_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(WorkerServer).ReceivePredicate(&workerReceivePredicateServer{stream})
-}
-
-type Worker_ReceivePredicateServer interface {
-	SendAndClose(*api.Payload) error
-	Recv() (*KVS, error)
-	grpc.ServerStream
-}
-
-type workerReceivePredicateServer struct {
-	grpc.ServerStream
-}
-
-func (x *workerReceivePredicateServer) SendAndClose(m *api.Payload) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *workerReceivePredicateServer) Recv() (*KVS, error) {
-	m := new(KVS)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _Worker_MovePredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovePredicatePayload)
+func _Zero_CommitOrAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.TxnContext)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).MovePredicate(ctx, in)
+		return srv.(ZeroServer).CommitOrAbort(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/MovePredicate",
+		FullMethod: "/pb.Zero/CommitOrAbort",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).MovePredicate(ctx, req.(*MovePredicatePayload))
+		return srv.(ZeroServer).CommitOrAbort(ctx, req.(*api.TxnContext))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscriptionRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(WorkerServer).Subscribe(m, &workerSubscribeServer{stream})
-}
-
-type Worker_SubscribeServer interface {
-	Send(*pb.KVList) error
-	grpc.ServerStream
-}
-
-type workerSubscribeServer struct {
-	grpc.ServerStream
-}
-
-func (x *workerSubscribeServer) Send(m *pb.KVList) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Worker_UpdateGraphQLSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGraphQLSchemaRequest)
+func _Zero_TryAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnTimestamps)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).UpdateGraphQLSchema(ctx, in)
+		return srv.(ZeroServer).TryAbort(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/UpdateGraphQLSchema",
+		FullMethod: "/pb.Zero/TryAbort",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).UpdateGraphQLSchema(ctx, req.(*UpdateGraphQLSchemaRequest))
+		return srv.(ZeroServer).TryAbort(ctx, req.(*TxnTimestamps))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Worker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Worker",
-	HandlerType: (*WorkerServer)(nil),
+var _Zero_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Zero",
+	HandlerType: (*ZeroServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Mutate",
-			Handler:    _Worker_Mutate_Handler,
-		},
-		{
-			MethodName: "ServeTask",
-			Handler:    _Worker_ServeTask_Handler,
-		},
-		{
-			MethodName: "Sort",
-			Handler:    _Worker_Sort_Handler,
+			MethodName: "Connect",
+			Handler:    _Zero_Connect_Handler,
 		},
 		{
-			MethodName: "Schema",
-			Handler:    _Worker_Schema_Handler,
+			MethodName: "UpdateMembership",
+			Handler:    _Zero_UpdateMembership_Handler,
 		},
 		{
-			MethodName: "Backup",
-			Handler:    _Worker_Backup_Handler,
+			MethodName: "ShouldServe",
+			Handler:    _Zero_ShouldServe_Handler,
 		},
 		{
-			MethodName: "Restore",
-			Handler:    _Worker_Restore_Handler,
+			MethodName: "AssignUids",
+			Handler:    _Zero_AssignUids_Handler,
 		},
 		{
-			MethodName: "Export",
-			Handler:    _Worker_Export_Handler,
+			MethodName: "Timestamps",
+			Handler:    _Zero_Timestamps_Handler,
 		},
 		{
-			MethodName: "MovePredicate",
-			Handler:    _Worker_MovePredicate_Handler,
+			MethodName: "CommitOrAbort",
+			Handler:    _Zero_CommitOrAbort_Handler,
 		},
 		{
-			MethodName: "UpdateGraphQLSchema",
-			Handler:    _Worker_UpdateGraphQLSchema_Handler,
+			MethodName: "TryAbort",
+			Handler:    _Zero_TryAbort_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "StreamSnapshot",
-			Handler:       _Worker_StreamSnapshot_Handler,
+			StreamName:    "StreamMembership",
+			Handler:       _Zero_StreamMembership_Handler,
 			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "ReceivePredicate",
-			Handler:       _Worker_ReceivePredicate_Handler,
-			ClientStreams: true,
 		},
 		{
-			StreamName:    "Subscribe",
-			Handler:       _Worker_Subscribe_Handler,
+			StreamName:    "Oracle",
+			Handler:       _Zero_Oracle_Handler,
 			ServerStreams: true,
 		},
 	},
 	Metadata: "pb.proto",
 }
 
-func (m *List) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *List) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *List) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// WorkerClient is the client API for Worker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkerClient interface {
+	// Data serving RPCs.
+	Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error)
+	ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error)
+	StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error)
+	Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error)
+	Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error)
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
+	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
+	UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error)
+	AcquireLocks(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*api.Payload, error)
+	ReleaseLocks(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*api.Payload, error)
+	CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*api.Payload, error)
+}
+
+type workerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
+	return &workerClient{cc}
+}
+
+func (c *workerClient) Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error) {
+	out := new(api.TxnContext)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Mutate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Uids) > 0 {
-		for iNdEx := len(m.Uids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uids[iNdEx]))
-		}
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Uids)*8))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *workerClient) ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/pb.Worker/ServeTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TaskValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[0], "/pb.Worker/StreamSnapshot", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workerStreamSnapshotClient{stream}
+	return x, nil
 }
 
-func (m *TaskValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_StreamSnapshotClient interface {
+	Send(*Snapshot) error
+	Recv() (*KVS, error)
+	grpc.ClientStream
 }
 
-func (m *TaskValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ValType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Val) > 0 {
-		i -= len(m.Val)
-		copy(dAtA[i:], m.Val)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Val)))
-		i--
-		dAtA[i] = 0xa
+type workerStreamSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerStreamSnapshotClient) Send(m *Snapshot) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerStreamSnapshotClient) Recv() (*KVS, error) {
+	m := new(KVS)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *SrcFunction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error) {
+	out := new(SortResult)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Sort", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SrcFunction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error) {
+	out := new(SchemaResult)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Schema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SrcFunction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsCount {
-		i--
-		if m.IsCount {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func (c *workerClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
+	out := new(BackupResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Backup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	return out, nil
+}
+
+func (c *workerClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *workerClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Query) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[1], "/pb.Worker/ReceivePredicate", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workerReceivePredicateClient{stream}
+	return x, nil
 }
 
-func (m *Query) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_ReceivePredicateClient interface {
+	Send(*KVS) error
+	CloseAndRecv() (*api.Payload, error)
+	grpc.ClientStream
 }
 
-func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.First != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.First))
-		i--
-		dAtA[i] = 0x78
+type workerReceivePredicateClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerReceivePredicateClient) Send(m *KVS) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerReceivePredicateClient) CloseAndRecv() (*api.Payload, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.Cache != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Cache))
-		i--
-		dAtA[i] = 0x70
+	m := new(api.Payload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
-		i--
-		dAtA[i] = 0x68
+	return m, nil
+}
+
+func (c *workerClient) MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Worker/MovePredicate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ExpandAll {
-		i--
-		if m.ExpandAll {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	return out, nil
+}
+
+func (c *workerClient) Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[2], "/pb.Worker/Subscribe", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.FacetsFilter != nil {
-		{
-			size, err := m.FacetsFilter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.FacetParam != nil {
-		{
-			size, err := m.FacetParam.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SrcFunc != nil {
-		{
-			size, err := m.SrcFunc.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.UidList != nil {
-		{
-			size, err := m.UidList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.DoCount {
-		i--
-		if m.DoCount {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AfterUid != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AfterUid))
-		i--
-		dAtA[i] = 0x19
-	}
-	if len(m.Langs) > 0 {
-		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Langs[iNdEx])
-			copy(dAtA[i:], m.Langs[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	x := &workerSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
-		i--
-		dAtA[i] = 0xa
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return x, nil
 }
 
-func (m *ValueList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Worker_SubscribeClient interface {
+	Recv() (*pb.KVList, error)
+	grpc.ClientStream
 }
 
-func (m *ValueList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type workerSubscribeClient struct {
+	grpc.ClientStream
 }
 
-func (m *ValueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (x *workerSubscribeClient) Recv() (*pb.KVList, error) {
+	m := new(pb.KVList)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *LangList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error) {
+	out := new(UpdateGraphQLSchemaResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/UpdateGraphQLSchema", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LangList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) AcquireLocks(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Worker/AcquireLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LangList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Lang) > 0 {
-		for iNdEx := len(m.Lang) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Lang[iNdEx])
-			copy(dAtA[i:], m.Lang[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Lang[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *workerClient) ReleaseLocks(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Worker/ReleaseLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Result) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) CancelIndexBuild(ctx context.Context, in *CancelIndexBuildRequest, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Worker/CancelIndexBuild", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Result) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// WorkerServer is the server API for Worker service.
+type WorkerServer interface {
+	// Data serving RPCs.
+	Mutate(context.Context, *Mutations) (*api.TxnContext, error)
+	ServeTask(context.Context, *Query) (*Result, error)
+	StreamSnapshot(Worker_StreamSnapshotServer) error
+	Sort(context.Context, *SortMessage) (*SortResult, error)
+	Schema(context.Context, *SchemaRequest) (*SchemaResult, error)
+	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
+	Restore(context.Context, *RestoreRequest) (*Status, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	ReceivePredicate(Worker_ReceivePredicateServer) error
+	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
+	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
+	UpdateGraphQLSchema(context.Context, *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error)
+	AcquireLocks(context.Context, *LockRequest) (*api.Payload, error)
+	ReleaseLocks(context.Context, *LockRequest) (*api.Payload, error)
+	CancelIndexBuild(context.Context, *CancelIndexBuildRequest) (*api.Payload, error)
 }
 
-func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.List {
-		i--
-		if m.List {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.LangMatrix) > 0 {
-		for iNdEx := len(m.LangMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LangMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.FacetMatrix) > 0 {
-		for iNdEx := len(m.FacetMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FacetMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.IntersectDest {
-		i--
-		if m.IntersectDest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkerServer struct {
+}
+
+func (*UnimplementedWorkerServer) Mutate(ctx context.Context, req *Mutations) (*api.TxnContext, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mutate not implemented")
+}
+func (*UnimplementedWorkerServer) ServeTask(ctx context.Context, req *Query) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServeTask not implemented")
+}
+func (*UnimplementedWorkerServer) StreamSnapshot(srv Worker_StreamSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSnapshot not implemented")
+}
+func (*UnimplementedWorkerServer) Sort(ctx context.Context, req *SortMessage) (*SortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sort not implemented")
+}
+func (*UnimplementedWorkerServer) Schema(ctx context.Context, req *SchemaRequest) (*SchemaResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schema not implemented")
+}
+func (*UnimplementedWorkerServer) Backup(ctx context.Context, req *BackupRequest) (*BackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (*UnimplementedWorkerServer) Restore(ctx context.Context, req *RestoreRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedWorkerServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedWorkerServer) ReceivePredicate(srv Worker_ReceivePredicateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReceivePredicate not implemented")
+}
+func (*UnimplementedWorkerServer) MovePredicate(ctx context.Context, req *MovePredicatePayload) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePredicate not implemented")
+}
+func (*UnimplementedWorkerServer) Subscribe(req *SubscriptionRequest, srv Worker_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedWorkerServer) UpdateGraphQLSchema(ctx context.Context, req *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGraphQLSchema not implemented")
+}
+func (*UnimplementedWorkerServer) AcquireLocks(ctx context.Context, req *LockRequest) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLocks not implemented")
+}
+func (*UnimplementedWorkerServer) ReleaseLocks(ctx context.Context, req *LockRequest) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLocks not implemented")
+}
+func (*UnimplementedWorkerServer) CancelIndexBuild(ctx context.Context, req *CancelIndexBuildRequest) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIndexBuild not implemented")
+}
+
+func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
+	s.RegisterService(&_Worker_serviceDesc, srv)
+}
+
+func _Worker_Mutate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Mutations)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Counts) > 0 {
-		dAtA6 := make([]byte, len(m.Counts)*10)
-		var j5 int
-		for _, num := range m.Counts {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintPb(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(WorkerServer).Mutate(ctx, in)
 	}
-	if len(m.ValueMatrix) > 0 {
-		for iNdEx := len(m.ValueMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValueMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Mutate",
 	}
-	if len(m.UidMatrix) > 0 {
-		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Mutate(ctx, req.(*Mutations))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Worker_ServeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(WorkerServer).ServeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/ServeTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ServeTask(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Worker_StreamSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).StreamSnapshot(&workerStreamSnapshotServer{stream})
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type Worker_StreamSnapshotServer interface {
+	Send(*KVS) error
+	Recv() (*Snapshot, error)
+	grpc.ServerStream
+}
+
+type workerStreamSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerStreamSnapshotServer) Send(m *KVS) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerStreamSnapshotServer) Recv() (*Snapshot, error) {
+	m := new(Snapshot)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if len(m.Langs) > 0 {
-		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Langs[iNdEx])
-			copy(dAtA[i:], m.Langs[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	return m, nil
+}
+
+func _Worker_Sort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Desc {
-		i--
-		if m.Desc {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(WorkerServer).Sort(ctx, in)
 	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Sort",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Sort(ctx, req.(*SortMessage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SortMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Worker_Schema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SortMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(WorkerServer).Schema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Schema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Schema(ctx, req.(*SchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SortMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Worker_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
-		i--
-		dAtA[i] = 0x68
+	if interceptor == nil {
+		return srv.(WorkerServer).Backup(ctx, in)
 	}
-	if m.Offset != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x20
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Backup",
 	}
-	if m.Count != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x18
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Backup(ctx, req.(*BackupRequest))
 	}
-	if len(m.UidMatrix) > 0 {
-		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Order) > 0 {
-		for iNdEx := len(m.Order) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Order[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(WorkerServer).Restore(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SortResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Worker_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(WorkerServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SortResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Worker_ReceivePredicate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).ReceivePredicate(&workerReceivePredicateServer{stream})
 }
 
-func (m *SortResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.UidMatrix) > 0 {
-		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type Worker_ReceivePredicateServer interface {
+	SendAndClose(*api.Payload) error
+	Recv() (*KVS, error)
+	grpc.ServerStream
 }
 
-func (m *RaftContext) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type workerReceivePredicateServer struct {
+	grpc.ServerStream
 }
 
-func (m *RaftContext) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *workerReceivePredicateServer) SendAndClose(m *api.Payload) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *RaftContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsLearner {
-		i--
-		if m.IsLearner {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+func (x *workerReceivePredicateServer) Recv() (*KVS, error) {
+	m := new(KVS)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.SnapshotTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
-		i--
-		dAtA[i] = 0x20
+	return m, nil
+}
+
+func _Worker_MovePredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePredicatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(WorkerServer).MovePredicate(ctx, in)
 	}
-	if m.Group != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/MovePredicate",
 	}
-	if m.Id != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
-		i--
-		dAtA[i] = 0x9
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).MovePredicate(ctx, req.(*MovePredicatePayload))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Member) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _Worker_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscriptionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(WorkerServer).Subscribe(m, &workerSubscribeServer{stream})
 }
 
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_SubscribeServer interface {
+	Send(*pb.KVList) error
+	grpc.ServerStream
 }
 
-func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type workerSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerSubscribeServer) Send(m *pb.KVList) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Worker_UpdateGraphQLSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGraphQLSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ForceGroupId {
-		i--
-		if m.ForceGroupId {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+	if interceptor == nil {
+		return srv.(WorkerServer).UpdateGraphQLSchema(ctx, in)
 	}
-	if m.ClusterInfoOnly {
-		i--
-		if m.ClusterInfoOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/UpdateGraphQLSchema",
 	}
-	if m.Learner {
-		i--
-		if m.Learner {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).UpdateGraphQLSchema(ctx, req.(*UpdateGraphQLSchemaRequest))
 	}
-	if m.LastUpdate != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.LastUpdate))
-		i--
-		dAtA[i] = 0x30
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_AcquireLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AmDead {
-		i--
-		if m.AmDead {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	if interceptor == nil {
+		return srv.(WorkerServer).AcquireLocks(ctx, in)
 	}
-	if m.Leader {
-		i--
-		if m.Leader {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/AcquireLocks",
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).AcquireLocks(ctx, req.(*LockRequest))
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ReleaseLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
-		i--
-		dAtA[i] = 0x9
+	if interceptor == nil {
+		return srv.(WorkerServer).ReleaseLocks(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/ReleaseLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ReleaseLocks(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Group) Marshal() (dAtA []byte, err error) {
+func _Worker_CancelIndexBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIndexBuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).CancelIndexBuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/CancelIndexBuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).CancelIndexBuild(ctx, req.(*CancelIndexBuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Worker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Worker",
+	HandlerType: (*WorkerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Mutate",
+			Handler:    _Worker_Mutate_Handler,
+		},
+		{
+			MethodName: "ServeTask",
+			Handler:    _Worker_ServeTask_Handler,
+		},
+		{
+			MethodName: "Sort",
+			Handler:    _Worker_Sort_Handler,
+		},
+		{
+			MethodName: "Schema",
+			Handler:    _Worker_Schema_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _Worker_Backup_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Worker_Restore_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _Worker_Export_Handler,
+		},
+		{
+			MethodName: "MovePredicate",
+			Handler:    _Worker_MovePredicate_Handler,
+		},
+		{
+			MethodName: "UpdateGraphQLSchema",
+			Handler:    _Worker_UpdateGraphQLSchema_Handler,
+		},
+		{
+			MethodName: "AcquireLocks",
+			Handler:    _Worker_AcquireLocks_Handler,
+		},
+		{
+			MethodName: "ReleaseLocks",
+			Handler:    _Worker_ReleaseLocks_Handler,
+		},
+		{
+			MethodName: "CancelIndexBuild",
+			Handler:    _Worker_CancelIndexBuild_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSnapshot",
+			Handler:       _Worker_StreamSnapshot_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReceivePredicate",
+			Handler:       _Worker_ReceivePredicate_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Worker_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pb.proto",
+}
+
+func (m *List) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7621,12 +7511,12 @@ func (m *Group) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Group) MarshalTo(dAtA []byte) (int, error) {
+func (m *List) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *List) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7635,75 +7525,19 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CheckpointTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CheckpointTs))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Checksum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Checksum))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.SnapshotTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Tablets) > 0 {
-		for k := range m.Tablets {
-			v := m.Tablets[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPb(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Members) > 0 {
-		for k := range m.Members {
-			v := m.Members[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	if len(m.Uids) > 0 {
+		for iNdEx := len(m.Uids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uids[iNdEx]))
 		}
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Uids)*8))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *License) Marshal() (dAtA []byte, err error) {
+func (m *TaskValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7713,12 +7547,12 @@ func (m *License) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *License) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskValue) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *License) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7727,37 +7561,22 @@ func (m *License) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ExpiryTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExpiryTs))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MaxNodes != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxNodes))
+	if m.ValType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.User)))
+	if len(m.Val) > 0 {
+		i -= len(m.Val)
+		copy(dAtA[i:], m.Val)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Val)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ZeroProposal) Marshal() (dAtA []byte, err error) {
+func (m *SrcFunction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7767,12 +7586,12 @@ func (m *ZeroProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ZeroProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *SrcFunction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SrcFunction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7781,21 +7600,106 @@ func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Snapshot != nil {
-		{
-			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.IsCount {
+		i--
+		if m.IsCount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x20
 	}
-	if m.License != nil {
-		{
-			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Query) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Query) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Decrypt {
+		i--
+		if m.Decrypt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Isolation != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Isolation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.First != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.First))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Cache != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Cache))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ExpandAll {
+		i--
+		if m.ExpandAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FacetsFilter != nil {
+		{
+			size, err := m.FacetsFilter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7803,18 +7707,11 @@ func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
-		i--
 		dAtA[i] = 0x4a
 	}
-	if m.Txn != nil {
+	if m.FacetParam != nil {
 		{
-			size, err := m.Txn.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FacetParam.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7822,26 +7719,21 @@ func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
-	}
-	if m.MaxRaftId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
-		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x42
 	}
-	if m.MaxTxnTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
+	if m.Reverse {
 		i--
-		dAtA[i] = 0x28
-	}
-	if m.MaxLeaseId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxLeaseId))
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x38
 	}
-	if m.Tablet != nil {
+	if m.SrcFunc != nil {
 		{
-			size, err := m.Tablet.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SrcFunc.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7849,11 +7741,11 @@ func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x32
 	}
-	if m.Member != nil {
+	if m.UidList != nil {
 		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.UidList.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7861,27 +7753,44 @@ func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if len(m.SnapshotTs) > 0 {
-		for k := range m.SnapshotTs {
-			v := m.SnapshotTs[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+	if m.DoCount {
+		i--
+		if m.DoCount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AfterUid != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AfterUid))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Langs) > 0 {
+		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Langs[iNdEx])
+			copy(dAtA[i:], m.Langs[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MembershipState) Marshal() (dAtA []byte, err error) {
+func (m *ValueList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7891,12 +7800,12 @@ func (m *MembershipState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValueList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7905,29 +7814,10 @@ func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.License != nil {
-		{
-			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Removed) > 0 {
-		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7935,81 +7825,49 @@ func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0xa
 		}
 	}
-	if m.MaxRaftId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
-		i--
-		dAtA[i] = 0x30
+	return len(dAtA) - i, nil
+}
+
+func (m *LangList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxTxnTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
-		i--
-		dAtA[i] = 0x28
+	return dAtA[:n], nil
+}
+
+func (m *LangList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LangList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxLeaseId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxLeaseId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Zeros) > 0 {
-		for k := range m.Zeros {
-			v := m.Zeros[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Groups) > 0 {
-		for k := range m.Groups {
-			v := m.Groups[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Counter != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Counter))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Lang) > 0 {
+		for iNdEx := len(m.Lang) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Lang[iNdEx])
+			copy(dAtA[i:], m.Lang[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Lang[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
+func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8019,12 +7877,12 @@ func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8033,39 +7891,113 @@ func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxPending != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxPending))
+	if len(m.ReadKeys) > 0 {
+		for iNdEx := len(m.ReadKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ReadKeys[iNdEx]))
+		}
+		i = encodeVarintPb(dAtA, i, uint64(len(m.ReadKeys)*8))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x42
 	}
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.List {
+		i--
+		if m.List {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LangMatrix) > 0 {
+		for iNdEx := len(m.LangMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LangMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FacetMatrix) > 0 {
+		for iNdEx := len(m.FacetMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FacetMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
+	}
+	if m.IntersectDest {
 		i--
-		dAtA[i] = 0x12
+		if m.IntersectDest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Member != nil {
-		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Counts) > 0 {
+		dAtA6 := make([]byte, len(m.Counts)*10)
+		var j5 int
+		for _, num := range m.Counts {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
 			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintPb(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValueMatrix) > 0 {
+		for iNdEx := len(m.ValueMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValueMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UidMatrix) > 0 {
+		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HealthInfo) Marshal() (dAtA []byte, err error) {
+func (m *Order) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8075,12 +8007,12 @@ func (m *HealthInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HealthInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8089,87 +8021,36 @@ func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxAssigned != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.EeFeatures) > 0 {
-		for iNdEx := len(m.EeFeatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EeFeatures[iNdEx])
-			copy(dAtA[i:], m.EeFeatures[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.EeFeatures[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.Indexing) > 0 {
-		for iNdEx := len(m.Indexing) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Indexing[iNdEx])
-			copy(dAtA[i:], m.Indexing[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Indexing[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.Ongoing) > 0 {
-		for iNdEx := len(m.Ongoing) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ongoing[iNdEx])
-			copy(dAtA[i:], m.Ongoing[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Ongoing[iNdEx])))
+	if len(m.Langs) > 0 {
+		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Langs[iNdEx])
+			copy(dAtA[i:], m.Langs[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.LastEcho != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.LastEcho))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Uptime != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Uptime))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Group)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Status)))
+	if m.Desc {
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Address)))
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Instance) > 0 {
-		i -= len(m.Instance)
-		copy(dAtA[i:], m.Instance)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Instance)))
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Tablet) Marshal() (dAtA []byte, err error) {
+func (m *SortMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8179,12 +8060,12 @@ func (m *Tablet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Tablet) MarshalTo(dAtA []byte) (int, error) {
+func (m *SortMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Tablet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SortMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8193,67 +8074,53 @@ func (m *Tablet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UncompressedBytes != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.UncompressedBytes))
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x68
 	}
-	if m.MoveTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MoveTs))
+	if m.Offset != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x20
 	}
-	if m.ReadOnly {
-		i--
-		if m.ReadOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Count != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x18
 	}
-	if m.Remove {
-		i--
-		if m.Remove {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.UidMatrix) > 0 {
+		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.OnDiskBytes != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.OnDiskBytes))
-		i--
-		dAtA[i] = 0x38
 	}
-	if m.Force {
-		i--
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Order) > 0 {
+		for iNdEx := len(m.Order) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Order[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DirectedEdge) Marshal() (dAtA []byte, err error) {
+func (m *SortResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8263,12 +8130,12 @@ func (m *DirectedEdge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DirectedEdge) MarshalTo(dAtA []byte) (int, error) {
+func (m *SortResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SortResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8277,19 +8144,10 @@ func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.AllowedPreds) > 0 {
-		for iNdEx := len(m.AllowedPreds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedPreds[iNdEx])
-			copy(dAtA[i:], m.AllowedPreds[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.AllowedPreds[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.Facets) > 0 {
-		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UidMatrix) > 0 {
+		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -8297,63 +8155,13 @@ func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Op != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Lang) > 0 {
-		i -= len(m.Lang)
-		copy(dAtA[i:], m.Lang)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Lang)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.ValueId != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ValueId))
-		i--
-		dAtA[i] = 0x29
-	}
-	if m.ValueType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Entity != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Entity))
-		i--
-		dAtA[i] = 0x9
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Mutations) Marshal() (dAtA []byte, err error) {
+func (m *RaftContext) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8363,12 +8171,12 @@ func (m *Mutations) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Mutations) MarshalTo(dAtA []byte) (int, error) {
+func (m *RaftContext) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RaftContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8377,86 +8185,43 @@ func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.IsLearner {
+		i--
+		if m.IsLearner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x28
 	}
-	if len(m.DropValue) > 0 {
-		i -= len(m.DropValue)
-		copy(dAtA[i:], m.DropValue)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
+	if m.SnapshotTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x20
 	}
-	if m.DropOp != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
 		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Types) > 0 {
-		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Schema) > 0 {
-		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Edges) > 0 {
-		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+		dAtA[i] = 0x1a
 	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+	if m.Group != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Group))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if m.Id != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
+func (m *Member) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8466,12 +8231,12 @@ func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8480,13 +8245,49 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PredHints) > 0 {
-		for k := range m.PredHints {
-			v := m.PredHints[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ForceGroupId {
+		i--
+		if m.ForceGroupId {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ClusterInfoOnly {
+		i--
+		if m.ClusterInfoOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.TabletLoads) > 0 {
+		for k := range m.TabletLoads {
+			v := m.TabletLoads[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintPb(dAtA, i, uint64(len(k)))
@@ -8494,13 +8295,77 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x52
+		}
+	}
+	if m.MemoryInUse != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MemoryInUse))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CpuUsage != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CpuUsage))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.Learner {
+		i--
+		if m.Learner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastUpdate != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LastUpdate))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AmDead {
+		i--
+		if m.AmDead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Leader {
+		i--
+		if m.Leader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Snapshot) Marshal() (dAtA []byte, err error) {
+func (m *Group) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8510,12 +8375,12 @@ func (m *Snapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *Group) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8524,47 +8389,75 @@ func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SinceTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
+	if m.CheckpointTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CheckpointTs))
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.Done {
-		i--
-		if m.Done {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Checksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Checksum))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+	if m.SnapshotTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Index != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Tablets) > 0 {
+		for k := range m.Tablets {
+			v := m.Tablets[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Context != nil {
-		{
-			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Members) > 0 {
+		for k := range m.Members {
+			v := m.Members[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ZeroSnapshot) Marshal() (dAtA []byte, err error) {
+func (m *License) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8574,12 +8467,12 @@ func (m *ZeroSnapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ZeroSnapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *License) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ZeroSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *License) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8588,32 +8481,37 @@ func (m *ZeroSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x20
 	}
-	if m.CheckpointTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CheckpointTs))
+	if m.ExpiryTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpiryTs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxNodes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxNodes))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Index != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.User)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
+func (m *ZeroProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8623,12 +8521,12 @@ func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ZeroProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8637,121 +8535,119 @@ func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.BackupNum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.BackupNum))
-		i--
-		dAtA[i] = 0x1
+	if m.Pin != nil {
+		{
+			size, err := m.Pin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x62
 	}
-	if len(m.VaultFormat) > 0 {
-		i -= len(m.VaultFormat)
-		copy(dAtA[i:], m.VaultFormat)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultFormat)))
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x5a
 	}
-	if len(m.VaultField) > 0 {
-		i -= len(m.VaultField)
-		copy(dAtA[i:], m.VaultField)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultField)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.VaultPath) > 0 {
-		i -= len(m.VaultPath)
-		copy(dAtA[i:], m.VaultPath)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultPath)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.VaultSecretidFile) > 0 {
-		i -= len(m.VaultSecretidFile)
-		copy(dAtA[i:], m.VaultSecretidFile)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultSecretidFile)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.VaultRoleidFile) > 0 {
-		i -= len(m.VaultRoleidFile)
-		copy(dAtA[i:], m.VaultRoleidFile)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultRoleidFile)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.VaultAddr) > 0 {
-		i -= len(m.VaultAddr)
-		copy(dAtA[i:], m.VaultAddr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultAddr)))
+	if m.License != nil {
+		{
+			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x52
 	}
-	if len(m.EncryptionKeyFile) > 0 {
-		i -= len(m.EncryptionKeyFile)
-		copy(dAtA[i:], m.EncryptionKeyFile)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.EncryptionKeyFile)))
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
 		i--
 		dAtA[i] = 0x4a
 	}
-	if m.Anonymous {
-		i--
-		if m.Anonymous {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Txn != nil {
+		{
+			size, err := m.Txn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.SessionToken) > 0 {
-		i -= len(m.SessionToken)
-		copy(dAtA[i:], m.SessionToken)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
-		i--
 		dAtA[i] = 0x3a
 	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
+	if m.MaxRaftId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x30
 	}
-	if len(m.AccessKey) > 0 {
-		i -= len(m.AccessKey)
-		copy(dAtA[i:], m.AccessKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+	if m.MaxTxnTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if len(m.BackupId) > 0 {
-		i -= len(m.BackupId)
-		copy(dAtA[i:], m.BackupId)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.BackupId)))
+	if m.MaxLeaseId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxLeaseId))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.Location) > 0 {
-		i -= len(m.Location)
-		copy(dAtA[i:], m.Location)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Location)))
+	if m.Tablet != nil {
+		{
+			size, err := m.Tablet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.RestoreTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.RestoreTs))
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.SnapshotTs) > 0 {
+		for k := range m.SnapshotTs {
+			v := m.SnapshotTs[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
+func (m *MembershipState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8761,12 +8657,12 @@ func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8775,31 +8671,26 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Restore != nil {
-		{
-			size, err := m.Restore.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if len(m.PinnedTablets) > 0 {
+		for k := range m.PinnedTablets {
+			v := m.PinnedTablets[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
 		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.ExpectedChecksum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Index != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x50
 	}
-	if m.Snapshot != nil {
+	if m.License != nil {
 		{
-			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8809,41 +8700,17 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4a
 	}
-	if m.Delta != nil {
-		{
-			size, err := m.Delta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
 		i--
 		dAtA[i] = 0x42
 	}
-	if len(m.CleanPredicate) > 0 {
-		i -= len(m.CleanPredicate)
-		copy(dAtA[i:], m.CleanPredicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CleanPredicate)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Kv) > 0 {
-		for iNdEx := len(m.Kv) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Kv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -8851,87 +8718,81 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x3a
 		}
 	}
-	if m.Mutations != nil {
-		{
-			size, err := m.Mutations.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if m.MaxRaftId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x30
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *KVS) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.MaxTxnTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
+		i--
+		dAtA[i] = 0x28
 	}
-	return dAtA[:n], nil
-}
-
-func (m *KVS) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *KVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Data)))
+	if m.MaxLeaseId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxLeaseId))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x20
 	}
-	if len(m.Types) > 0 {
-		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Types[iNdEx])
-			copy(dAtA[i:], m.Types[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Types[iNdEx])))
+	if len(m.Zeros) > 0 {
+		for k := range m.Zeros {
+			v := m.Zeros[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Predicates) > 0 {
-		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Predicates[iNdEx])
-			copy(dAtA[i:], m.Predicates[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x1a
 		}
 	}
-	if m.Done {
-		i--
-		if m.Done {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Groups) > 0 {
+		for k := range m.Groups {
+			v := m.Groups[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if m.Counter != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Counter))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Posting) Marshal() (dAtA []byte, err error) {
+func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8941,12 +8802,12 @@ func (m *Posting) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Posting) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Posting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8955,76 +8816,153 @@ func (m *Posting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+	if m.MaxPending != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxPending))
 		i--
-		dAtA[i] = 0x70
+		dAtA[i] = 0x18
 	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x12
 	}
-	if m.Op != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Op))
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HealthInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Learner {
+		i--
+		if m.Learner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x60
 	}
-	if len(m.Facets) > 0 {
-		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
+	if m.MaxAssigned != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.EeFeatures) > 0 {
+		for iNdEx := len(m.EeFeatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EeFeatures[iNdEx])
+			copy(dAtA[i:], m.EeFeatures[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.EeFeatures[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Indexing) > 0 {
+		for iNdEx := len(m.Indexing) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Indexing[iNdEx])
+			copy(dAtA[i:], m.Indexing[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Indexing[iNdEx])))
 			i--
 			dAtA[i] = 0x4a
 		}
 	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Label)))
+	if len(m.Ongoing) > 0 {
+		for iNdEx := len(m.Ongoing) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ongoing[iNdEx])
+			copy(dAtA[i:], m.Ongoing[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Ongoing[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.LastEcho != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LastEcho))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x38
 	}
-	if len(m.LangTag) > 0 {
-		i -= len(m.LangTag)
-		copy(dAtA[i:], m.LangTag)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.LangTag)))
+	if m.Uptime != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Uptime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Version)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.PostingType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.PostingType))
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Group)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.ValType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Status)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Uid != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uid))
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Instance)))
 		i--
-		dAtA[i] = 0x9
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UidBlock) Marshal() (dAtA []byte, err error) {
+func (m *Tablet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9034,12 +8972,12 @@ func (m *UidBlock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UidBlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tablet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Tablet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9048,27 +8986,67 @@ func (m *UidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NumUids != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.NumUids))
+	if m.UncompressedBytes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.UncompressedBytes))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x58
 	}
-	if len(m.Deltas) > 0 {
-		i -= len(m.Deltas)
-		copy(dAtA[i:], m.Deltas)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Deltas)))
+	if m.MoveTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MoveTs))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OnDiskBytes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.OnDiskBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Base != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Base))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UidPack) Marshal() (dAtA []byte, err error) {
+func (m *DirectedEdge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9078,12 +9056,12 @@ func (m *UidPack) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UidPack) MarshalTo(dAtA []byte) (int, error) {
+func (m *DirectedEdge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UidPack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9092,17 +9070,19 @@ func (m *UidPack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AllocRef != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.AllocRef))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
+	if len(m.AllowedPreds) > 0 {
+		for iNdEx := len(m.AllowedPreds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPreds[iNdEx])
+			copy(dAtA[i:], m.AllowedPreds[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.AllowedPreds[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if len(m.Blocks) > 0 {
-		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Facets) > 0 {
+		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9110,18 +9090,63 @@ func (m *UidPack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x4a
 		}
 	}
-	if m.BlockSize != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.BlockSize))
+	if m.Op != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Op))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x40
+	}
+	if len(m.Lang) > 0 {
+		i -= len(m.Lang)
+		copy(dAtA[i:], m.Lang)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Lang)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ValueId != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ValueId))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.ValueType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Entity != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Entity))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PostingList) Marshal() (dAtA []byte, err error) {
+func (m *Mutations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9131,12 +9156,12 @@ func (m *PostingList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PostingList) MarshalTo(dAtA []byte) (int, error) {
+func (m *Mutations) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9145,33 +9170,34 @@ func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Splits) > 0 {
-		dAtA28 := make([]byte, len(m.Splits)*10)
-		var j27 int
-		for _, num := range m.Splits {
-			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j27++
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintPb(dAtA, i, uint64(j27))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x4a
 	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+	if len(m.DropValue) > 0 {
+		i -= len(m.DropValue)
+		copy(dAtA[i:], m.DropValue)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x42
 	}
-	if len(m.Postings) > 0 {
-		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
+	if m.DropOp != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9179,25 +9205,51 @@ func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
 	}
-	if m.Pack != nil {
-		{
-			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Schema) > 0 {
+		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Edges) > 0 {
+		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FacetParam) Marshal() (dAtA []byte, err error) {
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9207,12 +9259,12 @@ func (m *FacetParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FacetParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FacetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9221,24 +9273,27 @@ func (m *FacetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Alias) > 0 {
-		i -= len(m.Alias)
-		copy(dAtA[i:], m.Alias)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Alias)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.PredHints) > 0 {
+		for k := range m.PredHints {
+			v := m.PredHints[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FacetParams) Marshal() (dAtA []byte, err error) {
+func (m *Snapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9248,12 +9303,12 @@ func (m *FacetParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FacetParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FacetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9262,75 +9317,47 @@ func (m *FacetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Param) > 0 {
-		for iNdEx := len(m.Param) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Param[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.SinceTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.AllKeys {
+	if m.Done {
 		i--
-		if m.AllKeys {
+		if m.Done {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x20
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Facets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x18
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Facets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Facets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Index != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Facets) > 0 {
-		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.Context != nil {
+		{
+			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FacetsList) Marshal() (dAtA []byte, err error) {
+func (m *ZeroSnapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9340,12 +9367,12 @@ func (m *FacetsList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FacetsList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ZeroSnapshot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FacetsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ZeroSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9354,24 +9381,32 @@ func (m *FacetsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FacetsList) > 0 {
-		for iNdEx := len(m.FacetsList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FacetsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CheckpointTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CheckpointTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Function) Marshal() (dAtA []byte, err error) {
+func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9381,12 +9416,12 @@ func (m *Function) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Function) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9395,33 +9430,121 @@ func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if m.BackupNum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.BackupNum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.VaultFormat) > 0 {
+		i -= len(m.VaultFormat)
+		copy(dAtA[i:], m.VaultFormat)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultFormat)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.VaultField) > 0 {
+		i -= len(m.VaultField)
+		copy(dAtA[i:], m.VaultField)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultField)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.VaultPath) > 0 {
+		i -= len(m.VaultPath)
+		copy(dAtA[i:], m.VaultPath)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultPath)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.VaultSecretidFile) > 0 {
+		i -= len(m.VaultSecretidFile)
+		copy(dAtA[i:], m.VaultSecretidFile)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultSecretidFile)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.VaultRoleidFile) > 0 {
+		i -= len(m.VaultRoleidFile)
+		copy(dAtA[i:], m.VaultRoleidFile)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultRoleidFile)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.VaultAddr) > 0 {
+		i -= len(m.VaultAddr)
+		copy(dAtA[i:], m.VaultAddr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultAddr)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.EncryptionKeyFile) > 0 {
+		i -= len(m.EncryptionKeyFile)
+		copy(dAtA[i:], m.EncryptionKeyFile)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.EncryptionKeyFile)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Anonymous {
+		i--
+		if m.Anonymous {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+	if len(m.SessionToken) > 0 {
+		i -= len(m.SessionToken)
+		copy(dAtA[i:], m.SessionToken)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
+	}
+	if len(m.AccessKey) > 0 {
+		i -= len(m.AccessKey)
+		copy(dAtA[i:], m.AccessKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BackupId) > 0 {
+		i -= len(m.BackupId)
+		copy(dAtA[i:], m.BackupId)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.BackupId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RestoreTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.RestoreTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FilterTree) Marshal() (dAtA []byte, err error) {
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9431,12 +9554,12 @@ func (m *FilterTree) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FilterTree) MarshalTo(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9445,9 +9568,16 @@ func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Func != nil {
+	if len(m.CancelIndexBuild) > 0 {
+		i -= len(m.CancelIndexBuild)
+		copy(dAtA[i:], m.CancelIndexBuild)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CancelIndexBuild)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Restore != nil {
 		{
-			size, err := m.Func.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Restore.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9455,12 +9585,65 @@ func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x62
 	}
-	if len(m.Children) > 0 {
-		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	if m.ExpectedChecksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Index != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Delta != nil {
+		{
+			size, err := m.Delta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CleanPredicate) > 0 {
+		i -= len(m.CleanPredicate)
+		copy(dAtA[i:], m.CleanPredicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CleanPredicate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Kv) > 0 {
+		for iNdEx := len(m.Kv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9468,20 +9651,25 @@ func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Op) > 0 {
-		i -= len(m.Op)
-		copy(dAtA[i:], m.Op)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Op)))
+	if m.Mutations != nil {
+		{
+			size, err := m.Mutations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchemaRequest) Marshal() (dAtA []byte, err error) {
+func (m *KVS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9491,12 +9679,12 @@ func (m *SchemaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *KVS) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9505,6 +9693,13 @@ func (m *SchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Types) > 0 {
 		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Types[iNdEx])
@@ -9514,33 +9709,29 @@ func (m *SchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Fields[iNdEx])
-			copy(dAtA[i:], m.Fields[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Fields[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
 	if len(m.Predicates) > 0 {
 		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Predicates[iNdEx])
 			copy(dAtA[i:], m.Predicates[iNdEx])
 			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if m.Done {
 		i--
-		dAtA[i] = 0x8
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchemaNode) Marshal() (dAtA []byte, err error) {
+func (m *Posting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9550,12 +9741,12 @@ func (m *SchemaNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *Posting) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Posting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9564,103 +9755,76 @@ func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NoConflict {
-		i--
-		if m.NoConflict {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x70
 	}
-	if m.Lang {
-		i--
-		if m.Lang {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x68
 	}
-	if m.Upsert {
-		i--
-		if m.Upsert {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Op != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Op))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x60
 	}
-	if m.List {
-		i--
-		if m.List {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Facets) > 0 {
+		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
-		i--
-		dAtA[i] = 0x38
 	}
-	if m.Count {
-		i--
-		if m.Count {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Label)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x32
 	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.LangTag) > 0 {
+		i -= len(m.LangTag)
+		copy(dAtA[i:], m.LangTag)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.LangTag)))
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Tokenizer) > 0 {
-		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tokenizer[iNdEx])
-			copy(dAtA[i:], m.Tokenizer[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0x2a
 	}
-	if m.Index {
+	if m.PostingType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.PostingType))
 		i--
-		if m.Index {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x20
+	}
+	if m.ValType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Type)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+	if m.Uid != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uid))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchemaResult) Marshal() (dAtA []byte, err error) {
+func (m *UidBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9670,12 +9834,12 @@ func (m *SchemaResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *UidBlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9684,24 +9848,27 @@ func (m *SchemaResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Schema) > 0 {
-		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.NumUids != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NumUids))
+		i--
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
+	if len(m.Deltas) > 0 {
+		i -= len(m.Deltas)
+		copy(dAtA[i:], m.Deltas)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Deltas)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Base != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Base))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SchemaUpdate) Marshal() (dAtA []byte, err error) {
+func (m *UidPack) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9711,12 +9878,12 @@ func (m *SchemaUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *UidPack) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UidPack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9725,113 +9892,36 @@ func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NoConflict {
-		i--
-		if m.NoConflict {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.ObjectTypeName) > 0 {
-		i -= len(m.ObjectTypeName)
-		copy(dAtA[i:], m.ObjectTypeName)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.ObjectTypeName)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.NonNullableList {
-		i--
-		if m.NonNullableList {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.NonNullable {
-		i--
-		if m.NonNullable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Lang {
-		i--
-		if m.Lang {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Upsert {
-		i--
-		if m.Upsert {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.List {
-		i--
-		if m.List {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Count {
+	if m.AllocRef != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.AllocRef))
 		i--
-		if m.Count {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0xb8
 	}
-	if len(m.Tokenizer) > 0 {
-		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tokenizer[iNdEx])
-			copy(dAtA[i:], m.Tokenizer[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Directive != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Directive))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ValueType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+	if m.BlockSize != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.BlockSize))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
+func (m *PostingList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9841,12 +9931,12 @@ func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TypeUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *PostingList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9855,10 +9945,33 @@ func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Splits) > 0 {
+		dAtA30 := make([]byte, len(m.Splits)*10)
+		var j29 int
+		for _, num := range m.Splits {
+			for num >= 1<<7 {
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			dAtA30[j29] = uint8(num)
+			j29++
+		}
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintPb(dAtA, i, uint64(j29))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Postings) > 0 {
+		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9869,17 +9982,22 @@ func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.TypeName) > 0 {
-		i -= len(m.TypeName)
-		copy(dAtA[i:], m.TypeName)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.TypeName)))
+	if m.Pack != nil {
+		{
+			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MapHeader) Marshal() (dAtA []byte, err error) {
+func (m *FacetParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9889,12 +10007,12 @@ func (m *MapHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MapHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *FacetParam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FacetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9903,19 +10021,24 @@ func (m *MapHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PartitionKeys) > 0 {
-		for iNdEx := len(m.PartitionKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PartitionKeys[iNdEx])
-			copy(dAtA[i:], m.PartitionKeys[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.PartitionKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
+func (m *FacetParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9925,12 +10048,12 @@ func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MovePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
+func (m *FacetParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MovePredicatePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FacetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9939,37 +10062,34 @@ func (m *MovePredicatePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExpectedChecksum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.TxnTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.TxnTs))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.DestGid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DestGid))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Param) > 0 {
+		for iNdEx := len(m.Param) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Param[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.SourceGid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SourceGid))
+	if m.AllKeys {
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		if m.AllKeys {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TxnStatus) Marshal() (dAtA []byte, err error) {
+func (m *Facets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9979,12 +10099,12 @@ func (m *TxnStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxnStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *Facets) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxnStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Facets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9993,67 +10113,10 @@ func (m *TxnStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *OracleDelta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OracleDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.GroupChecksums) > 0 {
-		for k := range m.GroupChecksums {
-			v := m.GroupChecksums[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.MaxAssigned != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Txns) > 0 {
-		for iNdEx := len(m.Txns) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Facets) > 0 {
+		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Txns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10067,7 +10130,7 @@ func (m *OracleDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TxnTimestamps) Marshal() (dAtA []byte, err error) {
+func (m *FacetsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10077,12 +10140,12 @@ func (m *TxnTimestamps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxnTimestamps) MarshalTo(dAtA []byte) (int, error) {
+func (m *FacetsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxnTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FacetsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10091,28 +10154,24 @@ func (m *TxnTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Ts) > 0 {
-		dAtA32 := make([]byte, len(m.Ts)*10)
-		var j31 int
-		for _, num := range m.Ts {
-			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j31++
+	if len(m.FacetsList) > 0 {
+		for iNdEx := len(m.FacetsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FacetsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
-			dAtA32[j31] = uint8(num)
-			j31++
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintPb(dAtA, i, uint64(j31))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PeerResponse) Marshal() (dAtA []byte, err error) {
+func (m *Function) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10122,12 +10181,12 @@ func (m *PeerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PeerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Function) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10136,20 +10195,33 @@ func (m *PeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RaftBatch) Marshal() (dAtA []byte, err error) {
+func (m *FilterTree) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10159,12 +10231,12 @@ func (m *RaftBatch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftBatch) MarshalTo(dAtA []byte) (int, error) {
+func (m *FilterTree) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10173,9 +10245,9 @@ func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Payload != nil {
+	if m.Func != nil {
 		{
-			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Func.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10183,24 +10255,33 @@ func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.Context != nil {
-		{
-			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Op)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
+func (m *SchemaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10210,12 +10291,12 @@ func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10224,58 +10305,42 @@ func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Prefixes) > 0 {
-		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Prefixes[iNdEx])
-			copy(dAtA[i:], m.Prefixes[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Types[iNdEx])
+			copy(dAtA[i:], m.Types[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Types[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SubscriptionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Kvs != nil {
-		{
-			size, err := m.Kvs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Num) Marshal() (dAtA []byte, err error) {
+func (m *SchemaNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10285,12 +10350,12 @@ func (m *Num) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Num) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Num) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10299,114 +10364,120 @@ func (m *Num) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Forwarded {
+	if m.Encrypted {
 		i--
-		if m.Forwarded {
+		if m.Encrypted {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x60
 	}
-	if m.ReadOnly {
+	if len(m.Storage) > 0 {
+		i -= len(m.Storage)
+		copy(dAtA[i:], m.Storage)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Storage)))
 		i--
-		if m.ReadOnly {
+		dAtA[i] = 0x5a
+	}
+	if m.NoConflict {
+		i--
+		if m.NoConflict {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x50
 	}
-	if m.Val != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Val))
+	if m.Lang {
 		i--
-		dAtA[i] = 0x8
+		if m.Lang {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AssignedIds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AssignedIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Upsert {
+		i--
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.ReadOnly != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadOnly))
+	if m.List {
 		i--
-		dAtA[i] = 0x28
+		if m.List {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.EndId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.EndId))
+	if m.Count {
 		i--
-		dAtA[i] = 0x10
+		if m.Count {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.StartId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartId))
+	if m.Reverse {
 		i--
-		dAtA[i] = 0x8
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SnapshotMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Tokenizer) > 0 {
+		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokenizer[iNdEx])
+			copy(dAtA[i:], m.Tokenizer[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SnapshotMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SnapshotMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Index {
+		i--
+		if m.Index {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Type)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.ClientTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ClientTs))
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Status) Marshal() (dAtA []byte, err error) {
+func (m *SchemaResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10416,12 +10487,12 @@ func (m *Status) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10430,22 +10501,24 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Schema) > 0 {
+		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
+func (m *SchemaUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10455,12 +10528,12 @@ func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10469,94 +10542,161 @@ func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Predicates) > 0 {
-		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Predicates[iNdEx])
-			copy(dAtA[i:], m.Predicates[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+	if len(m.DataKeys) > 0 {
+		for iNdEx := len(m.DataKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataKeys[iNdEx])
+			copy(dAtA[i:], m.DataKeys[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.DataKeys[iNdEx])))
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if m.Anonymous {
+	if m.Encrypted {
 		i--
-		if m.Anonymous {
+		if m.Encrypted {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x78
 	}
-	if len(m.SessionToken) > 0 {
-		i -= len(m.SessionToken)
-		copy(dAtA[i:], m.SessionToken)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
+	if m.Storage != nil {
+		{
+			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x72
 	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
+	if m.NoConflict {
 		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AccessKey) > 0 {
-		i -= len(m.AccessKey)
-		copy(dAtA[i:], m.AccessKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+		if m.NoConflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x68
 	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+	if len(m.ObjectTypeName) > 0 {
+		i -= len(m.ObjectTypeName)
+		copy(dAtA[i:], m.ObjectTypeName)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.ObjectTypeName)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x62
 	}
-	if len(m.UnixTs) > 0 {
-		i -= len(m.UnixTs)
-		copy(dAtA[i:], m.UnixTs)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.UnixTs)))
+	if m.NonNullableList {
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		if m.NonNullableList {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x58
 	}
-	if m.SinceTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
+	if m.NonNullable {
 		i--
-		dAtA[i] = 0x10
+		if m.NonNullable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+	if m.Lang {
 		i--
-		dAtA[i] = 0x8
+		if m.Lang {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BackupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if m.Upsert {
+		i--
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.List {
+		i--
+		if m.List {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Count {
+		i--
+		if m.Count {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tokenizer) > 0 {
+		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokenizer[iNdEx])
+			copy(dAtA[i:], m.Tokenizer[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Directive != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Directive))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValueType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *BackupResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TypeUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10565,10 +10705,10 @@ func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DropOperations) > 0 {
-		for iNdEx := len(m.DropOperations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DropOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10576,13 +10716,20 @@ func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.TypeName) > 0 {
+		i -= len(m.TypeName)
+		copy(dAtA[i:], m.TypeName)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.TypeName)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DropOperation) Marshal() (dAtA []byte, err error) {
+func (m *MapHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10592,12 +10739,12 @@ func (m *DropOperation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DropOperation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MapHeader) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MapHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10606,22 +10753,19 @@ func (m *DropOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DropValue) > 0 {
-		i -= len(m.DropValue)
-		copy(dAtA[i:], m.DropValue)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DropOp != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
-		i--
-		dAtA[i] = 0x8
+	if len(m.PartitionKeys) > 0 {
+		for iNdEx := len(m.PartitionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionKeys[iNdEx])
+			copy(dAtA[i:], m.PartitionKeys[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.PartitionKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
+func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10631,12 +10775,12 @@ func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExportRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MovePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MovePredicatePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10645,70 +10789,37 @@ func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Anonymous {
-		i--
-		if m.Anonymous {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.SessionToken) > 0 {
-		i -= len(m.SessionToken)
-		copy(dAtA[i:], m.SessionToken)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AccessKey) > 0 {
-		i -= len(m.AccessKey)
-		copy(dAtA[i:], m.AccessKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+	if m.ExpectedChecksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if len(m.Format) > 0 {
-		i -= len(m.Format)
-		copy(dAtA[i:], m.Format)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Format)))
+	if m.TxnTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.TxnTs))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if m.UnixTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.UnixTs))
+	if m.DestGid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DestGid))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+	if m.SourceGid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SourceGid))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExportResponse) Marshal() (dAtA []byte, err error) {
+func (m *TxnStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10718,12 +10829,12 @@ func (m *ExportResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExportResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxnStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxnStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10732,31 +10843,29 @@ func (m *ExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Files) > 0 {
-		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Files[iNdEx])
-			copy(dAtA[i:], m.Files[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Files[iNdEx])))
+	if len(m.Preds) > 0 {
+		for iNdEx := len(m.Preds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Preds[iNdEx])
+			copy(dAtA[i:], m.Preds[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Preds[iNdEx])))
 			i--
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupKey) Marshal() (dAtA []byte, err error) {
+func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10766,12 +10875,12 @@ func (m *BackupKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleDelta) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10780,44 +10889,44 @@ func (m *BackupKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Count != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Term) > 0 {
-		i -= len(m.Term)
-		copy(dAtA[i:], m.Term)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Term)))
-		i--
-		dAtA[i] = 0x2a
+	if len(m.GroupChecksums) > 0 {
+		for k := range m.GroupChecksums {
+			v := m.GroupChecksums[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.StartUid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartUid))
+	if m.MaxAssigned != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
 	}
-	if m.Uid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Txns) > 0 {
+		for iNdEx := len(m.Txns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupPostingList) Marshal() (dAtA []byte, err error) {
+func (m *TxnTimestamps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10827,12 +10936,12 @@ func (m *BackupPostingList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupPostingList) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxnTimestamps) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxnTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10841,72 +10950,65 @@ func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.UidBytes) > 0 {
-		i -= len(m.UidBytes)
-		copy(dAtA[i:], m.UidBytes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.UidBytes)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Splits) > 0 {
-		dAtA37 := make([]byte, len(m.Splits)*10)
-		var j36 int
-		for _, num := range m.Splits {
+	if len(m.Ts) > 0 {
+		dAtA35 := make([]byte, len(m.Ts)*10)
+		var j34 int
+		for _, num := range m.Ts {
 			for num >= 1<<7 {
-				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j36++
+				j34++
 			}
-			dAtA37[j36] = uint8(num)
-			j36++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j36
-		copy(dAtA[i:], dAtA37[:j36])
-		i = encodeVarintPb(dAtA, i, uint64(j36))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintPb(dAtA, i, uint64(j34))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
-		i--
-		dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Postings) > 0 {
-		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return dAtA[:n], nil
+}
+
+func (m *PeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Uids) > 0 {
-		dAtA39 := make([]byte, len(m.Uids)*10)
-		var j38 int
-		for _, num := range m.Uids {
-			for num >= 1<<7 {
-				dAtA39[j38] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j38++
-			}
-			dAtA39[j38] = uint8(num)
-			j38++
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= j38
-		copy(dAtA[i:], dAtA39[:j38])
-		i = encodeVarintPb(dAtA, i, uint64(j38))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateGraphQLSchemaRequest) Marshal() (dAtA []byte, err error) {
+func (m *RaftBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10916,12 +11018,12 @@ func (m *UpdateGraphQLSchemaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGraphQLSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RaftBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateGraphQLSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10930,50 +11032,34 @@ func (m *UpdateGraphQLSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DgraphTypes) > 0 {
-		for iNdEx := len(m.DgraphTypes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DgraphTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.DgraphPreds) > 0 {
-		for iNdEx := len(m.DgraphPreds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DgraphPreds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.GraphqlSchema) > 0 {
-		i -= len(m.GraphqlSchema)
-		copy(dAtA[i:], m.GraphqlSchema)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.GraphqlSchema)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+	if m.Context != nil {
+		{
+			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateGraphQLSchemaResponse) Marshal() (dAtA []byte, err error) {
+func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10983,12 +11069,12 @@ func (m *UpdateGraphQLSchemaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGraphQLSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateGraphQLSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10997,15 +11083,19 @@ func (m *UpdateGraphQLSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Uid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BulkMeta) Marshal() (dAtA []byte, err error) {
+func (m *SubscriptionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11015,12 +11105,12 @@ func (m *BulkMeta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BulkMeta) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BulkMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11029,699 +11119,1057 @@ func (m *BulkMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.SchemaMap) > 0 {
-		for k := range m.SchemaMap {
-			v := m.SchemaMap[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
+	if m.Kvs != nil {
+		{
+			size, err := m.Kvs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPb(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
-	}
-	if m.EdgeCount != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.EdgeCount))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Num) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *List) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Uids) > 0 {
-		n += 1 + sovPb(uint64(len(m.Uids)*8)) + len(m.Uids)*8
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *Num) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Num) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Val)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ValType != 0 {
-		n += 1 + sovPb(uint64(m.ValType))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *SrcFunction) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+	if m.Forwarded {
+		i--
+		if m.Forwarded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.IsCount {
-		n += 2
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Val != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Val))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Query) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignedIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignedIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Langs) > 0 {
-		for _, s := range m.Langs {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.AfterUid != 0 {
-		n += 9
-	}
-	if m.DoCount {
-		n += 2
-	}
-	if m.UidList != nil {
-		l = m.UidList.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.SrcFunc != nil {
-		l = m.SrcFunc.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Reverse {
-		n += 2
-	}
-	if m.FacetParam != nil {
-		l = m.FacetParam.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.FacetsFilter != nil {
-		l = m.FacetsFilter.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ExpandAll {
-		n += 2
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Cache != 0 {
-		n += 1 + sovPb(uint64(m.Cache))
+	if m.ReadOnly != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadOnly))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.First != 0 {
-		n += 1 + sovPb(uint64(m.First))
+	if m.EndId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.EndId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.StartId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValueList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SnapshotMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClientTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ClientTs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LangList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Lang) > 0 {
-		for _, s := range m.Lang {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Result) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ValueMatrix) > 0 {
-		for _, e := range m.ValueMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Namespaces) > 0 {
+		dAtA40 := make([]byte, len(m.Namespaces)*10)
+		var j39 int
+		for _, num := range m.Namespaces {
+			for num >= 1<<7 {
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
+			}
+			dAtA40[j39] = uint8(num)
+			j39++
 		}
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintPb(dAtA, i, uint64(j39))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if len(m.Counts) > 0 {
-		l = 0
-		for _, e := range m.Counts {
-			l += sovPb(uint64(e))
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0x52
 		}
-		n += 1 + sovPb(uint64(l)) + l
 	}
-	if m.IntersectDest {
-		n += 2
-	}
-	if len(m.FacetMatrix) > 0 {
-		for _, e := range m.FacetMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.Anonymous {
+		i--
+		if m.Anonymous {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.LangMatrix) > 0 {
-		for _, e := range m.LangMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if len(m.SessionToken) > 0 {
+		i -= len(m.SessionToken)
+		copy(dAtA[i:], m.SessionToken)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.List {
-		n += 2
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.AccessKey) > 0 {
+		i -= len(m.AccessKey)
+		copy(dAtA[i:], m.AccessKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.UnixTs) > 0 {
+		i -= len(m.UnixTs)
+		copy(dAtA[i:], m.UnixTs)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.UnixTs)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Desc {
-		n += 2
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Langs) > 0 {
-		for _, s := range m.Langs {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.SinceTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SortMessage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Order) > 0 {
-		for _, e := range m.Order {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.DropOperations) > 0 {
+		for iNdEx := len(m.DropOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DropOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Count != 0 {
-		n += 1 + sovPb(uint64(m.Count))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovPb(uint64(m.Offset))
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SortResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DropOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RaftContext) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DropOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 9
-	}
-	if m.Group != 0 {
-		n += 1 + sovPb(uint64(m.Group))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.SnapshotTs != 0 {
-		n += 1 + sovPb(uint64(m.SnapshotTs))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsLearner {
-		n += 2
+	if len(m.DropValue) > 0 {
+		i -= len(m.DropValue)
+		copy(dAtA[i:], m.DropValue)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DropOp != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Member) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 9
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Anonymous {
+		i--
+		if m.Anonymous {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Leader {
-		n += 2
+	if len(m.SessionToken) > 0 {
+		i -= len(m.SessionToken)
+		copy(dAtA[i:], m.SessionToken)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.AmDead {
-		n += 2
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.LastUpdate != 0 {
-		n += 1 + sovPb(uint64(m.LastUpdate))
+	if len(m.AccessKey) > 0 {
+		i -= len(m.AccessKey)
+		copy(dAtA[i:], m.AccessKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Learner {
-		n += 2
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ClusterInfoOnly {
-		n += 2
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ForceGroupId {
-		n += 2
+	if m.UnixTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.UnixTs))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Group) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for k, v := range m.Members {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Tablets) > 0 {
-		for k, v := range m.Tablets {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Files[iNdEx])
+			copy(dAtA[i:], m.Files[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Files[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.SnapshotTs != 0 {
-		n += 1 + sovPb(uint64(m.SnapshotTs))
-	}
-	if m.Checksum != 0 {
-		n += 1 + sovPb(uint64(m.Checksum))
-	}
-	if m.CheckpointTs != 0 {
-		n += 1 + sovPb(uint64(m.CheckpointTs))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *License) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxNodes != 0 {
-		n += 1 + sovPb(uint64(m.MaxNodes))
+	if m.Count != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.ExpiryTs != 0 {
-		n += 1 + sovPb(uint64(m.ExpiryTs))
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Enabled {
-		n += 2
+	if m.StartUid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartUid))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Uid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ZeroProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupPostingList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupPostingList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SnapshotTs) > 0 {
-		for k, v := range m.SnapshotTs {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
-	}
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Tablet != nil {
-		l = m.Tablet.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxLeaseId != 0 {
-		n += 1 + sovPb(uint64(m.MaxLeaseId))
+	if len(m.UidBytes) > 0 {
+		i -= len(m.UidBytes)
+		copy(dAtA[i:], m.UidBytes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.UidBytes)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.MaxTxnTs != 0 {
-		n += 1 + sovPb(uint64(m.MaxTxnTs))
+	if len(m.Splits) > 0 {
+		dAtA42 := make([]byte, len(m.Splits)*10)
+		var j41 int
+		for _, num := range m.Splits {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintPb(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.MaxRaftId != 0 {
-		n += 1 + sovPb(uint64(m.MaxRaftId))
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Txn != nil {
-		l = m.Txn.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.License != nil {
-		l = m.License.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Snapshot != nil {
-		l = m.Snapshot.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Postings) > 0 {
+		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Uids) > 0 {
+		dAtA44 := make([]byte, len(m.Uids)*10)
+		var j43 int
+		for _, num := range m.Uids {
+			for num >= 1<<7 {
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j43++
+			}
+			dAtA44[j43] = uint8(num)
+			j43++
+		}
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintPb(dAtA, i, uint64(j43))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MembershipState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateGraphQLSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGraphQLSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateGraphQLSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Counter != 0 {
-		n += 1 + sovPb(uint64(m.Counter))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Groups) > 0 {
-		for k, v := range m.Groups {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DgraphTypes) > 0 {
+		for iNdEx := len(m.DgraphTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DgraphTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Zeros) > 0 {
-		for k, v := range m.Zeros {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
+	if len(m.DgraphPreds) > 0 {
+		for iNdEx := len(m.DgraphPreds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DgraphPreds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.MaxLeaseId != 0 {
-		n += 1 + sovPb(uint64(m.MaxLeaseId))
-	}
-	if m.MaxTxnTs != 0 {
-		n += 1 + sovPb(uint64(m.MaxTxnTs))
-	}
-	if m.MaxRaftId != 0 {
-		n += 1 + sovPb(uint64(m.MaxRaftId))
-	}
-	if len(m.Removed) > 0 {
-		for _, e := range m.Removed {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if len(m.GraphqlSchema) > 0 {
+		i -= len(m.GraphqlSchema)
+		copy(dAtA[i:], m.GraphqlSchema)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.GraphqlSchema)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.License != nil {
-		l = m.License.Size()
-		n += 1 + l + sovPb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateGraphQLSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGraphQLSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateGraphQLSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Uid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ConnectionState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BulkMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxPending != 0 {
-		n += 1 + sovPb(uint64(m.MaxPending))
+	if len(m.SchemaMap) > 0 {
+		for k := range m.SchemaMap {
+			v := m.SchemaMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.EdgeCount != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.EdgeCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HealthInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TabletLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TabletLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TabletLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Instance)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.WritesPerSec != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.WritesPerSec))))
+		i--
+		dAtA[i] = 0x11
 	}
-	l = len(m.Group)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.ReadsPerSec != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ReadsPerSec))))
+		i--
+		dAtA[i] = 0x9
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *TabletPin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Uptime != 0 {
-		n += 1 + sovPb(uint64(m.Uptime))
+	return dAtA[:n], nil
+}
+
+func (m *TabletPin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TabletPin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.LastEcho != 0 {
-		n += 1 + sovPb(uint64(m.LastEcho))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Ongoing) > 0 {
-		for _, s := range m.Ongoing {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Indexing) > 0 {
-		for _, s := range m.Indexing {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageHint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.EeFeatures) > 0 {
-		for _, s := range m.EeFeatures {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *StorageHint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageHint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxAssigned != 0 {
-		n += 1 + sovPb(uint64(m.MaxAssigned))
+	if m.Cache != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Cache))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Level != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Compression != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Tablet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Predicate)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.LeaseMs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LeaseMs))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Force {
-		n += 2
+	if m.Owner != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Owner))
+		i--
+		dAtA[i] = 0x11
 	}
-	if m.OnDiskBytes != 0 {
-		n += 1 + sovPb(uint64(m.OnDiskBytes))
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Keys[iNdEx]))
+		}
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Keys)*8))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Remove {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelIndexBuildRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ReadOnly {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *CancelIndexBuildRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelIndexBuildRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MoveTs != 0 {
-		n += 1 + sovPb(uint64(m.MoveTs))
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.UncompressedBytes != 0 {
-		n += 1 + sovPb(uint64(m.UncompressedBytes))
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *List) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Uids) > 0 {
+		n += 1 + sovPb(uint64(len(m.Uids)*8)) + len(m.Uids)*8
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11729,117 +12177,125 @@ func (m *Tablet) Size() (n int) {
 	return n
 }
 
-func (m *DirectedEdge) Size() (n int) {
+func (m *TaskValue) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Entity != 0 {
-		n += 9
-	}
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Value)
+	l = len(m.Val)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.ValueType != 0 {
-		n += 1 + sovPb(uint64(m.ValueType))
+	if m.ValType != 0 {
+		n += 1 + sovPb(uint64(m.ValType))
 	}
-	if m.ValueId != 0 {
-		n += 9
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	return n
+}
+
+func (m *SrcFunction) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Lang)
+	var l int
+	_ = l
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Op != 0 {
-		n += 1 + sovPb(uint64(m.Op))
-	}
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if len(m.AllowedPreds) > 0 {
-		for _, s := range m.AllowedPreds {
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
 			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
+	if m.IsCount {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Mutations) Size() (n int) {
+func (m *Query) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
+	l = len(m.Attr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Edges) > 0 {
-		for _, e := range m.Edges {
-			l = e.Size()
+	if len(m.Langs) > 0 {
+		for _, s := range m.Langs {
+			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if len(m.Schema) > 0 {
-		for _, e := range m.Schema {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.AfterUid != 0 {
+		n += 9
 	}
-	if len(m.Types) > 0 {
-		for _, e := range m.Types {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.DoCount {
+		n += 2
 	}
-	if m.DropOp != 0 {
-		n += 1 + sovPb(uint64(m.DropOp))
+	if m.UidList != nil {
+		l = m.UidList.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.DropValue)
-	if l > 0 {
+	if m.SrcFunc != nil {
+		l = m.SrcFunc.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	if m.Reverse {
+		n += 2
+	}
+	if m.FacetParam != nil {
+		l = m.FacetParam.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.FacetsFilter != nil {
+		l = m.FacetsFilter.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if m.ExpandAll {
+		n += 2
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
+	if m.Cache != 0 {
+		n += 1 + sovPb(uint64(m.Cache))
+	}
+	if m.First != 0 {
+		n += 1 + sovPb(uint64(m.First))
+	}
+	if m.Isolation != 0 {
+		n += 2 + sovPb(uint64(m.Isolation))
+	}
+	if m.Decrypt {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Metadata) Size() (n int) {
+func (m *ValueList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PredHints) > 0 {
-		for k, v := range m.PredHints {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
 		}
 	}
 	if m.XXX_unrecognized != nil {
@@ -11848,27 +12304,17 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
-func (m *Snapshot) Size() (n int) {
+func (m *LangList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Context != nil {
-		l = m.Context.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovPb(uint64(m.Index))
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
-	}
-	if m.Done {
-		n += 2
-	}
-	if m.SinceTs != 0 {
-		n += 1 + sovPb(uint64(m.SinceTs))
+	if len(m.Lang) > 0 {
+		for _, s := range m.Lang {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11876,21 +12322,51 @@ func (m *Snapshot) Size() (n int) {
 	return n
 }
 
-func (m *ZeroSnapshot) Size() (n int) {
+func (m *Result) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovPb(uint64(m.Index))
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.CheckpointTs != 0 {
-		n += 1 + sovPb(uint64(m.CheckpointTs))
+	if len(m.ValueMatrix) > 0 {
+		for _, e := range m.ValueMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.IntersectDest {
+		n += 2
+	}
+	if len(m.FacetMatrix) > 0 {
+		for _, e := range m.FacetMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.LangMatrix) > 0 {
+		for _, e := range m.LangMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.List {
+		n += 2
+	}
+	if len(m.ReadKeys) > 0 {
+		n += 1 + sovPb(uint64(len(m.ReadKeys)*8)) + len(m.ReadKeys)*8
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11898,71 +12374,24 @@ func (m *ZeroSnapshot) Size() (n int) {
 	return n
 }
 
-func (m *RestoreRequest) Size() (n int) {
+func (m *Order) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
-	if m.RestoreTs != 0 {
-		n += 1 + sovPb(uint64(m.RestoreTs))
-	}
-	l = len(m.Location)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.BackupId)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.AccessKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.SecretKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.SessionToken)
+	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Anonymous {
+	if m.Desc {
 		n += 2
 	}
-	l = len(m.EncryptionKeyFile)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultAddr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultRoleidFile)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultSecretidFile)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultPath)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultField)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultFormat)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.BackupNum != 0 {
-		n += 2 + sovPb(uint64(m.BackupNum))
+	if len(m.Langs) > 0 {
+		for _, s := range m.Langs {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11970,47 +12399,32 @@ func (m *RestoreRequest) Size() (n int) {
 	return n
 }
 
-func (m *Proposal) Size() (n int) {
+func (m *SortMessage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Mutations != nil {
-		l = m.Mutations.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Kv) > 0 {
-		for _, e := range m.Kv {
+	if len(m.Order) > 0 {
+		for _, e := range m.Order {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.CleanPredicate)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Delta != nil {
-		l = m.Delta.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Snapshot != nil {
-		l = m.Snapshot.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.Index != 0 {
-		n += 1 + sovPb(uint64(m.Index))
+	if m.Count != 0 {
+		n += 1 + sovPb(uint64(m.Count))
 	}
-	if m.ExpectedChecksum != 0 {
-		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	if m.Offset != 0 {
+		n += 1 + sovPb(uint64(m.Offset))
 	}
-	if m.Restore != nil {
-		l = m.Restore.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12018,78 +12432,45 @@ func (m *Proposal) Size() (n int) {
 	return n
 }
 
-func (m *KVS) Size() (n int) {
+func (m *SortResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Done {
-		n += 2
-	}
-	if len(m.Predicates) > 0 {
-		for _, s := range m.Predicates {
-			l = len(s)
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
+			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if len(m.Types) > 0 {
-		for _, s := range m.Types {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Posting) Size() (n int) {
+func (m *RaftContext) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Uid != 0 {
+	if m.Id != 0 {
 		n += 9
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ValType != 0 {
-		n += 1 + sovPb(uint64(m.ValType))
-	}
-	if m.PostingType != 0 {
-		n += 1 + sovPb(uint64(m.PostingType))
-	}
-	l = len(m.LangTag)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Group != 0 {
+		n += 1 + sovPb(uint64(m.Group))
 	}
-	l = len(m.Label)
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.Op != 0 {
-		n += 1 + sovPb(uint64(m.Op))
-	}
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
+	if m.SnapshotTs != 0 {
+		n += 1 + sovPb(uint64(m.SnapshotTs))
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
+	if m.IsLearner {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12097,45 +12478,62 @@ func (m *Posting) Size() (n int) {
 	return n
 }
 
-func (m *UidBlock) Size() (n int) {
+func (m *Member) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Base != 0 {
-		n += 1 + sovPb(uint64(m.Base))
+	if m.Id != 0 {
+		n += 9
 	}
-	l = len(m.Deltas)
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.NumUids != 0 {
-		n += 1 + sovPb(uint64(m.NumUids))
+	if m.Leader {
+		n += 2
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.AmDead {
+		n += 2
 	}
-	return n
-}
-
-func (m *UidPack) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LastUpdate != 0 {
+		n += 1 + sovPb(uint64(m.LastUpdate))
 	}
-	var l int
-	_ = l
-	if m.BlockSize != 0 {
-		n += 1 + sovPb(uint64(m.BlockSize))
+	if m.Learner {
+		n += 2
 	}
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.CpuUsage != 0 {
+		n += 9
+	}
+	if m.MemoryInUse != 0 {
+		n += 1 + sovPb(uint64(m.MemoryInUse))
+	}
+	if len(m.TabletLoads) > 0 {
+		for k, v := range m.TabletLoads {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
 		}
 	}
-	if m.AllocRef != 0 {
-		n += 2 + sovPb(uint64(m.AllocRef))
+	if m.ClusterInfoOnly {
+		n += 2
+	}
+	if m.ForceGroupId {
+		n += 2
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12143,31 +12541,46 @@ func (m *UidPack) Size() (n int) {
 	return n
 }
 
-func (m *PostingList) Size() (n int) {
+func (m *Group) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pack != nil {
-		l = m.Pack.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Members) > 0 {
+		for k, v := range m.Members {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
 	}
-	if len(m.Postings) > 0 {
-		for _, e := range m.Postings {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Tablets) > 0 {
+		for k, v := range m.Tablets {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
 		}
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
+	if m.SnapshotTs != 0 {
+		n += 1 + sovPb(uint64(m.SnapshotTs))
 	}
-	if len(m.Splits) > 0 {
-		l = 0
-		for _, e := range m.Splits {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	if m.Checksum != 0 {
+		n += 1 + sovPb(uint64(m.Checksum))
+	}
+	if m.CheckpointTs != 0 {
+		n += 1 + sovPb(uint64(m.CheckpointTs))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12175,102 +12588,81 @@ func (m *PostingList) Size() (n int) {
 	return n
 }
 
-func (m *FacetParam) Size() (n int) {
+func (m *License) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Alias)
+	l = len(m.User)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.MaxNodes != 0 {
+		n += 1 + sovPb(uint64(m.MaxNodes))
 	}
-	return n
-}
-
-func (m *FacetParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ExpiryTs != 0 {
+		n += 1 + sovPb(uint64(m.ExpiryTs))
 	}
-	var l int
-	_ = l
-	if m.AllKeys {
+	if m.Enabled {
 		n += 2
 	}
-	if len(m.Param) > 0 {
-		for _, e := range m.Param {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Facets) Size() (n int) {
+func (m *ZeroProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.SnapshotTs) > 0 {
+		for k, v := range m.SnapshotTs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *FacetsList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Tablet != nil {
+		l = m.Tablet.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	var l int
-	_ = l
-	if len(m.FacetsList) > 0 {
-		for _, e := range m.FacetsList {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.MaxLeaseId != 0 {
+		n += 1 + sovPb(uint64(m.MaxLeaseId))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.MaxTxnTs != 0 {
+		n += 1 + sovPb(uint64(m.MaxTxnTs))
 	}
-	return n
-}
-
-func (m *Function) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxRaftId != 0 {
+		n += 1 + sovPb(uint64(m.MaxRaftId))
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
+	if m.Txn != nil {
+		l = m.Txn.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Key)
+	l = len(m.Cid)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.License != nil {
+		l = m.License.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Pin != nil {
+		l = m.Pin.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12278,58 +12670,94 @@ func (m *Function) Size() (n int) {
 	return n
 }
 
-func (m *FilterTree) Size() (n int) {
+func (m *MembershipState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Op)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Counter != 0 {
+		n += 1 + sovPb(uint64(m.Counter))
 	}
-	if len(m.Children) > 0 {
-		for _, e := range m.Children {
+	if len(m.Groups) > 0 {
+		for k, v := range m.Groups {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Zeros) > 0 {
+		for k, v := range m.Zeros {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if m.MaxLeaseId != 0 {
+		n += 1 + sovPb(uint64(m.MaxLeaseId))
+	}
+	if m.MaxTxnTs != 0 {
+		n += 1 + sovPb(uint64(m.MaxTxnTs))
+	}
+	if m.MaxRaftId != 0 {
+		n += 1 + sovPb(uint64(m.MaxRaftId))
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.Func != nil {
-		l = m.Func.Size()
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.License != nil {
+		l = m.License.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if len(m.PinnedTablets) > 0 {
+		for k, v := range m.PinnedTablets {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SchemaRequest) Size() (n int) {
+func (m *ConnectionState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
-	if len(m.Predicates) > 0 {
-		for _, s := range m.Predicates {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Fields) > 0 {
-		for _, s := range m.Fields {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Types) > 0 {
-		for _, s := range m.Types {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.MaxPending != 0 {
+		n += 1 + sovPb(uint64(m.MaxPending))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12337,45 +12765,60 @@ func (m *SchemaRequest) Size() (n int) {
 	return n
 }
 
-func (m *SchemaNode) Size() (n int) {
+func (m *HealthInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Predicate)
+	l = len(m.Instance)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Type)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Index {
-		n += 2
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Tokenizer) > 0 {
-		for _, s := range m.Tokenizer {
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Uptime != 0 {
+		n += 1 + sovPb(uint64(m.Uptime))
+	}
+	if m.LastEcho != 0 {
+		n += 1 + sovPb(uint64(m.LastEcho))
+	}
+	if len(m.Ongoing) > 0 {
+		for _, s := range m.Ongoing {
 			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.Reverse {
-		n += 2
-	}
-	if m.Count {
-		n += 2
-	}
-	if m.List {
-		n += 2
+	if len(m.Indexing) > 0 {
+		for _, s := range m.Indexing {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.Upsert {
-		n += 2
+	if len(m.EeFeatures) > 0 {
+		for _, s := range m.EeFeatures {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.Lang {
-		n += 2
+	if m.MaxAssigned != 0 {
+		n += 1 + sovPb(uint64(m.MaxAssigned))
 	}
-	if m.NoConflict {
+	if m.Learner {
 		n += 2
 	}
 	if m.XXX_unrecognized != nil {
@@ -12384,70 +12827,36 @@ func (m *SchemaNode) Size() (n int) {
 	return n
 }
 
-func (m *SchemaResult) Size() (n int) {
+func (m *Tablet) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Schema) > 0 {
-		for _, e := range m.Schema {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *SchemaUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
 	}
-	var l int
-	_ = l
 	l = len(m.Predicate)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.ValueType != 0 {
-		n += 1 + sovPb(uint64(m.ValueType))
-	}
-	if m.Directive != 0 {
-		n += 1 + sovPb(uint64(m.Directive))
-	}
-	if len(m.Tokenizer) > 0 {
-		for _, s := range m.Tokenizer {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.Count {
-		n += 2
-	}
-	if m.List {
-		n += 2
-	}
-	if m.Upsert {
+	if m.Force {
 		n += 2
 	}
-	if m.Lang {
-		n += 2
+	if m.OnDiskBytes != 0 {
+		n += 1 + sovPb(uint64(m.OnDiskBytes))
 	}
-	if m.NonNullable {
+	if m.Remove {
 		n += 2
 	}
-	if m.NonNullableList {
+	if m.ReadOnly {
 		n += 2
 	}
-	l = len(m.ObjectTypeName)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.MoveTs != 0 {
+		n += 1 + sovPb(uint64(m.MoveTs))
 	}
-	if m.NoConflict {
-		n += 2
+	if m.UncompressedBytes != 0 {
+		n += 1 + sovPb(uint64(m.UncompressedBytes))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12455,37 +12864,49 @@ func (m *SchemaUpdate) Size() (n int) {
 	return n
 }
 
-func (m *TypeUpdate) Size() (n int) {
+func (m *DirectedEdge) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.TypeName)
+	if m.Entity != 0 {
+		n += 9
+	}
+	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ValueType != 0 {
+		n += 1 + sovPb(uint64(m.ValueType))
+	}
+	if m.ValueId != 0 {
+		n += 9
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Lang)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Op != 0 {
+		n += 1 + sovPb(uint64(m.Op))
+	}
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *MapHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PartitionKeys) > 0 {
-		for _, b := range m.PartitionKeys {
-			l = len(b)
+	if len(m.AllowedPreds) > 0 {
+		for _, s := range m.AllowedPreds {
+			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
@@ -12495,127 +12916,45 @@ func (m *MapHeader) Size() (n int) {
 	return n
 }
 
-func (m *MovePredicatePayload) Size() (n int) {
+func (m *Mutations) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Predicate)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.SourceGid != 0 {
-		n += 1 + sovPb(uint64(m.SourceGid))
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
 	}
-	if m.DestGid != 0 {
-		n += 1 + sovPb(uint64(m.DestGid))
-	}
-	if m.TxnTs != 0 {
-		n += 1 + sovPb(uint64(m.TxnTs))
-	}
-	if m.ExpectedChecksum != 0 {
-		n += 1 + sovPb(uint64(m.ExpectedChecksum))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *TxnStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
 	if m.StartTs != 0 {
 		n += 1 + sovPb(uint64(m.StartTs))
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *OracleDelta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Txns) > 0 {
-		for _, e := range m.Txns {
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.MaxAssigned != 0 {
-		n += 1 + sovPb(uint64(m.MaxAssigned))
-	}
-	if len(m.GroupChecksums) > 0 {
-		for k, v := range m.GroupChecksums {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+	if len(m.Schema) > 0 {
+		for _, e := range m.Schema {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *TxnTimestamps) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ts) > 0 {
-		l = 0
-		for _, e := range m.Ts {
-			l += sovPb(uint64(e))
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
 		}
-		n += 1 + sovPb(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *PeerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *RaftBatch) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DropOp != 0 {
+		n += 1 + sovPb(uint64(m.DropOp))
 	}
-	var l int
-	_ = l
-	if m.Context != nil {
-		l = m.Context.Size()
+	l = len(m.DropValue)
+	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Payload != nil {
-		l = m.Payload.Size()
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -12624,16 +12963,18 @@ func (m *RaftBatch) Size() (n int) {
 	return n
 }
 
-func (m *SubscriptionRequest) Size() (n int) {
+func (m *Metadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Prefixes) > 0 {
-		for _, b := range m.Prefixes {
-			l = len(b)
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.PredHints) > 0 {
+		for k, v := range m.PredHints {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
 		}
 	}
 	if m.XXX_unrecognized != nil {
@@ -12642,36 +12983,27 @@ func (m *SubscriptionRequest) Size() (n int) {
 	return n
 }
 
-func (m *SubscriptionResponse) Size() (n int) {
+func (m *Snapshot) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Kvs != nil {
-		l = m.Kvs.Size()
+	if m.Context != nil {
+		l = m.Context.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Num) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Index != 0 {
+		n += 1 + sovPb(uint64(m.Index))
 	}
-	var l int
-	_ = l
-	if m.Val != 0 {
-		n += 1 + sovPb(uint64(m.Val))
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
 	}
-	if m.ReadOnly {
+	if m.Done {
 		n += 2
 	}
-	if m.Forwarded {
-		n += 2
+	if m.SinceTs != 0 {
+		n += 1 + sovPb(uint64(m.SinceTs))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12679,20 +13011,21 @@ func (m *Num) Size() (n int) {
 	return n
 }
 
-func (m *AssignedIds) Size() (n int) {
+func (m *ZeroSnapshot) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StartId != 0 {
-		n += 1 + sovPb(uint64(m.StartId))
+	if m.Index != 0 {
+		n += 1 + sovPb(uint64(m.Index))
 	}
-	if m.EndId != 0 {
-		n += 1 + sovPb(uint64(m.EndId))
+	if m.CheckpointTs != 0 {
+		n += 1 + sovPb(uint64(m.CheckpointTs))
 	}
-	if m.ReadOnly != 0 {
-		n += 1 + sovPb(uint64(m.ReadOnly))
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12700,86 +13033,71 @@ func (m *AssignedIds) Size() (n int) {
 	return n
 }
 
-func (m *SnapshotMeta) Size() (n int) {
+func (m *RestoreRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ClientTs != 0 {
-		n += 1 + sovPb(uint64(m.ClientTs))
-	}
 	if m.GroupId != 0 {
 		n += 1 + sovPb(uint64(m.GroupId))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Status) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RestoreTs != 0 {
+		n += 1 + sovPb(uint64(m.RestoreTs))
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Msg)
+	l = len(m.BackupId)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.AccessKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *BackupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
+	l = len(m.SessionToken)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.SinceTs != 0 {
-		n += 1 + sovPb(uint64(m.SinceTs))
+	if m.Anonymous {
+		n += 2
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	l = len(m.EncryptionKeyFile)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.UnixTs)
+	l = len(m.VaultAddr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Destination)
+	l = len(m.VaultRoleidFile)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.AccessKey)
+	l = len(m.VaultSecretidFile)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.SecretKey)
+	l = len(m.VaultPath)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.SessionToken)
+	l = len(m.VaultField)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Anonymous {
-		n += 2
+	l = len(m.VaultFormat)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Predicates) > 0 {
-		for _, s := range m.Predicates {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.BackupNum != 0 {
+		n += 2 + sovPb(uint64(m.BackupNum))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12787,34 +13105,80 @@ func (m *BackupRequest) Size() (n int) {
 	return n
 }
 
-func (m *BackupResponse) Size() (n int) {
+func (m *Proposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.DropOperations) > 0 {
-		for _, e := range m.DropOperations {
+	if m.Mutations != nil {
+		l = m.Mutations.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Kv) > 0 {
+		for _, e := range m.Kv {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.CleanPredicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Delta != nil {
+		l = m.Delta.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPb(uint64(m.Index))
+	}
+	if m.ExpectedChecksum != 0 {
+		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	}
+	if m.Restore != nil {
+		l = m.Restore.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.CancelIndexBuild)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
 	return n
 }
 
-func (m *DropOperation) Size() (n int) {
+func (m *KVS) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DropOp != 0 {
-		n += 1 + sovPb(uint64(m.DropOp))
+	if m.Done {
+		n += 2
 	}
-	l = len(m.DropValue)
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
@@ -12824,43 +13188,47 @@ func (m *DropOperation) Size() (n int) {
 	return n
 }
 
-func (m *ExportRequest) Size() (n int) {
+func (m *Posting) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
-	}
-	if m.UnixTs != 0 {
-		n += 1 + sovPb(uint64(m.UnixTs))
+	if m.Uid != 0 {
+		n += 9
 	}
-	l = len(m.Format)
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.ValType != 0 {
+		n += 1 + sovPb(uint64(m.ValType))
 	}
-	l = len(m.AccessKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.PostingType != 0 {
+		n += 1 + sovPb(uint64(m.PostingType))
 	}
-	l = len(m.SecretKey)
+	l = len(m.LangTag)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.SessionToken)
+	l = len(m.Label)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Anonymous {
-		n += 2
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Op != 0 {
+		n += 1 + sovPb(uint64(m.Op))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12868,24 +13236,21 @@ func (m *ExportRequest) Size() (n int) {
 	return n
 }
 
-func (m *ExportResponse) Size() (n int) {
+func (m *UidBlock) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if m.Base != 0 {
+		n += 1 + sovPb(uint64(m.Base))
 	}
-	l = len(m.Msg)
+	l = len(m.Deltas)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Files) > 0 {
-		for _, s := range m.Files {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.NumUids != 0 {
+		n += 1 + sovPb(uint64(m.NumUids))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12893,31 +13258,23 @@ func (m *ExportResponse) Size() (n int) {
 	return n
 }
 
-func (m *BackupKey) Size() (n int) {
+func (m *UidPack) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPb(uint64(m.Type))
-	}
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Uid != 0 {
-		n += 1 + sovPb(uint64(m.Uid))
-	}
-	if m.StartUid != 0 {
-		n += 1 + sovPb(uint64(m.StartUid))
+	if m.BlockSize != 0 {
+		n += 1 + sovPb(uint64(m.BlockSize))
 	}
-	l = len(m.Term)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.Count != 0 {
-		n += 1 + sovPb(uint64(m.Count))
+	if m.AllocRef != 0 {
+		n += 2 + sovPb(uint64(m.AllocRef))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -12925,18 +13282,15 @@ func (m *BackupKey) Size() (n int) {
 	return n
 }
 
-func (m *BackupPostingList) Size() (n int) {
+func (m *PostingList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Uids) > 0 {
-		l = 0
-		for _, e := range m.Uids {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	if m.Pack != nil {
+		l = m.Pack.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
 	if len(m.Postings) > 0 {
 		for _, e := range m.Postings {
@@ -12954,37 +13308,61 @@ func (m *BackupPostingList) Size() (n int) {
 		}
 		n += 1 + sovPb(uint64(l)) + l
 	}
-	l = len(m.UidBytes)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *UpdateGraphQLSchemaRequest) Size() (n int) {
+func (m *FacetParam) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.GraphqlSchema)
+	l = len(m.Alias)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.DgraphPreds) > 0 {
-		for _, e := range m.DgraphPreds {
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FacetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllKeys {
+		n += 2
+	}
+	if len(m.Param) > 0 {
+		for _, e := range m.Param {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if len(m.DgraphTypes) > 0 {
-		for _, e := range m.DgraphTypes {
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Facets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
@@ -12995,14 +13373,17 @@ func (m *UpdateGraphQLSchemaRequest) Size() (n int) {
 	return n
 }
 
-func (m *UpdateGraphQLSchemaResponse) Size() (n int) {
+func (m *FacetsList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Uid != 0 {
-		n += 1 + sovPb(uint64(m.Uid))
+	if len(m.FacetsList) > 0 {
+		for _, e := range m.FacetsList {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -13010,26 +13391,24 @@ func (m *UpdateGraphQLSchemaResponse) Size() (n int) {
 	return n
 }
 
-func (m *BulkMeta) Size() (n int) {
+func (m *Function) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EdgeCount != 0 {
-		n += 1 + sovPb(uint64(m.EdgeCount))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.SchemaMap) > 0 {
-		for k, v := range m.SchemaMap {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
 		}
 	}
 	if m.XXX_unrecognized != nil {
@@ -13038,42 +13417,2123 @@ func (m *BulkMeta) Size() (n int) {
 	return n
 }
 
-func sovPb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPb(x uint64) (n int) {
-	return sovPb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *List) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
+func (m *FilterTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Func != nil {
+		l = m.Func.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Index {
+		n += 2
+	}
+	if len(m.Tokenizer) > 0 {
+		for _, s := range m.Tokenizer {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.Count {
+		n += 2
+	}
+	if m.List {
+		n += 2
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.Lang {
+		n += 2
+	}
+	if m.NoConflict {
+		n += 2
+	}
+	l = len(m.Storage)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Encrypted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		for _, e := range m.Schema {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ValueType != 0 {
+		n += 1 + sovPb(uint64(m.ValueType))
+	}
+	if m.Directive != 0 {
+		n += 1 + sovPb(uint64(m.Directive))
+	}
+	if len(m.Tokenizer) > 0 {
+		for _, s := range m.Tokenizer {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Count {
+		n += 2
+	}
+	if m.List {
+		n += 2
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.Lang {
+		n += 2
+	}
+	if m.NonNullable {
+		n += 2
+	}
+	if m.NonNullableList {
+		n += 2
+	}
+	l = len(m.ObjectTypeName)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.NoConflict {
+		n += 2
+	}
+	if m.Storage != nil {
+		l = m.Storage.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Encrypted {
+		n += 2
+	}
+	if len(m.DataKeys) > 0 {
+		for _, b := range m.DataKeys {
+			l = len(b)
+			n += 2 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TypeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MapHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartitionKeys) > 0 {
+		for _, b := range m.PartitionKeys {
+			l = len(b)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MovePredicatePayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.SourceGid != 0 {
+		n += 1 + sovPb(uint64(m.SourceGid))
+	}
+	if m.DestGid != 0 {
+		n += 1 + sovPb(uint64(m.DestGid))
+	}
+	if m.TxnTs != 0 {
+		n += 1 + sovPb(uint64(m.TxnTs))
+	}
+	if m.ExpectedChecksum != 0 {
+		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	if len(m.Preds) > 0 {
+		for _, s := range m.Preds {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OracleDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txns) > 0 {
+		for _, e := range m.Txns {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.MaxAssigned != 0 {
+		n += 1 + sovPb(uint64(m.MaxAssigned))
+	}
+	if len(m.GroupChecksums) > 0 {
+		for k, v := range m.GroupChecksums {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TxnTimestamps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ts) > 0 {
+		l = 0
+		for _, e := range m.Ts {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for _, b := range m.Prefixes {
+			l = len(b)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kvs != nil {
+		l = m.Kvs.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Num) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Val != 0 {
+		n += 1 + sovPb(uint64(m.Val))
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.Forwarded {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignedIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartId != 0 {
+		n += 1 + sovPb(uint64(m.StartId))
+	}
+	if m.EndId != 0 {
+		n += 1 + sovPb(uint64(m.EndId))
+	}
+	if m.ReadOnly != 0 {
+		n += 1 + sovPb(uint64(m.ReadOnly))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientTs != 0 {
+		n += 1 + sovPb(uint64(m.ClientTs))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovPb(uint64(m.SinceTs))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	l = len(m.UnixTs)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.AccessKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SessionToken)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Anonymous {
+		n += 2
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		l = 0
+		for _, e := range m.Namespaces {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DropOperations) > 0 {
+		for _, e := range m.DropOperations {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DropOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropOp != 0 {
+		n += 1 + sovPb(uint64(m.DropOp))
+	}
+	l = len(m.DropValue)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
+	if m.UnixTs != 0 {
+		n += 1 + sovPb(uint64(m.UnixTs))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.AccessKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SessionToken)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Anonymous {
+		n += 2
+	}
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Files) > 0 {
+		for _, s := range m.Files {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPb(uint64(m.Type))
+	}
+	l = len(m.Attr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Uid != 0 {
+		n += 1 + sovPb(uint64(m.Uid))
+	}
+	if m.StartUid != 0 {
+		n += 1 + sovPb(uint64(m.StartUid))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPb(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupPostingList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Uids) > 0 {
+		l = 0
+		for _, e := range m.Uids {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.Postings) > 0 {
+		for _, e := range m.Postings {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	if len(m.Splits) > 0 {
+		l = 0
+		for _, e := range m.Splits {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	l = len(m.UidBytes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateGraphQLSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	l = len(m.GraphqlSchema)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.DgraphPreds) > 0 {
+		for _, e := range m.DgraphPreds {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.DgraphTypes) > 0 {
+		for _, e := range m.DgraphTypes {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateGraphQLSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Uid != 0 {
+		n += 1 + sovPb(uint64(m.Uid))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EdgeCount != 0 {
+		n += 1 + sovPb(uint64(m.EdgeCount))
+	}
+	if len(m.SchemaMap) > 0 {
+		for k, v := range m.SchemaMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TabletLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReadsPerSec != 0 {
+		n += 9
+	}
+	if m.WritesPerSec != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TabletPin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageHint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compression != 0 {
+		n += 1 + sovPb(uint64(m.Compression))
+	}
+	if m.Level != 0 {
+		n += 1 + sovPb(uint64(m.Level))
+	}
+	if m.Cache != 0 {
+		n += 1 + sovPb(uint64(m.Cache))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		n += 1 + sovPb(uint64(len(m.Keys)*8)) + len(m.Keys)*8
+	}
+	if m.Owner != 0 {
+		n += 9
+	}
+	if m.LeaseMs != 0 {
+		n += 1 + sovPb(uint64(m.LeaseMs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelIndexBuildRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPb(x uint64) (n int) {
+	return sovPb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.Uids = append(m.Uids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Uids) == 0 {
+					m.Uids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.Uids = append(m.Uids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
+			if m.Val == nil {
+				m.Val = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			}
+			m.ValType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValType |= Posting_ValType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCount = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
+			}
+			m.AfterUid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterUid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoCount = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UidList == nil {
+				m.UidList = &List{}
+			}
+			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SrcFunc == nil {
+				m.SrcFunc = &SrcFunction{}
+			}
+			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetParam == nil {
+				m.FacetParam = &FacetParams{}
+			}
+			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetsFilter == nil {
+				m.FacetsFilter = &FilterTree{}
+			}
+			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpandAll = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			m.Cache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cache |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			m.First = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.First |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isolation", wireType)
+			}
+			m.Isolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Isolation |= Query_Isolation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrypt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decrypt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &TaskValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LangList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: List: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
+			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Counts) == 0 {
+					m.Counts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntersectDest = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
+			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LangMatrix = append(m.LangMatrix, &LangList{})
+			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.List = bool(v != 0)
+		case 8:
 			if wireType == 1 {
 				var v uint64
 				if (iNdEx + 8) > l {
@@ -13081,7 +15541,7 @@ func (m *List) Unmarshal(dAtA []byte) error {
 				}
 				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 				iNdEx += 8
-				m.Uids = append(m.Uids, v)
+				m.ReadKeys = append(m.ReadKeys, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -13110,8 +15570,8 @@ func (m *List) Unmarshal(dAtA []byte) error {
 				}
 				var elementCount int
 				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Uids) == 0 {
-					m.Uids = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.ReadKeys) == 0 {
+					m.ReadKeys = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -13120,10 +15580,10 @@ func (m *List) Unmarshal(dAtA []byte) error {
 					}
 					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 					iNdEx += 8
-					m.Uids = append(m.Uids, v)
+					m.ReadKeys = append(m.ReadKeys, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadKeys", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -13147,7 +15607,7 @@ func (m *List) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskValue) Unmarshal(dAtA []byte) error {
+func (m *Order) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13170,17 +15630,17 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13190,31 +15650,238 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
-			if m.Val == nil {
-				m.Val = []byte{}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = append(m.Order, &Order{})
+			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
 			}
-			m.ValType = 0
+			m.ReadTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13224,7 +15891,7 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= Posting_ValType(b&0x7F) << shift
+				m.ReadTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13251,7 +15918,7 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+func (m *SortResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13274,17 +15941,17 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
+			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13294,76 +15961,26 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsCount = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -13386,7 +16003,7 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13409,17 +16026,27 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13429,27 +16056,14 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Group |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13477,23 +16091,13 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
-			}
-			m.AfterUid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AfterUid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
 			}
-			var v int
+			m.SnapshotTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13503,17 +16107,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SnapshotTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DoCount = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13523,69 +16126,78 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
+			m.IsLearner = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UidList == nil {
-				m.UidList = &List{}
-			}
-			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			if postIndex > l {
+			m.Id = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SrcFunc == nil {
-				m.SrcFunc = &SrcFunction{}
-			}
-			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var v int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13595,17 +16207,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reverse = bool(v != 0)
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13615,33 +16226,29 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FacetParam == nil {
-				m.FacetParam = &FacetParams{}
-			}
-			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13651,31 +16258,15 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FacetsFilter == nil {
-				m.FacetsFilter = &FilterTree{}
-			}
-			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+			m.Leader = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -13692,12 +16283,12 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ExpandAll = bool(v != 0)
-		case 13:
+			m.AmDead = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
 			}
-			m.ReadTs = 0
+			m.LastUpdate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13707,16 +16298,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				m.LastUpdate |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
 			}
-			m.Cache = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13726,16 +16317,28 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cache |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			m.Learner = bool(v != 0)
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsage", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CpuUsage = float64(math.Float64frombits(v))
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryInUse", wireType)
 			}
-			m.First = 0
+			m.MemoryInUse = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13745,67 +16348,165 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.First |= int32(b&0x7F) << shift
+				m.MemoryInUse |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletLoads", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValueList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			if m.TabletLoads == nil {
+				m.TabletLoads = make(map[string]*TabletLoad)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *TabletLoad
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TabletLoad{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TabletLoads[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+			m.ClusterInfoOnly = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceGroupId", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -13815,80 +16516,15 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Values = append(m.Values, &TaskValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LangList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ForceGroupId = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13916,7 +16552,7 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
+			m.Zone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13940,7 +16576,7 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *Group) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13963,15 +16599,15 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13998,14 +16634,95 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Members == nil {
+				m.Members = make(map[uint64]*Member)
+			}
+			var mapkey uint64
+			var mapvalue *Member
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Member{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Members[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14032,14 +16749,14 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
-			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Tablets == nil {
+				m.Tablets = make(map[string]*Tablet)
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint32
+			var mapkey string
+			var mapvalue *Tablet
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowPb
@@ -14049,51 +16766,43 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= uint32(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.Counts = append(m.Counts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
 					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
 					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Counts) == 0 {
-					m.Counts = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowPb
@@ -14103,41 +16812,48 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint32(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Counts = append(m.Counts, v)
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Tablet{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
-		case 4:
+			m.Tablets[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IntersectDest = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
 			}
-			var msglen int
+			m.SnapshotTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14147,31 +16863,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SnapshotTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
-			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			m.Checksum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14181,31 +16882,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Checksum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LangMatrix = append(m.LangMatrix, &LangList{})
-			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
 			}
-			var v int
+			m.CheckpointTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14215,12 +16901,11 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CheckpointTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.List = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14243,7 +16928,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *License) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14266,15 +16951,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: License: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: License: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14302,13 +16987,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNodes", wireType)
 			}
-			var v int
+			m.MaxNodes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14318,17 +17003,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxNodes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Desc = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTs", wireType)
 			}
-			var stringLen uint64
+			m.ExpiryTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14338,24 +17022,31 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiryTs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14378,7 +17069,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SortMessage) Unmarshal(dAtA []byte) error {
+func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14397,19 +17088,118 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SnapshotTs == nil {
+				m.SnapshotTs = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SnapshotTs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14436,14 +17226,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Order = append(m.Order, &Order{})
-			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14470,16 +17262,18 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tablet == nil {
+				m.Tablet = &Tablet{}
+			}
+			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
 			}
-			m.Count = 0
+			m.MaxLeaseId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14489,16 +17283,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				m.MaxLeaseId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
 			}
-			m.Offset = 0
+			m.MaxTxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14508,16 +17302,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				m.MaxTxnTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
 			}
-			m.ReadTs = 0
+			m.MaxRaftId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14527,65 +17321,14 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				m.MaxRaftId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SortResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14612,77 +17355,50 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Txn == nil {
+				m.Txn = &api.TxnContext{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RaftContext) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
 			}
-			m.Group = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14692,16 +17408,33 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.License == nil {
+				m.License = &License{}
+			}
+			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14711,29 +17444,33 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			if m.Snapshot == nil {
+				m.Snapshot = &ZeroSnapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pin", wireType)
 			}
-			m.SnapshotTs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14743,31 +17480,28 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.IsLearner = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pin == nil {
+				m.Pin = &TabletPin{}
+			}
+			if err := m.Pin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14790,7 +17524,7 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14813,27 +17547,36 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14843,16 +17586,112 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Groups == nil {
+				m.Groups = make(map[uint32]*Group)
+			}
+			var mapkey uint32
+			var mapvalue *Group
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Group{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Groups[mapkey] = mapvalue
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14862,29 +17701,112 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Zeros == nil {
+				m.Zeros = make(map[uint64]*Member)
+			}
+			var mapkey uint64
+			var mapvalue *Member
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Member{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Zeros[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
 			}
-			var v int
+			m.MaxLeaseId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14894,17 +17816,16 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxLeaseId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Leader = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
 			}
-			var v int
+			m.MaxTxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14914,17 +17835,16 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxTxnTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AmDead = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
 			}
-			m.LastUpdate = 0
+			m.MaxRaftId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14934,16 +17854,16 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdate |= uint64(b&0x7F) << shift
+				m.MaxRaftId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14953,37 +17873,31 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Learner = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.ClusterInfoOnly = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceGroupId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.Removed = append(m.Removed, &Member{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14993,66 +17907,27 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceGroupId = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Group) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15079,95 +17954,16 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Members == nil {
-				m.Members = make(map[uint64]*Member)
-			}
-			var mapkey uint64
-			var mapvalue *Member
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Member{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Members[mapkey] = mapvalue
+			if m.License == nil {
+				m.License = &License{}
+			}
+			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedTablets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15194,11 +17990,11 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tablets == nil {
-				m.Tablets = make(map[string]*Tablet)
+			if m.PinnedTablets == nil {
+				m.PinnedTablets = make(map[string]uint32)
 			}
 			var mapkey string
-			var mapvalue *Tablet
+			var mapvalue uint32
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -15247,7 +18043,6 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowPb
@@ -15257,26 +18052,11 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						mapvalue |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Tablet{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipPb(dAtA[iNdEx:])
@@ -15292,13 +18072,136 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Tablets[mapkey] = mapvalue
+			m.PinnedTablets[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
 			}
-			m.SnapshotTs = 0
+			m.MaxPending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15308,16 +18211,67 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTs |= uint64(b&0x7F) << shift
+				m.MaxPending |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Checksum = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15327,16 +18281,61 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Checksum |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.CheckpointTs = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15346,65 +18345,27 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CheckpointTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *License) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: License: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: License: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15432,13 +18393,13 @@ func (m *License) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNodes", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.MaxNodes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15448,16 +18409,29 @@ func (m *License) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNodes |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			m.ExpiryTs = 0
+			m.Uptime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15467,16 +18441,16 @@ func (m *License) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiryTs |= int64(b&0x7F) << shift
+				m.Uptime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEcho", wireType)
 			}
-			var v int
+			m.LastEcho = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15486,68 +18460,16 @@ func (m *License) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.LastEcho |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ongoing", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15557,96 +18479,29 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SnapshotTs == nil {
-				m.SnapshotTs = make(map[uint32]uint64)
-			}
-			var mapkey uint32
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SnapshotTs[mapkey] = mapvalue
+			m.Ongoing = append(m.Ongoing, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexing", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15656,33 +18511,29 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Indexing = append(m.Indexing, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EeFeatures", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15692,33 +18543,29 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tablet == nil {
-				m.Tablet = &Tablet{}
-			}
-			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EeFeatures = append(m.EeFeatures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
 			}
-			m.MaxLeaseId = 0
+			m.MaxAssigned = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15728,16 +18575,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxLeaseId |= uint64(b&0x7F) << shift
+				m.MaxAssigned |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
 			}
-			m.MaxTxnTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15747,16 +18594,68 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTxnTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.Learner = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tablet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.MaxRaftId = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15766,16 +18665,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= uint64(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15785,33 +18684,29 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Txn == nil {
-				m.Txn = &api.TxnContext{}
-			}
-			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15821,29 +18716,17 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+			m.Force = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDiskBytes", wireType)
 			}
-			var msglen int
+			m.OnDiskBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15853,33 +18736,36 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OnDiskBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.License == nil {
-				m.License = &License{}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			m.Remove = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15889,28 +18775,50 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.ReadOnly = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveTs", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MoveTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Snapshot == nil {
-				m.Snapshot = &ZeroSnapshot{}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedBytes", wireType)
 			}
-			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UncompressedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15933,7 +18841,7 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MembershipState) Unmarshal(dAtA []byte) error {
+func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15956,17 +18864,27 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
 			}
-			m.Counter = 0
+			m.Entity = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entity = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15976,16 +18894,29 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Counter |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15995,112 +18926,31 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Groups == nil {
-				m.Groups = make(map[uint32]*Group)
-			}
-			var mapkey uint32
-			var mapvalue *Group
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Group{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			m.Groups[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
 			}
-			var msglen int
+			m.ValueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16110,112 +18960,26 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ValueType |= Posting_ValType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
 			}
-			if postIndex > l {
+			m.ValueId = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Zeros == nil {
-				m.Zeros = make(map[uint64]*Member)
-			}
-			var mapkey uint64
-			var mapvalue *Member
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Member{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Zeros[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+			m.ValueId = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			m.MaxLeaseId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16225,16 +18989,29 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxLeaseId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.MaxTxnTs = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16244,16 +19021,29 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTxnTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.MaxRaftId = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16263,14 +19053,14 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= uint64(b&0x7F) << shift
+				m.Op |= DirectedEdge_Op(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16297,14 +19087,14 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Removed = append(m.Removed, &Member{})
-			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPreds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16332,43 +19122,7 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.License == nil {
-				m.License = &License{}
-			}
-			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedPreds = append(m.AllowedPreds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16392,7 +19146,7 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *Mutations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16415,17 +19169,17 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16435,31 +19189,33 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Member == nil {
-				m.Member = &Member{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16486,18 +19242,16 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Edges = append(m.Edges, &DirectedEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.MaxPending = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16507,67 +19261,31 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPending |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HealthInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Schema = append(m.Schema, &SchemaUpdate{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HealthInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16577,29 +19295,31 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Instance = string(dAtA[iNdEx:postIndex])
+			m.Types = append(m.Types, &TypeUpdate{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
 			}
-			var stringLen uint64
+			m.DropOp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16609,27 +19329,14 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DropOp |= Mutations_DropOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16657,13 +19364,13 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.DropValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16673,29 +19380,84 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PredHints", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16705,67 +19467,161 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			if m.PredHints == nil {
+				m.PredHints = make(map[string]Metadata_HintType)
 			}
-			m.Uptime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue Metadata_HintType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Uptime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Metadata_HintType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEcho", wireType)
+			m.PredHints[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastEcho = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastEcho |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 8:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Snapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ongoing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16775,29 +19631,33 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ongoing = append(m.Ongoing, string(dAtA[iNdEx:postIndex]))
+			if m.Context == nil {
+				m.Context = &RaftContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexing", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16807,29 +19667,16 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Indexing = append(m.Indexing, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EeFeatures", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
 			}
-			var stringLen uint64
+			m.ReadTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16839,29 +19686,36 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReadTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.EeFeatures = append(m.EeFeatures, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 11:
+			m.Done = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
 			}
-			m.MaxAssigned = 0
+			m.SinceTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16871,7 +19725,7 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAssigned |= uint64(b&0x7F) << shift
+				m.SinceTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16898,7 +19752,7 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tablet) Unmarshal(dAtA []byte) error {
+func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16921,17 +19775,17 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZeroSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZeroSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.GroupId = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16941,16 +19795,35 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
+			}
+			m.CheckpointTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16960,29 +19833,84 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var v int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16992,17 +19920,16 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnDiskBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreTs", wireType)
 			}
-			m.OnDiskBytes = 0
+			m.RestoreTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17012,16 +19939,16 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OnDiskBytes |= int64(b&0x7F) << shift
+				m.RestoreTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17031,17 +19958,29 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Remove = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17051,17 +19990,29 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnly = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MoveTs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			m.MoveTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17071,16 +20022,29 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MoveTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedBytes", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.UncompressedBytes = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17090,75 +20054,27 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UncompressedBytes |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.Entity = 0
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entity = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17186,13 +20102,33 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Anonymous = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyFile", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17202,31 +20138,29 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.EncryptionKeyFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddr", wireType)
 			}
-			m.ValueType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17236,24 +20170,27 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueType |= Posting_ValType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.ValueId = 0
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueId = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 6:
+			m.VaultAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultRoleidFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17281,11 +20218,11 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.VaultRoleidFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultSecretidFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17313,13 +20250,13 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lang = string(dAtA[iNdEx:postIndex])
+			m.VaultSecretidFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultPath", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17329,16 +20266,29 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= DirectedEdge_Op(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultField", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17348,29 +20298,27 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VaultField = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPreds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17398,8 +20346,27 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedPreds = append(m.AllowedPreds, string(dAtA[iNdEx:postIndex]))
+			m.VaultFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupNum", wireType)
+			}
+			m.BackupNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackupNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -17422,7 +20389,7 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Mutations) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17445,17 +20412,17 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17465,16 +20432,33 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.StartTs = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mutations == nil {
+				m.Mutations = &Mutations{}
+			}
+			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17484,14 +20468,29 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kv = append(m.Kv, &pb.KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17518,14 +20517,48 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Edges = append(m.Edges, &DirectedEdge{})
-			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17552,14 +20585,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = append(m.Schema, &SchemaUpdate{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Delta == nil {
+				m.Delta = &OracleDelta{}
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17586,16 +20621,18 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Types = append(m.Types, &TypeUpdate{})
-			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Snapshot == nil {
+				m.Snapshot = &Snapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.DropOp = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17605,16 +20642,35 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DropOp |= Mutations_DropOp(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
+			}
+			m.ExpectedChecksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedChecksum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17624,29 +20680,33 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DropValue = string(dAtA[iNdEx:postIndex])
+			if m.Restore == nil {
+				m.Restore = &RestoreRequest{}
+			}
+			if err := m.Restore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelIndexBuild", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17656,27 +20716,23 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CancelIndexBuild = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17700,7 +20756,7 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *KVS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17723,17 +20779,101 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: KVS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KVS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredHints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17743,104 +20883,25 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PredHints == nil {
-				m.PredHints = make(map[string]Metadata_HintType)
-			}
-			var mapkey string
-			var mapvalue Metadata_HintType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= Metadata_HintType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.PredHints[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17864,7 +20925,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Snapshot) Unmarshal(dAtA []byte) error {
+func (m *Posting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17887,17 +20948,27 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17907,33 +20978,31 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &RaftContext{}
-			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
 			}
-			m.Index = 0
+			m.ValType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17943,16 +21012,16 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.ValType |= Posting_ValType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
 			}
-			m.ReadTs = 0
+			m.PostingType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17962,16 +21031,16 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				m.PostingType |= Posting_PostingType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17981,17 +21050,31 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Done = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.SinceTs = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
+			if m.LangTag == nil {
+				m.LangTag = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18001,67 +21084,63 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SinceTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ZeroSnapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZeroSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.Index = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18071,16 +21150,16 @@ func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.Op |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			m.CheckpointTs = 0
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18090,16 +21169,16 @@ func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CheckpointTs |= uint64(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var msglen int
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18109,28 +21188,11 @@ func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CommitTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -18153,7 +21215,7 @@ func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+func (m *UidBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18176,17 +21238,17 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UidBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UidBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			m.GroupId = 0
+			m.Base = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18196,35 +21258,16 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				m.Base |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestoreTs", wireType)
-			}
-			m.RestoreTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RestoreTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18234,29 +21277,31 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Location = string(dAtA[iNdEx:postIndex])
+			m.Deltas = append(m.Deltas[:0], dAtA[iNdEx:postIndex]...)
+			if m.Deltas == nil {
+				m.Deltas = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackupId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUids", wireType)
 			}
-			var stringLen uint64
+			m.NumUids = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18266,61 +21311,67 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumUids |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BackupId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UidPack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UidPack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UidPack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
+			}
+			m.BlockSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18330,29 +21381,16 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18362,49 +21400,31 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.Anonymous = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyFile", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			m.Blocks = append(m.Blocks, &UidBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocRef", wireType)
+			}
+			m.AllocRef = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18414,29 +21434,67 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AllocRef |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptionKeyFile = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostingList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pack", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18446,29 +21504,33 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddr = string(dAtA[iNdEx:postIndex])
+			if m.Pack == nil {
+				m.Pack = &UidPack{}
+			}
+			if err := m.Pack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultRoleidFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18478,29 +21540,31 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultRoleidFile = string(dAtA[iNdEx:postIndex])
+			m.Postings = append(m.Postings, &Posting{})
+			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultSecretidFile", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var stringLen uint64
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18510,27 +21574,141 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CommitTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Splits = append(m.Splits, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Splits) == 0 {
+					m.Splits = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Splits = append(m.Splits, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultSecretidFile = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18558,11 +21736,11 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultPath = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultField", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18590,13 +21768,64 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultField = string(dAtA[iNdEx:postIndex])
+			m.Alias = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultFormat", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18606,29 +21835,17 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VaultFormat = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackupNum", wireType)
+			m.AllKeys = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
 			}
-			m.BackupNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18638,11 +21855,26 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BackupNum |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Param = append(m.Param, &FacetParam{})
+			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -18665,7 +21897,7 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *Facets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18688,15 +21920,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18723,16 +21955,65 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutations == nil {
-				m.Mutations = &Mutations{}
-			}
-			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18759,16 +22040,67 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &pb.KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FacetsList = append(m.FacetsList, &Facets{})
+			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Function) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18778,31 +22110,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18830,13 +22158,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18846,33 +22174,80 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delta == nil {
-				m.Delta = &OracleDelta{}
-			}
-			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FilterTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18882,33 +22257,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Snapshot == nil {
-				m.Snapshot = &Snapshot{}
-			}
-			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Op = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18918,33 +22289,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.ExpectedChecksum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedChecksum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 12:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &FilterTree{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18971,10 +22338,10 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Restore == nil {
-				m.Restore = &RestoreRequest{}
+			if m.Func == nil {
+				m.Func = &Function{}
 			}
-			if err := m.Restore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19000,7 +22367,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KVS) Unmarshal(dAtA []byte) error {
+func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19023,17 +22390,17 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KVS: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KVS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var v int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19043,13 +22410,12 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Done = bool(v != 0)
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
@@ -19081,9 +22447,9 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 			}
 			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19111,13 +22477,13 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19127,25 +22493,23 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19169,7 +22533,7 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Posting) Unmarshal(dAtA []byte) error {
+func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19192,27 +22556,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19222,69 +22576,29 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
-			}
-			m.ValType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValType |= Posting_ValType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
-			}
-			m.PostingType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PostingType |= Posting_PostingType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19294,29 +22608,47 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
-			if m.LangTag == nil {
-				m.LangTag = []byte{}
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Index = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19344,13 +22676,13 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19360,31 +22692,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+			m.Reverse = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Op = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19394,16 +22712,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			m.Count = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			m.StartTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19413,16 +22732,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			m.List = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
 			}
-			m.CommitTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19432,67 +22752,37 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UidBlock) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Upsert = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UidBlock: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UidBlock: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Lang = bool(v != 0)
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
 			}
-			m.Base = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19502,16 +22792,17 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Base |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.NoConflict = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19521,31 +22812,29 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deltas = append(m.Deltas[:0], dAtA[iNdEx:postIndex]...)
-			if m.Deltas == nil {
-				m.Deltas = []byte{}
-			}
+			m.Storage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumUids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
 			}
-			m.NumUids = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19555,11 +22844,12 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumUids |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Encrypted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -19582,7 +22872,7 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UidPack) Unmarshal(dAtA []byte) error {
+func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19605,34 +22895,15 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UidPack: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UidPack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
-			}
-			m.BlockSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockSize |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19649,40 +22920,21 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Blocks = append(m.Blocks, &UidBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocRef", wireType)
-			}
-			m.AllocRef = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AllocRef |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = append(m.Schema, &SchemaNode{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -19705,7 +22957,7 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostingList) Unmarshal(dAtA []byte) error {
+func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19728,17 +22980,17 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19748,33 +23000,67 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pack == nil {
-				m.Pack = &UidPack{}
-			}
-			if err := m.Pack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueType |= Posting_ValType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
+			}
+			m.Directive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Directive |= SchemaUpdate_Directive(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19784,31 +23070,29 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Postings = append(m.Postings, &Posting{})
-			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.CommitTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19818,143 +23102,57 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.Count = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				m.Splits = append(m.Splits, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			}
+			m.List = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Splits) == 0 {
-					m.Splits = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Splits = append(m.Splits, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FacetParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			m.Upsert = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19964,27 +23162,55 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+			m.Lang = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonNullable", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.NonNullable = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonNullableList", wireType)
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonNullableList = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20012,62 +23238,11 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.ObjectTypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FacetParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -20084,10 +23259,10 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AllKeys = bool(v != 0)
-		case 2:
+			m.NoConflict = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20114,67 +23289,18 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Param = append(m.Param, &FacetParam{})
-			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Storage == nil {
+				m.Storage = &StorageHint{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Facets) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encrypted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20184,82 +23310,17 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FacetsList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+					break
+				}
+			}
+			m.Encrypted = bool(v != 0)
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataKeys", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20269,25 +23330,23 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FacetsList = append(m.FacetsList, &Facets{})
-			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataKeys = append(m.DataKeys, make([]byte, postIndex-iNdEx))
+			copy(m.DataKeys[len(m.DataKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20311,7 +23370,7 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Function) Unmarshal(dAtA []byte) error {
+func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20334,15 +23393,15 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+			return fmt.Errorf("proto: TypeUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TypeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20370,13 +23429,13 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20386,29 +23445,82 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Fields = append(m.Fields, &SchemaUpdate{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MapHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MapHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MapHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20418,23 +23530,23 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.PartitionKeys = append(m.PartitionKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PartitionKeys[len(m.PartitionKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20458,7 +23570,7 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilterTree) Unmarshal(dAtA []byte) error {
+func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20481,15 +23593,15 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20517,13 +23629,13 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Op = string(dAtA[iNdEx:postIndex])
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGid", wireType)
 			}
-			var msglen int
+			m.SourceGid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20533,31 +23645,16 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SourceGid |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Children = append(m.Children, &FilterTree{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestGid", wireType)
 			}
-			var msglen int
+			m.DestGid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20567,28 +23664,49 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DestGid |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnTs", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TxnTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxnTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Func == nil {
-				m.Func = &Function{}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
 			}
-			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ExpectedChecksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedChecksum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20611,7 +23729,7 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20634,68 +23752,17 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var stringLen uint64
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20705,27 +23772,33 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CommitTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20753,7 +23826,7 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			m.Preds = append(m.Preds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20777,7 +23850,7 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaNode) Unmarshal(dAtA []byte) error {
+func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20800,17 +23873,17 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txns", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20820,61 +23893,31 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Txns = append(m.Txns, &TxnStatus{})
+			if err := m.Txns[len(m.Txns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
 			}
-			var v int
+			m.MaxAssigned = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20884,17 +23927,16 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxAssigned |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Index = bool(v != 0)
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupChecksums", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20904,144 +23946,91 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reverse = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Count = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.List = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Upsert = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Lang = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
+			if m.GroupChecksums == nil {
+				m.GroupChecksums = make(map[uint32]uint64)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.NoConflict = bool(v != 0)
+			m.GroupChecksums[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21064,7 +24053,7 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaResult) Unmarshal(dAtA []byte) error {
+func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21087,46 +24076,88 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Ts = append(m.Ts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ts) == 0 {
+					m.Ts = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ts = append(m.Ts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = append(m.Schema, &SchemaNode{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21149,7 +24180,7 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
+func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21166,123 +24197,21 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
-			}
-			m.ValueType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValueType |= Posting_ValType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
-			}
-			m.Directive = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Directive |= SchemaUpdate_Directive(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				break
 			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21299,72 +24228,63 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Count = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.List = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			m.Upsert = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Lang = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonNullable", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21374,37 +24294,33 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NonNullable = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonNullableList", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.NonNullableList = bool(v != 0)
-		case 12:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &RaftContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21414,44 +24330,28 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectTypeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
+			if m.Payload == nil {
+				m.Payload = &api.Payload{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NoConflict = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21474,7 +24374,7 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21497,17 +24397,17 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TypeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TypeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21517,27 +24417,78 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Prefixes = append(m.Prefixes, make([]byte, postIndex-iNdEx))
+			copy(m.Prefixes[len(m.Prefixes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21564,8 +24515,10 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, &SchemaUpdate{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Kvs == nil {
+				m.Kvs = &pb.KVList{}
+			}
+			if err := m.Kvs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21591,7 +24544,7 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapHeader) Unmarshal(dAtA []byte) error {
+func (m *Num) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21614,17 +24567,17 @@ func (m *MapHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: Num: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			var byteLen int
+			m.Val = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21634,24 +24587,51 @@ func (m *MapHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Val |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ReadOnly = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
 			}
-			m.PartitionKeys = append(m.PartitionKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PartitionKeys[len(m.PartitionKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forwarded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21674,7 +24654,7 @@ func (m *MapHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
+func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21697,17 +24677,17 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
 			}
-			var stringLen uint64
+			m.StartId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21717,29 +24697,16 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceGid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
 			}
-			m.SourceGid = 0
+			m.EndId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21749,16 +24716,16 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceGid |= uint32(b&0x7F) << shift
+				m.EndId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestGid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
-			m.DestGid = 0
+			m.ReadOnly = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21768,16 +24735,67 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestGid |= uint32(b&0x7F) << shift
+				m.ReadOnly |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxnTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTs", wireType)
 			}
-			m.TxnTs = 0
+			m.ClientTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21787,16 +24805,16 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxnTs |= uint64(b&0x7F) << shift
+				m.ClientTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.ExpectedChecksum = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21806,7 +24824,7 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpectedChecksum |= uint64(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -21833,7 +24851,7 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnStatus) Unmarshal(dAtA []byte) error {
+func (m *Status) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21856,17 +24874,17 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.StartTs = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21876,16 +24894,16 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
+				m.Code |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			m.CommitTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21895,11 +24913,24 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21922,7 +24953,7 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleDelta) Unmarshal(dAtA []byte) error {
+func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21945,17 +24976,36 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txns", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
 			}
-			var msglen int
+			m.SinceTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21965,31 +25015,16 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SinceTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txns = append(m.Txns, &TxnStatus{})
-			if err := m.Txns[len(m.Txns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.MaxAssigned = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21999,16 +25034,16 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAssigned |= uint64(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupChecksums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22018,274 +25053,93 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GroupChecksums == nil {
-				m.GroupChecksums = make(map[uint32]uint64)
+			m.UnixTs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var mapkey uint32
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.GroupChecksums[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ts = append(m.Ts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ts) == 0 {
-					m.Ts = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ts = append(m.Ts, v)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PeerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PeerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22295,68 +25149,29 @@ func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RaftBatch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftBatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftBatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22366,33 +25181,49 @@ func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &RaftContext{}
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
 			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.Anonymous = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22402,28 +25233,100 @@ func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payload == nil {
-				m.Payload = &api.Payload{}
-			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Namespaces = append(m.Namespaces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Namespaces) == 0 {
+					m.Namespaces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Namespaces = append(m.Namespaces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -22446,7 +25349,7 @@ func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22469,17 +25372,17 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOperations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22489,23 +25392,25 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefixes = append(m.Prefixes, make([]byte, postIndex-iNdEx))
-			copy(m.Prefixes[len(m.Prefixes)-1], dAtA[iNdEx:postIndex])
+			m.DropOperations = append(m.DropOperations, &DropOperation{})
+			if err := m.DropOperations[len(m.DropOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22529,7 +25434,7 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
+func (m *DropOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22552,17 +25457,36 @@ func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DropOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DropOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
+			}
+			m.DropOp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DropOp |= DropOperation_DropOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22572,27 +25496,23 @@ func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kvs == nil {
-				m.Kvs = &pb.KVList{}
-			}
-			if err := m.Kvs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DropValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22616,7 +25536,7 @@ func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Num) Unmarshal(dAtA []byte) error {
+func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22639,17 +25559,17 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Num: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.Val = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22659,16 +25579,16 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Val |= uint64(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
 			}
-			var v int
+			m.ReadTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22678,17 +25598,16 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ReadTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnly = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
 			}
-			var v int
+			m.UnixTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22698,68 +25617,48 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.UnixTs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Forwarded = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AssignedIds) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			m.StartId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22769,16 +25668,29 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.EndId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22788,16 +25700,29 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.ReadOnly = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22807,67 +25732,61 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadOnly |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SnapshotMeta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapshotMeta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
 			}
-			m.ClientTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22877,16 +25796,17 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.Anonymous = bool(v != 0)
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.GroupId = 0
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22896,7 +25816,7 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -22923,7 +25843,7 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Status) Unmarshal(dAtA []byte) error {
+func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22946,10 +25866,10 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23003,6 +25923,38 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -23025,7 +25977,7 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23048,17 +26000,17 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ReadTs = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23068,148 +26020,14 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				m.Type |= BackupKey_KeyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
-			}
-			m.SinceTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SinceTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnixTs = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23237,13 +26055,13 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			m.Attr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var stringLen uint64
+			m.Uid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23253,29 +26071,16 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Uid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUid", wireType)
 			}
-			var v int
+			m.StartUid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23285,15 +26090,14 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.StartUid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Anonymous = bool(v != 0)
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23321,8 +26125,27 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			m.Term = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -23345,7 +26168,7 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupResponse) Unmarshal(dAtA []byte) error {
+func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23368,15 +26191,91 @@ func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupPostingList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupPostingList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Uids = append(m.Uids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Uids) == 0 {
+					m.Uids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Uids = append(m.Uids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropOperations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23403,67 +26302,16 @@ func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DropOperations = append(m.DropOperations, &DropOperation{})
-			if err := m.DropOperations[len(m.DropOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Postings = append(m.Postings, &Posting{})
+			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DropOperation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DropOperation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropOperation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			m.DropOp = 0
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23473,16 +26321,92 @@ func (m *DropOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DropOp |= DropOperation_DropOp(b&0x7F) << shift
+				m.CommitTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Splits = append(m.Splits, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Splits) == 0 {
+					m.Splits = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Splits = append(m.Splits, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UidBytes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23492,23 +26416,25 @@ func (m *DropOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DropValue = string(dAtA[iNdEx:postIndex])
+			m.UidBytes = append(m.UidBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.UidBytes == nil {
+				m.UidBytes = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23532,7 +26458,7 @@ func (m *DropOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExportRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23555,17 +26481,17 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateGraphQLSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateGraphQLSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			m.GroupId = 0
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23575,52 +26501,14 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
-			}
-			m.ReadTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
-			}
-			m.UnixTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnixTs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GraphqlSchema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23648,13 +26536,13 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
+			m.GraphqlSchema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DgraphPreds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23664,61 +26552,31 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DgraphPreds = append(m.DgraphPreds, &SchemaUpdate{})
+			if err := m.DgraphPreds[len(m.DgraphPreds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AccessKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DgraphTypes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23728,61 +26586,31 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DgraphTypes = append(m.DgraphTypes, &TypeUpdate{})
+			if err := m.DgraphTypes[len(m.DgraphTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SessionToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var v int
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23792,12 +26620,11 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Anonymous = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -23820,7 +26647,7 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExportResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23843,68 +26670,17 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExportResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			return fmt.Errorf("proto: UpdateGraphQLSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateGraphQLSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var stringLen uint64
+			m.Uid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23914,24 +26690,11 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Uid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Files = append(m.Files, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -23954,7 +26717,7 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupKey) Unmarshal(dAtA []byte) error {
+func (m *BulkMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23977,17 +26740,17 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkMeta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EdgeCount", wireType)
 			}
-			m.Type = 0
+			m.EdgeCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23997,16 +26760,16 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= BackupKey_KeyType(b&0x7F) << shift
+				m.EdgeCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24016,113 +26779,194 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			if m.SchemaMap == nil {
+				m.SchemaMap = make(map[string]*SchemaUpdate)
 			}
-			m.Uid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *SchemaUpdate
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Uid |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SchemaUpdate{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartUid", wireType)
+			m.SchemaMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.StartUid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartUid |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TabletLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if postIndex > l {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TabletLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TabletLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadsPerSec", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Term = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ReadsPerSec = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WritesPerSec", wireType)
 			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.WritesPerSec = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -24145,7 +26989,7 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
+func (m *TabletPin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24168,93 +27012,17 @@ func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupPostingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TabletPin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupPostingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TabletPin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Uids = append(m.Uids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Uids) == 0 {
-					m.Uids = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Uids = append(m.Uids, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24264,126 +27032,29 @@ func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Postings = append(m.Postings, &Posting{})
-			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
-			}
-			m.CommitTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Splits = append(m.Splits, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Splits) == 0 {
-					m.Splits = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Splits = append(m.Splits, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var byteLen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24393,26 +27064,11 @@ func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UidBytes = append(m.UidBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.UidBytes == nil {
-				m.UidBytes = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -24435,7 +27091,7 @@ func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *StorageHint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24458,36 +27114,17 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateGraphQLSchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageHint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateGraphQLSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageHint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
-			}
-			m.StartTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GraphqlSchema", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
-			var stringLen uint64
+			m.Compression = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24497,29 +27134,16 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Compression |= StorageHint_Compression(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GraphqlSchema = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DgraphPreds", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
-			var msglen int
+			m.Level = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24529,31 +27153,16 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Level |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DgraphPreds = append(m.DgraphPreds, &SchemaUpdate{})
-			if err := m.DgraphPreds[len(m.DgraphPreds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DgraphTypes", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
 			}
-			var msglen int
+			m.Cache = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24563,26 +27172,11 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Cache |= StorageHint_Cache(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DgraphTypes = append(m.DgraphTypes, &TypeUpdate{})
-			if err := m.DgraphTypes[len(m.DgraphTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -24605,7 +27199,7 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
+func (m *LockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24628,17 +27222,79 @@ func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateGraphQLSchemaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateGraphQLSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.Keys = append(m.Keys, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Keys) == 0 {
+					m.Keys = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.Keys = append(m.Keys, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			m.Owner = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseMs", wireType)
 			}
-			m.Uid = 0
+			m.LeaseMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24648,7 +27304,7 @@ func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uid |= uint64(b&0x7F) << shift
+				m.LeaseMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -24675,7 +27331,7 @@ func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BulkMeta) Unmarshal(dAtA []byte) error {
+func (m *CancelIndexBuildRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24698,36 +27354,17 @@ func (m *BulkMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelIndexBuildRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelIndexBuildRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EdgeCount", wireType)
-			}
-			m.EdgeCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EdgeCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24737,120 +27374,23 @@ func (m *BulkMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchemaMap == nil {
-				m.SchemaMap = make(map[string]*SchemaUpdate)
-			}
-			var mapkey string
-			var mapvalue *SchemaUpdate
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &SchemaUpdate{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SchemaMap[mapkey] = mapvalue
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24,6 +24,7 @@ import (
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
 )
 
@@ -58,7 +59,7 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 	}
 	if child.SrcFunc != nil && isAggregatorFn(child.SrcFunc.Name) {
 		if fieldName == "" {
-			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
+			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, x.ParseAttr(child.Attr))
 		}
 		finalVal, err := aggregateGroup(grp, child)
 		if err != nil {
@@ -211,7 +212,7 @@ func (sg *SubGraph) formResult(ul *pb.List) (*groupResults, error) {
 
 		attr := child.Params.Alias
 		if attr == "" {
-			attr = child.Attr
+			attr = x.ParseAttr(child.Attr)
 		}
 		if len(child.DestUIDs.GetUids()) > 0 {
 			// It's a UID node.
@@ -293,7 +294,7 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 
 		attr := child.Params.Alias
 		if attr == "" {
-			attr = child.Attr
+			attr = x.ParseAttr(child.Attr)
 		}
 		if len(child.DestUIDs.GetUids()) > 0 {
 			// It's a UID node.
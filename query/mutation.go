@@ -74,7 +74,7 @@ func expandEdges(ctx context.Context, m *pb.Mutations) ([]*pb.DirectedEdge, erro
 				return nil, err
 			}
 			preds = append(preds, getPredicatesFromTypes(types)...)
-			preds = append(preds, x.StarAllPredicates()...)
+			preds = append(preds, namespacePreds(namespaceOf(ctx), x.StarAllPredicates())...)
 			// AllowedPreds are used only with ACL. Do not delete all predicates but
 			// delete predicates to which the mutation has access
 			if edge.AllowedPreds != nil {
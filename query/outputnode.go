@@ -1093,7 +1093,8 @@ func (sg *SubGraph) toFastJSON(l *Latency) ([]byte, error) {
 }
 
 func (sg *SubGraph) fieldName() string {
-	fieldName := sg.Attr
+	// The predicates are returned with their names in the namespace of the request.
+	fieldName := x.ParseAttr(sg.Attr)
 	if sg.Params.Alias != "" {
 		fieldName = sg.Params.Alias
 	}
@@ -1108,7 +1109,7 @@ func (sg *SubGraph) addCount(enc *encoder, count uint64, dst fastJsonNode) error
 	c.Value = int64(count)
 	fieldName := sg.Params.Alias
 	if fieldName == "" {
-		fieldName = fmt.Sprintf("count(%s)", sg.Attr)
+		fieldName = fmt.Sprintf("count(%s)", x.ParseAttr(sg.Attr))
 	}
 	return enc.AddValue(dst, enc.idForAttr(fieldName), c)
 }
@@ -1146,7 +1147,7 @@ func (sg *SubGraph) addCheckPwd(enc *encoder, vals []*pb.TaskValue, dst fastJson
 
 	fieldName := sg.Params.Alias
 	if fieldName == "" {
-		fieldName = fmt.Sprintf("checkpwd(%s)", sg.Attr)
+		fieldName = fmt.Sprintf("checkpwd(%s)", x.ParseAttr(sg.Attr))
 	}
 	return enc.AddValue(dst, enc.idForAttr(fieldName), c)
 }
@@ -148,7 +148,7 @@ func (b *rdfBuilder) writeTriple(val []byte) {
 func (b *rdfBuilder) rdfForCount(subject uint64, count uint32, sg *SubGraph) {
 	fieldName := sg.Params.Alias
 	if fieldName == "" {
-		fieldName = fmt.Sprintf("count(%s)", sg.Attr)
+		fieldName = fmt.Sprintf("count(%s)", x.ParseAttr(sg.Attr))
 	}
 	b.writeRDF(subject, []byte(fieldName),
 		quotedNumber([]byte(strconv.FormatUint(uint64(count), 10))))
@@ -162,6 +162,8 @@ type params struct {
 	Cascade []string
 	// IgnoreReflex is true if the @ignorereflex directive is specified.
 	IgnoreReflex bool
+	// Lock is true if the @lock directive is specified.
+	Lock bool
 
 	// ShortestPathArgs contains the from and to functions to execute a shortest path query.
 	ShortestPathArgs gql.ShortestPathArgs
@@ -279,6 +281,9 @@ type SubGraph struct {
 
 	FilterOp     string
 	Filters      []*SubGraph // List of filters specified at the current node.
+	// policyFilter is the filter of the node-level policies of the user on an expand() node,
+	// which is added to the uid predicates it expands to.
+	policyFilter *SubGraph
 	facetsFilter *pb.FilterTree
 	MathExp      *mathTree
 	Children     []*SubGraph // children of the current node, should be empty for leaf nodes.
@@ -629,6 +634,12 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 			}
 			dst.Filters = append(dst.Filters, dstf)
 		}
+		if gchild.PolicyFilter != nil {
+			dst.policyFilter = &SubGraph{}
+			if err := filterCopy(dst.policyFilter, gchild.PolicyFilter); err != nil {
+				return err
+			}
+		}
 
 		if gchild.FacetsFilter != nil {
 			facetsFilter, err := toFacetsFilter(gchild.FacetsFilter)
@@ -739,9 +750,27 @@ type ContextKey int
 const (
 	// DebugKey is the key used to toggle debug mode.
 	DebugKey ContextKey = iota
+	// decryptKey is the key of the function which tells whether the values of an @encrypted
+	// predicate are decrypted for the request.
+	decryptKey
 )
 
-func isDebug(ctx context.Context) bool {
+// WithDecrypt returns ctx with the function which tells whether the values of an @encrypted
+// predicate are decrypted for the request. The values of the @encrypted predicates are returned
+// as they are stored, encrypted, if it isn't set.
+func WithDecrypt(ctx context.Context, canDecrypt func(pred string) bool) context.Context {
+	return context.WithValue(ctx, decryptKey, canDecrypt)
+}
+
+// canDecrypt returns whether the values of the predicate attr are decrypted for the request, if
+// the predicate is @encrypted.
+func canDecrypt(ctx context.Context, attr string) bool {
+	canDecrypt, _ := ctx.Value(decryptKey).(func(string) bool)
+	return canDecrypt != nil && canDecrypt(x.ParseAttr(attr))
+}
+
+// IsDebug returns true if the query is run in debug mode, which returns the uids of the nodes.
+func IsDebug(ctx context.Context) bool {
 	var debug bool
 
 	// gRPC client passes information about debug as metadata.
@@ -778,9 +807,10 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 	args := params{
 		Alias:            gq.Alias,
 		Cascade:          gq.Cascade,
-		GetUid:           isDebug(ctx),
+		GetUid:           IsDebug(ctx),
 		IgnoreReflex:     gq.IgnoreReflex,
 		IsEmpty:          gq.IsEmpty,
+		Lock:             gq.Lock,
 		Langs:            gq.Langs,
 		NeedsVar:         append(gq.NeedsVar[:0:0], gq.NeedsVar...),
 		Normalize:        gq.Normalize,
@@ -1928,7 +1958,7 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 			if len(child.ExpandPreds) > 0 {
 				span.Annotate(nil, "expand default")
 				// We already have the predicates populated from the var.
-				preds = getPredsFromVals(child.ExpandPreds)
+				preds = namespacePreds(namespaceOf(ctx), getPredsFromVals(child.ExpandPreds))
 			} else {
 				typeNames := strings.Split(child.Params.Expand, ",")
 				preds = getPredicatesFromTypes(typeNames)
@@ -1945,6 +1975,18 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 			}
 		}
 
+		// The node-level policies filter the nodes of the expanded uid predicates.
+		policyPreds := make(map[string]struct{})
+		if child.policyFilter != nil {
+			uidPreds, err := filterUidPredicates(ctx, preds)
+			if err != nil {
+				return out, err
+			}
+			for _, pred := range uidPreds {
+				policyPreds[pred] = struct{}{}
+			}
+		}
+
 		for _, pred := range preds {
 			temp := &SubGraph{
 				ReadTs: sg.ReadTs,
@@ -1967,6 +2009,11 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 				recursiveCopy(s, cf)
 				temp.Filters = append(temp.Filters, s)
 			}
+			if _, ok := policyPreds[pred]; ok {
+				s := &SubGraph{}
+				recursiveCopy(s, child.policyFilter)
+				temp.Filters = append(temp.Filters, s)
+			}
 
 			// Go through each child, create a copy and attach to temp.Children.
 			for _, cc := range child.Children {
@@ -2091,6 +2138,8 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				rch <- err
 				return
 			}
+			// The values are decrypted by the group serving the predicate, which has its data key.
+			taskQuery.Decrypt = canDecrypt(ctx, sg.Attr)
 			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
 			switch {
 			case err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage):
@@ -2574,9 +2623,29 @@ func isUidFnWithoutVar(f *gql.Function) bool {
 	return f != nil && f.Name == "uid" && len(f.NeedsVar) == 0
 }
 
+// namespaceOf returns the namespace of the request, which is set in ctx by edgraph.
+func namespaceOf(ctx context.Context) uint64 {
+	ns, _ := x.ExtractNamespace(ctx)
+	return ns
+}
+
+// namespacePreds returns the names of the given predicates, or types, in the namespace ns.
+func namespacePreds(ns uint64, preds []string) []string {
+	if ns == x.GalaxyNamespace {
+		return preds
+	}
+	res := make([]string, 0, len(preds))
+	for _, pred := range preds {
+		res = append(res, x.NamespaceAttr(ns, pred))
+	}
+	return res
+}
+
+// getNodeTypes returns the types of the nodes, with their names in the namespace of the request.
 func getNodeTypes(ctx context.Context, sg *SubGraph) ([]string, error) {
+	ns := namespaceOf(ctx)
 	temp := &SubGraph{
-		Attr:    "dgraph.type",
+		Attr:    x.NamespaceAttr(ns, "dgraph.type"),
 		SrcUIDs: sg.DestUIDs,
 		ReadTs:  sg.ReadTs,
 	}
@@ -2588,7 +2657,7 @@ func getNodeTypes(ctx context.Context, sg *SubGraph) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	return getPredsFromVals(result.ValueMatrix), nil
+	return namespacePreds(ns, getPredsFromVals(result.ValueMatrix)), nil
 }
 
 // getPredicatesFromTypes returns the list of preds contained in the given types.
@@ -2746,6 +2815,11 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 				continue
 			}
 
+			ctx := ctx
+			if sg.Params.Lock {
+				// Collect the keys read by the block, for the upsert to lock them.
+				ctx = worker.WithLockedReads(ctx)
+			}
 			switch {
 			case sg.Params.Alias == "shortest":
 				// We allow only one shortest path block per query.
@@ -2856,7 +2930,7 @@ func calculateMetrics(sg *SubGraph, metrics map[string]uint64) {
 	// Skip internal nodes.
 	if !sg.IsInternal() {
 		// Add the number of SrcUIDs. This is the number of uids processed by this attribute.
-		metrics[sg.Attr] += uint64(len(sg.SrcUIDs.GetUids()))
+		metrics[x.ParseAttr(sg.Attr)] += uint64(len(sg.SrcUIDs.GetUids()))
 	}
 	// Add all the uids gathered by filters.
 	for _, filter := range sg.Filters {
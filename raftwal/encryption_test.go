@@ -66,6 +66,47 @@ func TestEntryReadWrite(t *testing.T) {
 	require.EqualError(t, err, "Logfile is encrypted but encryption key is nil")
 }
 
+func TestRotateEncryptionKey(t *testing.T) {
+	key := []byte("badger16byteskey")
+	dir, err := ioutil.TempDir("", "raftwal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ds, err := InitEncrypted(dir, key)
+	require.NoError(t, err)
+
+	data := make([]byte, 100)
+	rand.Read(data)
+	require.NoError(t, ds.wal.AddEntries([]raftpb.Entry{{Index: 1, Term: 1, Data: data}}))
+
+	// Rotate the key while the WAL is open, and keep using it.
+	newKey := []byte("new16byteskeyabc")
+	require.NoError(t, ds.RotateEncryptionKey(newKey))
+	require.NoError(t, ds.wal.AddEntries([]raftpb.Entry{{Index: 2, Term: 1, Data: data}}))
+	// A new log file picks up the new key.
+	lf, err := openLogFile(dir, ds.wal.current.fid+1)
+	require.NoError(t, err)
+	require.NotZero(t, lf.keyID())
+	require.NoError(t, lf.delete())
+
+	// The old key doesn't open the WAL anymore, the new key does.
+	_, err = InitEncrypted(dir, key)
+	require.EqualError(t, err, "Encryption key mismatch")
+	ds2, err := InitEncrypted(dir, newKey)
+	require.NoError(t, err)
+	entries := ds2.wal.allEntries(0, 100, 10000)
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, data, entries[0].Data)
+	require.Equal(t, data, entries[1].Data)
+
+	// The key of an unencrypted WAL can't be rotated.
+	dir2, err := ioutil.TempDir("", "raftwal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir2)
+	ds3, err := InitEncrypted(dir2, nil)
+	require.NoError(t, err)
+	require.Error(t, ds3.RotateEncryptionKey(newKey))
+}
+
 // TestLogRotate writes enough log file entries to cause 1 file rotation.
 func TestLogRotate(t *testing.T) {
 	dir, err := ioutil.TempDir("", "raftwal")
@@ -19,7 +19,9 @@ package raftwal
 import (
 	"math"
 	"sync"
+	"time"
 
+	"github.com/dgraph-io/badger/v3"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -385,6 +387,36 @@ func (w *DiskStorage) Sync() error {
 	return nil
 }
 
+// RotateEncryptionKey makes newKey the encryption key of the WAL. The data keys that encrypt the
+// log files are re-encrypted with it, but the log files themselves aren't rewritten. It can be
+// called while the WAL is in use.
+func (w *DiskStorage) RotateEncryptionKey(newKey x.SensitiveByteSlice) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if len(encryptionKey) == 0 || len(newKey) == 0 {
+		return errors.New("Only the key of an encrypted WAL can be rotated")
+	}
+	krOpt := badger.KeyRegistryOptions{
+		ReadOnly:                      true,
+		Dir:                           w.dir,
+		EncryptionKey:                 encryptionKey,
+		EncryptionKeyRotationDuration: 10 * 24 * time.Hour,
+	}
+	kr, err := badger.OpenKeyRegistry(krOpt)
+	if err != nil {
+		return errors.Wrapf(err, "while opening the key registry")
+	}
+	// The log files open the key registry file when they're created, so the new key must be set
+	// while holding the lock, right after the registry is rewritten.
+	krOpt.EncryptionKey = newKey
+	if err := badger.WriteKeyRegistry(kr, krOpt); err != nil {
+		return errors.Wrapf(err, "while writing the key registry")
+	}
+	encryptionKey = newKey
+	return nil
+}
+
 // Close closes the DiskStorage.
 func (w *DiskStorage) Close() error {
 	return w.Sync()
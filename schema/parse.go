@@ -17,6 +17,8 @@
 package schema
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/dgraph-io/dgraph/lex"
@@ -25,6 +27,7 @@ import (
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 
+	"github.com/dgraph-io/badger/v3/y"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 )
@@ -73,6 +76,14 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 		schema.Upsert = true
 	case "noconflict":
 		schema.NoConflict = true
+	case "storage":
+		hint, err := parseStorageDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.Storage = hint
+	case "encrypted":
+		schema.Encrypted = true
 	case "lang":
 		if t != types.StringID || schema.List {
 			return next.Errorf("@lang directive can only be specified for string type."+
@@ -155,6 +166,20 @@ func parseScalarPair(it *lex.ItemIterator, predicate string) (*pb.SchemaUpdate,
 		}
 		next = it.Item()
 	}
+	if schema.Encrypted {
+		// The encrypted values can't be compared to the values of a function.
+		switch {
+		case t != types.StringID:
+			return nil, next.Errorf("@encrypted directive can only be specified for string"+
+				" type. Got: [%v] for attr: [%v]", t.Name(), predicate)
+		case schema.List:
+			// The values of a list are deleted by their text, which can't be matched once they
+			// are encrypted.
+			return nil, next.Errorf("@encrypted predicate %s can't be a list", predicate)
+		case schema.Directive == pb.SchemaUpdate_INDEX || schema.Upsert:
+			return nil, next.Errorf("@encrypted predicate %s can't be indexed", predicate)
+		}
+	}
 
 	if next.Typ != itemDot {
 		return nil, next.Errorf("Invalid ending")
@@ -244,6 +269,123 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 	return tokenizers, nil
 }
 
+// parseStorageDirective works on "@storage(compression: zstd, level: 3, cache: hot)". All the
+// arguments are optional.
+func parseStorageDirective(it *lex.ItemIterator, predicate string) (*pb.StorageHint, error) {
+	if !it.Next() {
+		return nil, it.Item().Errorf("Invalid ending.")
+	}
+	next := it.Item()
+	if next.Typ != itemLeftRound {
+		return nil, next.Errorf("Require arguments to @storage for pred: %s", predicate)
+	}
+
+	hint := &pb.StorageHint{}
+	var seen = make(map[string]bool)
+	var hasLevel bool
+	expectArg := true
+	for {
+		it.Next()
+		next = it.Item()
+		if next.Typ == itemRightRound {
+			break
+		}
+		if next.Typ == itemComma {
+			if expectArg {
+				return nil, next.Errorf("Expected a storage argument but got comma")
+			}
+			expectArg = true
+			continue
+		}
+		if next.Typ != itemText {
+			return nil, next.Errorf("Expected storage argument but got: %v", next.Val)
+		}
+		if !expectArg {
+			return nil, next.Errorf("Expected a comma but got: %v", next.Val)
+		}
+		name := strings.ToLower(next.Val)
+		if seen[name] {
+			return nil, next.Errorf("Duplicate storage argument %s for pred %s", name, predicate)
+		}
+		seen[name] = true
+		if it.Next(); it.Item().Typ != itemColon {
+			return nil, it.Item().Errorf("Expected a colon after storage argument %s", name)
+		}
+		it.Next()
+		next = it.Item()
+
+		switch name {
+		case "compression":
+			switch strings.ToLower(next.Val) {
+			case "none":
+				hint.Compression = pb.StorageHint_DEFAULT
+			case "snappy":
+				hint.Compression = pb.StorageHint_SNAPPY
+			case "zstd":
+				hint.Compression = pb.StorageHint_ZSTD
+			default:
+				return nil, next.Errorf("Invalid compression %s for pred %s", next.Val, predicate)
+			}
+		case "level":
+			level, err := strconv.Atoi(next.Val)
+			if next.Typ != itemNumber || err != nil || level <= 0 {
+				return nil, next.Errorf("Compression level must be a positive integer"+
+					" for pred %s. Got: %v", predicate, next.Val)
+			}
+			hint.Level = int32(level)
+			hasLevel = true
+		case "cache":
+			switch strings.ToLower(next.Val) {
+			case "normal":
+				hint.Cache = pb.StorageHint_NORMAL
+			case "hot":
+				hint.Cache = pb.StorageHint_HOT
+			case "cold":
+				hint.Cache = pb.StorageHint_COLD
+			default:
+				return nil, next.Errorf("Invalid cache %s for pred %s", next.Val, predicate)
+			}
+		default:
+			return nil, next.Errorf("Invalid storage argument %s for pred %s", name, predicate)
+		}
+		expectArg = false
+	}
+
+	switch {
+	case hasLevel && hint.Compression != pb.StorageHint_ZSTD:
+		return nil, next.Errorf("Compression level is only supported by zstd for pred %s",
+			predicate)
+	case hint.Compression == pb.StorageHint_ZSTD && !y.CgoEnabled:
+		return nil, next.Errorf("zstd compression requires Dgraph to be built with cgo"+
+			" for pred %s", predicate)
+	case hint.Compression == pb.StorageHint_ZSTD && !hasLevel:
+		// Same default level as for Badger.
+		hint.Level = 3
+	case hint.Compression == pb.StorageHint_DEFAULT && hint.Cache == pb.StorageHint_NORMAL:
+		// Nothing to tune.
+		return nil, nil
+	}
+	return hint, nil
+}
+
+// StorageHintString returns the arguments of the @storage directive which sets the hint.
+func StorageHintString(hint *pb.StorageHint) string {
+	var args []string
+	switch hint.GetCompression() {
+	case pb.StorageHint_SNAPPY:
+		args = append(args, "compression: snappy")
+	case pb.StorageHint_ZSTD:
+		args = append(args, "compression: zstd", fmt.Sprintf("level: %d", hint.GetLevel()))
+	}
+	switch hint.GetCache() {
+	case pb.StorageHint_HOT:
+		args = append(args, "cache: hot")
+	case pb.StorageHint_COLD:
+		args = append(args, "cache: cold")
+	}
+	return strings.Join(args, ", ")
+}
+
 // resolveTokenizers resolves default tokenizers and verifies tokenizers definitions.
 func resolveTokenizers(updates []*pb.SchemaUpdate) error {
 	for _, schema := range updates {
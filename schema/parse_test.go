@@ -368,6 +368,73 @@ func TestParseUpsert(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseStorage(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		blob: string @storage(compression: zstd, cache: cold) .
+		name: string @index(exact) @storage(compression: snappy, cache: hot) .
+		note: string @storage(compression: zstd, level: 7) .
+		plain: string @storage(compression: none) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(result.Preds))
+	require.EqualValues(t, &pb.StorageHint{
+		Compression: pb.StorageHint_ZSTD,
+		Level:       3,
+		Cache:       pb.StorageHint_COLD,
+	}, result.Preds[0].Storage)
+	require.EqualValues(t, &pb.StorageHint{
+		Compression: pb.StorageHint_SNAPPY,
+		Cache:       pb.StorageHint_HOT,
+	}, result.Preds[1].Storage)
+	require.Equal(t, "compression: zstd, level: 7", StorageHintString(result.Preds[2].Storage))
+	require.Nil(t, result.Preds[3].Storage)
+}
+
+func TestParseStorageError(t *testing.T) {
+	for _, s := range []string{
+		"blob: string @storage .",
+		"blob: string @storage(compression: lz4) .",
+		"blob: string @storage(compression: snappy, level: 3) .",
+		"blob: string @storage(compression: zstd, level: 0) .",
+		"blob: string @storage(cache: warm) .",
+		"blob: string @storage(cache: hot, cache: cold) .",
+		"blob: string @storage(size: 3) .",
+		"blob: string @storage(cache hot) .",
+		"blob: string @storage(cache: hot compression: zstd) .",
+	} {
+		reset()
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseEncrypted(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		ssn: string @encrypted .
+		name: string @index(exact) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Preds))
+	require.True(t, result.Preds[0].Encrypted)
+	require.False(t, result.Preds[1].Encrypted)
+}
+
+func TestParseEncryptedError(t *testing.T) {
+	for _, s := range []string{
+		"ssn: int @encrypted .",
+		"ssn: [string] @encrypted @index(exact) .",
+		"ssn: [string] @encrypted .",
+		"ssn: string @encrypted @index(exact) .",
+		"ssn: string @index(exact) @upsert @encrypted .",
+	} {
+		reset()
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
 func TestParseEmptyType(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -71,8 +72,15 @@ type state struct {
 	elog      trace.EventLog
 	// mutSchema holds the schema update that is being applied in the background.
 	mutSchema map[string]*pb.SchemaUpdate
+	// numStorageHints is the number of predicates with a storage hint. It lets the posting
+	// lists skip looking up the hint of their predicate when there is none.
+	numStorageHints int32
 }
 
+// typesVersion is incremented whenever a type is set or deleted. It is not reset along with the
+// state, so that the values derived from the types of an older state are never taken as current.
+var typesVersion uint64
+
 // State returns the struct holding the current schema.
 func State() *state {
 	return pstate
@@ -85,10 +93,12 @@ func (s *state) DeleteAll() {
 	for pred := range s.predicate {
 		delete(s.predicate, pred)
 	}
+	atomic.StoreInt32(&s.numStorageHints, 0)
 
 	for typ := range s.types {
 		delete(s.types, typ)
 	}
+	atomic.AddUint64(&typesVersion, 1)
 
 	for pred := range s.mutSchema {
 		delete(s.mutSchema, pred)
@@ -110,6 +120,9 @@ func (s *state) Delete(attr string) error {
 		return err
 	}
 
+	if s.predicate[attr].GetStorage() != nil {
+		atomic.AddInt32(&s.numStorageHints, -1)
+	}
 	delete(s.predicate, attr)
 	delete(s.mutSchema, attr)
 	return nil
@@ -135,6 +148,7 @@ func (s *state) DeleteType(typeName string) error {
 	}
 
 	delete(s.types, typeName)
+	atomic.AddUint64(&typesVersion, 1)
 	return nil
 }
 
@@ -164,6 +178,12 @@ func (s *state) Set(pred string, schema *pb.SchemaUpdate) {
 
 	s.Lock()
 	defer s.Unlock()
+	if s.predicate[pred].GetStorage() != nil {
+		atomic.AddInt32(&s.numStorageHints, -1)
+	}
+	if schema.Storage != nil {
+		atomic.AddInt32(&s.numStorageHints, 1)
+	}
 	s.predicate[pred] = schema
 	s.elog.Printf(logUpdate(schema, pred))
 }
@@ -204,9 +224,15 @@ func (s *state) SetType(typeName string, typ pb.TypeUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.types[typeName] = &typ
+	atomic.AddUint64(&typesVersion, 1)
 	s.elog.Printf(logTypeUpdate(typ, typeName))
 }
 
+// TypesVersion returns a number which changes whenever a type is set or deleted.
+func (s *state) TypesVersion() uint64 {
+	return atomic.LoadUint64(&typesVersion)
+}
+
 // Get gets the schema for the given predicate.
 func (s *state) Get(ctx context.Context, pred string) (pb.SchemaUpdate, bool) {
 	isWrite, _ := ctx.Value(isWrite).(bool)
@@ -410,6 +436,28 @@ func (s *state) HasNoConflict(pred string) bool {
 	return s.predicate[pred].GetNoConflict()
 }
 
+// IsEncrypted returns whether the values of the predicate are encrypted.
+func (s *state) IsEncrypted(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.predicate[pred].GetEncrypted()
+}
+
+// StorageHint returns the storage hint set for the predicate, or nil if there is none.
+func (s *state) StorageHint(pred string) *pb.StorageHint {
+	if atomic.LoadInt32(&s.numStorageHints) == 0 {
+		return nil
+	}
+	s.RLock()
+	defer s.RUnlock()
+	return s.predicate[pred].GetStorage()
+}
+
+// HasStorageHints returns true if any predicate has a storage hint.
+func (s *state) HasStorageHints() bool {
+	return atomic.LoadInt32(&s.numStorageHints) > 0
+}
+
 // IndexingInProgress checks whether indexing is going on for a given predicate.
 func (s *state) IndexingInProgress() bool {
 	s.RLock()
@@ -616,6 +664,10 @@ func initialTypesInternal(all bool) []*pb.TypeUpdate {
 					Predicate: "dgraph.user.group",
 					ValueType: pb.Posting_UID,
 				},
+				{
+					Predicate: "dgraph.user.password_changed",
+					ValueType: pb.Posting_DATETIME,
+				},
 			},
 		},
 			&pb.TypeUpdate{
@@ -629,6 +681,18 @@ func initialTypesInternal(all bool) []*pb.TypeUpdate {
 						Predicate: "dgraph.acl.rule",
 						ValueType: pb.Posting_UID,
 					},
+					{
+						Predicate: "dgraph.acl.rate_limit",
+						ValueType: pb.Posting_FLOAT,
+					},
+					{
+						Predicate: "dgraph.acl.burst",
+						ValueType: pb.Posting_INT,
+					},
+					{
+						Predicate: "dgraph.acl.policy",
+						ValueType: pb.Posting_UID,
+					},
 				},
 			},
 			&pb.TypeUpdate{
@@ -643,6 +707,48 @@ func initialTypesInternal(all bool) []*pb.TypeUpdate {
 						ValueType: pb.Posting_INT,
 					},
 				},
+			},
+			&pb.TypeUpdate{
+				TypeName: "dgraph.type.Policy",
+				Fields: []*pb.SchemaUpdate{
+					{
+						Predicate: "dgraph.policy.type",
+						ValueType: pb.Posting_STRING,
+					},
+					{
+						Predicate: "dgraph.policy.filter",
+						ValueType: pb.Posting_STRING,
+					},
+				},
+			},
+			&pb.TypeUpdate{
+				TypeName: "dgraph.type.ApiKey",
+				Fields: []*pb.SchemaUpdate{
+					{
+						Predicate: "dgraph.apikey.name",
+						ValueType: pb.Posting_STRING,
+					},
+					{
+						Predicate: "dgraph.apikey.hash",
+						ValueType: pb.Posting_STRING,
+					},
+					{
+						Predicate: "dgraph.apikey.group",
+						ValueType: pb.Posting_UID,
+					},
+					{
+						Predicate: "dgraph.apikey.expiry",
+						ValueType: pb.Posting_DATETIME,
+					},
+					{
+						Predicate: "dgraph.apikey.ips",
+						ValueType: pb.Posting_STRING,
+					},
+					{
+						Predicate: "dgraph.apikey.readonly",
+						ValueType: pb.Posting_BOOL,
+					},
+				},
 			})
 	}
 
@@ -730,11 +836,28 @@ func initialSchemaInternal(all bool) []*pb.SchemaUpdate {
 				ValueType: pb.Posting_UID,
 				List:      true,
 			},
+			{
+				Predicate: "dgraph.user.password_changed",
+				ValueType: pb.Posting_DATETIME,
+			},
 			{
 				Predicate: "dgraph.acl.rule",
 				ValueType: pb.Posting_UID,
 				List:      true,
 			},
+			{
+				Predicate: "dgraph.acl.rate_limit",
+				ValueType: pb.Posting_FLOAT,
+			},
+			{
+				Predicate: "dgraph.acl.burst",
+				ValueType: pb.Posting_INT,
+			},
+			{
+				Predicate: "dgraph.acl.policy",
+				ValueType: pb.Posting_UID,
+				List:      true,
+			},
 			{
 				Predicate: "dgraph.rule.predicate",
 				ValueType: pb.Posting_STRING,
@@ -746,6 +869,46 @@ func initialSchemaInternal(all bool) []*pb.SchemaUpdate {
 				Predicate: "dgraph.rule.permission",
 				ValueType: pb.Posting_INT,
 			},
+			{
+				Predicate: "dgraph.policy.type",
+				ValueType: pb.Posting_STRING,
+				Directive: pb.SchemaUpdate_INDEX,
+				Tokenizer: []string{"exact"},
+				Upsert:    true,
+			},
+			{
+				Predicate: "dgraph.policy.filter",
+				ValueType: pb.Posting_STRING,
+			},
+			{
+				Predicate: "dgraph.apikey.name",
+				ValueType: pb.Posting_STRING,
+				Directive: pb.SchemaUpdate_INDEX,
+				Tokenizer: []string{"exact"},
+				Upsert:    true,
+			},
+			{
+				Predicate: "dgraph.apikey.hash",
+				ValueType: pb.Posting_STRING,
+			},
+			{
+				Predicate: "dgraph.apikey.group",
+				Directive: pb.SchemaUpdate_REVERSE,
+				ValueType: pb.Posting_UID,
+			},
+			{
+				Predicate: "dgraph.apikey.expiry",
+				ValueType: pb.Posting_DATETIME,
+			},
+			{
+				Predicate: "dgraph.apikey.ips",
+				ValueType: pb.Posting_STRING,
+				List:      true,
+			},
+			{
+				Predicate: "dgraph.apikey.readonly",
+				ValueType: pb.Posting_BOOL,
+			},
 		}...)
 	}
 
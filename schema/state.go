@@ -35,6 +35,7 @@ const (
 	itemLeftSquare
 	itemRightSquare
 	itemExclamationMark
+	itemNumber
 )
 
 func lexText(l *lex.Lexer) lex.StateFn {
@@ -81,6 +82,8 @@ Loop:
 		case r == '_':
 			// Predicates can start with _.
 			return lexWord
+		case isDigit(r):
+			return lexNumber
 		default:
 			return l.Errorf("Invalid schema. Unexpected %s", l.Input[l.Start:l.Pos])
 		}
@@ -106,6 +109,21 @@ func lexWord(l *lex.Lexer) lex.StateFn {
 	return lexText
 }
 
+// lexNumber lexes an integer, like the arguments of @storage.
+func lexNumber(l *lex.Lexer) lex.StateFn {
+	for {
+		// The caller already checked isDigit, and absorbed one rune.
+		r := l.Next()
+		if isDigit(r) {
+			continue
+		}
+		l.Backup()
+		l.Emit(itemNumber)
+		break
+	}
+	return lexText
+}
+
 // lexTextComment lexes a comment text inside a schema.
 func lexTextComment(l *lex.Lexer) lex.StateFn {
 	for {
@@ -137,6 +155,10 @@ func isNameBegin(r rune) bool {
 	}
 }
 
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
 func isNameSuffix(r rune) bool {
 	if isNameBegin(r) {
 		return true
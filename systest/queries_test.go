@@ -399,12 +399,45 @@ func SchemaQueryTestPredicate1(t *testing.T, c *dgo.Dgraph) {
 	  {
 		  "predicate": "dgraph.acl.rule"
 	  },
+	  {
+		  "predicate": "dgraph.acl.rate_limit"
+	  },
+	  {
+		  "predicate": "dgraph.acl.burst"
+	  },
+	  {
+		  "predicate": "dgraph.acl.policy"
+	  },
 	  {
 		  "predicate": "dgraph.rule.predicate"
 	  },
 	  {
 		  "predicate": "dgraph.rule.permission"
 	  },
+	  {
+		  "predicate": "dgraph.policy.type"
+	  },
+	  {
+		  "predicate": "dgraph.policy.filter"
+	  },
+	  {
+		  "predicate": "dgraph.apikey.name"
+	  },
+	  {
+		  "predicate": "dgraph.apikey.hash"
+	  },
+	  {
+		  "predicate": "dgraph.apikey.group"
+	  },
+	  {
+		  "predicate": "dgraph.apikey.expiry"
+	  },
+	  {
+		  "predicate": "dgraph.apikey.ips"
+	  },
+	  {
+		  "predicate": "dgraph.apikey.readonly"
+	  },
 	  {
         "predicate": "dgraph.graphql.schema"
 	  },
@@ -414,6 +447,9 @@ func SchemaQueryTestPredicate1(t *testing.T, c *dgo.Dgraph) {
       {
         "predicate": "dgraph.user.group"
       },
+      {
+        "predicate": "dgraph.user.password_changed"
+      },
       {
         "predicate": "friends"
       },
@@ -30,9 +30,21 @@ const (
 {"predicate":"dgraph.xid","type":"string", "index":true, "tokenizer":["exact"], "upsert":true},
 {"predicate":"dgraph.password","type":"password"},
 {"predicate":"dgraph.user.group","list":true, "reverse":true, "type":"uid"},
+{"predicate":"dgraph.user.password_changed","type":"datetime"},
 {"predicate":"dgraph.acl.rule","type":"uid","list":true},
+{"predicate":"dgraph.acl.rate_limit","type":"float"},
+{"predicate":"dgraph.acl.burst","type":"int"},
+{"predicate":"dgraph.acl.policy","type":"uid","list":true},
 {"predicate":"dgraph.rule.predicate","type":"string","index":true,"tokenizer":["exact"],"upsert":true},
-{"predicate":"dgraph.rule.permission","type":"int"}
+{"predicate":"dgraph.rule.permission","type":"int"},
+{"predicate":"dgraph.policy.type","type":"string","index":true,"tokenizer":["exact"],"upsert":true},
+{"predicate":"dgraph.policy.filter","type":"string"},
+{"predicate":"dgraph.apikey.name","type":"string","index":true,"tokenizer":["exact"],"upsert":true},
+{"predicate":"dgraph.apikey.hash","type":"string"},
+{"predicate":"dgraph.apikey.group","type":"uid","reverse":true},
+{"predicate":"dgraph.apikey.expiry","type":"datetime"},
+{"predicate":"dgraph.apikey.ips","type":"string","list":true},
+{"predicate":"dgraph.apikey.readonly","type":"bool"}
 `
 	otherInternalPreds = `
 {"predicate":"dgraph.type","type":"string","index":true,"tokenizer":["exact"],"list":true},
@@ -47,14 +59,20 @@ const (
 `
 	aclTypes = `
 {
-	"fields": [{"name": "dgraph.password"},{"name": "dgraph.xid"},{"name": "dgraph.user.group"}],
+	"fields": [{"name": "dgraph.password"},{"name": "dgraph.xid"},{"name": "dgraph.user.group"},{"name": "dgraph.user.password_changed"}],
 	"name": "dgraph.type.User"
 },{
-	"fields": [{"name": "dgraph.acl.rule"},{"name": "dgraph.xid"}],
+	"fields": [{"name": "dgraph.acl.rule"},{"name": "dgraph.acl.rate_limit"},{"name": "dgraph.acl.burst"},{"name": "dgraph.acl.policy"},{"name": "dgraph.xid"}],
 	"name": "dgraph.type.Group"
 },{
 	"fields": [{"name": "dgraph.rule.predicate"},{"name": "dgraph.rule.permission"}],
 	"name": "dgraph.type.Rule"
+},{
+	"fields": [{"name": "dgraph.policy.type"},{"name": "dgraph.policy.filter"}],
+	"name": "dgraph.type.Policy"
+},{
+	"fields": [{"name": "dgraph.apikey.name"},{"name": "dgraph.apikey.hash"},{"name": "dgraph.apikey.group"},{"name": "dgraph.apikey.expiry"},{"name": "dgraph.apikey.ips"},{"name": "dgraph.apikey.readonly"}],
+	"name": "dgraph.type.ApiKey"
 }
 `
 	otherInternalTypes = `
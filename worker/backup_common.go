@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
 )
 
 const (
@@ -59,9 +60,15 @@ type Manifest struct {
 	Path string `json:"-"`
 	// Encrypted indicates whether this backup was encrypted or not.
 	Encrypted bool `json:"encrypted"`
+	// EncryptionKeyId identifies the key that encrypted this backup, so that the key needed
+	// to restore it is known after the encryption key is rotated. See x.SensitiveByteSlice.KeyId.
+	EncryptionKeyId string `json:"encryption_key_id,omitempty"`
 	// DropOperations lists the various DROP operations that took place since the last backup.
 	// These are used during restore to redo those operations before applying the backup.
 	DropOperations []*pb.DropOperation `json:"drop_operations"`
+	// Namespaces lists the namespaces covered by this backup. The backup covers all the
+	// namespaces if it's empty.
+	Namespaces []uint64 `json:"namespaces,omitempty"`
 }
 
 func (m *Manifest) getPredsInGroup(gid uint32) predicateSet {
@@ -77,6 +84,38 @@ func (m *Manifest) getPredsInGroup(gid uint32) predicateSet {
 	return predSet
 }
 
+// inNamespaces returns true if the attribute belongs to one of the given namespaces, or if no
+// namespace is given.
+func inNamespaces(attr string, namespaces []uint64) bool {
+	if len(namespaces) == 0 {
+		return true
+	}
+	ns := x.ParseNamespace(attr)
+	for _, n := range namespaces {
+		if n == ns {
+			return true
+		}
+	}
+	return false
+}
+
+// sameNamespaces returns true if both lists hold the same namespaces, in any order.
+func sameNamespaces(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[uint64]struct{}, len(a))
+	for _, ns := range a {
+		set[ns] = struct{}{}
+	}
+	for _, ns := range b {
+		if _, ok := set[ns]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // GetCredentialsFromRequest extracts the credentials from a backup request.
 func GetCredentialsFromRequest(req *pb.BackupRequest) *Credentials {
 	return &Credentials{
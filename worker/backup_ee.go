@@ -136,16 +136,30 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest, forceFull
 	}
 
 	req.SinceTs = latestManifest.Since
+	if latestManifest.Type != "" && !sameNamespaces(latestManifest.Namespaces, req.Namespaces) {
+		// An incremental backup must cover the same namespaces as the rest of its series.
+		glog.Infof("Latest backup covers the namespaces %v instead of %v. Taking a full backup.",
+			latestManifest.Namespaces, req.Namespaces)
+		forceFull = true
+	}
 	if forceFull {
 		req.SinceTs = 0
 	} else {
-		if x.WorkerConfig.EncryptionKey != nil {
+		if key := x.EncryptionKey(); key != nil {
 			// If encryption key given, latest backup should be encrypted.
 			if latestManifest.Type != "" && !latestManifest.Encrypted {
 				err = errors.Errorf("latest manifest indicates the last backup was not encrypted " +
 					"but this instance has encryption turned on. Try \"forceFull\" flag.")
 				return err
 			}
+			// It should also be encrypted with the same key, since a series is restored with
+			// one key.
+			keyId := key.KeyId()
+			if latestManifest.EncryptionKeyId != "" && latestManifest.EncryptionKeyId != keyId {
+				err = errors.Errorf("latest manifest indicates the last backup was encrypted " +
+					"with another key than the current one. Try \"forceFull\" flag.")
+				return err
+			}
 		} else {
 			// If encryption turned off, latest backup should be unencrypted.
 			if latestManifest.Type != "" && latestManifest.Encrypted {
@@ -169,7 +183,11 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest, forceFull
 		groups = append(groups, gid)
 		predMap[gid] = make([]string, 0)
 		for pred := range group.Tablets {
-			predMap[gid] = append(predMap[gid], pred)
+			// The drops of the galaxy namespace are backed up with every namespace, because
+			// DROP_ALL and DROP_DATA also drop the other namespaces.
+			if inNamespaces(pred, req.Namespaces) || pred == "dgraph.drop.op" {
+				predMap[gid] = append(predMap[gid], pred)
+			}
 		}
 	}
 
@@ -197,8 +215,20 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest, forceFull
 			dropOperations = append(dropOperations, backupRes.res.GetDropOperations()...)
 		}
 	}
+	if len(req.Namespaces) > 0 {
+		// Only the predicates dropped in the backed up namespaces are replayed on restore.
+		var ops []*pb.DropOperation
+		for _, op := range dropOperations {
+			if op.DropOp == pb.DropOperation_ATTR && !inNamespaces(op.DropValue, req.Namespaces) {
+				continue
+			}
+			ops = append(ops, op)
+		}
+		dropOperations = ops
+	}
 
-	m := Manifest{Since: req.ReadTs, Groups: predMap, DropOperations: dropOperations}
+	m := Manifest{Since: req.ReadTs, Groups: predMap, DropOperations: dropOperations,
+		Namespaces: req.Namespaces}
 	if req.SinceTs == 0 {
 		m.Type = "full"
 		m.BackupId = x.GetRandomName(1)
@@ -208,7 +238,9 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest, forceFull
 		m.BackupId = latestManifest.BackupId
 		m.BackupNum = latestManifest.BackupNum + 1
 	}
-	m.Encrypted = (x.WorkerConfig.EncryptionKey != nil)
+	key := x.EncryptionKey()
+	m.Encrypted = (key != nil)
+	m.EncryptionKeyId = key.KeyId()
 
 	bp := NewBackupProcessor(nil, req)
 	return bp.CompleteBackup(ctx, &m)
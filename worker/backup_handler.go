@@ -19,6 +19,7 @@ import (
 	"sort"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
 
 	"github.com/pkg/errors"
 )
@@ -167,6 +168,45 @@ func LoadBackup(location, backupId string, backupNum uint64, creds *Credentials,
 	return h.Load(uri, backupId, backupNum, fn)
 }
 
+// VerifyBackupKey checks that the backups of the given series were encrypted with key. The
+// manifests of backups taken before the key ID was recorded aren't checked.
+func VerifyBackupKey(location, backupId string, backupNum uint64, creds *Credentials,
+	key x.SensitiveByteSlice) error {
+	uri, err := url.Parse(location)
+	if err != nil {
+		return err
+	}
+
+	h := getHandler(uri.Scheme, creds)
+	if h == nil {
+		return errors.Errorf("Unsupported URI: %v", uri)
+	}
+
+	manifests, err := h.GetManifests(uri, backupId, backupNum)
+	if err != nil {
+		return errors.Wrapf(err, "cannot retrieve manifests")
+	}
+	for _, manifest := range manifests {
+		if err := verifyManifestKey(manifest, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyManifestKey checks that the backup of the manifest was encrypted with key.
+func verifyManifestKey(manifest *Manifest, key x.SensitiveByteSlice) error {
+	if manifest.EncryptionKeyId == "" || manifest.EncryptionKeyId == key.KeyId() {
+		return nil
+	}
+	if len(key) == 0 {
+		return errors.Errorf("the backup at %s is encrypted with the key with ID %s, "+
+			"but no key was given", manifest.Path, manifest.EncryptionKeyId)
+	}
+	return errors.Errorf("the backup at %s is encrypted with the key with ID %s, "+
+		"but the given key has ID %s", manifest.Path, manifest.EncryptionKeyId, key.KeyId())
+}
+
 // VerifyBackup will access the backup location and verify that the specified backup can
 // be restored to the cluster.
 func VerifyBackup(req *pb.RestoreRequest, creds *Credentials, currentGroups []uint32) error {
@@ -256,12 +296,22 @@ func verifyManifests(manifests []*Manifest) error {
 	}
 
 	backupId := manifests[0].BackupId
+	// The manifests written before the key IDs were recorded have no key ID, and match any key.
+	var keyId string
 	var backupNum uint64
 	for _, manifest := range manifests {
 		if manifest.BackupId != backupId {
 			return errors.Errorf("found a manifest with backup ID %s but expected %s",
 				manifest.BackupId, backupId)
 		}
+		switch {
+		case manifest.EncryptionKeyId == "":
+		case keyId == "":
+			keyId = manifest.EncryptionKeyId
+		case manifest.EncryptionKeyId != keyId:
+			return errors.Errorf("found a manifest with encryption key ID %q but expected %q",
+				manifest.EncryptionKeyId, keyId)
+		}
 
 		backupNum++
 		if manifest.BackupNum != backupNum {
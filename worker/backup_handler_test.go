@@ -15,6 +15,9 @@ package worker
 import (
 	"testing"
 
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
 )
 
@@ -138,3 +141,131 @@ func TestFilterManifestDifferentSeries(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "found a manifest with backup ID")
 }
+
+func TestFilterManifestDifferentKeys(t *testing.T) {
+	manifests := []*Manifest{
+		{
+			Type:            "full",
+			BackupId:        "aa",
+			BackupNum:       1,
+			EncryptionKeyId: "0123456789abcdef",
+		},
+		{
+			Type:            "incremental",
+			BackupId:        "aa",
+			BackupNum:       2,
+			EncryptionKeyId: "fedcba9876543210",
+		},
+	}
+	_, err := filterManifests(manifests, "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "found a manifest with encryption key ID")
+}
+
+func TestFilterManifestKeyIdAdded(t *testing.T) {
+	// A full backup taken before the key IDs were recorded, followed by an incremental one with
+	// the ID of the key.
+	manifests := []*Manifest{
+		{
+			Type:      "full",
+			BackupId:  "aa",
+			BackupNum: 1,
+			Encrypted: true,
+		},
+		{
+			Type:            "incremental",
+			BackupId:        "aa",
+			BackupNum:       2,
+			Encrypted:       true,
+			EncryptionKeyId: "0123456789abcdef",
+		},
+		{
+			Type:            "incremental",
+			BackupId:        "aa",
+			BackupNum:       3,
+			Encrypted:       true,
+			EncryptionKeyId: "fedcba9876543210",
+		},
+	}
+	filtered, err := filterManifests(manifests[:2], "")
+	require.NoError(t, err)
+	require.Len(t, filtered, 2)
+
+	_, err = filterManifests(manifests, "")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "found a manifest with encryption key ID")
+}
+
+func TestVerifyManifestKey(t *testing.T) {
+	key := x.SensitiveByteSlice("1234567890123456")
+	otherKey := x.SensitiveByteSlice("6543210987654321")
+
+	// Manifests without a key ID are not checked.
+	require.NoError(t, verifyManifestKey(&Manifest{}, nil))
+	require.NoError(t, verifyManifestKey(&Manifest{}, key))
+
+	m := &Manifest{EncryptionKeyId: key.KeyId()}
+	require.NoError(t, verifyManifestKey(m, key))
+	err := verifyManifestKey(m, otherKey)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "but the given key has ID "+otherKey.KeyId())
+	err = verifyManifestKey(m, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "but no key was given")
+}
+
+func TestDropNamespacesBeforeRestore(t *testing.T) {
+	db, err := badger.OpenManaged(badger.DefaultOptions("").WithInMemory(true))
+	require.NoError(t, err)
+	defer db.Close()
+
+	galaxyName := x.NamespaceAttr(x.GalaxyNamespace, "name")
+	name := x.NamespaceAttr(5, "name")
+	typ := x.NamespaceAttr(5, "Person")
+	setKeys := func() {
+		txn := db.NewTransactionAt(1, true)
+		for _, key := range [][]byte{
+			x.SchemaKey(galaxyName), x.DataKey(galaxyName, 1), x.TypeKey("Person"),
+			x.SchemaKey(name), x.DataKey(name, 1), x.TypeKey(typ),
+		} {
+			require.NoError(t, txn.Set(key, []byte("v")))
+		}
+		require.NoError(t, txn.CommitAt(1, nil))
+	}
+	exists := func(key []byte) bool {
+		txn := db.NewTransactionAt(1, false)
+		defer txn.Discard()
+		_, err := txn.Get(key)
+		if err == badger.ErrKeyNotFound {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+
+	// DROP_ALL only drops the keys of the namespace.
+	setKeys()
+	ops := []*pb.DropOperation{{DropOp: pb.DropOperation_ALL}}
+	require.NoError(t, dropNamespacesBeforeRestore(db, ops, []uint64{5}))
+	require.False(t, exists(x.SchemaKey(name)))
+	require.False(t, exists(x.DataKey(name, 1)))
+	require.False(t, exists(x.TypeKey(typ)))
+	require.True(t, exists(x.SchemaKey(galaxyName)))
+	require.True(t, exists(x.DataKey(galaxyName, 1)))
+	require.True(t, exists(x.TypeKey("Person")))
+
+	// Without drop operations, only the schema and the types of the namespace are dropped.
+	setKeys()
+	require.NoError(t, dropNamespacesBeforeRestore(db, nil, []uint64{5}))
+	require.False(t, exists(x.SchemaKey(name)))
+	require.False(t, exists(x.TypeKey(typ)))
+	require.True(t, exists(x.DataKey(name, 1)))
+}
+
+func TestInNamespaces(t *testing.T) {
+	require.True(t, inNamespaces(x.NamespaceAttr(5, "name"), nil))
+	require.True(t, inNamespaces(x.NamespaceAttr(5, "name"), []uint64{1, 5}))
+	require.False(t, inNamespaces("name", []uint64{5}))
+	require.True(t, sameNamespaces([]uint64{1, 5}, []uint64{5, 1}))
+	require.False(t, sameNamespaces(nil, []uint64{0}))
+}
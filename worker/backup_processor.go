@@ -120,7 +120,7 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 
 	var maxVersion uint64
 
-	newhandler, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, handler)
+	newhandler, err := enc.GetWriter(x.EncryptionKey(), handler)
 	if err != nil {
 		return &response, err
 	}
@@ -157,9 +157,9 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 			return false
 		}
 
-		// Backup type keys in every group.
+		// Backup type keys of the requested namespaces in every group.
 		if parsedKey.IsType() {
-			return true
+			return inNamespaces(parsedKey.Attr, pr.Request.Namespaces)
 		}
 
 		// Only backup schema and data keys for the requested predicates.
@@ -18,6 +18,7 @@ package worker
 
 import (
 	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	bo "github.com/dgraph-io/badger/v3/options"
@@ -58,12 +59,33 @@ type Options struct {
 	// WalCache is the size of block cache for wstore
 	WalCache int64
 
-	// HmacSecret stores the secret used to sign JSON Web Tokens (JWT).
+	// HmacSecret stores the secret used to sign JSON Web Tokens (JWT) at startup. Use
+	// HmacSecrets to get the secrets in use, which change when the secret is rotated.
 	HmacSecret x.SensitiveByteSlice
 	// AccessJwtTtl is the TTL for the access JWT.
 	AccessJwtTtl time.Duration
 	// RefreshJwtTtl is the TTL of the refresh JWT.
 	RefreshJwtTtl time.Duration
+	// PasswordMinLength is the min length of the passwords of the ACL users.
+	PasswordMinLength int
+	// PasswordMinClasses is the min number of character classes (lower case letters, upper case
+	// letters, digits and other characters) of the passwords of the ACL users.
+	PasswordMinClasses int
+	// PasswordMaxAge is the age after which the passwords of the ACL users expire. Zero means
+	// that they never expire.
+	PasswordMaxAge time.Duration
+	// LoginLockoutThreshold is the number of failed logins after which an ACL user is locked
+	// out. Zero means that the users are never locked out.
+	LoginLockoutThreshold int
+	// LoginLockoutDuration is how long the first lockout of an ACL user lasts. Every further
+	// failed login doubles it.
+	LoginLockoutDuration time.Duration
+	// IPRateLimit is the number of requests per second allowed from each client IP, for the
+	// requests without an ACL user. Zero means that they aren't limited.
+	IPRateLimit float64
+	// IPRateBurst is the max number of requests allowed at once from a client IP. If it is
+	// zero, it defaults to IPRateLimit.
+	IPRateBurst int64
 
 	// CachePercentage is the comma-separated list of cache percentages
 	// used to split the total cache size among the multiple caches.
@@ -82,6 +104,25 @@ func SetConfiguration(newConfig *Options) {
 	}
 	newConfig.validate()
 	Config = *newConfig
+	hmacSecrets.Store([]x.SensitiveByteSlice{Config.HmacSecret})
+}
+
+// hmacSecrets holds the current HMAC secret, followed by the one it replaced, if any.
+var hmacSecrets atomic.Value
+
+// HmacSecrets returns the secrets of the JWTs. The first one signs new JWTs. After a rotation,
+// the second one is the secret it replaced, and JWTs signed with it are still valid.
+func HmacSecrets() []x.SensitiveByteSlice {
+	if secrets, ok := hmacSecrets.Load().([]x.SensitiveByteSlice); ok {
+		return secrets
+	}
+	return []x.SensitiveByteSlice{Config.HmacSecret}
+}
+
+// RotateHmacSecret makes secret the one that signs new JWTs. The JWTs signed with the secret
+// it replaces stay valid until they expire, or until the next rotation.
+func RotateHmacSecret(secret x.SensitiveByteSlice) {
+	hmacSecrets.Store([]x.SensitiveByteSlice{secret, HmacSecrets()[0]})
 }
 
 // AvailableMemory is the total size of the memory we were able to identify.
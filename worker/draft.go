@@ -54,6 +54,8 @@ import (
 type node struct {
 	// This needs to be 64 bit aligned for atomics to work on 32 bit machine.
 	pendingSize int64
+	// Time in unix nanoseconds when this node was last caught up with the leader of its group.
+	caughtUpAt int64
 
 	// embedded struct
 	*conn.Node
@@ -430,6 +432,7 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 			schemaMap[edge.Attr] = posting.TypeID(edge)
 		}
 	}
+	tabletLoads.addWrites(proposal.Mutations.Edges)
 
 	total := len(proposal.Mutations.Edges)
 
@@ -576,8 +579,21 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 				proposal.CleanPredicate, proposal.ExpectedChecksum)
 			return nil
 		}
+		if attr, start, ok := x.ParseSplitTablet(proposal.CleanPredicate); ok {
+			// Only the uid range of the split tablet has to be deleted.
+			end := splitEnd(groups().tabletSplits(attr), start)
+			return posting.DeleteDataRange(ctx, attr, start, end, posting.Oracle().MaxAssigned())
+		}
 		return posting.DeletePredicate(ctx, proposal.CleanPredicate)
 
+	case len(proposal.CancelIndexBuild) > 0:
+		// The build stops and the schema served while building the indexes is kept. Replicas
+		// which already finished building the indexes serve the new schema.
+		if posting.CancelIndexBuild(proposal.CancelIndexBuild) {
+			glog.Infof("Cancelled index build for predicate %s", proposal.CancelIndexBuild)
+		}
+		return nil
+
 	case proposal.Delta != nil:
 		n.elog.Printf("Applying Oracle Delta for key: %d", key)
 		return n.commitOrAbort(key, proposal.Delta)
@@ -1054,6 +1070,7 @@ func (n *node) Run() {
 
 	firstRun := true
 	var leader bool
+	var commit uint64
 	// See also our configuration of HeartbeatTick and ElectionTick.
 	// Before we used to have 20ms ticks, but they would overload the Raft tick channel, causing
 	// "tick missed to fire" logs. Etcd uses 100ms and they haven't seen those issues.
@@ -1097,6 +1114,7 @@ func (n *node) Run() {
 			// indefinitely because checkpoints and snapshots are being calculated indefinitely.
 		case <-ticker.C:
 			n.Raft().Tick()
+			n.updateCaughtUp(leader, commit)
 
 		case rd := <-n.Raft().Ready():
 			timer.Start()
@@ -1189,6 +1207,9 @@ func (n *node) Run() {
 
 			// Store the hardstate and entries. Note that these are not CommittedEntries.
 			n.SaveToStorage(&rd.HardState, rd.Entries, &rd.Snapshot)
+			if !raft.IsEmptyHardState(rd.HardState) {
+				commit = rd.HardState.Commit
+			}
 			timer.Record("disk")
 			if span != nil {
 				span.Annotatef(nil, "Saved %d entries. Snapshot, HardState empty? (%v, %v)",
@@ -1319,6 +1340,23 @@ func listWrap(kv *bpb.KV) *bpb.KVList {
 	return &bpb.KVList{Kv: []*bpb.KV{kv}}
 }
 
+// updateCaughtUp records the time this node was last caught up with the leader of its group, given
+// the latest commit index it knows of. It is called on every tick.
+func (n *node) updateCaughtUp(leader bool, commit uint64) {
+	switch {
+	case leader:
+		atomic.StoreInt64(&n.caughtUpAt, time.Now().UnixNano())
+	case n.Applied.DoneUntil() >= commit:
+		// The commit index is as recent as the last message from the leader.
+		atomic.StoreInt64(&n.caughtUpAt, n.LastLeaderContact().UnixNano())
+	}
+}
+
+// staleness returns how far behind the leader of its group this node might be.
+func (n *node) staleness() time.Duration {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&n.caughtUpAt)))
+}
+
 // calculateTabletSizes updates the tablet sizes for the keys.
 func (n *node) calculateTabletSizes() {
 	if !n.AmLeader() {
@@ -1334,6 +1372,10 @@ func (n *node) calculateTabletSizes() {
 		if pred == "" {
 			return
 		}
+		if tablet := groups().splitTabletFor(pred, n.gid); tablet != "" {
+			// This group only serves a uid range of the predicate.
+			pred = tablet
+		}
 		if tablet, ok := tablets[pred]; ok {
 			tablet.OnDiskBytes += int64(tinfo.OnDiskSize)
 			tablet.UncompressedBytes += int64(tinfo.UncompressedSize)
@@ -44,6 +44,7 @@ import (
 	"github.com/dgraph-io/dgraph/ee/enc"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
@@ -76,6 +77,19 @@ type exporter struct {
 	uid    uint64
 	attr   string
 	readTs uint64
+	// dataKey is the data key of the predicate, if it is @encrypted.
+	dataKey x.SensitiveByteSlice
+}
+
+// value returns the value of a posting, decrypted if the predicate is @encrypted. The values are
+// exported in the clear, since the data key of the predicate isn't exported.
+func (e *exporter) value(p *pb.Posting) []byte {
+	if e.dataKey != nil {
+		if val, err := enc.DecryptValue(e.dataKey, p.Value); err == nil {
+			return val
+		}
+	}
+	return p.Value
 }
 
 // Map from our types to RDF type. Useful when writing storage types
@@ -162,7 +176,7 @@ func (e *exporter) toJSON() (*bpb.KVList, error) {
 				fmt.Fprintf(bp, `,"%s":`, e.attr)
 			}
 
-			val := types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}
+			val := types.Val{Tid: types.TypeID(p.ValType), Value: e.value(p)}
 			str, err := valToStr(val)
 			if err != nil {
 				// Copying this behavior from RDF exporter.
@@ -221,7 +235,7 @@ func (e *exporter) toRDF() (*bpb.KVList, error) {
 		if p.PostingType == pb.Posting_REF {
 			fmt.Fprint(bp, fmt.Sprintf(uidFmtStrRdf, p.Uid))
 		} else {
-			val := types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}
+			val := types.Val{Tid: types.TypeID(p.ValType), Value: e.value(p)}
 			str, err := valToStr(val)
 			if err != nil {
 				glog.Errorf("Ignoring error: %+v\n", err)
@@ -311,6 +325,14 @@ func toSchema(attr string, update *pb.SchemaUpdate) (*bpb.KVList, error) {
 	if update.GetUpsert() {
 		x.Check2(buf.WriteString(" @upsert"))
 	}
+	if update.GetStorage() != nil {
+		x.Check2(buf.WriteString(" @storage("))
+		x.Check2(buf.WriteString(schema.StorageHintString(update.GetStorage())))
+		x.Check2(buf.WriteRune(')'))
+	}
+	if update.GetEncrypted() {
+		x.Check2(buf.WriteString(" @encrypted"))
+	}
 	x.Check2(buf.WriteString(" . \n"))
 	kv := &bpb.KV{
 		Value:   buf.Bytes(),
@@ -338,14 +360,15 @@ func toType(attr string, update pb.TypeUpdate) (*bpb.KVList, error) {
 func fieldToString(update *pb.SchemaUpdate) string {
 	var builder strings.Builder
 	x.Check2(builder.WriteString("\t"))
+	pred := x.ParseAttr(update.Predicate)
 	// While exporting type definitions, "<" and ">" brackets must be written around
 	// the name of reverse predicates or Dgraph won't be able to parse the exported schema.
-	if strings.HasPrefix(update.Predicate, "~") {
+	if strings.HasPrefix(pred, "~") {
 		x.Check2(builder.WriteString("<"))
-		x.Check2(builder.WriteString(update.Predicate))
+		x.Check2(builder.WriteString(pred))
 		x.Check2(builder.WriteString(">"))
 	} else {
-		x.Check2(builder.WriteString(update.Predicate))
+		x.Check2(builder.WriteString(pred))
 	}
 	x.Check2(builder.WriteString("\n"))
 	return builder.String()
@@ -365,7 +388,7 @@ func (writer *fileWriter) open(fpath string) error {
 		return err
 	}
 	writer.bw = bufio.NewWriterSize(writer.fd, 1e6)
-	w, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, writer.bw)
+	w, err := enc.GetWriter(x.EncryptionKey(), writer.bw)
 	if err != nil {
 		return err
 	}
@@ -604,6 +627,11 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 			return false
 		}
 
+		// Only the predicates and types of the namespace are exported.
+		if x.ParseNamespace(pk.Attr) != in.Namespace {
+			return false
+		}
+
 		if !pk.IsType() && !skipZero {
 			if servesTablet, err := groups().ServesTablet(pk.Attr); err != nil || !servesTablet {
 				return false
@@ -625,8 +653,11 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 		e := &exporter{
 			readTs: in.ReadTs,
 		}
+		// The predicates and types are exported with their names in the namespace, so that the
+		// export can be loaded into any namespace.
+		attr := x.ParseAttr(pk.Attr)
 		e.uid = pk.Uid
-		e.attr = pk.Attr
+		e.attr = attr
 
 		// Schema and type keys should be handled first because schema keys are also
 		// considered data keys.
@@ -641,7 +672,7 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 				glog.Errorf("Unable to unmarshal schema: %+v. Err=%v\n", pk, err)
 				return nil, nil
 			}
-			return toSchema(pk.Attr, &update)
+			return toSchema(attr, &update)
 
 		case pk.IsType():
 			var update pb.TypeUpdate
@@ -653,23 +684,23 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 				glog.Errorf("Unable to unmarshal type: %+v. Err=%v\n", pk, err)
 				return nil, nil
 			}
-			return toType(pk.Attr, update)
+			return toType(attr, update)
 
-		case pk.Attr == "dgraph.graphql.xid":
+		case attr == "dgraph.graphql.xid":
 			// Ignore this predicate.
-		case pk.Attr == "dgraph.cors":
+		case attr == "dgraph.cors":
 			// Ignore this predicate.
-		case pk.Attr == "dgraph.drop.op":
+		case attr == "dgraph.drop.op":
 			// Ignore this predicate.
-		case pk.Attr == "dgraph.graphql.schema_created_at":
+		case attr == "dgraph.graphql.schema_created_at":
 			// Ignore this predicate.
-		case pk.Attr == "dgraph.graphql.schema_history":
+		case attr == "dgraph.graphql.schema_history":
 			// Ignore this predicate.
-		case pk.Attr == "dgraph.graphql.p_query":
+		case attr == "dgraph.graphql.p_query":
 			// Ignore this predicate.
-		case pk.Attr == "dgraph.graphql.p_sha256hash":
+		case attr == "dgraph.graphql.p_sha256hash":
 			// Ignore this predicate.
-		case pk.IsData() && pk.Attr == "dgraph.graphql.schema":
+		case pk.IsData() && attr == "dgraph.graphql.schema":
 			// Export the graphql schema.
 			pl, err := posting.ReadPostingList(key, itr)
 			if err != nil {
@@ -704,10 +735,15 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 			if err != nil {
 				return nil, err
 			}
+			if su, ok := schema.State().Get(ctx, pk.Attr); ok && su.Encrypted {
+				if e.dataKey, err = dataKey(&su); err != nil {
+					return nil, err
+				}
+			}
 
 			// The GraphQL layer will create a node of type "dgraph.graphql". That entry
 			// should not be exported.
-			if pk.Attr == "dgraph.type" {
+			if attr == "dgraph.type" {
 				vals, err := e.pl.AllValues(in.ReadTs)
 				if err != nil {
 					return nil, errors.Wrapf(err, "cannot read value of dgraph.type entry")
@@ -872,10 +908,11 @@ func ExportOverNetwork(ctx context.Context, input *pb.ExportRequest) (ExportedFi
 	for _, gid := range gids {
 		go func(group uint32) {
 			req := &pb.ExportRequest{
-				GroupId: group,
-				ReadTs:  readTs,
-				UnixTs:  time.Now().Unix(),
-				Format:  input.Format,
+				GroupId:   group,
+				ReadTs:    readTs,
+				UnixTs:    time.Now().Unix(),
+				Format:    input.Format,
+				Namespace: input.Namespace,
 
 				Destination:  input.Destination,
 				AccessKey:    input.AccessKey,
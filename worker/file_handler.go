@@ -314,7 +314,7 @@ func (h *fileHandler) ExportBackup(backupDir, exportDir, format string,
 			return 0, errors.Wrapf(err, "cannot open DB at %s", dir)
 		}
 		defer db.Close()
-		_, err = loadFromBackup(db, gzReader, 0, preds, nil)
+		_, err = loadFromBackup(db, gzReader, 0, preds, nil, nil)
 		if err != nil {
 			return 0, errors.Wrapf(err, "cannot load backup")
 		}
@@ -331,6 +331,9 @@ func (h *fileHandler) ExportBackup(backupDir, exportDir, format string,
 	if manifest.Since == 0 || len(manifest.Groups) == 0 {
 		return errors.Errorf("no data found in backup")
 	}
+	if err := verifyManifestKey(manifest, key); err != nil {
+		return err
+	}
 
 	// Restore backup to disk.
 	for gid := range manifest.Groups {
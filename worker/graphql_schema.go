@@ -26,6 +26,7 @@ import (
 	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
 )
 
@@ -76,9 +77,12 @@ func (w *grpcWorker) UpdateGraphQLSchema(ctx context.Context,
 	schemaLock.Lock()
 	defer schemaLock.Unlock()
 
+	// Each namespace has its own GraphQL schema node, stored in the predicates of the namespace.
+	schemaPred := x.NamespaceAttr(req.Namespace, GqlSchemaPred)
+
 	// query the GraphQL schema node uid
 	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
-		Attr:    GqlSchemaPred,
+		Attr:    schemaPred,
 		SrcFunc: &pb.SrcFunction{Name: "has"},
 		ReadTs:  req.StartTs,
 		// there can only be one GraphQL schema node,
@@ -121,7 +125,7 @@ func (w *grpcWorker) UpdateGraphQLSchema(ctx context.Context,
 		Edges: []*pb.DirectedEdge{
 			{
 				Entity:    schemaNodeUid,
-				Attr:      GqlSchemaPred,
+				Attr:      schemaPred,
 				Value:     []byte(req.GraphqlSchema),
 				ValueType: pb.Posting_STRING,
 				Op:        pb.DirectedEdge_SET,
@@ -134,7 +138,7 @@ func (w *grpcWorker) UpdateGraphQLSchema(ctx context.Context,
 				// directive on xid. So, this way we make sure that even in this rare case there can
 				// only be one server which is able to successfully update the GraphQL schema.
 				Entity:    schemaNodeUid,
-				Attr:      gqlSchemaXidPred,
+				Attr:      x.NamespaceAttr(req.Namespace, gqlSchemaXidPred),
 				Value:     []byte(gqlSchemaXidVal),
 				ValueType: pb.Posting_STRING,
 				Op:        pb.DirectedEdge_SET,
@@ -144,7 +148,7 @@ func (w *grpcWorker) UpdateGraphQLSchema(ctx context.Context,
 	if creatingNode {
 		m.Edges = append(m.Edges, &pb.DirectedEdge{
 			Entity:    schemaNodeUid,
-			Attr:      "dgraph.type",
+			Attr:      x.NamespaceAttr(req.Namespace, "dgraph.type"),
 			Value:     []byte("dgraph.graphql"),
 			ValueType: pb.Posting_STRING,
 			Op:        pb.DirectedEdge_SET,
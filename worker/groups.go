@@ -45,6 +45,7 @@ type groupi struct {
 	Node         *node
 	gid          uint32
 	tablets      map[string]*pb.Tablet
+	splits       map[string][]splitRange
 	triggerCh    chan struct{} // Used to trigger membership sync
 	blockDeletes *sync.Mutex   // Ensure that deletion won't happen when move is going on.
 	closer       *z.Closer
@@ -60,7 +61,7 @@ var gr = &groupi{
 	tablets:      make(map[string]*pb.Tablet),
 }
 
-var RaftDefaults = "idx=0; group=0; learner=false; snapshot-after=10000"
+var RaftDefaults = "idx=0; group=0; learner=false; snapshot-after=10000; zone="
 
 func groups() *groupi {
 	return gr
@@ -110,6 +111,7 @@ func StartRaftNodes(walStore *raftwal.DiskStorage, bindall bool) {
 		GroupId: x.WorkerConfig.ProposedGroupId,
 		Addr:    x.WorkerConfig.MyAddr,
 		Learner: x.WorkerConfig.Raft.GetBool("learner"),
+		Zone:    x.WorkerConfig.Raft.Get("zone"),
 	}
 	if m.GroupId > 0 {
 		m.ForceGroupId = true
@@ -336,6 +338,7 @@ func (g *groupi) applyState(myId uint64, state *pb.MembershipState) {
 			atomic.StoreUint64(&g.membershipChecksum, group.Checksum)
 		}
 	}
+	g.splits = splitsFromTablets(g.tablets)
 	for _, member := range g.state.Zeros {
 		if x.WorkerConfig.MyAddr != member.Addr {
 			conn.GetPools().Connect(member.Addr, x.WorkerConfig.TLSClientConfig)
@@ -545,6 +548,36 @@ func (g *groupi) AnyTwoServers(gid uint32) []string {
 	return res
 }
 
+// FastestServers returns the addresses of up to n healthy servers of the given group, the fastest
+// first. The servers whose circuit is open are only returned if no other server is available.
+func (g *groupi) FastestServers(gid uint32, n int) []string {
+	var pools, open []*conn.Pool
+	for _, m := range g.members(gid) {
+		pl, err := conn.GetPools().Get(m.Addr)
+		if err != nil {
+			continue
+		}
+		if pl.CircuitOpen() {
+			open = append(open, pl)
+		} else {
+			pools = append(pools, pl)
+		}
+	}
+	if len(pools) == 0 {
+		pools = open
+	}
+	conn.SortByLatency(pools)
+
+	var res []string
+	for _, pl := range pools {
+		if len(res) >= n {
+			break
+		}
+		res = append(res, pl.Addr)
+	}
+	return res
+}
+
 func (g *groupi) members(gid uint32) map[uint64]*pb.Member {
 	g.RLock()
 	defer g.RUnlock()
@@ -622,6 +655,12 @@ func GroupId() uint32 {
 	return groups().groupId()
 }
 
+// Staleness returns how far behind the leader of its group this worker might be. Reads at the
+// timestamps known to this worker are at most this stale.
+func Staleness() time.Duration {
+	return groups().Node.staleness()
+}
+
 func (g *groupi) triggerMembershipSync() {
 	// It's ok if we miss the trigger, periodic membership sync runs every minute.
 	select {
@@ -704,6 +743,13 @@ func (g *groupi) doSendMembership(tablets map[string]*pb.Tablet) error {
 		Members: make(map[uint64]*pb.Member),
 	}
 	group.Members[member.Id] = member
+	gid := member.GroupId
+	tabletLoads.report(member, func(attr string) string {
+		if tablet := g.splitTabletFor(attr, gid); tablet != "" {
+			return tablet
+		}
+		return attr
+	})
 	if leader {
 		// Do not send tablet information, if I'm not the leader.
 		group.Tablets = tablets
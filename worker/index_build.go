@@ -0,0 +1,72 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/pkg/errors"
+)
+
+// CancelIndexBuildOverNetwork cancels the index build running in the background for attr, on the
+// group serving attr. It returns an error if no index is being built for attr.
+func CancelIndexBuildOverNetwork(ctx context.Context, attr string) error {
+	gid, err := groups().BelongsToReadOnly(attr, 0)
+	switch {
+	case err != nil:
+		return err
+	case gid == 0:
+		return errors.Errorf("No index build is running for predicate %s", attr)
+	}
+
+	req := &pb.CancelIndexBuildRequest{Predicate: attr}
+	if groups().ServesGroup(gid) {
+		_, err := (&grpcWorker{}).CancelIndexBuild(ctx, req)
+		return err
+	}
+	pl := groups().Leader(gid)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	_, err = pb.NewWorkerClient(pl.Get()).CancelIndexBuild(ctx, req)
+	return err
+}
+
+// CancelIndexBuild proposes to cancel the index build of the predicate to the replicas of the
+// group.
+func (w *grpcWorker) CancelIndexBuild(ctx context.Context,
+	req *pb.CancelIndexBuildRequest) (*api.Payload, error) {
+	gid, err := groups().BelongsToReadOnly(req.Predicate, 0)
+	switch {
+	case err != nil:
+		return nil, err
+	case !groups().ServesGroup(gid):
+		return nil, errors.Errorf("This server doesn't serve group id: %v", gid)
+	case !posting.IsIndexBuilding(req.Predicate):
+		return nil, errors.Errorf("No index build is running for predicate %s",
+			req.Predicate)
+	}
+	if err := groups().Node.proposeAndWait(ctx,
+		&pb.Proposal{CancelIndexBuild: req.Predicate}); err != nil {
+		return nil, err
+	}
+	return &api.Payload{}, nil
+}
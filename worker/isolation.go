@@ -0,0 +1,188 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+/*
+Transactions run with snapshot isolation by default: Zero only checks the keys written by a
+transaction for conflicts at commit, so write skew is possible. A transaction can opt in to
+other isolation levels:
+
+- Serializable: processTask tracks the conflict keys of the posting lists read, and returns them
+in the result. They are sent to Zero at commit, prefixed with x.ReadConflictKeyPrefix, which
+aborts the transaction if any of them was written after it started. To detect the writes to
+lists, like [uid] predicates or indexes, whose conflict keys depend on the value written,
+transactions also send the conflict keys of the whole lists written. Zero only records those
+while serializable transactions commit, see trackListKeys in dgraph/cmd/zero/oracle.go.
+
+- Read committed: processTask reads the latest commits, instead of the snapshot at the read
+timestamp of the query. It is meant for long read-only queries, which don't need a consistent
+snapshot.
+
+The query blocks of an upsert with the @lock directive also track the keys read, whatever the
+isolation level, so that the upsert can take locks on them before running its mutations. See
+locks.go.
+*/
+
+// readSet holds the conflict keys of the posting lists read by a serializable transaction.
+type readSet struct {
+	sync.Mutex
+	keys map[uint64]struct{}
+}
+
+// add adds the key of a posting list read. It does nothing on a nil readSet, so that reads can
+// be tracked unconditionally.
+func (rs *readSet) add(key []byte) {
+	if rs == nil {
+		return
+	}
+	rs.addKeys([]uint64{posting.ListConflictKey(key)})
+}
+
+func (rs *readSet) addKeys(keys []uint64) {
+	if rs == nil || len(keys) == 0 {
+		return
+	}
+	rs.Lock()
+	defer rs.Unlock()
+	if rs.keys == nil {
+		rs.keys = make(map[uint64]struct{})
+	}
+	for _, k := range keys {
+		rs.keys[k] = struct{}{}
+	}
+}
+
+func (rs *readSet) list() []uint64 {
+	if rs == nil {
+		return nil
+	}
+	rs.Lock()
+	defer rs.Unlock()
+	keys := make([]uint64, 0, len(rs.keys))
+	for k := range rs.keys {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+type isolationCtxKey struct{}
+
+type txnIsolation struct {
+	level pb.Query_Isolation
+	reads *readSet
+	// locks holds the keys read by the query blocks with the @lock directive. lock is true for
+	// the queries of such blocks.
+	locks *readSet
+	lock  bool
+}
+
+// WithIsolation returns a context to run the queries of a transaction with the given isolation
+// level. The keys read by the queries of a serializable transaction can then be retrieved with
+// ReadKeys.
+func WithIsolation(ctx context.Context, level pb.Query_Isolation) context.Context {
+	if level == pb.Query_SNAPSHOT {
+		return ctx
+	}
+	return context.WithValue(ctx, isolationCtxKey{}, &txnIsolation{level: level, reads: &readSet{}})
+}
+
+// WithLocks returns a context to collect the keys read by the query blocks with the @lock
+// directive, which can then be retrieved with LockedKeys.
+func WithLocks(ctx context.Context) context.Context {
+	iso := &txnIsolation{reads: &readSet{}}
+	if parent := isolationFrom(ctx); parent != nil {
+		*iso = *parent
+	}
+	iso.locks = &readSet{}
+	return context.WithValue(ctx, isolationCtxKey{}, iso)
+}
+
+// WithLockedReads returns a context to run a query block with the @lock directive. It does
+// nothing unless ctx was returned by WithLocks.
+func WithLockedReads(ctx context.Context) context.Context {
+	parent := isolationFrom(ctx)
+	if parent == nil || parent.locks == nil {
+		return ctx
+	}
+	iso := *parent
+	iso.lock = true
+	return context.WithValue(ctx, isolationCtxKey{}, &iso)
+}
+
+func isolationFrom(ctx context.Context) *txnIsolation {
+	iso, _ := ctx.Value(isolationCtxKey{}).(*txnIsolation)
+	return iso
+}
+
+// taskIsolation returns the isolation level to process the tasks of the query with. The tasks
+// of the blocks with the @lock directive track the keys read like serializable ones.
+func (iso *txnIsolation) taskIsolation() pb.Query_Isolation {
+	if iso.lock && iso.level == pb.Query_SNAPSHOT {
+		return pb.Query_SERIALIZABLE
+	}
+	return iso.level
+}
+
+// addReads adds the keys read by the task which returned res, for serializable transactions and
+// the query blocks with the @lock directive.
+func (iso *txnIsolation) addReads(res *pb.Result) {
+	if iso == nil {
+		return
+	}
+	if iso.level == pb.Query_SERIALIZABLE {
+		iso.reads.addKeys(res.GetReadKeys())
+	}
+	if iso.lock {
+		iso.locks.addKeys(res.GetReadKeys())
+	}
+}
+
+// ReadKeys returns the conflict keys of the posting lists read so far by the queries run with ctx,
+// in the format expected by Zero. It returns nil unless ctx is for a serializable transaction.
+func ReadKeys(ctx context.Context) []string {
+	iso := isolationFrom(ctx)
+	if iso == nil || iso.level != pb.Query_SERIALIZABLE {
+		return nil
+	}
+	var keys []string
+	for _, k := range iso.reads.list() {
+		keys = append(keys, x.ReadConflictKeyPrefix+strconv.FormatUint(k, 36))
+	}
+	return keys
+}
+
+// LockedKeys returns the conflict keys of the posting lists read so far by the query blocks with
+// the @lock directive run with ctx. It returns nil unless ctx was returned by WithLocks.
+func LockedKeys(ctx context.Context) []uint64 {
+	iso := isolationFrom(ctx)
+	if iso == nil {
+		return nil
+	}
+	return iso.locks.list()
+}
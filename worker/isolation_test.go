@@ -0,0 +1,78 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestReadKeys(t *testing.T) {
+	require.Nil(t, ReadKeys(context.Background()))
+
+	// Reads are not tracked for snapshot isolation.
+	ctx := WithIsolation(context.Background(), pb.Query_SNAPSHOT)
+	require.Nil(t, isolationFrom(ctx))
+
+	ctx = WithIsolation(context.Background(), pb.Query_READ_COMMITTED)
+	isolationFrom(ctx).addReads(&pb.Result{ReadKeys: []uint64{1}})
+	require.Nil(t, ReadKeys(ctx))
+
+	var qs queryState
+	qs.reads = &readSet{}
+	key := x.DataKey("name", 1)
+	qs.reads.add(key)
+	qs.reads.add(key)
+
+	ctx = WithIsolation(context.Background(), pb.Query_SERIALIZABLE)
+	isolationFrom(ctx).addReads(&pb.Result{ReadKeys: qs.reads.list()})
+	isolationFrom(ctx).addReads(nil)
+	require.Equal(t, []string{
+		x.ReadConflictKeyPrefix + strconv.FormatUint(posting.ListConflictKey(key), 36),
+	}, ReadKeys(ctx))
+}
+
+func TestLockedKeys(t *testing.T) {
+	ctx := WithIsolation(context.Background(), pb.Query_SERIALIZABLE)
+	// Blocks with @lock don't track reads unless the request collects the keys to lock.
+	require.Equal(t, ctx, WithLockedReads(ctx))
+	require.Nil(t, LockedKeys(ctx))
+
+	ctx = WithLocks(ctx)
+	isolationFrom(ctx).addReads(&pb.Result{ReadKeys: []uint64{1}})
+	require.Empty(t, LockedKeys(ctx))
+
+	lockCtx := WithLockedReads(ctx)
+	require.Equal(t, pb.Query_SERIALIZABLE, isolationFrom(lockCtx).taskIsolation())
+	isolationFrom(lockCtx).addReads(&pb.Result{ReadKeys: []uint64{3, 2}})
+	require.Equal(t, []uint64{2, 3}, LockedKeys(ctx))
+	// The reads of all the blocks count for serializable transactions.
+	require.Len(t, ReadKeys(ctx), 3)
+
+	ctx = WithLockedReads(WithLocks(context.Background()))
+	require.Equal(t, pb.Query_SERIALIZABLE, isolationFrom(ctx).taskIsolation())
+	isolationFrom(ctx).addReads(&pb.Result{ReadKeys: []uint64{4}})
+	require.Equal(t, []uint64{4}, LockedKeys(ctx))
+	require.Nil(t, ReadKeys(ctx))
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+/*
+Upserts whose query has a block with the @lock directive take locks on the keys read by that block
+before running their mutations, so that contending upserts wait for each other instead of
+aborting. The locks are held in memory by the leader of group one. They are leased, so that the
+locks of an Alpha which goes away are released after a while.
+
+A request is granted all its locks at once, or none of them, so that upserts can't deadlock. The
+locks only serialize the upserts which take them. Zero still checks the commits for conflicts,
+so an upsert can still be aborted, e.g. if the leader of group one changes while it holds locks.
+*/
+
+var errNotGroupOneLeader = errors.New("locks can only be taken on the leader of group one")
+
+type heldLock struct {
+	owner   uint64
+	expires time.Time
+}
+
+// lockManager grants the locks on the conflict keys of posting lists.
+type lockManager struct {
+	sync.Mutex
+	held map[uint64]heldLock
+	// released is closed, and replaced, whenever locks are released.
+	released chan struct{}
+}
+
+var locks = newLockManager()
+
+func newLockManager() *lockManager {
+	return &lockManager{
+		held:     make(map[uint64]heldLock),
+		released: make(chan struct{}),
+	}
+}
+
+// tryAcquire grants the locks of req if none of them is held by another owner. Otherwise, it
+// returns when the first of the locks blocking req expires.
+func (lm *lockManager) tryAcquire(req *pb.LockRequest, now time.Time) (bool, time.Time) {
+	lm.Lock()
+	defer lm.Unlock()
+
+	var expires time.Time
+	for _, k := range req.Keys {
+		l, ok := lm.held[k]
+		if !ok || l.owner == req.Owner || now.After(l.expires) {
+			continue
+		}
+		if expires.IsZero() || l.expires.Before(expires) {
+			expires = l.expires
+		}
+	}
+	if !expires.IsZero() {
+		return false, expires
+	}
+	l := heldLock{owner: req.Owner, expires: now.Add(time.Duration(req.LeaseMs) * time.Millisecond)}
+	for _, k := range req.Keys {
+		lm.held[k] = l
+	}
+	return true, time.Time{}
+}
+
+// acquire waits until the locks of req are granted, or ctx is done.
+func (lm *lockManager) acquire(ctx context.Context, req *pb.LockRequest) error {
+	if req.LeaseMs <= 0 {
+		return errors.Errorf("Invalid lease for locks: %dms", req.LeaseMs)
+	}
+	for {
+		lm.Lock()
+		released := lm.released
+		lm.Unlock()
+
+		ok, expires := lm.tryAcquire(req, time.Now())
+		if ok {
+			return nil
+		}
+		timer := time.NewTimer(time.Until(expires))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrapf(ctx.Err(), "while waiting for %d locks", len(req.Keys))
+		case <-released:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// release releases the locks of req held by its owner.
+func (lm *lockManager) release(req *pb.LockRequest) {
+	lm.Lock()
+	defer lm.Unlock()
+	for _, k := range req.Keys {
+		if l, ok := lm.held[k]; ok && l.owner == req.Owner {
+			delete(lm.held, k)
+		}
+	}
+	// Also drop the expired locks, so that the map doesn't grow forever.
+	now := time.Now()
+	for k, l := range lm.held {
+		if now.After(l.expires) {
+			delete(lm.held, k)
+		}
+	}
+	close(lm.released)
+	lm.released = make(chan struct{})
+}
+
+// AcquireLocks takes locks on the given conflict keys for owner, waiting for the other owners to
+// release them. The locks are released after lease, unless ReleaseLocks is called before.
+func AcquireLocks(ctx context.Context, keys []uint64, owner uint64, lease time.Duration) error {
+	req := &pb.LockRequest{Keys: keys, Owner: owner, LeaseMs: lease.Milliseconds()}
+	if isGroupOneLeader() {
+		_, err := (&grpcWorker{}).AcquireLocks(ctx, req)
+		return err
+	}
+	pl := groups().Leader(1)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	_, err := pb.NewWorkerClient(pl.Get()).AcquireLocks(ctx, req)
+	return err
+}
+
+// ReleaseLocks releases the locks on the given conflict keys held by owner.
+func ReleaseLocks(ctx context.Context, keys []uint64, owner uint64) error {
+	req := &pb.LockRequest{Keys: keys, Owner: owner}
+	if isGroupOneLeader() {
+		_, err := (&grpcWorker{}).ReleaseLocks(ctx, req)
+		return err
+	}
+	pl := groups().Leader(1)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	_, err := pb.NewWorkerClient(pl.Get()).ReleaseLocks(ctx, req)
+	return err
+}
+
+// AcquireLocks waits until the locks of the request are granted.
+func (w *grpcWorker) AcquireLocks(ctx context.Context, req *pb.LockRequest) (*api.Payload, error) {
+	if !isGroupOneLeader() {
+		return nil, errNotGroupOneLeader
+	}
+	if err := locks.acquire(ctx, req); err != nil {
+		return nil, err
+	}
+	glog.V(3).Infof("Granted %d locks to owner %#x", len(req.Keys), req.Owner)
+	return &api.Payload{}, nil
+}
+
+// ReleaseLocks releases the locks of the request.
+func (w *grpcWorker) ReleaseLocks(ctx context.Context, req *pb.LockRequest) (*api.Payload, error) {
+	if !isGroupOneLeader() {
+		return nil, errNotGroupOneLeader
+	}
+	locks.release(req)
+	return &api.Payload{}, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestLockManager(t *testing.T) {
+	lm := newLockManager()
+	ctx := context.Background()
+	lease := time.Minute.Milliseconds()
+
+	a := &pb.LockRequest{Keys: []uint64{1, 2}, Owner: 1, LeaseMs: lease}
+	require.NoError(t, lm.acquire(ctx, a))
+	// The owner of the locks can take them again.
+	require.NoError(t, lm.acquire(ctx, a))
+
+	// Locks are granted all at once, so 3 is not taken while 2 is held.
+	b := &pb.LockRequest{Keys: []uint64{2, 3}, Owner: 2, LeaseMs: lease}
+	ok, expires := lm.tryAcquire(b, time.Now())
+	require.False(t, ok)
+	require.False(t, expires.IsZero())
+	require.NotContains(t, lm.held, uint64(3))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- lm.acquire(ctx, b)
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("Got locks held by another owner: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	lm.release(a)
+	require.NoError(t, <-done)
+	require.Equal(t, uint64(2), lm.held[2].owner)
+	require.Equal(t, uint64(2), lm.held[3].owner)
+
+	// Waiting for locks stops with the context.
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, lm.acquire(cctx, a))
+
+	// Locks are granted once their lease expires.
+	ok, _ = lm.tryAcquire(a, time.Now().Add(2*time.Minute))
+	require.True(t, ok)
+
+	require.Error(t, lm.acquire(ctx, &pb.LockRequest{Keys: []uint64{4}, Owner: 3}))
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/dgraph-io/dgo/v200"
 	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/ee/enc"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
@@ -131,6 +132,25 @@ func undoSchemaUpdate(predicate string) {
 	}
 }
 
+// abortIndexBuild keeps serving the schema that was served while the indexes were getting built,
+// once building them failed or was cancelled. If the old indexes were kept, the old schema is
+// restored. Otherwise, they were dropped before building the new ones, so the schema is persisted
+// without them.
+func abortIndexBuild(rebuild posting.IndexRebuild) {
+	if rebuild.ServesOldIndexes() {
+		undoSchemaUpdate(rebuild.Attr)
+		return
+	}
+	querySchema := rebuild.GetQuerySchema()
+	if querySchema.Directive == pb.SchemaUpdate_INDEX && len(querySchema.Tokenizer) == 0 {
+		querySchema.Directive = pb.SchemaUpdate_NONE
+	}
+	if err := updateSchema(querySchema); err != nil {
+		glog.Errorf("error in persisting schema for %s: %v", rebuild.Attr, err)
+		undoSchemaUpdate(rebuild.Attr)
+	}
+}
+
 func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs uint64) error {
 	if len(updates) == 0 {
 		return nil
@@ -176,14 +196,20 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 	}
 	defer stopIndexing(closer)
 
-	buildIndexesHelper := func(update *pb.SchemaUpdate, rebuild posting.IndexRebuild) error {
-		wrtCtx := schema.GetWriteContext(context.Background())
+	buildIndexesHelper := func(ctx context.Context, update *pb.SchemaUpdate,
+		rebuild posting.IndexRebuild) error {
+		wrtCtx := schema.GetWriteContext(ctx)
 		if err := rebuild.BuildIndexes(wrtCtx); err != nil {
 			return err
 		}
 		if err := updateSchema(update); err != nil {
 			return err
 		}
+		// The new indexes are being served now, so the ones which were kept for queries while
+		// building them can be dropped.
+		if err := rebuild.DropOldIndexes(wrtCtx); err != nil {
+			glog.Errorf("error in dropping old indexes for %s: %v", update.Predicate, err)
+		}
 
 		glog.Infof("Done schema update %+v\n", update)
 		return nil
@@ -194,9 +220,11 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 	var wg sync.WaitGroup
 	wg.Add(1)
 	defer wg.Done()
-	buildIndexes := func(update *pb.SchemaUpdate, rebuild posting.IndexRebuild) {
+	buildIndexes := func(ctx context.Context, done func(), update *pb.SchemaUpdate,
+		rebuild posting.IndexRebuild) {
 		// In case background indexing is running, we should call it here again.
 		defer stopIndexing(closer)
+		defer done()
 
 		// We should only start building indexes once this function has returned.
 		// This is in order to ensure that we do not call DropPrefix for one predicate
@@ -204,10 +232,10 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		// cause writes to badger to fail leading to undesired indexing failures.
 		wg.Wait()
 
-		// undo schema changes in case re-indexing fails.
-		if err := buildIndexesHelper(update, rebuild); err != nil {
+		// undo schema changes in case re-indexing fails or is cancelled.
+		if err := buildIndexesHelper(ctx, update, rebuild); err != nil {
 			glog.Errorf("error in building indexes, aborting :: %v\n", err)
-			undoSchemaUpdate(update.Predicate)
+			abortIndexBuild(rebuild)
 		}
 	}
 
@@ -223,6 +251,7 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		}
 
 		old, _ := schema.State().Get(ctx, su.Predicate)
+		keepDataKey(&old, su)
 		rebuild := posting.IndexRebuild{
 			Attr:          su.Predicate,
 			StartTs:       startTs,
@@ -233,7 +262,7 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		// Sets the schema only in memory. The schema is written to
 		// disk only after schema mutations are successful.
 		schema.State().Set(su.Predicate, querySchema)
-		schema.State().SetMutSchema(su.Predicate, su)
+		schema.State().SetMutSchema(su.Predicate, rebuild.GetWriteSchema())
 
 		// TODO(Aman): If we return an error, we may not have right schema reflected.
 		setup := func() error {
@@ -249,9 +278,12 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		}
 
 		if rebuild.NeedIndexRebuild() {
-			go buildIndexes(su, rebuild)
+			buildCtx, done := posting.TrackIndexBuild(context.Background(), su.Predicate)
+			go buildIndexes(buildCtx, done, su, rebuild)
 		} else if err := updateSchema(su); err != nil {
 			return err
+		} else if err := rebuild.DropOldIndexes(ctx); err != nil {
+			return err
 		}
 	}
 
@@ -366,6 +398,12 @@ func checkSchema(s *pb.SchemaUpdate) error {
 		return errors.Errorf("No predicate specified in schema mutation")
 	}
 
+	if len(groups().tabletSplits(s.Predicate)) > 0 &&
+		(s.Directive == pb.SchemaUpdate_INDEX || s.Directive == pb.SchemaUpdate_REVERSE || s.Count) {
+		return errors.Errorf("Cannot add index, reverse or count to predicate %s, because it is"+
+			" split across groups", s.Predicate)
+	}
+
 	if x.IsInternalPredicate(s.Predicate) {
 		return errors.Errorf("Cannot create user-defined predicate with internal name %s",
 			s.Predicate)
@@ -431,6 +469,75 @@ func checkSchema(s *pb.SchemaUpdate) error {
 // ValidateAndConvert checks compatibility or converts to the schema type if the storage type is
 // specified. If no storage type is specified then it converts to the schema type.
 func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
+	if err := validateAndConvert(edge, su); err != nil {
+		return err
+	}
+	if !su.GetEncrypted() {
+		return nil
+	}
+	switch {
+	case edge.Op == pb.DirectedEdge_SET:
+		return encryptEdge(edge, su)
+	case !isDeletePredicateEdge(edge) && !isStarAll(edge.Value):
+		// The values are encrypted with random nonces, so a value can't be found by its text.
+		return errors.Errorf("Values of the @encrypted predicate %s can only be deleted with *",
+			x.ParseAttr(edge.Attr))
+	}
+	return nil
+}
+
+// encryptEdge encrypts the value of an edge of an @encrypted predicate with its data key. The
+// values which are already encrypted with the data key, like the ones proposed by the leader, are
+// kept as they are.
+func encryptEdge(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
+	key, err := dataKey(su)
+	if err != nil {
+		return err
+	}
+	if _, err := enc.DecryptValue(key, edge.Value); err == nil {
+		return nil
+	}
+	val, err := enc.EncryptValue(key, edge.Value)
+	if err != nil {
+		return errors.Wrapf(err, "while encrypting the value of predicate %s",
+			x.ParseAttr(edge.Attr))
+	}
+	edge.Value = val
+	return nil
+}
+
+// dataKey returns the data key of the @encrypted predicate of su, unwrapped with the encryption
+// key.
+func dataKey(su *pb.SchemaUpdate) (x.SensitiveByteSlice, error) {
+	key := x.EncryptionKey()
+	if key == nil {
+		return nil, errors.Errorf("Predicate %s is @encrypted, but no encryption key is set",
+			x.ParseAttr(su.Predicate))
+	}
+	dataKey, err := enc.UnwrapDataKey(key, su.DataKeys)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the data key of predicate %s",
+			x.ParseAttr(su.Predicate))
+	}
+	return dataKey, nil
+}
+
+// keepDataKey keeps the data key of the predicate in the update su, if the current schema cur has
+// one. The data key of su is only used if the predicate has none yet, or if it is the same data
+// key wrapped again, so that a predicate keeps the data key its values are encrypted with.
+func keepDataKey(cur, su *pb.SchemaUpdate) {
+	if len(cur.DataKeys) == 0 {
+		return
+	}
+	if curKey, err := dataKey(cur); err == nil {
+		if newKey, err := dataKey(su); err == nil && bytes.Equal(curKey, newKey) {
+			return
+		}
+	}
+	su.DataKeys = cur.DataKeys
+}
+
+func validateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 	if isDeletePredicateEdge(edge) {
 		return nil
 	}
@@ -482,13 +589,28 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 		return err
 	}
 
-	if x.WorkerConfig.AclEnabled && edge.GetAttr() == "dgraph.rule.permission" {
+	// The ACL predicates are checked in every namespace.
+	attr := x.ParseAttr(edge.GetAttr())
+	if x.WorkerConfig.AclEnabled && attr == "dgraph.rule.permission" {
 		perm, ok := dst.Value.(int64)
 		if !ok {
 			return errors.Errorf("Value for predicate <dgraph.rule.permission> should be of type int")
 		}
-		if perm < 0 || perm > 7 {
-			return errors.Errorf("Can't set <dgraph.rule.permission> to %d, Value for this predicate should be between 0 and 7", perm)
+		if perm < 0 || perm > 15 {
+			return errors.Errorf("Can't set <dgraph.rule.permission> to %d, Value for this predicate should be between 0 and 15", perm)
+		}
+	}
+	if x.WorkerConfig.AclEnabled {
+		switch val := dst.Value.(type) {
+		case float64:
+			if attr == "dgraph.acl.rate_limit" && val < 0 {
+				return errors.Errorf("Can't set <dgraph.acl.rate_limit> to %v, it can't be negative",
+					val)
+			}
+		case int64:
+			if attr == "dgraph.acl.burst" && val < 0 {
+				return errors.Errorf("Can't set <dgraph.acl.burst> to %d, it can't be negative", val)
+			}
 		}
 	}
 
@@ -574,18 +696,25 @@ func proposeOrSend(ctx context.Context, gid uint32, m *pb.Mutations, chr chan re
 func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 	mm := make(map[uint32]*pb.Mutations)
 	for _, edge := range src.Edges {
-		gid, err := groups().BelongsTo(edge.Attr)
+		gid, err := groups().BelongsToUid(edge.Attr, edge.Entity)
 		if err != nil {
 			return nil, err
 		}
 
-		mu := mm[gid]
-		if mu == nil {
-			mu = &pb.Mutations{GroupId: gid}
-			mm[gid] = mu
+		gids := []uint32{gid}
+		if isDeletePredicateEdge(edge) {
+			// The data of a split predicate lives in all the groups serving its ranges.
+			gids = groupsServing(edge.Attr, gid)
+		}
+		for _, gid := range gids {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Edges = append(mu.Edges, edge)
+			mu.Metadata = src.Metadata
 		}
-		mu.Edges = append(mu.Edges, edge)
-		mu.Metadata = src.Metadata
 	}
 
 	for _, schema := range src.Schema {
@@ -594,12 +723,15 @@ func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 			return nil, err
 		}
 
-		mu := mm[gid]
-		if mu == nil {
-			mu = &pb.Mutations{GroupId: gid}
-			mm[gid] = mu
+		// Groups serving the ranges of a split predicate need its schema too.
+		for _, gid := range groupsServing(schema.Predicate, gid) {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Schema = append(mu.Schema, schema)
 		}
-		mu.Schema = append(mu.Schema, schema)
 	}
 
 	if src.DropOp > 0 {
@@ -788,6 +920,10 @@ func CommitOverNetwork(ctx context.Context, tc *api.TxnContext) (uint64, error)
 		ostats.Record(context.Background(), x.TxnAborts.M(1))
 		return 0, dgo.ErrAborted
 	}
+	// Record the commit before the oracle delta for it arrives, so that the cached results of the
+	// queries run through this Alpha are invalidated right away.
+	posting.Oracle().RecordCommit(txnPredicates(tc.Preds), tctx.CommitTs)
+	State.noteTs(tctx.CommitTs)
 	return tctx.CommitTs, nil
 }
 
@@ -17,6 +17,7 @@
 package worker
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
 )
 
 func TestConvertEdgeType(t *testing.T) {
@@ -116,6 +118,37 @@ func TestValidateEdgeTypeError(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestValidateEncryptedDelete(t *testing.T) {
+	su := &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Encrypted: true}
+	edge := &pb.DirectedEdge{
+		Value:     []byte("123-45-6789"),
+		ValueType: pb.Posting_STRING,
+		Attr:      x.NamespaceAttr(x.GalaxyNamespace, "ssn"),
+		Op:        pb.DirectedEdge_DEL,
+	}
+	require.Error(t, ValidateAndConvert(edge, su))
+
+	edge.Value = []byte(x.Star)
+	edge.ValueType = pb.Posting_DEFAULT
+	require.NoError(t, ValidateAndConvert(edge, su))
+}
+
+func TestEncryptedFunctions(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("ssn: string @encrypted ."), 1))
+	ctx := context.Background()
+	for _, fn := range []*pb.SrcFunction{
+		{Name: "eq", Args: []string{"123-45-6789"}},
+		{Name: "regexp", Args: []string{"/^123/"}},
+		{Name: "lt", Args: []string{"2"}},
+	} {
+		_, err := parseSrcFn(ctx, &pb.Query{Attr: "ssn", SrcFunc: fn,
+			UidList: &pb.List{Uids: []uint64{1}}})
+		require.Error(t, err, fn.Name)
+	}
+	_, err := parseSrcFn(ctx, &pb.Query{Attr: "ssn", SrcFunc: &pb.SrcFunction{Name: "has"}})
+	require.NoError(t, err)
+}
+
 func TestPopulateMutationMap(t *testing.T) {
 	edges := []*pb.DirectedEdge{{
 		Value: []byte("set edge"),
@@ -193,22 +193,6 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest) error {
 		return errors.Errorf("nil restore request")
 	}
 
-	// Drop all the current data. This also cancels all existing transactions.
-	dropProposal := pb.Proposal{
-		Mutations: &pb.Mutations{
-			GroupId: req.GroupId,
-			StartTs: req.RestoreTs,
-			DropOp:  pb.Mutations_ALL,
-		},
-	}
-	if err := groups().Node.applyMutations(ctx, &dropProposal); err != nil {
-		return err
-	}
-
-	// TODO: after the drop, the tablets for the predicates stored in this group's
-	// backup could be in a different group. The tablets need to be moved.
-
-	// Reset tablets and set correct tablets to match the restored backup.
 	creds := &Credentials{
 		AccessKey:    req.AccessKey,
 		SecretKey:    req.SecretKey,
@@ -233,6 +217,29 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest) error {
 	}
 
 	lastManifest := manifests[len(manifests)-1]
+	if len(lastManifest.Namespaces) > 0 {
+		// Only drop the namespaces of the backup, and leave the others untouched.
+		if err := dropNamespaces(ctx, lastManifest.Namespaces); err != nil {
+			return errors.Wrapf(err, "cannot drop the namespaces %v", lastManifest.Namespaces)
+		}
+	} else {
+		// Drop all the current data. This also cancels all existing transactions.
+		dropProposal := pb.Proposal{
+			Mutations: &pb.Mutations{
+				GroupId: req.GroupId,
+				StartTs: req.RestoreTs,
+				DropOp:  pb.Mutations_ALL,
+			},
+		}
+		if err := groups().Node.applyMutations(ctx, &dropProposal); err != nil {
+			return err
+		}
+	}
+
+	// TODO: after the drop, the tablets for the predicates stored in this group's
+	// backup could be in a different group. The tablets need to be moved.
+
+	// Reset tablets and set correct tablets to match the restored backup.
 	preds, ok := lastManifest.Groups[req.GroupId]
 	if !ok {
 		return errors.Errorf("backup manifest does not contain information for group ID %d",
@@ -249,7 +256,7 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest) error {
 	}
 
 	// Write restored values to disk and update the UID lease.
-	if err := writeBackup(ctx, req); err != nil {
+	if err := writeBackup(ctx, req, lastManifest.Namespaces); err != nil {
 		return errors.Wrapf(err, "cannot write backup")
 	}
 
@@ -271,6 +278,27 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest) error {
 	return nil
 }
 
+// dropNamespaces drops the predicates and the types of the given namespaces served by this group.
+func dropNamespaces(ctx context.Context, namespaces []uint64) error {
+	for _, pred := range schema.State().Predicates() {
+		if !inNamespaces(pred, namespaces) {
+			continue
+		}
+		if err := posting.DeletePredicate(ctx, pred); err != nil {
+			return err
+		}
+	}
+	for _, typ := range schema.State().Types() {
+		if !inNamespaces(typ, namespaces) {
+			continue
+		}
+		if err := schema.State().DeleteType(typ); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // create a config object from the request for use with enc package.
 func getEncConfig(req *pb.RestoreRequest) (*viper.Viper, error) {
 	config := viper.New()
@@ -310,9 +338,22 @@ func getCredentialsFromRestoreRequest(req *pb.RestoreRequest) *Credentials {
 	}
 }
 
-func writeBackup(ctx context.Context, req *pb.RestoreRequest) error {
+func writeBackup(ctx context.Context, req *pb.RestoreRequest, namespaces []uint64) error {
+	cfg, err := getEncConfig(req)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get encryption config")
+	}
+	key, err := enc.ReadKey(cfg)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read key")
+	}
+	creds := getCredentialsFromRestoreRequest(req)
+	if err := VerifyBackupKey(req.Location, req.BackupId, req.BackupNum, creds, key); err != nil {
+		return err
+	}
+
 	res := LoadBackup(req.Location, req.BackupId, req.BackupNum,
-		getCredentialsFromRestoreRequest(req), func(r io.Reader, groupId uint32,
+		creds, func(r io.Reader, groupId uint32,
 			preds predicateSet, dropOperations []*pb.DropOperation) (uint64, error) {
 			if groupId != req.GroupId {
 				// LoadBackup will try to call the backup function for every group.
@@ -320,15 +361,7 @@ func writeBackup(ctx context.Context, req *pb.RestoreRequest) error {
 				return 0, nil
 			}
 
-			cfg, err := getEncConfig(req)
-			if err != nil {
-				return 0, errors.Wrapf(err, "unable to get encryption config")
-			}
-			key, err := enc.ReadKey(cfg)
-			if err != nil {
-				return 0, errors.Wrapf(err, "unable to read key")
-			}
-			r, err = enc.GetReader(key, r)
+			r, err := enc.GetReader(key, r)
 			if err != nil {
 				return 0, errors.Wrapf(err, "cannot get encrypted reader")
 			}
@@ -337,7 +370,8 @@ func writeBackup(ctx context.Context, req *pb.RestoreRequest) error {
 				return 0, errors.Wrapf(err, "couldn't create gzip reader")
 			}
 
-			maxUid, err := loadFromBackup(pstore, gzReader, req.RestoreTs, preds, dropOperations)
+			maxUid, err := loadFromBackup(pstore, gzReader, req.RestoreTs, preds, dropOperations,
+				namespaces)
 			if err != nil {
 				return 0, errors.Wrapf(err, "cannot write backup")
 			}
@@ -220,7 +220,21 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 		return &emptyPayload, errors.Errorf("While waiting for txn ts: %d. Error: %v", in.TxnTs, err)
 	}
 
-	gid, err := groups().BelongsTo(in.Predicate)
+	var gid uint32
+	var err error
+	if attr, start, ok := x.ParseSplitTablet(in.Predicate); ok {
+		// Only the predicates whose keys can be partitioned by subject can be split.
+		wctx := schema.GetWriteContext(ctx)
+		if schema.State().IsIndexed(wctx, attr) || schema.State().IsReversed(wctx, attr) ||
+			schema.State().HasCount(wctx, attr) {
+			return &emptyPayload, errors.Errorf("Unable to split predicate %s with index,"+
+				" reverse or count", attr)
+		}
+		// The split tablet might not exist yet. Find the group serving the range it starts in.
+		gid, err = groups().BelongsToUid(attr, start)
+	} else {
+		gid, err = groups().BelongsTo(in.Predicate)
+	}
 	switch {
 	case err != nil:
 		return &emptyPayload, err
@@ -268,8 +282,14 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 	txn := pstore.NewTransactionAt(in.TxnTs, false)
 	defer txn.Discard()
 
+	// A split tablet carries the data keys of its predicate for a range of subject uids.
+	attr, start, isSplit := x.ParseSplitTablet(in.Predicate)
+	if !isSplit {
+		attr = in.Predicate
+	}
+
 	// Send schema first.
-	schemaKey := x.SchemaKey(in.Predicate)
+	schemaKey := x.SchemaKey(attr)
 	item, err := txn.Get(schemaKey)
 	switch {
 	case err == badger.ErrKeyNotFound:
@@ -304,7 +324,15 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 	// Read the predicate keys and stream to keysCh.
 	stream := pstore.NewStreamAt(in.TxnTs)
 	stream.LogPrefix = fmt.Sprintf("Sending predicate: [%s]", in.Predicate)
-	stream.Prefix = x.PredicatePrefix(in.Predicate)
+	stream.Prefix = x.PredicatePrefix(attr)
+	if isSplit {
+		end := splitEnd(groups().tabletSplits(attr), start)
+		stream.Prefix = x.ParsedKey{Attr: attr}.DataPrefix()
+		stream.ChooseKey = func(item *badger.Item) bool {
+			pk, err := x.Parse(item.Key())
+			return err == nil && pk.Uid >= start && pk.Uid < end
+		}
+	}
 	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
 		// For now, just send out full posting lists, because we use delete markers to delete older
 		// data in the prefix range. So, by sending only one version per key, and writing it at a
@@ -148,7 +148,7 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 			return err
 		case tablet == nil || tablet.GroupId == 0:
 			return errNonExistentTablet
-		case tablet.GroupId != groups().groupId():
+		case tablet.GroupId != groups().groupId() && !groups().servesSplitOf(pred):
 			return errUnservedTablet
 		default:
 			return nil
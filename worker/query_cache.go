@@ -0,0 +1,112 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/golang/glog"
+)
+
+/*
+Alphas can cache the results of read-only queries, see edgraph. A cached result is invalidated when
+any of the predicates read by the query is written by a transaction committed after the result
+was read. The predicates written by each transaction are sent by Zero in the oracle deltas, which
+all the Alphas apply. Schema updates and drops don't go through Zero, so they are recorded as
+commits of empty transactions on the predicates they change.
+*/
+
+// readPredicates holds the predicates read by a query, so that its cached result can be
+// invalidated when any of them is written.
+type readPredicates struct {
+	sync.Mutex
+	attrs map[string]struct{}
+}
+
+type readPredicatesCtxKey struct{}
+
+// WithReadPredicates returns a context which collects the predicates read by the queries run
+// with it. They can then be retrieved with ReadPredicates.
+func WithReadPredicates(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readPredicatesCtxKey{},
+		&readPredicates{attrs: make(map[string]struct{})})
+}
+
+// addReadPredicate records that attr is read, if ctx was returned by WithReadPredicates.
+func addReadPredicate(ctx context.Context, attr string) {
+	rp, ok := ctx.Value(readPredicatesCtxKey{}).(*readPredicates)
+	if !ok {
+		return
+	}
+	rp.Lock()
+	defer rp.Unlock()
+	rp.attrs[attr] = struct{}{}
+}
+
+// ReadPredicates returns the sorted predicates read by the queries run with ctx, or nil unless
+// ctx was returned by WithReadPredicates.
+func ReadPredicates(ctx context.Context) []string {
+	rp, ok := ctx.Value(readPredicatesCtxKey{}).(*readPredicates)
+	if !ok {
+		return nil
+	}
+	rp.Lock()
+	defer rp.Unlock()
+	attrs := make([]string, 0, len(rp.attrs))
+	for attr := range rp.attrs {
+		attrs = append(attrs, attr)
+	}
+	sort.Strings(attrs)
+	return attrs
+}
+
+// txnPredicates strips the group ids from the predicates of a transaction context, which are of
+// the form "gid-predicate".
+func txnPredicates(preds []string) []string {
+	attrs := make([]string, 0, len(preds))
+	for _, pkey := range preds {
+		if splits := strings.SplitN(pkey, "-", 2); len(splits) == 2 {
+			attrs = append(attrs, splits[1])
+		}
+	}
+	return attrs
+}
+
+// RecordAlter commits an empty transaction on the given predicates through Zero, so that all the
+// Alphas invalidate the cached results of the queries reading them. It is recorded even if the
+// query cache of this Alpha is disabled, since it may be enabled on the other Alphas.
+func RecordAlter(ctx context.Context, preds []string) {
+	tc := &api.TxnContext{StartTs: State.GetTimestamp(false)}
+	for _, pred := range preds {
+		gid, err := groups().BelongsToReadOnly(pred, 0)
+		if err != nil || gid == 0 {
+			continue
+		}
+		tc.Preds = append(tc.Preds, fmt.Sprintf("%d-%s", gid, pred))
+	}
+	if len(tc.Preds) == 0 {
+		return
+	}
+	if _, err := CommitOverNetwork(ctx, tc); err != nil {
+		glog.Warningf("Error while recording the alter of predicates %v: %v", preds, err)
+	}
+}
@@ -42,6 +42,10 @@ func RunRestore(pdir, location, backupId string, key x.SensitiveByteSlice, ctype
 		return LoadResult{0, 0, err}
 	}
 
+	if err := VerifyBackupKey(location, backupId, 0, nil, key); err != nil {
+		return LoadResult{0, 0, err}
+	}
+
 	// Scan location for backup files and load them. Each file represents a node group,
 	// and we create a new p dir for each.
 	return LoadBackup(location, backupId, 0, nil,
@@ -80,7 +84,7 @@ func RunRestore(pdir, location, backupId string, key x.SensitiveByteSlice, ctype
 			if !pathExist(dir) {
 				fmt.Println("Creating new db:", dir)
 			}
-			maxUid, err := loadFromBackup(db, gzReader, 0, preds, dropOperations)
+			maxUid, err := loadFromBackup(db, gzReader, 0, preds, dropOperations, nil)
 			if err != nil {
 				return 0, err
 			}
@@ -93,24 +97,32 @@ func RunRestore(pdir, location, backupId string, key x.SensitiveByteSlice, ctype
 // values from predicates no longer assigned to this group.
 // If restoreTs is greater than zero, the key-value pairs will be written with that timestamp.
 // Otherwise, the original value is used.
+// If namespaces are given, only the keys of those namespaces are dropped and loaded, and the
+// keys of the other namespaces in the DB are left untouched.
 // TODO(DGRAPH-1234): Check whether restoreTs can be removed.
 func loadFromBackup(db *badger.DB, r io.Reader, restoreTs uint64, preds predicateSet,
-	dropOperations []*pb.DropOperation) (uint64, error) {
+	dropOperations []*pb.DropOperation, namespaces []uint64) (uint64, error) {
 	br := bufio.NewReaderSize(r, 16<<10)
 	unmarshalBuf := make([]byte, 1<<10)
 
-	// if there were any DROP operations that need to be applied before loading the backup into
-	// the db, then apply them here
-	if err := applyDropOperationsBeforeRestore(db, dropOperations); err != nil {
-		return 0, errors.Wrapf(err, "cannot apply DROP operations while loading backup")
-	}
+	if len(namespaces) > 0 {
+		if err := dropNamespacesBeforeRestore(db, dropOperations, namespaces); err != nil {
+			return 0, errors.Wrapf(err, "cannot apply DROP operations while loading backup")
+		}
+	} else {
+		// if there were any DROP operations that need to be applied before loading the backup
+		// into the db, then apply them here
+		if err := applyDropOperationsBeforeRestore(db, dropOperations); err != nil {
+			return 0, errors.Wrapf(err, "cannot apply DROP operations while loading backup")
+		}
 
-	// Delete schemas and types. Each backup file should have a complete copy of the schema.
-	if err := db.DropPrefix([]byte{x.ByteSchema}); err != nil {
-		return 0, err
-	}
-	if err := db.DropPrefix([]byte{x.ByteType}); err != nil {
-		return 0, err
+		// Delete schemas and types. Each backup file should have a complete copy of the schema.
+		if err := db.DropPrefix([]byte{x.ByteSchema}); err != nil {
+			return 0, err
+		}
+		if err := db.DropPrefix([]byte{x.ByteType}); err != nil {
+			return 0, err
+		}
 	}
 
 	loader := db.NewKVLoader(16)
@@ -158,6 +170,11 @@ func loadFromBackup(db *badger.DB, r io.Reader, restoreTs uint64, preds predicat
 			if _, ok := preds[parsedKey.Attr]; !parsedKey.IsType() && !ok {
 				continue
 			}
+			// The backup of a namespace also holds the drop operations of the galaxy namespace,
+			// which must not be restored.
+			if !inNamespaces(parsedKey.Attr, namespaces) {
+				continue
+			}
 
 			// Update the max id that has been seen while restoring this backup.
 			if parsedKey.Uid > maxUid {
@@ -249,6 +266,65 @@ func applyDropOperationsBeforeRestore(db *badger.DB, dropOperations []*pb.DropOp
 	return nil
 }
 
+// dropNamespacesBeforeRestore applies the DROP operations of a backup of the given namespaces,
+// and then deletes the schema and the types of those namespaces, as each backup file should have
+// a complete copy of them. DROP_ALL and DROP_DATA only drop the keys of the namespaces.
+func dropNamespacesBeforeRestore(db *badger.DB, dropOperations []*pb.DropOperation,
+	namespaces []uint64) error {
+	dataPrefixes, schemaKeys, err := namespaceKeys(db, namespaces)
+	if err != nil {
+		return err
+	}
+
+	var prefixes [][]byte
+	for _, operation := range dropOperations {
+		switch operation.DropOp {
+		case pb.DropOperation_ALL, pb.DropOperation_DATA:
+			// The schema keys are dropped below in any case.
+			prefixes = append(prefixes, dataPrefixes...)
+		case pb.DropOperation_ATTR:
+			prefixes = append(prefixes, x.PredicatePrefix(operation.DropValue))
+		}
+	}
+	prefixes = append(prefixes, schemaKeys...)
+	if len(prefixes) == 0 {
+		return nil
+	}
+	return db.DropPrefix(prefixes...)
+}
+
+// namespaceKeys returns the prefixes of the data keys of the predicates of the given namespaces,
+// and the schema and type keys of those namespaces.
+func namespaceKeys(db *badger.DB, namespaces []uint64) ([][]byte, [][]byte, error) {
+	txn := db.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+
+	var dataPrefixes, schemaKeys [][]byte
+	for _, prefix := range [][]byte{x.SchemaPrefix(), x.TypePrefix()} {
+		iopt := badger.DefaultIteratorOptions
+		iopt.PrefetchValues = false
+		iopt.Prefix = prefix
+		itr := txn.NewIterator(iopt)
+		for itr.Rewind(); itr.Valid(); itr.Next() {
+			key := itr.Item().KeyCopy(nil)
+			pk, err := x.Parse(key)
+			if err != nil {
+				itr.Close()
+				return nil, nil, errors.Wrapf(err, "could not parse key %s", hex.Dump(key))
+			}
+			if !inNamespaces(pk.Attr, namespaces) {
+				continue
+			}
+			schemaKeys = append(schemaKeys, key)
+			if pk.IsSchema() {
+				dataPrefixes = append(dataPrefixes, x.PredicatePrefix(pk.Attr))
+			}
+		}
+		itr.Close()
+	}
+	return dataPrefixes, schemaKeys, nil
+}
+
 func fromBackupKey(key []byte) ([]byte, error) {
 	backupKey := &pb.BackupKey{}
 	if err := backupKey.Unmarshal(key); err != nil {
@@ -0,0 +1,104 @@
+// +build !oss
+
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/dgraph-io/dgraph/ee/audit"
+	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+var rotateKeyLock sync.Mutex
+
+// RotateEncryptionKey makes newKey the encryption key of this Alpha while it runs. The data keys
+// of the WAL, the audit log and the @encrypted predicates are re-encrypted with it right away.
+// Badger keeps the key registry of the postings open, so its data keys are re-encrypted when the
+// Alpha starts again, with newKey. The backups and exports are encrypted with newKey from then on.
+func RotateEncryptionKey(newKey x.SensitiveByteSlice) error {
+	rotateKeyLock.Lock()
+	defer rotateKeyLock.Unlock()
+
+	oldKey := x.EncryptionKey()
+	if len(oldKey) == 0 {
+		return errors.Errorf("encryption at rest isn't turned on")
+	}
+	if bytes.Equal(oldKey, newKey) {
+		return nil
+	}
+	// The data keys stay wrapped with the old key too, so they can be unwrapped by the Alphas
+	// whose key isn't rotated yet.
+	if err := rewrapDataKeys(oldKey, newKey); err != nil {
+		return errors.Wrapf(err, "while wrapping the data keys of the @encrypted predicates")
+	}
+
+	if err := audit.RotateKey(newKey); err != nil {
+		return errors.Wrapf(err, "while re-encrypting the audit log")
+	}
+	// undo puts the audit log back to the old key, if the rotation fails after it.
+	undo := func() {
+		if err := audit.RotateKey(oldKey); err != nil {
+			glog.Errorf("Error while re-encrypting the audit log with the previous key: %v", err)
+		}
+	}
+	if err := enc.DeferKeyRotation(Config.PostingDir, oldKey, newKey); err != nil {
+		undo()
+		return err
+	}
+	if err := State.WALstore.RotateEncryptionKey(newKey); err != nil {
+		if err := enc.CancelKeyRotation(Config.PostingDir); err != nil {
+			glog.Errorf("Error while cancelling the rotation of the postings: %v", err)
+		}
+		undo()
+		return errors.Wrapf(err, "while rotating the encryption key of the WAL")
+	}
+	x.SetEncryptionKey(newKey)
+	glog.Infof("Rotated the encryption key to the key with ID %s.", newKey.KeyId())
+	return nil
+}
+
+// rewrapDataKeys wraps the data keys of the @encrypted predicates served by this Alpha with newKey,
+// through a schema update of their group.
+func rewrapDataKeys(oldKey, newKey x.SensitiveByteSlice) error {
+	ctx := schema.GetWriteContext(context.Background())
+	var updates []*pb.SchemaUpdate
+	for _, pred := range schema.State().Predicates() {
+		su, ok := schema.State().Get(ctx, pred)
+		if !ok || len(su.DataKeys) == 0 {
+			continue
+		}
+		dataKeys, err := enc.RewrapDataKey(oldKey, newKey, su.DataKeys)
+		switch {
+		case err != nil:
+			return errors.Wrapf(err, "predicate %s", x.ParseAttr(pred))
+		case dataKeys == nil:
+			// Another Alpha of the group wrapped it already.
+			continue
+		}
+		su.DataKeys = dataKeys
+		updates = append(updates, &su)
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	m := &pb.Mutations{StartTs: State.GetTimestamp(false), Schema: updates}
+	_, err := MutateOverNetwork(ctx, m)
+	return err
+}
@@ -0,0 +1,66 @@
+// +build !oss
+
+/*
+ * Copyright 2021 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Dgraph Community License (the "License"); you
+ * may not use this file except in compliance with the License. You
+ * may obtain a copy of the License at
+ *
+ *     https://github.com/dgraph-io/dgraph/blob/master/licenses/DCL.txt
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestEncryptedDataKey(t *testing.T) {
+	oldKey := x.SensitiveByteSlice("123456789012345678901234")
+	newKey := x.SensitiveByteSlice("abcdefghijklmnopqrstuvwx")
+	defer func(key x.SensitiveByteSlice) { x.WorkerConfig.EncryptionKey = key }(
+		x.WorkerConfig.EncryptionKey)
+	x.WorkerConfig.EncryptionKey = oldKey
+
+	wrapped, err := enc.NewDataKey(oldKey)
+	require.NoError(t, err)
+	cur := &pb.SchemaUpdate{Predicate: "ssn", ValueType: pb.Posting_STRING, Encrypted: true,
+		DataKeys: [][]byte{wrapped}}
+	edge := &pb.DirectedEdge{Value: []byte("123-45-6789"), ValueType: pb.Posting_STRING,
+		Attr: "ssn", Op: pb.DirectedEdge_SET}
+	require.NoError(t, ValidateAndConvert(edge, cur))
+	require.NotEqual(t, "123-45-6789", string(edge.Value))
+	plain, err := enc.UnwrapDataKey(oldKey, cur.DataKeys)
+	require.NoError(t, err)
+	val, err := enc.DecryptValue(plain, edge.Value)
+	require.NoError(t, err)
+	require.Equal(t, "123-45-6789", string(val))
+
+	// An alter doesn't replace the data key the values are encrypted with.
+	other, err := enc.NewDataKey(oldKey)
+	require.NoError(t, err)
+	su := &pb.SchemaUpdate{Predicate: "ssn", DataKeys: [][]byte{other}}
+	keepDataKey(cur, su)
+	require.Equal(t, cur.DataKeys, su.DataKeys)
+
+	// A rotation of the encryption key wraps it again.
+	rewrapped, err := enc.RewrapDataKey(oldKey, newKey, cur.DataKeys)
+	require.NoError(t, err)
+	su = &pb.SchemaUpdate{Predicate: "ssn", DataKeys: rewrapped}
+	keepDataKey(cur, su)
+	require.Equal(t, rewrapped, su.DataKeys)
+
+	// The values are still decrypted once the key is rotated.
+	x.WorkerConfig.EncryptionKey = newKey
+	su.Encrypted = true
+	got, err := dataKey(su)
+	require.NoError(t, err)
+	require.Equal(t, plain, got)
+}
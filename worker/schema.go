@@ -56,7 +56,7 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert",
-			"lang", "noconflict"}
+			"lang", "noconflict", "storage", "encrypted"}
 	}
 
 	myGid := groups().groupId()
@@ -111,6 +111,10 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.Lang = schema.State().HasLang(attr)
 		case "noconflict":
 			schemaNode.NoConflict = schema.State().HasNoConflict(attr)
+		case "storage":
+			schemaNode.Storage = schema.StorageHintString(schema.State().StorageHint(attr))
+		case "encrypted":
+			schemaNode.Encrypted = schema.State().IsEncrypted(attr)
 		default:
 			//pass
 		}
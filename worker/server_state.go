@@ -20,9 +20,12 @@ import (
 	"context"
 	"math"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/ee/enc"
+	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/raftwal"
 	"github.com/dgraph-io/dgraph/x"
@@ -39,6 +42,8 @@ type ServerState struct {
 	gcCloser *z.Closer // closer for valueLogGC
 
 	needTs chan tsReq
+	// latestTs is the latest commit or read-only timestamp this Alpha got from Zero.
+	latestTs uint64
 }
 
 // State is the instance of ServerState used by the current server.
@@ -106,6 +111,9 @@ func (s *ServerState) initStorage() {
 		// All the writes to posting store should be synchronous. We use batched writers
 		// for posting lists, so the cost of sync writes is amortized.
 		x.Check(os.MkdirAll(Config.PostingDir, 0700))
+		// The key of the postings might have been rotated while Badger had them open.
+		x.Checkf(enc.FinishKeyRotation(Config.PostingDir, x.WorkerConfig.EncryptionKey),
+			"Error while rotating the encryption key of the postings")
 		opt := badger.DefaultOptions(Config.PostingDir).
 			WithValueThreshold(1 << 10 /* 1KB */).
 			WithNumVersionsToKeep(math.MaxInt32).
@@ -211,6 +219,9 @@ func (s *ServerState) fillTimestampRequests() {
 			}
 			goto retry
 		}
+		if num.ReadOnly {
+			s.noteTs(ts.ReadOnly)
+		}
 		var offset uint64
 		for _, req := range reqs {
 			if req.readOnly {
@@ -224,6 +235,29 @@ func (s *ServerState) fillTimestampRequests() {
 	}
 }
 
+// noteTs records a timestamp got from Zero. The read-only queries served without contacting Zero
+// read at this timestamp at least.
+func (s *ServerState) noteTs(ts uint64) {
+	for {
+		cur := atomic.LoadUint64(&s.latestTs)
+		if ts <= cur || atomic.CompareAndSwapUint64(&s.latestTs, cur, ts) {
+			return
+		}
+	}
+}
+
+// ReadTimestamp returns a start timestamp for a read-only query, without contacting Zero. It is
+// the max assigned timestamp streamed by Zero, or the latest timestamp this Alpha got from Zero if
+// it is ahead, so that the query sees the transactions committed through this Alpha. It returns
+// zero if this Alpha doesn't know of any timestamp yet.
+func (s *ServerState) ReadTimestamp() uint64 {
+	ts := posting.Oracle().MaxAssigned()
+	if latest := atomic.LoadUint64(&s.latestTs); latest > ts {
+		ts = latest
+	}
+	return ts
+}
+
 type tsReq struct {
 	readOnly bool
 	// A one-shot chan which we can send a txn timestamp upon.
@@ -0,0 +1,36 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/posting"
+)
+
+func TestReadTimestamp(t *testing.T) {
+	var s ServerState
+	maxAssigned := posting.Oracle().MaxAssigned()
+	require.Equal(t, maxAssigned, s.ReadTimestamp())
+
+	// Read-only queries see the timestamps got from Zero before the oracle deltas.
+	s.noteTs(maxAssigned + 10)
+	s.noteTs(maxAssigned + 5)
+	require.Equal(t, maxAssigned+10, s.ReadTimestamp())
+}
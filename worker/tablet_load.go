@@ -0,0 +1,149 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"io/ioutil"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// clockTicks is the number of clock ticks per second used by /proc/self/stat. It is 100 on
+// practically all Linux systems.
+const clockTicks = 100
+
+// memoryRefresh is how often the memory in use is read. ReadMemStats stops the world, so don't
+// call it every time the load is reported.
+const memoryRefresh = time.Minute
+
+// loadTracker counts the reads and writes of every predicate served by this Alpha. The rates are
+// sent to Zero along with the CPU and memory usage on every membership update, so that tablets
+// can be rebalanced based on load and not only on their size.
+type loadTracker struct {
+	sync.Mutex
+	reads  map[string]uint64
+	writes map[string]uint64
+	since  time.Time
+
+	cpuTime   time.Duration
+	memory    uint64
+	memoryAge time.Time
+}
+
+var tabletLoads = newLoadTracker()
+
+func newLoadTracker() *loadTracker {
+	t := &loadTracker{
+		reads:  make(map[string]uint64),
+		writes: make(map[string]uint64),
+		since:  time.Now(),
+	}
+	t.cpuTime, _ = processCPUTime()
+	return t
+}
+
+func (t *loadTracker) addRead(attr string) {
+	t.Lock()
+	defer t.Unlock()
+	t.reads[attr]++
+}
+
+func (t *loadTracker) addWrites(edges []*pb.DirectedEdge) {
+	t.Lock()
+	defer t.Unlock()
+	for _, edge := range edges {
+		t.writes[edge.Attr]++
+	}
+}
+
+// report fills in the load of member since the last report, and resets the counters. name maps a
+// predicate to the name of the tablet it is reported under.
+func (t *loadTracker) report(member *pb.Member, name func(attr string) string) {
+	t.Lock()
+	defer t.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(t.since).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	loads := make(map[string]*pb.TabletLoad)
+	get := func(attr string) *pb.TabletLoad {
+		tablet := name(attr)
+		if _, ok := loads[tablet]; !ok {
+			loads[tablet] = &pb.TabletLoad{}
+		}
+		return loads[tablet]
+	}
+	for attr, n := range t.reads {
+		get(attr).ReadsPerSec += float64(n) / elapsed
+	}
+	for attr, n := range t.writes {
+		get(attr).WritesPerSec += float64(n) / elapsed
+	}
+	member.TabletLoads = loads
+
+	if cpuTime, ok := processCPUTime(); ok {
+		used := (cpuTime - t.cpuTime).Seconds() / elapsed
+		member.CpuUsage = used / float64(runtime.NumCPU())
+		t.cpuTime = cpuTime
+	}
+	if now.Sub(t.memoryAge) > memoryRefresh {
+		var ms runtime.MemStats
+		runtime.ReadMemStats(&ms)
+		t.memory = ms.HeapInuse + ms.StackInuse
+		t.memoryAge = now
+	}
+	member.MemoryInUse = t.memory
+
+	t.reads = make(map[string]uint64)
+	t.writes = make(map[string]uint64)
+	t.since = now
+}
+
+// processCPUTime returns the user and system CPU time used by this process so far. It returns
+// false if the CPU time can't be found out on this platform.
+func processCPUTime() (time.Duration, bool) {
+	if runtime.GOOS != "linux" {
+		return 0, false
+	}
+	contents, err := ioutil.ReadFile("/proc/self/stat")
+	if err != nil {
+		return 0, false
+	}
+	// The second field is the command name in parentheses, which can contain spaces. utime and
+	// stime are the 14th and 15th fields.
+	stat := string(contents)
+	fields := strings.Fields(stat[strings.LastIndexByte(stat, ')')+1:])
+	if len(fields) < 13 {
+		return 0, false
+	}
+	utime, err := strconv.ParseUint(fields[11], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	stime, err := strconv.ParseUint(fields[12], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(utime+stime) * time.Second / clockTicks, true
+}
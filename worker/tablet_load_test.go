@@ -0,0 +1,52 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTrackerReport(t *testing.T) {
+	tracker := newLoadTracker()
+	tracker.since = time.Now().Add(-10 * time.Second)
+	for i := 0; i < 100; i++ {
+		tracker.addRead("name")
+	}
+	tracker.addWrites([]*pb.DirectedEdge{{Attr: "name"}, {Attr: "follows"}})
+
+	member := &pb.Member{}
+	tracker.report(member, func(attr string) string {
+		if attr == "follows" {
+			return "follows|0x100"
+		}
+		return attr
+	})
+	require.Len(t, member.TabletLoads, 2)
+	require.InDelta(t, 10, member.TabletLoads["name"].ReadsPerSec, 0.1)
+	require.InDelta(t, 0.1, member.TabletLoads["name"].WritesPerSec, 0.01)
+	require.InDelta(t, 0.1, member.TabletLoads["follows|0x100"].WritesPerSec, 0.01)
+	require.NotZero(t, member.MemoryInUse)
+
+	// The counters are reset after every report.
+	member = &pb.Member{}
+	tracker.report(member, func(attr string) string { return attr })
+	require.Empty(t, member.TabletLoads)
+}
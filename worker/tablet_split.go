@@ -0,0 +1,342 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+	otrace "go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+/*
+A predicate can be split by subject uid ranges across multiple groups. Zero keeps one tablet named
+after the predicate (the primary tablet), which holds the schema, the index, reverse and count keys,
+and the data keys for all the uids not covered by a split. Every split is a separate tablet named
+x.SplitTabletName(attr, start), which holds the data keys for subject uids in [start, next), where
+next is the start of the following split of the same predicate.
+
+Only predicates without indexes, reverse edges and counts can be split, because those keys are keyed
+by value and can't be partitioned by subject. Queries which look up a uid list, including the
+functions used as filters, are partitioned by range, sent to the groups serving those ranges and
+merged back. has() at root is evaluated on every part. The other functions at root look up an index,
+so they are rejected.
+*/
+
+// splitRange is a range of subject uids of a split predicate served by group gid.
+type splitRange struct {
+	start  uint64
+	gid    uint32
+	tablet string
+}
+
+// splitsFromTablets returns the uid ranges for every split predicate found in tablets, sorted by
+// their start uid.
+func splitsFromTablets(tablets map[string]*pb.Tablet) map[string][]splitRange {
+	splits := make(map[string][]splitRange)
+	for name, tablet := range tablets {
+		attr, start, ok := x.ParseSplitTablet(name)
+		if !ok {
+			continue
+		}
+		splits[attr] = append(splits[attr], splitRange{start: start, gid: tablet.GroupId,
+			tablet: name})
+	}
+	for _, ranges := range splits {
+		sort.Slice(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+	}
+	return splits
+}
+
+// splitEnd returns the end (exclusive) of the range of split tablet, given all the ranges of its
+// predicate.
+func splitEnd(ranges []splitRange, start uint64) uint64 {
+	for _, r := range ranges {
+		if r.start > start {
+			return r.start
+		}
+	}
+	return math.MaxUint64
+}
+
+// tabletSplits returns the uid ranges of attr which are not served by the primary tablet.
+func (g *groupi) tabletSplits(attr string) []splitRange {
+	g.RLock()
+	defer g.RUnlock()
+	return g.splits[attr]
+}
+
+// servesSplitOf returns true if this group serves a uid range of attr.
+func (g *groupi) servesSplitOf(attr string) bool {
+	for _, r := range g.tabletSplits(attr) {
+		if r.gid == g.groupId() {
+			return true
+		}
+	}
+	return false
+}
+
+// splitTabletFor returns the name of the split tablet of attr served by gid, if any.
+func (g *groupi) splitTabletFor(attr string, gid uint32) string {
+	for _, r := range g.tabletSplits(attr) {
+		if r.gid == gid {
+			return r.tablet
+		}
+	}
+	return ""
+}
+
+// BelongsToUid acts like BelongsTo, except that for split predicates it returns the group serving
+// the range which contains uid.
+func (g *groupi) BelongsToUid(attr string, uid uint64) (uint32, error) {
+	ranges := g.tabletSplits(attr)
+	idx := sort.Search(len(ranges), func(i int) bool { return ranges[i].start > uid })
+	if idx > 0 {
+		return ranges[idx-1].gid, nil
+	}
+	return g.BelongsTo(attr)
+}
+
+// groupsServing returns all the groups serving a part of attr, starting with primary.
+func groupsServing(attr string, primary uint32) []uint32 {
+	gids := []uint32{primary}
+	seen := map[uint32]struct{}{primary: {}}
+	for _, r := range groups().tabletSplits(attr) {
+		if _, ok := seen[r.gid]; !ok {
+			seen[r.gid] = struct{}{}
+			gids = append(gids, r.gid)
+		}
+	}
+	return gids
+}
+
+// splitPart is the part of a query sent to a single group, along with the positions of its uids
+// in the original uid list.
+type splitPart struct {
+	gid   uint32
+	query *pb.Query
+	idx   []int
+	res   *pb.Result
+}
+
+// partitionBySplits divides the uid list of q into one query for each group serving a range of
+// the uids. Groups are returned in the order of their first uid.
+func partitionBySplits(q *pb.Query, primary uint32, ranges []splitRange) []*splitPart {
+	var parts []*splitPart
+	byGroup := make(map[uint32]*splitPart)
+	for i, uid := range q.UidList.GetUids() {
+		gid := primary
+		if idx := sort.Search(len(ranges), func(i int) bool {
+			return ranges[i].start > uid
+		}); idx > 0 {
+			gid = ranges[idx-1].gid
+		}
+		part, ok := byGroup[gid]
+		if !ok {
+			pq := *q
+			pq.UidList = &pb.List{}
+			part = &splitPart{gid: gid, query: &pq}
+			byGroup[gid] = part
+			parts = append(parts, part)
+		}
+		part.query.UidList.Uids = append(part.query.UidList.Uids, uid)
+		part.idx = append(part.idx, i)
+	}
+	return parts
+}
+
+// mergeSplitResults puts the results of all the parts back at the positions of their uids in a
+// result for n uids.
+func mergeSplitResults(n int, parts []*splitPart) *pb.Result {
+	out := &pb.Result{}
+	var hasUids, hasValues, hasCounts, hasFacets, hasLangs bool
+	for _, part := range parts {
+		hasUids = hasUids || len(part.res.UidMatrix) > 0
+		hasValues = hasValues || len(part.res.ValueMatrix) > 0
+		hasCounts = hasCounts || len(part.res.Counts) > 0
+		hasFacets = hasFacets || len(part.res.FacetMatrix) > 0
+		hasLangs = hasLangs || len(part.res.LangMatrix) > 0
+		out.IntersectDest = out.IntersectDest || part.res.IntersectDest
+		out.List = out.List || part.res.List
+	}
+	if hasUids {
+		out.UidMatrix = make([]*pb.List, n)
+	}
+	if hasValues {
+		out.ValueMatrix = make([]*pb.ValueList, n)
+	}
+	if hasCounts {
+		out.Counts = make([]uint32, n)
+	}
+	if hasFacets {
+		out.FacetMatrix = make([]*pb.FacetsList, n)
+	}
+	if hasLangs {
+		out.LangMatrix = make([]*pb.LangList, n)
+	}
+
+	for _, part := range parts {
+		res := part.res
+		for i, pos := range part.idx {
+			if i < len(res.UidMatrix) {
+				out.UidMatrix[pos] = res.UidMatrix[i]
+			}
+			if i < len(res.ValueMatrix) {
+				out.ValueMatrix[pos] = res.ValueMatrix[i]
+			}
+			if i < len(res.Counts) {
+				out.Counts[pos] = res.Counts[i]
+			}
+			if i < len(res.FacetMatrix) {
+				out.FacetMatrix[pos] = res.FacetMatrix[i]
+			}
+			if i < len(res.LangMatrix) {
+				out.LangMatrix[pos] = res.LangMatrix[i]
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		if hasUids && out.UidMatrix[i] == nil {
+			out.UidMatrix[i] = &pb.List{}
+		}
+		if hasValues && out.ValueMatrix[i] == nil {
+			out.ValueMatrix[i] = &pb.ValueList{}
+		}
+		if hasFacets && out.FacetMatrix[i] == nil {
+			out.FacetMatrix[i] = &pb.FacetsList{}
+		}
+		if hasLangs && out.LangMatrix[i] == nil {
+			out.LangMatrix[i] = &pb.LangList{}
+		}
+	}
+	return out
+}
+
+// concatSplitResults returns the lists of uids of all the parts. It's used for the functions which
+// only return a list for every uid which matched, so that there are no positions to keep.
+func concatSplitResults(parts []*splitPart) *pb.Result {
+	out := &pb.Result{}
+	for _, part := range parts {
+		out.UidMatrix = append(out.UidMatrix, part.res.UidMatrix...)
+		out.List = out.List || part.res.List
+	}
+	return out
+}
+
+// returnsRowPerUid returns true if the results of q have a row for every uid of its uid list.
+func returnsRowPerUid(q *pb.Query) bool {
+	if q.DoCount {
+		return true
+	}
+	fnType, _ := parseFuncType(q.SrcFunc)
+	switch fnType {
+	case notAFunction, aggregatorFn, passwordFn, compareAttrFn:
+		return true
+	}
+	return false
+}
+
+// processTaskInGroup runs q locally if this Alpha serves gid, or sends it to a server of gid.
+func processTaskInGroup(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, error) {
+	if groups().ServesGroup(gid) {
+		res, err := processTask(ctx, q, gid)
+		// The merged results don't keep the keys read. So, collect them here.
+		isolationFrom(ctx).addReads(res)
+		return res, err
+	}
+	result, err := processWithBackupRequest(ctx, gid,
+		func(ctx context.Context, c pb.WorkerClient) (interface{}, error) {
+			return c.ServeTask(ctx, q)
+		})
+	if err != nil {
+		return nil, err
+	}
+	isolationFrom(ctx).addReads(result.(*pb.Result))
+	return result.(*pb.Result), nil
+}
+
+// processSplitTask runs q against a predicate which is split across groups. It returns false if q
+// can only be served by the primary tablet, and an error if q can't be served on a split predicate.
+func processSplitTask(ctx context.Context, q *pb.Query, primary uint32,
+	ranges []splitRange) (*pb.Result, bool, error) {
+	span := otrace.FromContext(ctx)
+
+	switch {
+	case q.Reverse:
+		return nil, false, nil
+	case q.SrcFunc != nil && q.SrcFunc.Name == "has" && q.UidList == nil:
+		// Every part returns the sorted uids it has. Merge them into a single list.
+		gids := groupsServing(q.Attr, primary)
+		lists := make([]*pb.List, len(gids))
+		g, gctx := errgroup.WithContext(ctx)
+		for i, gid := range gids {
+			i, gid := i, gid
+			g.Go(func() error {
+				res, err := processTaskInGroup(gctx, q, gid)
+				if err != nil {
+					return err
+				}
+				lists[i] = &pb.List{}
+				if len(res.UidMatrix) > 0 {
+					lists[i] = res.UidMatrix[0]
+				}
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nil, true, err
+		}
+		merged := algo.MergeSorted(lists)
+		if q.First > 0 && len(merged.Uids) > int(q.First) {
+			merged.Uids = merged.Uids[:q.First]
+		}
+		return &pb.Result{UidMatrix: []*pb.List{merged}}, true, nil
+	case q.SrcFunc != nil && q.UidList == nil:
+		return nil, true, errors.Errorf("Function %s is not supported at root on predicate %s, "+
+			"because it's split across groups", q.SrcFunc.Name, x.ParseAttr(q.Attr))
+	case q.UidList == nil:
+		return nil, false, nil
+	}
+
+	parts := partitionBySplits(q, primary, ranges)
+	if span != nil {
+		span.Annotatef(nil, "Split predicate: %q. Sending %d uids to %d groups",
+			q.Attr, len(q.UidList.Uids), len(parts))
+	}
+	g, gctx := errgroup.WithContext(ctx)
+	for _, part := range parts {
+		part := part
+		g.Go(func() error {
+			var err error
+			part.res, err = processTaskInGroup(gctx, part.query, part.gid)
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, true, err
+	}
+	if !returnsRowPerUid(q) {
+		return concatSplitResults(parts), true, nil
+	}
+	return mergeSplitResults(len(q.UidList.Uids), parts), true, nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitsFromTablets(t *testing.T) {
+	splits := splitsFromTablets(map[string]*pb.Tablet{
+		"follows":        {GroupId: 1},
+		"follows|0x200":  {GroupId: 3},
+		"follows|0x100":  {GroupId: 2},
+		"name":           {GroupId: 1},
+		"friend|0x10000": {GroupId: 2},
+	})
+	require.Len(t, splits, 2)
+	require.Equal(t, []splitRange{
+		{start: 0x100, gid: 2, tablet: "follows|0x100"},
+		{start: 0x200, gid: 3, tablet: "follows|0x200"},
+	}, splits["follows"])
+
+	require.Equal(t, uint64(0x200), splitEnd(splits["follows"], 0x100))
+	require.Equal(t, uint64(math.MaxUint64), splitEnd(splits["follows"], 0x200))
+}
+
+func TestPartitionAndMergeSplits(t *testing.T) {
+	ranges := []splitRange{{start: 0x100, gid: 2}, {start: 0x200, gid: 3}}
+	q := &pb.Query{Attr: "follows", UidList: &pb.List{Uids: []uint64{0x1, 0x150, 0x2, 0x250}}}
+
+	parts := partitionBySplits(q, 1, ranges)
+	require.Len(t, parts, 3)
+	require.Equal(t, uint32(1), parts[0].gid)
+	require.Equal(t, []uint64{0x1, 0x2}, parts[0].query.UidList.Uids)
+	require.Equal(t, []int{0, 2}, parts[0].idx)
+	require.Equal(t, uint32(2), parts[1].gid)
+	require.Equal(t, []uint64{0x150}, parts[1].query.UidList.Uids)
+	require.Equal(t, uint32(3), parts[2].gid)
+	require.Equal(t, []uint64{0x250}, parts[2].query.UidList.Uids)
+	// The original query must be left untouched.
+	require.Len(t, q.UidList.Uids, 4)
+
+	for _, part := range parts {
+		res := &pb.Result{}
+		for _, uid := range part.query.UidList.Uids {
+			res.UidMatrix = append(res.UidMatrix, &pb.List{Uids: []uint64{uid + 1}})
+			res.Counts = append(res.Counts, uint32(uid))
+		}
+		part.res = res
+	}
+	// Only one part returns values.
+	parts[1].res.ValueMatrix = []*pb.ValueList{{Values: []*pb.TaskValue{{Val: []byte("a")}}}}
+
+	out := mergeSplitResults(len(q.UidList.Uids), parts)
+	require.Len(t, out.UidMatrix, 4)
+	for i, uid := range q.UidList.Uids {
+		require.Equal(t, []uint64{uid + 1}, out.UidMatrix[i].Uids)
+		require.Equal(t, uint32(uid), out.Counts[i])
+	}
+	require.Len(t, out.ValueMatrix, 4)
+	require.Empty(t, out.ValueMatrix[0].Values)
+	require.Equal(t, []byte("a"), out.ValueMatrix[1].Values[0].Val)
+	require.Nil(t, out.FacetMatrix)
+}
+
+func TestConcatSplitResults(t *testing.T) {
+	require.True(t, returnsRowPerUid(&pb.Query{}))
+	require.True(t, returnsRowPerUid(&pb.Query{SrcFunc: &pb.SrcFunction{Name: "eq"}}))
+	require.False(t, returnsRowPerUid(&pb.Query{SrcFunc: &pb.SrcFunction{Name: "has"}}))
+	require.True(t, returnsRowPerUid(&pb.Query{SrcFunc: &pb.SrcFunction{Name: "has"},
+		DoCount: true}))
+
+	// has() returns a list for every uid which has the predicate.
+	parts := []*splitPart{
+		{res: &pb.Result{UidMatrix: []*pb.List{{Uids: []uint64{0x1}}, {Uids: []uint64{0x2}}}}},
+		{res: &pb.Result{}},
+		{res: &pb.Result{UidMatrix: []*pb.List{{Uids: []uint64{0x250}}}}},
+	}
+	out := concatSplitResults(parts)
+	require.Equal(t, []*pb.List{{Uids: []uint64{0x1}}, {Uids: []uint64{0x2}},
+		{Uids: []uint64{0x250}}}, out.UidMatrix)
+}
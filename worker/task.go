@@ -28,6 +28,7 @@ import (
 	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/ee/enc"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
@@ -58,7 +59,10 @@ func invokeNetworkRequest(ctx context.Context, addr string,
 		span.Annotatef(nil, "invokeNetworkRequest: Sending request to %v", addr)
 	}
 	c := pb.NewWorkerClient(con)
-	return f(ctx, c)
+	start := time.Now()
+	reply, err := f(ctx, c)
+	pl.RecordRequest(ctx, start, err)
+	return reply, err
 }
 
 const backupRequestGracePeriod = time.Second
@@ -68,7 +72,8 @@ func processWithBackupRequest(
 	ctx context.Context,
 	gid uint32,
 	f func(context.Context, pb.WorkerClient) (interface{}, error)) (interface{}, error) {
-	addrs := groups().AnyTwoServers(gid)
+	// Send the request to the fastest server, and the backup request to the second fastest.
+	addrs := groups().FastestServers(gid, 2)
 	if len(addrs) == 0 {
 		return nil, errors.New("No network connection")
 	}
@@ -129,7 +134,15 @@ func processWithBackupRequest(
 // ProcessTaskOverNetwork is used to process the query and get the result from
 // the instance which stores posting list corresponding to the predicate in the
 // query.
-func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error) {
+func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (res *pb.Result, rerr error) {
+	addReadPredicate(ctx, q.Attr)
+	if iso := isolationFrom(ctx); iso != nil {
+		q.Isolation = iso.taskIsolation()
+		defer func() {
+			iso.addReads(res)
+		}()
+	}
+
 	attr := q.Attr
 	gid, err := groups().BelongsToReadOnly(attr, q.ReadTs)
 	switch {
@@ -145,6 +158,13 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 			attr, gid, q.ReadTs, groups().Node.Id)
 	}
 
+	if ranges := groups().tabletSplits(attr); len(ranges) > 0 {
+		// The predicate is split across groups by uid ranges.
+		if res, ok, err := processSplitTask(ctx, q, gid, ranges); ok {
+			return res, err
+		}
+	}
+
 	if groups().ServesGroup(gid) {
 		// No need for a network call, as this should be run from within this instance.
 		return processTask(ctx, q, gid)
@@ -391,7 +411,7 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 			key := x.DataKey(q.Attr, q.UidList.Uids[i])
 
 			// Get or create the posting list for an entity, attribute combination.
-			pl, err := qs.cache.Get(key)
+			pl, err := qs.get(key)
 			if err != nil {
 				return err
 			}
@@ -750,7 +770,7 @@ func (qs *queryState) handleUidPostings(
 			}
 
 			// Get or create the posting list for an entity, attribute combination.
-			pl, err := qs.cache.Get(key)
+			pl, err := qs.get(key)
 			if err != nil {
 				return err
 			}
@@ -909,11 +929,24 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 		return nil, err
 	case knownGid == 0:
 		return nil, errNonExistentTablet
-	case knownGid != groups().groupId():
+	case knownGid != groups().groupId() && !groups().servesSplitOf(q.Attr):
 		return nil, errUnservedTablet
 	}
+	tabletLoads.addRead(q.Attr)
 
 	var qs queryState
+	switch q.Isolation {
+	case pb.Query_SERIALIZABLE:
+		qs.reads = &readSet{}
+	case pb.Query_READ_COMMITTED:
+		// Read the latest commits known to this Alpha, instead of the snapshot at read ts.
+		if maxAssigned := posting.Oracle().MaxAssigned(); maxAssigned > q.ReadTs {
+			rq := *q
+			rq.ReadTs = maxAssigned
+			q = &rq
+			span.Annotatef(nil, "Read committed. Reading at: %d", q.ReadTs)
+		}
+	}
 	if q.Cache == UseTxnCache {
 		qs.cache = posting.Oracle().CacheAt(q.ReadTs)
 	}
@@ -927,11 +960,53 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 	if err != nil {
 		return nil, err
 	}
+	if q.Decrypt {
+		if err := decryptValues(ctx, q.Attr, out); err != nil {
+			return nil, err
+		}
+	}
+	out.ReadKeys = qs.reads.list()
 	return out, nil
 }
 
+// decryptValues decrypts the values of out if the predicate attr is @encrypted. The values which
+// fail to be decrypted, like the ones set before the predicate was @encrypted, are returned as they
+// are.
+func decryptValues(ctx context.Context, attr string, out *pb.Result) error {
+	su, ok := schema.State().Get(ctx, attr)
+	if !ok || !su.Encrypted {
+		return nil
+	}
+	key, err := dataKey(&su)
+	if err != nil {
+		return err
+	}
+	for _, list := range out.ValueMatrix {
+		for i, tv := range list.GetValues() {
+			if val, err := enc.DecryptValue(key, tv.Val); err == nil {
+				list.Values[i] = &pb.TaskValue{Val: val, ValType: tv.ValType}
+			}
+		}
+	}
+	return nil
+}
+
 type queryState struct {
 	cache *posting.LocalCache
+	// reads tracks the posting lists read for a serializable transaction. It is nil otherwise.
+	reads *readSet
+}
+
+// get returns the posting list of key from the cache, and tracks it as read.
+func (qs *queryState) get(key []byte) (*posting.List, error) {
+	qs.reads.add(key)
+	return qs.cache.Get(key)
+}
+
+// getNoStore reads the posting list of key without caching it, and tracks it as read.
+func (qs *queryState) getNoStore(key []byte, readTs uint64) (*posting.List, error) {
+	qs.reads.add(key)
+	return posting.GetNoStore(key, readTs)
 }
 
 func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint32) (
@@ -1170,7 +1245,7 @@ func (qs *queryState) handleRegexFunction(ctx context.Context, arg funcArgs) err
 			return ctx.Err()
 		default:
 		}
-		pl, err := qs.cache.Get(x.DataKey(attr, uid))
+		pl, err := qs.get(x.DataKey(attr, uid))
 		if err != nil {
 			return err
 		}
@@ -1256,7 +1331,7 @@ func (qs *queryState) handleCompareFunction(ctx context.Context, arg funcArgs) e
 			switch lang {
 			case "":
 				if isList {
-					pl, err := posting.GetNoStore(x.DataKey(attr, uid), arg.q.ReadTs)
+					pl, err := qs.getNoStore(x.DataKey(attr, uid), arg.q.ReadTs)
 					if err != nil {
 						filterErr = err
 						return false
@@ -1278,7 +1353,7 @@ func (qs *queryState) handleCompareFunction(ctx context.Context, arg funcArgs) e
 					return false
 				}
 
-				pl, err := posting.GetNoStore(x.DataKey(attr, uid), arg.q.ReadTs)
+				pl, err := qs.getNoStore(x.DataKey(attr, uid), arg.q.ReadTs)
 				if err != nil {
 					filterErr = err
 					return false
@@ -1293,7 +1368,7 @@ func (qs *queryState) handleCompareFunction(ctx context.Context, arg funcArgs) e
 				dst, err := types.Convert(sv, typ)
 				return err == nil && compareFunc(dst)
 			case ".":
-				pl, err := posting.GetNoStore(x.DataKey(attr, uid), arg.q.ReadTs)
+				pl, err := qs.getNoStore(x.DataKey(attr, uid), arg.q.ReadTs)
 				if err != nil {
 					filterErr = err
 					return false
@@ -1415,7 +1490,7 @@ func (qs *queryState) handleMatchFunction(ctx context.Context, arg funcArgs) err
 			return ctx.Err()
 		default:
 		}
-		pl, err := qs.cache.Get(x.DataKey(attr, uid))
+		pl, err := qs.get(x.DataKey(attr, uid))
 		if err != nil {
 			return err
 		}
@@ -1475,7 +1550,7 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 		filtered[idx] = &pb.List{}
 		out := filtered[idx]
 		for _, uid := range uids.Uids[start:end] {
-			pl, err := qs.cache.Get(x.DataKey(attr, uid))
+			pl, err := qs.get(x.DataKey(attr, uid))
 			if err != nil {
 				return err
 			}
@@ -1608,7 +1683,7 @@ func (qs *queryState) filterStringFunction(arg funcArgs) error {
 
 func (qs *queryState) getValsForUID(attr, lang string, uid, ReadTs uint64) ([]types.Val, error) {
 	key := x.DataKey(attr, uid)
-	pl, err := qs.cache.Get(key)
+	pl, err := qs.get(key)
 	if err != nil {
 		return nil, err
 	}
@@ -1697,6 +1772,17 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 	isIndexedAttr := schema.State().IsIndexed(ctx, attr)
 	var err error
 
+	switch fnType {
+	case notAFunction, hasFn, compareScalarFn:
+	default:
+		// The values of the @encrypted predicates are encrypted with random nonces, so they can't
+		// be compared to the arguments of the functions.
+		if schema.State().IsEncrypted(attr) {
+			return nil, errors.Errorf("Function %s can't be used on the @encrypted predicate %s",
+				f, x.ParseAttr(attr))
+		}
+	}
+
 	t, err := schema.State().TypeOf(attr)
 	if err == nil && fnType != notAFunction && t.Name() == types.StringID.Name() {
 		fc.isStringFn = true
@@ -1958,7 +2044,11 @@ func (w *grpcWorker) ServeTask(ctx context.Context, q *pb.Query) (*pb.Result, er
 	case gid == 0:
 		return nil, errNonExistentTablet
 	case gid != groups().groupId():
-		return nil, errUnservedTablet
+		if !groups().servesSplitOf(q.Attr) {
+			return nil, errUnservedTablet
+		}
+		// This group serves a uid range of a split predicate.
+		gid = groups().groupId()
 	}
 
 	var numUids int
@@ -2240,7 +2330,7 @@ func (qs *queryState) evaluate(cp countParams, out *pb.Result) error {
 
 	countKey := x.CountKey(cp.attr, uint32(countl), cp.reverse)
 	if cp.fn == "eq" {
-		pl, err := qs.cache.Get(countKey)
+		pl, err := qs.get(countKey)
 		if err != nil {
 			return err
 		}
@@ -2286,7 +2376,7 @@ func (qs *queryState) evaluate(cp countParams, out *pb.Result) error {
 			break
 		}
 
-		pl, err := qs.cache.Get(item.KeyCopy(key))
+		pl, err := qs.get(item.KeyCopy(key))
 		if err != nil {
 			return err
 		}
@@ -2381,6 +2471,7 @@ loop:
 		}
 		if item.UserMeta()&posting.BitCompletePosting > 0 {
 			// This bit would only be set if there are valid uids in UidPack.
+			qs.reads.add(item.Key())
 			err := checkInclusion(pk.Uid)
 			switch {
 			case err == posting.ErrNoValue:
@@ -2398,6 +2489,7 @@ loop:
 		}
 
 		// We do need to copy over the key for ReadPostingList.
+		qs.reads.add(item.Key())
 		l, err := posting.ReadPostingList(item.KeyCopy(nil), it)
 		if err != nil {
 			return err
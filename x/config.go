@@ -19,6 +19,7 @@ package x
 import (
 	"crypto/tls"
 	"net"
+	"sync/atomic"
 	"time"
 
 	"github.com/spf13/viper"
@@ -112,6 +113,23 @@ type WorkerOptions struct {
 // WorkerConfig stores the global instance of the worker package's options.
 var WorkerConfig WorkerOptions
 
+// encryptionKey holds the encryption key it was rotated to while the Alpha runs, if any.
+var encryptionKey atomic.Value
+
+// EncryptionKey returns the current encryption key. It is WorkerConfig.EncryptionKey, until the
+// key is rotated while the Alpha runs.
+func EncryptionKey() SensitiveByteSlice {
+	if key, ok := encryptionKey.Load().(SensitiveByteSlice); ok {
+		return key
+	}
+	return WorkerConfig.EncryptionKey
+}
+
+// SetEncryptionKey makes key the current encryption key, once it is rotated.
+func SetEncryptionKey(key SensitiveByteSlice) {
+	encryptionKey.Store(key)
+}
+
 func (w *WorkerOptions) Parse(conf *viper.Viper) {
 	w.MyAddr = conf.GetString("my")
 	w.Tracing = conf.GetFloat64("trace")
@@ -19,7 +19,9 @@ package x
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -533,7 +535,8 @@ func IsDropOpKey(key []byte) (bool, error) {
 		return false, errors.Wrapf(err, "could not parse key %s", hex.Dump(key))
 	}
 
-	if pk.IsData() && pk.Attr == "dgraph.drop.op" {
+	// Every namespace keeps the records of its own drop operations.
+	if pk.IsData() && ParseAttr(pk.Attr) == "dgraph.drop.op" {
 		return true, nil
 	}
 	return false, nil
@@ -545,12 +548,24 @@ var starAllPredicateMap = map[string]struct{}{
 }
 
 var aclPredicateMap = map[string]struct{}{
-	"dgraph.xid":             {},
-	"dgraph.password":        {},
-	"dgraph.user.group":      {},
-	"dgraph.rule.predicate":  {},
-	"dgraph.rule.permission": {},
-	"dgraph.acl.rule":        {},
+	"dgraph.xid":                   {},
+	"dgraph.password":              {},
+	"dgraph.user.group":            {},
+	"dgraph.user.password_changed": {},
+	"dgraph.rule.predicate":        {},
+	"dgraph.rule.permission":       {},
+	"dgraph.acl.rule":              {},
+	"dgraph.acl.rate_limit":        {},
+	"dgraph.acl.burst":             {},
+	"dgraph.acl.policy":            {},
+	"dgraph.policy.type":           {},
+	"dgraph.policy.filter":         {},
+	"dgraph.apikey.name":           {},
+	"dgraph.apikey.hash":           {},
+	"dgraph.apikey.group":          {},
+	"dgraph.apikey.expiry":         {},
+	"dgraph.apikey.ips":            {},
+	"dgraph.apikey.readonly":       {},
 }
 
 // TODO: rename this map to a better suited name as per its properties. It is not just for GraphQL
@@ -580,6 +595,8 @@ var preDefinedTypeMap = map[string]struct{}{
 	"dgraph.type.User":               {},
 	"dgraph.type.Group":              {},
 	"dgraph.type.Rule":               {},
+	"dgraph.type.Policy":             {},
+	"dgraph.type.ApiKey":             {},
 	"dgraph.graphql.history":         {},
 	"dgraph.graphql.persisted_query": {},
 	"dgraph.type.cors":               {},
@@ -589,7 +606,7 @@ var preDefinedTypeMap = map[string]struct{}{
 // These are a subset of PreDefined predicates, so follow all their properties. In addition,
 // the value for these predicates is also not allowed to be mutated directly by the users.
 func IsGraphqlReservedPredicate(pred string) bool {
-	_, ok := graphqlReservedPredicate[pred]
+	_, ok := graphqlReservedPredicate[ParseAttr(pred)]
 	return ok
 }
 
@@ -625,14 +642,14 @@ func IsReservedPredicate(pred string) bool {
 //
 // Pre-defined predicates are subset of reserved predicates.
 func IsPreDefinedPredicate(pred string) bool {
-	_, ok := starAllPredicateMap[strings.ToLower(pred)]
+	_, ok := starAllPredicateMap[strings.ToLower(ParseAttr(pred))]
 	return ok || IsAclPredicate(pred) || IsGraphqlReservedPredicate(pred)
 }
 
 // IsAclPredicate returns true if the predicate is in the list of reserved
 // predicates for the ACL feature.
 func IsAclPredicate(pred string) bool {
-	_, ok := aclPredicateMap[strings.ToLower(pred)]
+	_, ok := aclPredicateMap[strings.ToLower(ParseAttr(pred))]
 	return ok
 }
 
@@ -657,7 +674,7 @@ func AllACLPredicates() []string {
 // IsInternalPredicate returns true if the predicate is in the internal predicate list.
 // Currently, `uid` is the only such candidate.
 func IsInternalPredicate(pred string) bool {
-	_, ok := internalPredicateMap[strings.ToLower(pred)]
+	_, ok := internalPredicateMap[strings.ToLower(ParseAttr(pred))]
 	return ok
 }
 
@@ -685,11 +702,90 @@ func IsReservedType(typ string) bool {
 //
 // Pre-defined types are subset of reserved types.
 func IsPreDefinedType(typ string) bool {
-	_, ok := preDefinedTypeMap[typ]
+	_, ok := preDefinedTypeMap[ParseAttr(typ)]
 	return ok
 }
 
 // isReservedName returns true if the given name is prefixed with `dgraph.`
 func isReservedName(name string) bool {
-	return strings.HasPrefix(strings.ToLower(name), "dgraph.")
+	return strings.HasPrefix(strings.ToLower(ParseAttr(name)), "dgraph.")
+}
+
+// SplitTabletSeparator separates the predicate from the start uid in the name of a split tablet.
+// It is not a valid character in a predicate name, so split tablet names can't collide with
+// regular tablets.
+const SplitTabletSeparator = "|"
+
+// SplitTabletName returns the name of the tablet which serves the data keys of attr for subject
+// uids starting at start, up to the start uid of the next split of attr. The rest of the data for
+// the predicate, along with its schema, index, reverse and count keys, stays with the tablet named
+// after the predicate itself.
+func SplitTabletName(attr string, start uint64) string {
+	return fmt.Sprintf("%s%s%#x", attr, SplitTabletSeparator, start)
+}
+
+// ParseSplitTablet returns the predicate and the start uid encoded in the name of a split tablet.
+// The returned bool is false if name does not belong to a split tablet.
+func ParseSplitTablet(name string) (string, uint64, bool) {
+	idx := strings.LastIndex(name, SplitTabletSeparator)
+	if idx <= 0 {
+		return "", 0, false
+	}
+	start, err := strconv.ParseUint(name[idx+1:], 0, 64)
+	if err != nil || start == 0 {
+		return "", 0, false
+	}
+	return name[:idx], start, true
+}
+
+// GalaxyNamespace is the default namespace. Its predicates and types keep their names, so that the
+// data written before namespaces existed belongs to it.
+const GalaxyNamespace uint64 = 0
+
+// NamespaceSeparator separates the namespace from the name of a predicate, or of a type, which
+// belongs to a namespace other than the galaxy namespace. Like SplitTabletSeparator, it is not a
+// valid character in a predicate name, so the predicates of a namespace can't collide with the
+// predicates of another namespace. The reserved, pre-defined and internal predicates and types are
+// the same in every namespace.
+const NamespaceSeparator = "^"
+
+// NamespaceAttr returns the name of the predicate, or of the type, attr in the namespace ns. The
+// reverse of a predicate is marked by a leading ~, which is kept in front of the namespace.
+func NamespaceAttr(ns uint64, attr string) string {
+	if ns == GalaxyNamespace {
+		return attr
+	}
+	if strings.HasPrefix(attr, "~") {
+		return "~" + NamespaceAttr(ns, attr[1:])
+	}
+	return strconv.FormatUint(ns, 10) + NamespaceSeparator + attr
+}
+
+// ParseNamespaceAttr returns the namespace of attr, and its name in that namespace.
+func ParseNamespaceAttr(attr string) (uint64, string) {
+	if strings.HasPrefix(attr, "~") {
+		ns, name := ParseNamespaceAttr(attr[1:])
+		return ns, "~" + name
+	}
+	idx := strings.Index(attr, NamespaceSeparator)
+	if idx <= 0 {
+		return GalaxyNamespace, attr
+	}
+	ns, err := strconv.ParseUint(attr[:idx], 10, 64)
+	if err != nil {
+		return GalaxyNamespace, attr
+	}
+	return ns, attr[idx+len(NamespaceSeparator):]
+}
+
+// ParseAttr returns the name of attr in its namespace.
+func ParseAttr(attr string) string {
+	_, name := ParseNamespaceAttr(attr)
+	return name
+}
+
+// ParseNamespace returns the namespace of attr.
+func ParseNamespace(attr string) uint64 {
+	ns, _ := ParseNamespaceAttr(attr)
+	return ns
 }
@@ -266,3 +266,49 @@ func TestBadKeys(t *testing.T) {
 	_, err = Parse(key)
 	require.Error(t, err)
 }
+
+func TestSplitTabletName(t *testing.T) {
+	name := SplitTabletName("follows", 0x2710)
+	require.Equal(t, "follows|0x2710", name)
+
+	attr, start, ok := ParseSplitTablet(name)
+	require.True(t, ok)
+	require.Equal(t, "follows", attr)
+	require.Equal(t, uint64(0x2710), start)
+
+	for _, name := range []string{"follows", "|0x10", "follows|", "follows|0x0", "follows|abc"} {
+		_, _, ok := ParseSplitTablet(name)
+		require.False(t, ok, "name: %s", name)
+	}
+}
+
+func TestNamespaceAttr(t *testing.T) {
+	require.Equal(t, "name", NamespaceAttr(GalaxyNamespace, "name"))
+	require.Equal(t, "12^name", NamespaceAttr(12, "name"))
+	require.Equal(t, "~12^friend", NamespaceAttr(12, "~friend"))
+
+	for _, tc := range []struct {
+		attr string
+		ns   uint64
+		name string
+	}{
+		{"name", GalaxyNamespace, "name"},
+		{"~friend", GalaxyNamespace, "~friend"},
+		{"12^name", 12, "name"},
+		{"~12^friend", 12, "~friend"},
+		{"12^dgraph.type", 12, "dgraph.type"},
+		{"^name", GalaxyNamespace, "^name"},
+		{"abc^name", GalaxyNamespace, "abc^name"},
+	} {
+		ns, name := ParseNamespaceAttr(tc.attr)
+		require.Equal(t, tc.ns, ns, "attr: %s", tc.attr)
+		require.Equal(t, tc.name, name, "attr: %s", tc.attr)
+		require.Equal(t, tc.name, ParseAttr(tc.attr))
+		require.Equal(t, tc.ns, ParseNamespace(tc.attr))
+	}
+
+	attr, start, ok := ParseSplitTablet(SplitTabletName(NamespaceAttr(12, "follows"), 0x10))
+	require.True(t, ok)
+	require.Equal(t, "12^follows", attr)
+	require.Equal(t, uint64(0x10), start)
+}
@@ -102,6 +102,12 @@ var (
 	// TxnAborts records count of aborted transactions.
 	TxnAborts = stats.Int64("txn_aborts_total",
 		"Number of transaction aborts", stats.UnitDimensionless)
+	// LoginAttempts records count of ACL logins with a password.
+	LoginAttempts = stats.Int64("login_attempts_total",
+		"Number of logins with a password", stats.UnitDimensionless)
+	// LoginLockouts records count of ACL users locked out after too many failed logins.
+	LoginLockouts = stats.Int64("login_lockouts_total",
+		"Number of users locked out", stats.UnitDimensionless)
 	// PBlockHitRatio records the hit ratio of posting store block cache.
 	PBlockHitRatio = stats.Float64("hit_ratio_postings_block",
 		"Hit ratio of p store block cache", stats.UnitDimensionless)
@@ -198,6 +204,20 @@ var (
 			Aggregation: view.Count(),
 			TagKeys:     allTagKeys,
 		},
+		{
+			Name:        LoginAttempts.Name(),
+			Measure:     LoginAttempts,
+			Description: LoginAttempts.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     allTagKeys,
+		},
+		{
+			Name:        LoginLockouts.Name(),
+			Measure:     LoginLockouts,
+			Description: LoginLockouts.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     nil,
+		},
 
 		// Last value aggregations
 		{
@@ -17,6 +17,7 @@
 package x
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"io/ioutil"
@@ -25,6 +26,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 )
 
 // TLSHelperConfig define params used to create a tls.Config
@@ -227,6 +230,41 @@ func setupClientAuth(authType string) (tls.ClientAuthType, error) {
 	return tls.NoClientCert, nil
 }
 
+// VerifiedClientCert returns the client certificate of the TLS connection of the request, if the
+// client sent one which was verified against the CA certs. The certificates of the HTTP requests
+// are attached to the context by AttachRemoteIP.
+func VerifiedClientCert(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return info.State.VerifiedChains[0][0]
+}
+
+// CertIdentity returns the identity held by the given field of the certificate: the common name
+// for "cn", or the first subject alternative name for "san", out of its email addresses, DNS
+// names and URIs, in that order.
+func CertIdentity(cert *x509.Certificate, field string) string {
+	switch strings.ToLower(field) {
+	case "cn":
+		return cert.Subject.CommonName
+	case "san":
+		switch {
+		case len(cert.EmailAddresses) > 0:
+			return cert.EmailAddresses[0]
+		case len(cert.DNSNames) > 0:
+			return cert.DNSNames[0]
+		case len(cert.URIs) > 0:
+			return cert.URIs[0].String()
+		}
+	}
+	return ""
+}
+
 // GenerateServerTLSConfig creates and returns a new *tls.Config with the
 // configuration provided.
 func GenerateServerTLSConfig(config *TLSHelperConfig) (tlsCfg *tls.Config, err error) {
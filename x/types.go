@@ -16,6 +16,11 @@
 
 package x
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
 // SensitiveByteSlice implements the Stringer interface to redact its contents.
 // Use this type for sensitive info such as keys, passwords, or secrets so it doesn't leak
 // as output such as logs.
@@ -24,3 +29,13 @@ type SensitiveByteSlice []byte
 func (SensitiveByteSlice) String() string {
 	return "****"
 }
+
+// KeyId identifies the key without revealing it. It's the hex encoded start of the SHA-256
+// hash of the key, or empty if there's no key.
+func (s SensitiveByteSlice) KeyId() string {
+	if len(s) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(s)
+	return hex.EncodeToString(sum[:8])
+}
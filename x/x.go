@@ -45,6 +45,7 @@ import (
 	badgerpb "github.com/dgraph-io/badger/v3/pb"
 	"github.com/dgraph-io/dgo/v200"
 	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/ristretto/z"
 	"github.com/dustin/go-humanize"
 
@@ -125,9 +126,33 @@ const (
 	GroupIdFileName = "group_id"
 
 	AccessControlAllowedHeaders = "X-Dgraph-AccessToken, X-Dgraph-AuthToken, " +
-		"Content-Type, Content-Length, Accept-Encoding, Cache-Control, " +
+		"X-Dgraph-Namespace, Content-Type, Content-Length, Accept-Encoding, Cache-Control, " +
 		"X-CSRF-Token, X-Auth-Token, X-Requested-With"
 	DgraphCostHeader = "Dgraph-TouchedUids"
+
+	// MaxStalenessKey is the key in the grpc context metadata holding the max staleness allowed
+	// for a read-only query.
+	MaxStalenessKey = "max-staleness"
+
+	// LinearizableKey is the key in the grpc context metadata which asks for a read-only query
+	// to get its timestamp from Zero, instead of the latest timestamp known to the Alpha.
+	LinearizableKey = "linearizable"
+
+	// IsolationKey is the key in the grpc context metadata holding the isolation level of the
+	// transaction, one of snapshot (the default), serializable or read-committed.
+	IsolationKey = "isolation"
+
+	// NamespaceKey is the key in the grpc context metadata holding the namespace of the request.
+	// When ACLs are enabled, the namespace is the one of the access JWT instead.
+	NamespaceKey = "namespace"
+
+	// ReadConflictKeyPrefix marks the conflict keys of the posting lists read by a serializable
+	// transaction. Zero checks them for conflicts at commit, but doesn't record them.
+	ReadConflictKeyPrefix = "r:"
+	// ListConflictKeyPrefix marks the conflict keys of the whole posting lists written by a
+	// transaction. Zero records them at commit, so that they conflict with the reads of
+	// serializable transactions, but doesn't check them.
+	ListConflictKeyPrefix = "w:"
 )
 
 var (
@@ -410,16 +435,152 @@ func AttachAccessJwt(ctx context.Context, r *http.Request) context.Context {
 	return ctx
 }
 
-// AttachRemoteIP adds any incoming IP data into the grpc context metadata
+// AttachNamespaceHeader adds the namespace of the X-Dgraph-Namespace header into the grpc context
+// metadata.
+func AttachNamespaceHeader(ctx context.Context, r *http.Request) context.Context {
+	if ns := r.Header.Get("X-Dgraph-Namespace"); ns != "" {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			md = metadata.New(nil)
+		}
+
+		md.Set(NamespaceKey, ns)
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	return ctx
+}
+
+// ExtractNamespace returns the namespace of the request, which is passed in the namespace key of
+// the grpc context metadata. It returns the galaxy namespace if it isn't set.
+func ExtractNamespace(ctx context.Context) (uint64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return GalaxyNamespace, nil
+	}
+	vals := md.Get(NamespaceKey)
+	if len(vals) == 0 || vals[0] == "" {
+		return GalaxyNamespace, nil
+	}
+	ns, err := strconv.ParseUint(vals[0], 0, 64)
+	return ns, errors.Wrapf(err, "while parsing %s", NamespaceKey)
+}
+
+// AttachNamespace sets the namespace of the request in the grpc context metadata.
+func AttachNamespace(ctx context.Context, ns uint64) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		// Don't change the metadata of ctx, which might be shared with other requests.
+		md = md.Copy()
+	} else {
+		md = metadata.New(nil)
+	}
+	md.Set(NamespaceKey, strconv.FormatUint(ns, 10))
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// ExtractMaxStaleness returns the max staleness the client allows for a read-only query, which
+// is passed as a duration in the max-staleness key of the grpc context metadata. Such a query can
+// be served by any replica, including learners, at the timestamp it knows of, as long as the
+// replica is not further behind the leader of its group. It returns zero if it isn't set.
+func ExtractMaxStaleness(ctx context.Context) (time.Duration, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	vals := md.Get(MaxStalenessKey)
+	if len(vals) == 0 {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(vals[0])
+	if err != nil {
+		return 0, errors.Wrapf(err, "while parsing %s", MaxStalenessKey)
+	}
+	if d < 0 {
+		return 0, errors.Errorf("%s can't be negative. Got: %v", MaxStalenessKey, d)
+	}
+	return d, nil
+}
+
+// ExtractLinearizable returns true if the client asked for a linearizable read-only query, by
+// setting the linearizable key of the grpc context metadata to true.
+func ExtractLinearizable(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	vals := md.Get(LinearizableKey)
+	if len(vals) == 0 || vals[0] == "" {
+		return false, nil
+	}
+	linearizable, err := strconv.ParseBool(vals[0])
+	return linearizable, errors.Wrapf(err, "while parsing %s", LinearizableKey)
+}
+
+// ExtractIsolation returns the isolation level of the transaction, which is passed in the
+// isolation key of the grpc context metadata. It returns snapshot isolation if it isn't set.
+func ExtractIsolation(ctx context.Context) (pb.Query_Isolation, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return pb.Query_SNAPSHOT, nil
+	}
+	vals := md.Get(IsolationKey)
+	if len(vals) == 0 || vals[0] == "" {
+		return pb.Query_SNAPSHOT, nil
+	}
+	level := strings.ToUpper(strings.Replace(vals[0], "-", "_", -1))
+	isolation, ok := pb.Query_Isolation_value[level]
+	if !ok {
+		return pb.Query_SNAPSHOT, errors.Errorf("Invalid %s: %q. Valid values are snapshot,"+
+			" serializable and read-committed", IsolationKey, vals[0])
+	}
+	return pb.Query_Isolation(isolation), nil
+}
+
+// AttachIsolation adds the isolation level of the transaction into the grpc context metadata.
+func AttachIsolation(ctx context.Context, isolation string) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set(IsolationKey, isolation)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// AttachLinearizable asks for a linearizable read-only query in the grpc context metadata.
+func AttachLinearizable(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set(LinearizableKey, "true")
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// AttachMaxStaleness adds the max staleness of a read-only query into the grpc context metadata.
+func AttachMaxStaleness(ctx context.Context, d time.Duration) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set(MaxStalenessKey, d.String())
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// AttachRemoteIP adds any incoming IP data into the grpc context metadata, along with the TLS
+// connection state, which holds the client certificate.
 func AttachRemoteIP(ctx context.Context, r *http.Request) context.Context {
 	if ip, port, err := net.SplitHostPort(r.RemoteAddr); err == nil {
 		if intPort, convErr := strconv.Atoi(port); convErr == nil {
-			ctx = peer.NewContext(ctx, &peer.Peer{
+			p := &peer.Peer{
 				Addr: &net.TCPAddr{
 					IP:   net.ParseIP(ip),
 					Port: intPort,
 				},
-			})
+			}
+			if r.TLS != nil {
+				p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+			}
+			ctx = peer.NewContext(ctx, p)
 		}
 	}
 	return ctx
@@ -17,11 +17,16 @@
 package x
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
 )
 
 func TestSensitiveByteSlice(t *testing.T) {
@@ -197,3 +202,53 @@ func TestToHex(t *testing.T) {
 	require.Equal(t, []byte(`"0xffffffffffffffff"`), ToHex(math.MaxUint64, false))
 	require.Equal(t, []byte(`<0xffffffffffffffff>`), ToHex(math.MaxUint64, true))
 }
+
+func TestMaxStaleness(t *testing.T) {
+	d, err := ExtractMaxStaleness(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, d)
+
+	ctx := AttachMaxStaleness(context.Background(), 5*time.Second)
+	d, err = ExtractMaxStaleness(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, d)
+
+	ctx = metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(MaxStalenessKey, "soon"))
+	_, err = ExtractMaxStaleness(ctx)
+	require.Error(t, err)
+}
+
+func TestLinearizable(t *testing.T) {
+	linearizable, err := ExtractLinearizable(context.Background())
+	require.NoError(t, err)
+	require.False(t, linearizable)
+
+	linearizable, err = ExtractLinearizable(AttachLinearizable(context.Background()))
+	require.NoError(t, err)
+	require.True(t, linearizable)
+
+	md := metadata.New(map[string]string{LinearizableKey: "maybe"})
+	_, err = ExtractLinearizable(metadata.NewIncomingContext(context.Background(), md))
+	require.Error(t, err)
+}
+
+func TestIsolation(t *testing.T) {
+	isolation, err := ExtractIsolation(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, pb.Query_SNAPSHOT, isolation)
+
+	for level, want := range map[string]pb.Query_Isolation{
+		"snapshot":       pb.Query_SNAPSHOT,
+		"serializable":   pb.Query_SERIALIZABLE,
+		"read-committed": pb.Query_READ_COMMITTED,
+		"READ_COMMITTED": pb.Query_READ_COMMITTED,
+	} {
+		isolation, err = ExtractIsolation(AttachIsolation(context.Background(), level))
+		require.NoError(t, err)
+		require.Equal(t, want, isolation)
+	}
+
+	_, err = ExtractIsolation(AttachIsolation(context.Background(), "repeatable-read"))
+	require.Error(t, err)
+}